@@ -41,7 +41,10 @@ import (
 	gcpworker "github.com/gardener/gardener-extension-provider-gcp/pkg/controller/worker"
 	"github.com/gardener/gardener-extension-provider-gcp/pkg/features"
 	"github.com/gardener/gardener-extension-provider-gcp/pkg/gcp"
+	gcpclient "github.com/gardener/gardener-extension-provider-gcp/pkg/gcp/client"
+	gcpcontrolplanewebhook "github.com/gardener/gardener-extension-provider-gcp/pkg/webhook/controlplane"
 	gcpseedprovider "github.com/gardener/gardener-extension-provider-gcp/pkg/webhook/seedprovider"
+	gcpshootwebhook "github.com/gardener/gardener-extension-provider-gcp/pkg/webhook/shoot"
 )
 
 // NewControllerManagerCommand creates a new command for running a GCP provider controller.
@@ -215,6 +218,27 @@ func NewControllerManagerCommand(ctx context.Context) *cobra.Command {
 			log.Info("Adding controllers to manager")
 			configFileOpts.Completed().ApplyETCDStorage(&gcpseedprovider.DefaultAddOptions.ETCDStorage)
 			configFileOpts.Completed().ApplyHealthCheckConfig(&healthcheck.DefaultAddOptions.HealthCheckConfig)
+			configFileOpts.Completed().ApplyInfrastructure(&gcpinfrastructure.DefaultAddOptions.Infrastructure)
+			configFileOpts.Completed().ApplyWorker(&gcpworker.DefaultAddOptions.Worker)
+			configFileOpts.Completed().ApplyControlPlane(&gcpcontrolplane.DefaultAddOptions.ControlPlane)
+			configFileOpts.Completed().ApplyBastion(&gcpbastion.DefaultAddOptions.Bastion)
+			configFileOpts.Completed().ApplyNode(&gcpcontrolplanewebhook.DefaultAddOptions.Node)
+			configFileOpts.Completed().ApplyStorageClasses(&gcpshootwebhook.DefaultAddOptions.StorageClasses)
+
+			// all controllers use the same factory, so that their compute clients share one rate limiter.
+			gcpClientOptions := gcpclient.DefaultOptions()
+			configFileOpts.Completed().ApplyAPIEndpoints(&gcpClientOptions.Endpoints)
+			configFileOpts.Completed().ApplyComputeRetry(&gcpClientOptions.Compute.Retry)
+			configFileOpts.Completed().ApplyComputeRateLimit(&gcpClientOptions.Compute.RateLimit)
+			configFileOpts.Completed().ApplyComputeOperationWait(&gcpClientOptions.Compute.OperationWait)
+			gcpClientFactory := gcpclient.New(gcpClientOptions)
+			gcpbackupbucket.DefaultAddOptions.GCPClientFactory = gcpClientFactory
+			gcpbackupentry.DefaultAddOptions.GCPClientFactory = gcpClientFactory
+			gcpbastion.DefaultAddOptions.GCPClientFactory = gcpClientFactory
+			gcpdnsrecord.DefaultAddOptions.GCPClientFactory = gcpClientFactory
+			gcpinfrastructure.DefaultAddOptions.GCPClientFactory = gcpClientFactory
+			gcpworker.DefaultAddOptions.GCPClientFactory = gcpClientFactory
+
 			healthCheckCtrlOpts.Completed().Apply(&healthcheck.DefaultAddOptions.Controller)
 			heartbeatCtrlOpts.Completed().Apply(&heartbeat.DefaultAddOptions)
 			backupBucketCtrlOpts.Completed().Apply(&gcpbackupbucket.DefaultAddOptions.Controller)
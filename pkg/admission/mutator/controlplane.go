@@ -0,0 +1,89 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package mutator
+
+import (
+	"encoding/json"
+	"fmt"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	"github.com/gardener/gardener-extension-provider-gcp/pkg/apis/gcp/v1alpha1"
+	"github.com/gardener/gardener-extension-provider-gcp/pkg/gcp"
+)
+
+const zoneKey = "zone"
+
+// mutateControlPlaneConfig defaults the zone of the control plane config of the given shoot if it is omitted. The zone
+// of the old shoot is kept because it is immutable, otherwise the first zone of the worker pools is used.
+func mutateControlPlaneConfig(shoot, oldShoot *gardencorev1beta1.Shoot) error {
+	if shoot.Spec.Provider.Type != gcp.Type {
+		return nil
+	}
+
+	controlPlaneConfig, err := decodeControlPlaneConfig(shoot.Spec.Provider.ControlPlaneConfig)
+	if err != nil {
+		return fmt.Errorf("could not decode controlPlaneConfig: %w", err)
+	}
+	if zone, ok := controlPlaneConfig[zoneKey].(string); ok && len(zone) > 0 {
+		return nil
+	}
+
+	zone := firstWorkerZone(shoot)
+	if oldShoot != nil {
+		oldControlPlaneConfig, err := decodeControlPlaneConfig(oldShoot.Spec.Provider.ControlPlaneConfig)
+		if err != nil {
+			return fmt.Errorf("could not decode controlPlaneConfig of old shoot: %w", err)
+		}
+		if oldZone, ok := oldControlPlaneConfig[zoneKey].(string); ok && len(oldZone) > 0 {
+			zone = oldZone
+		}
+	}
+	if len(zone) == 0 {
+		return nil
+	}
+
+	controlPlaneConfig[zoneKey] = zone
+	if controlPlaneConfig["apiVersion"] == nil {
+		controlPlaneConfig["apiVersion"] = v1alpha1.SchemeGroupVersion.String()
+		controlPlaneConfig["kind"] = "ControlPlaneConfig"
+	}
+
+	modifiedJSON, err := json.Marshal(controlPlaneConfig)
+	if err != nil {
+		return err
+	}
+	shoot.Spec.Provider.ControlPlaneConfig = &runtime.RawExtension{
+		Raw: modifiedJSON,
+	}
+
+	return nil
+}
+
+// firstWorkerZone returns the first zone of the worker pools of the given shoot or an empty string if no worker pool
+// has a zone.
+func firstWorkerZone(shoot *gardencorev1beta1.Shoot) string {
+	for _, pool := range shoot.Spec.Provider.Workers {
+		if len(pool.Zones) > 0 {
+			return pool.Zones[0]
+		}
+	}
+	return ""
+}
+
+func decodeControlPlaneConfig(providerConfig *runtime.RawExtension) (map[string]interface{}, error) {
+	controlPlaneConfig := map[string]interface{}{}
+	if providerConfig == nil || providerConfig.Raw == nil {
+		return controlPlaneConfig, nil
+	}
+	if err := json.Unmarshal(providerConfig.Raw, &controlPlaneConfig); err != nil {
+		return nil, err
+	}
+	if controlPlaneConfig == nil {
+		return map[string]interface{}{}, nil
+	}
+	return controlPlaneConfig, nil
+}
@@ -109,7 +109,11 @@ func (s *shoot) Mutate(_ context.Context, newObj, oldObj client.Object) error {
 		}
 	}
 
-	return nil
+	if err := mutateControlPlaneConfig(shoot, oldShoot); err != nil {
+		return err
+	}
+
+	return mutateWorkers(shoot)
 }
 
 func (s *shoot) decodeNetworkConfig(network *runtime.RawExtension) (map[string]interface{}, error) {
@@ -194,5 +194,118 @@ var _ = Describe("Shoot mutator", func() {
 			})
 
 		})
+
+		Context("Mutate control plane providerconfig", func() {
+			BeforeEach(func() {
+				shoot.Spec.Provider.Workers[0].Zones = []string{"us-west1-b", "us-west1-a"}
+			})
+
+			It("should default the zone to the first worker zone", func() {
+				Expect(shootMutator.Mutate(ctx, shoot, nil)).To(Succeed())
+				Expect(shoot.Spec.Provider.ControlPlaneConfig).To(Equal(&runtime.RawExtension{
+					Raw: []byte(`{"apiVersion":"gcp.provider.extensions.gardener.cloud/v1alpha1","kind":"ControlPlaneConfig","zone":"us-west1-b"}`),
+				}))
+			})
+
+			It("should not overwrite a configured zone", func() {
+				shoot.Spec.Provider.ControlPlaneConfig = &runtime.RawExtension{
+					Raw: []byte(`{"apiVersion":"gcp.provider.extensions.gardener.cloud/v1alpha1","kind":"ControlPlaneConfig","zone":"us-west1-a"}`),
+				}
+				controlPlaneConfigExpected := shoot.Spec.Provider.ControlPlaneConfig.DeepCopy()
+
+				Expect(shootMutator.Mutate(ctx, shoot, nil)).To(Succeed())
+				Expect(shoot.Spec.Provider.ControlPlaneConfig).To(Equal(controlPlaneConfigExpected))
+			})
+
+			It("should take the zone from the old shoot when unspecified in the new shoot", func() {
+				oldShoot.Spec.Provider.ControlPlaneConfig = &runtime.RawExtension{
+					Raw: []byte(`{"apiVersion":"gcp.provider.extensions.gardener.cloud/v1alpha1","kind":"ControlPlaneConfig","zone":"us-west1-a"}`),
+				}
+				shoot.Spec.Provider.ControlPlaneConfig = &runtime.RawExtension{
+					Raw: []byte(`{"apiVersion":"gcp.provider.extensions.gardener.cloud/v1alpha1","kind":"ControlPlaneConfig"}`),
+				}
+
+				Expect(shootMutator.Mutate(ctx, shoot, oldShoot)).To(Succeed())
+				Expect(shoot.Spec.Provider.ControlPlaneConfig).To(Equal(&runtime.RawExtension{
+					Raw: []byte(`{"apiVersion":"gcp.provider.extensions.gardener.cloud/v1alpha1","kind":"ControlPlaneConfig","zone":"us-west1-a"}`),
+				}))
+			})
+
+			It("should not add a providerconfig if no worker pool has a zone", func() {
+				shoot.Spec.Provider.Workers[0].Zones = nil
+
+				Expect(shootMutator.Mutate(ctx, shoot, nil)).To(Succeed())
+				Expect(shoot.Spec.Provider.ControlPlaneConfig).To(BeNil())
+			})
+		})
+
+		Context("Mutate worker pool providerconfig", func() {
+			It("should not add a providerconfig to worker pools without local SSDs or service account", func() {
+				Expect(shootMutator.Mutate(ctx, shoot, nil)).To(Succeed())
+				Expect(shoot.Spec.Provider.Workers[0].ProviderConfig).To(BeNil())
+			})
+
+			It("should default the local SSD interface for SCRATCH volumes", func() {
+				shoot.Spec.Provider.Workers[0].DataVolumes = []gardencorev1beta1.DataVolume{{Name: "scratch", Type: ptr.To("SCRATCH")}}
+
+				Expect(shootMutator.Mutate(ctx, shoot, nil)).To(Succeed())
+				Expect(shoot.Spec.Provider.Workers[0].ProviderConfig).To(Equal(&runtime.RawExtension{
+					Raw: []byte(`{"apiVersion":"gcp.provider.extensions.gardener.cloud/v1alpha1","kind":"WorkerConfig","volume":{"interface":"NVME"}}`),
+				}))
+			})
+
+			It("should default the local SSD interface for local SSDs", func() {
+				shoot.Spec.Provider.Workers[0].ProviderConfig = &runtime.RawExtension{
+					Raw: []byte(`{"apiVersion":"gcp.provider.extensions.gardener.cloud/v1alpha1","kind":"WorkerConfig","volume":{"localSSDCount":2}}`),
+				}
+
+				Expect(shootMutator.Mutate(ctx, shoot, nil)).To(Succeed())
+				Expect(shoot.Spec.Provider.Workers[0].ProviderConfig).To(Equal(&runtime.RawExtension{
+					Raw: []byte(`{"apiVersion":"gcp.provider.extensions.gardener.cloud/v1alpha1","kind":"WorkerConfig","volume":{"interface":"NVME","localSSDCount":2}}`),
+				}))
+			})
+
+			It("should not overwrite a configured local SSD interface", func() {
+				shoot.Spec.Provider.Workers[0].DataVolumes = []gardencorev1beta1.DataVolume{{Name: "scratch", Type: ptr.To("SCRATCH")}}
+				shoot.Spec.Provider.Workers[0].ProviderConfig = &runtime.RawExtension{
+					Raw: []byte(`{"apiVersion":"gcp.provider.extensions.gardener.cloud/v1alpha1","kind":"WorkerConfig","volume":{"interface":"SCSI"}}`),
+				}
+				workersExpected := shoot.DeepCopy().Spec.Provider.Workers
+
+				Expect(shootMutator.Mutate(ctx, shoot, nil)).To(Succeed())
+				Expect(shoot.Spec.Provider.Workers).To(DeepEqual(workersExpected))
+			})
+
+			It("should default the scopes of a service account without scopes", func() {
+				shoot.Spec.Provider.Workers[0].ProviderConfig = &runtime.RawExtension{
+					Raw: []byte(`{"apiVersion":"gcp.provider.extensions.gardener.cloud/v1alpha1","kind":"WorkerConfig","serviceAccount":{"email":"foo@bar.iam.gserviceaccount.com"}}`),
+				}
+
+				Expect(shootMutator.Mutate(ctx, shoot, nil)).To(Succeed())
+				Expect(shoot.Spec.Provider.Workers[0].ProviderConfig).To(Equal(&runtime.RawExtension{
+					Raw: []byte(`{"apiVersion":"gcp.provider.extensions.gardener.cloud/v1alpha1","kind":"WorkerConfig","serviceAccount":{"email":"foo@bar.iam.gserviceaccount.com","scopes":["https://www.googleapis.com/auth/compute"]}}`),
+				}))
+			})
+
+			It("should be idempotent", func() {
+				shoot.Spec.Provider.Workers[0].DataVolumes = []gardencorev1beta1.DataVolume{{Name: "scratch", Type: ptr.To("SCRATCH")}}
+				shoot.Spec.Provider.Workers[0].ProviderConfig = &runtime.RawExtension{
+					Raw: []byte(`{"apiVersion":"gcp.provider.extensions.gardener.cloud/v1alpha1","kind":"WorkerConfig","serviceAccount":{"email":"foo@bar.iam.gserviceaccount.com"}}`),
+				}
+
+				Expect(shootMutator.Mutate(ctx, shoot, nil)).To(Succeed())
+				shootExpected := shoot.DeepCopy()
+				Expect(shootMutator.Mutate(ctx, shoot, nil)).To(Succeed())
+				Expect(shoot).To(DeepEqual(shootExpected))
+			})
+
+			It("should not mutate worker pools of shoots of other providers", func() {
+				shoot.Spec.Provider.Type = "aws"
+				shoot.Spec.Provider.Workers[0].DataVolumes = []gardencorev1beta1.DataVolume{{Name: "scratch", Type: ptr.To("SCRATCH")}}
+
+				Expect(shootMutator.Mutate(ctx, shoot, nil)).To(Succeed())
+				Expect(shoot.Spec.Provider.Workers[0].ProviderConfig).To(BeNil())
+			})
+		})
 	})
 })
@@ -0,0 +1,129 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package mutator
+
+import (
+	"encoding/json"
+	"fmt"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	computev1 "google.golang.org/api/compute/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	"github.com/gardener/gardener-extension-provider-gcp/pkg/apis/gcp/v1alpha1"
+	"github.com/gardener/gardener-extension-provider-gcp/pkg/controller/worker"
+	"github.com/gardener/gardener-extension-provider-gcp/pkg/gcp"
+)
+
+const (
+	volumeKey            = "volume"
+	localSSDInterfaceKey = "interface"
+	localSSDCountKey     = "localSSDCount"
+	serviceAccountKey    = "serviceAccount"
+	scopesKey            = "scopes"
+
+	// defaultLocalSSDInterface is the interface of local SSDs if the worker pool does not configure one.
+	defaultLocalSSDInterface = "NVME"
+)
+
+// defaultServiceAccountScopes are the scopes of a service account configured by the worker pool without scopes. They
+// match the scopes of the service account which is created by the infrastructure controller.
+var defaultServiceAccountScopes = []interface{}{computev1.ComputeScope}
+
+// mutateWorkers fills in the defaults of the provider configs of the GCP worker pools of the given shoot, so that the
+// configuration used for the machines is explicit. Fields which are already set are never changed.
+func mutateWorkers(shoot *gardencorev1beta1.Shoot) error {
+	if shoot.Spec.Provider.Type != gcp.Type {
+		return nil
+	}
+
+	for i := range shoot.Spec.Provider.Workers {
+		pool := &shoot.Spec.Provider.Workers[i]
+
+		workerConfig, err := decodeWorkerConfig(pool.ProviderConfig)
+		if err != nil {
+			return fmt.Errorf("could not decode providerConfig of worker pool %q: %w", pool.Name, err)
+		}
+
+		mutated := mutateLocalSSDInterface(pool, workerConfig)
+		mutated = mutateServiceAccountScopes(workerConfig) || mutated
+		if !mutated {
+			continue
+		}
+
+		if workerConfig["apiVersion"] == nil {
+			workerConfig["apiVersion"] = v1alpha1.SchemeGroupVersion.String()
+			workerConfig["kind"] = "WorkerConfig"
+		}
+
+		modifiedJSON, err := json.Marshal(workerConfig)
+		if err != nil {
+			return err
+		}
+		pool.ProviderConfig = &runtime.RawExtension{
+			Raw: modifiedJSON,
+		}
+	}
+
+	return nil
+}
+
+// mutateLocalSSDInterface sets the interface of the local SSDs if the worker pool uses SCRATCH data volumes or local
+// SSDs but does not configure the interface. It returns true if the worker config was changed.
+func mutateLocalSSDInterface(pool *gardencorev1beta1.Worker, workerConfig map[string]interface{}) bool {
+	volume, ok := workerConfig[volumeKey].(map[string]interface{})
+	if !ok {
+		volume = map[string]interface{}{}
+	}
+	if volume[localSSDInterfaceKey] != nil {
+		return false
+	}
+
+	usesLocalSSDs := false
+	if count, ok := volume[localSSDCountKey].(float64); ok && count > 0 {
+		usesLocalSSDs = true
+	}
+	for _, dataVolume := range pool.DataVolumes {
+		if dataVolume.Type != nil && *dataVolume.Type == worker.VolumeTypeScratch {
+			usesLocalSSDs = true
+		}
+	}
+	if !usesLocalSSDs {
+		return false
+	}
+
+	volume[localSSDInterfaceKey] = defaultLocalSSDInterface
+	workerConfig[volumeKey] = volume
+	return true
+}
+
+// mutateServiceAccountScopes sets the scopes of the service account if the worker pool configures a service account
+// without scopes. It returns true if the worker config was changed.
+func mutateServiceAccountScopes(workerConfig map[string]interface{}) bool {
+	serviceAccount, ok := workerConfig[serviceAccountKey].(map[string]interface{})
+	if !ok {
+		return false
+	}
+	if scopes, ok := serviceAccount[scopesKey].([]interface{}); ok && len(scopes) > 0 {
+		return false
+	}
+
+	serviceAccount[scopesKey] = defaultServiceAccountScopes
+	return true
+}
+
+func decodeWorkerConfig(providerConfig *runtime.RawExtension) (map[string]interface{}, error) {
+	workerConfig := map[string]interface{}{}
+	if providerConfig == nil || providerConfig.Raw == nil {
+		return workerConfig, nil
+	}
+	if err := json.Unmarshal(providerConfig.Raw, &workerConfig); err != nil {
+		return nil, err
+	}
+	if workerConfig == nil {
+		return map[string]interface{}{}, nil
+	}
+	return workerConfig, nil
+}
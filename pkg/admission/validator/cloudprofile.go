@@ -10,9 +10,12 @@ import (
 
 	extensionswebhook "github.com/gardener/gardener/extensions/pkg/webhook"
 	"github.com/gardener/gardener/pkg/apis/core"
+	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/serializer"
+	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/apimachinery/pkg/util/validation/field"
+	"k8s.io/utils/ptr"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/manager"
 
@@ -47,5 +50,41 @@ func (cp *cloudProfile) Validate(_ context.Context, newObj, _ client.Object) err
 		return err
 	}
 
-	return gcpvalidation.ValidateCloudProfileConfig(cpConfig, cloudProfile.Spec.MachineImages, specPath).ToAggregate()
+	allErrs := gcpvalidation.ValidateCloudProfileConfig(cpConfig, cloudProfile.Spec.MachineImages, specPath)
+	allErrs = append(allErrs, validateMachineTypeArchitectures(cloudProfile.Spec.MachineTypes, cloudProfile.Spec.MachineImages, specPath)...)
+	return allErrs.ToAggregate()
+}
+
+// validateMachineTypeArchitectures validates that every architecture of the machine types is provided by at least one
+// machine image version, as shoots could otherwise select machine types for which no image can be booted.
+func validateMachineTypeArchitectures(machineTypes []core.MachineType, machineImages []core.MachineImage, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	providedArchitectures := sets.New[string]()
+	for _, machineImage := range machineImages {
+		for _, version := range machineImage.Versions {
+			if len(version.Architectures) == 0 {
+				providedArchitectures.Insert(v1beta1constants.ArchitectureAMD64)
+			}
+			providedArchitectures.Insert(version.Architectures...)
+		}
+	}
+
+	for i, machineType := range machineTypes {
+		architecture := ptr.Deref(machineType.Architecture, v1beta1constants.ArchitectureAMD64)
+		if !providedArchitectures.Has(architecture) {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("machineTypes").Index(i).Child("architecture"), architecture,
+				fmt.Sprintf("no version of the machine images %v provides architecture %q", machineImageNames(machineImages), architecture)))
+		}
+	}
+
+	return allErrs
+}
+
+func machineImageNames(machineImages []core.MachineImage) []string {
+	names := make([]string, 0, len(machineImages))
+	for _, machineImage := range machineImages {
+		names = append(names, machineImage.Name)
+	}
+	return names
 }
@@ -0,0 +1,105 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package validator_test
+
+import (
+	"context"
+
+	extensionswebhook "github.com/gardener/gardener/extensions/pkg/webhook"
+	"github.com/gardener/gardener/pkg/apis/core"
+	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
+	"github.com/gardener/gardener/pkg/utils/test"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gstruct"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"k8s.io/utils/ptr"
+
+	"github.com/gardener/gardener-extension-provider-gcp/pkg/admission/validator"
+	"github.com/gardener/gardener-extension-provider-gcp/pkg/apis/gcp/install"
+)
+
+var _ = Describe("CloudProfile Validator", func() {
+	var (
+		ctx = context.Background()
+
+		cloudProfileValidator extensionswebhook.Validator
+		cloudProfile          *core.CloudProfile
+	)
+
+	BeforeEach(func() {
+		scheme := runtime.NewScheme()
+		utilruntime.Must(install.AddToScheme(scheme))
+
+		cloudProfileValidator = validator.NewCloudProfileValidator(&test.FakeManager{Scheme: scheme})
+		cloudProfile = &core.CloudProfile{
+			ObjectMeta: metav1.ObjectMeta{Name: "cloud-profile"},
+			Spec: core.CloudProfileSpec{
+				ProviderConfig: &runtime.RawExtension{Raw: []byte(`{
+"apiVersion":"gcp.provider.extensions.gardener.cloud/v1alpha1",
+"kind":"CloudProfileConfig",
+"machineImages":[{"name":"gardenlinux","versions":[
+  {"version":"1.0.0","image":"projects/gardenlinux/global/images/gardenlinux-amd64","architecture":"amd64"},
+  {"version":"1.0.0","image":"projects/gardenlinux/global/images/gardenlinux-arm64","architecture":"arm64"}
+]}]}`)},
+				MachineImages: []core.MachineImage{{
+					Name: "gardenlinux",
+					Versions: []core.MachineImageVersion{{
+						ExpirableVersion: core.ExpirableVersion{Version: "1.0.0"},
+						Architectures:    []string{v1beta1constants.ArchitectureAMD64, v1beta1constants.ArchitectureARM64},
+					}},
+				}},
+				MachineTypes: []core.MachineType{
+					{Name: "n2-standard-2", Architecture: ptr.To(v1beta1constants.ArchitectureAMD64)},
+					{Name: "t2a-standard-2", Architecture: ptr.To(v1beta1constants.ArchitectureARM64)},
+				},
+			},
+		}
+	})
+
+	Describe("#Validate", func() {
+		It("should succeed if the machine images provide all architectures of the machine types", func() {
+			Expect(cloudProfileValidator.Validate(ctx, cloudProfile, nil)).To(Succeed())
+		})
+
+		It("should fail if no machine image provides the arm64 architecture of a machine type", func() {
+			cloudProfile.Spec.ProviderConfig.Raw = []byte(`{
+"apiVersion":"gcp.provider.extensions.gardener.cloud/v1alpha1",
+"kind":"CloudProfileConfig",
+"machineImages":[{"name":"gardenlinux","versions":[
+  {"version":"1.0.0","image":"projects/gardenlinux/global/images/gardenlinux-amd64","architecture":"amd64"}
+]}]}`)
+			cloudProfile.Spec.MachineImages[0].Versions[0].Architectures = []string{v1beta1constants.ArchitectureAMD64}
+
+			err := cloudProfileValidator.Validate(ctx, cloudProfile, nil)
+			Expect(err).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":     Equal(field.ErrorTypeInvalid),
+				"Field":    Equal("spec.machineTypes[1].architecture"),
+				"BadValue": Equal(v1beta1constants.ArchitectureARM64),
+			}))))
+		})
+
+		It("should fail if no machine image provides the amd64 architecture of machine types without architecture", func() {
+			cloudProfile.Spec.ProviderConfig.Raw = []byte(`{
+"apiVersion":"gcp.provider.extensions.gardener.cloud/v1alpha1",
+"kind":"CloudProfileConfig",
+"machineImages":[{"name":"gardenlinux","versions":[
+  {"version":"1.0.0","image":"projects/gardenlinux/global/images/gardenlinux-arm64","architecture":"arm64"}
+]}]}`)
+			cloudProfile.Spec.MachineImages[0].Versions[0].Architectures = []string{v1beta1constants.ArchitectureARM64}
+			cloudProfile.Spec.MachineTypes[0].Architecture = nil
+
+			err := cloudProfileValidator.Validate(ctx, cloudProfile, nil)
+			Expect(err).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":     Equal(field.ErrorTypeInvalid),
+				"Field":    Equal("spec.machineTypes[0].architecture"),
+				"BadValue": Equal(v1beta1constants.ArchitectureAMD64),
+			}))))
+		})
+	})
+})
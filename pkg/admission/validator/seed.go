@@ -13,6 +13,7 @@ import (
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/serializer"
 	"k8s.io/apimachinery/pkg/util/validation/field"
+	"k8s.io/utils/ptr"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/manager"
 
@@ -78,6 +79,7 @@ func (s *seedValidator) validateCreate(seed *core.Seed) field.ErrorList {
 	}
 
 	allErrs = append(allErrs, gcpvalidation.ValidateBackupBucketConfig(backupBucketConfig, providerConfigfldPath)...)
+	allErrs = append(allErrs, validateBackupBucketEncryptionRegion(seed, backupBucketConfig, providerConfigfldPath)...)
 
 	return allErrs
 }
@@ -109,10 +111,23 @@ func (s *seedValidator) validateUpdate(oldSeed, newSeed *core.Seed) field.ErrorL
 
 	allErrs = append(allErrs, gcpvalidation.ValidateBackupBucketConfig(newBackupBucketConfig, providerConfigfldPath)...)
 	allErrs = append(allErrs, s.validateImmutabilityUpdate(oldBackupBucketConfig, newBackupBucketConfig, providerConfigfldPath)...)
+	allErrs = append(allErrs, gcpvalidation.ValidateBackupBucketConfigUpdate(oldBackupBucketConfig, newBackupBucketConfig, providerConfigfldPath)...)
+	allErrs = append(allErrs, validateBackupBucketEncryptionRegion(newSeed, newBackupBucketConfig, providerConfigfldPath)...)
 
 	return allErrs
 }
 
+// validateBackupBucketEncryptionRegion validates the location of the KMS key against the region of the backup bucket if
+// the location of the bucket is not overridden by the BackupBucketConfig.
+func validateBackupBucketEncryptionRegion(seed *core.Seed, config *gcp.BackupBucketConfig, fldPath *field.Path) field.ErrorList {
+	if config == nil || config.Encryption == nil || config.Location != "" {
+		return nil
+	}
+
+	region := ptr.Deref(seed.Spec.Backup.Region, seed.Spec.Provider.Region)
+	return gcpvalidation.ValidateBackupBucketEncryptionLocation(config.Encryption, region, fldPath.Child("encryption", "kmsKeyName"))
+}
+
 // extractBackupBucketConfig extracts BackupBucketConfig from the Seed.
 func (s *seedValidator) extractBackupBucketConfig(seed *core.Seed, decoder runtime.Decoder) (*gcp.BackupBucketConfig, error) {
 	if seed.Spec.Backup != nil && seed.Spec.Backup.ProviderConfig != nil {
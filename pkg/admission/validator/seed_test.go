@@ -17,6 +17,7 @@ import (
 	. "github.com/onsi/gomega"
 	"go.uber.org/mock/gomock"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/utils/ptr"
 
 	"github.com/gardener/gardener-extension-provider-gcp/pkg/admission/validator"
 	apisgcp "github.com/gardener/gardener-extension-provider-gcp/pkg/apis/gcp"
@@ -183,6 +184,30 @@ var _ = Describe("Seed Validator", func() {
 		)
 	})
 
+	Describe("encryption", func() {
+		generateEncryptedSeed := func(region, kmsKeyName string) *core.Seed {
+			return &core.Seed{
+				Spec: core.SeedSpec{
+					Backup: &core.SeedBackup{
+						Region: ptr.To(region),
+						ProviderConfig: &runtime.RawExtension{
+							Raw: []byte(`{"apiVersion":"gcp.provider.extensions.gardener.cloud/v1alpha1","kind":"BackupBucketConfig","encryption":{"kmsKeyName":"` + kmsKeyName + `"}}`),
+						},
+					},
+				},
+			}
+		}
+
+		It("should allow a KMS key in the region of the backup bucket", func() {
+			Expect(seedValidator.Validate(context.Background(), generateEncryptedSeed("europe-west1", "projects/foo/locations/europe-west1/keyRings/bar/cryptoKeys/baz"), nil)).To(Succeed())
+		})
+
+		It("should forbid a KMS key in another region than the backup bucket", func() {
+			err := seedValidator.Validate(context.Background(), generateEncryptedSeed("europe-west1", "projects/foo/locations/europe-west4/keyRings/bar/cryptoKeys/baz"), nil)
+			Expect(err).To(MatchError(ContainSubstring("must be located in \"europe-west1\"")))
+		})
+	})
+
 	Describe("ValidateCreate", func() {
 		DescribeTable("Valid creation scenarios",
 			func(newSeed *core.Seed) {
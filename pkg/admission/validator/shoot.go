@@ -6,38 +6,69 @@ package validator
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
+	"path"
 	"reflect"
+	"strings"
+	"sync"
 
 	extensionswebhook "github.com/gardener/gardener/extensions/pkg/webhook"
 	"github.com/gardener/gardener/pkg/apis/core"
 	gardencorehelper "github.com/gardener/gardener/pkg/apis/core/helper"
 	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	securityv1alpha1 "github.com/gardener/gardener/pkg/apis/security/v1alpha1"
 	"github.com/gardener/gardener/pkg/utils/gardener"
+	"google.golang.org/api/compute/v1"
+	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/serializer"
 	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/apimachinery/pkg/util/validation/field"
+	"k8s.io/utils/ptr"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/manager"
 
 	"github.com/gardener/gardener-extension-provider-gcp/pkg/admission"
 	apisgcp "github.com/gardener/gardener-extension-provider-gcp/pkg/apis/gcp"
+	gcpapihelper "github.com/gardener/gardener-extension-provider-gcp/pkg/apis/gcp/helper"
 	gcpvalidation "github.com/gardener/gardener-extension-provider-gcp/pkg/apis/gcp/validation"
+	gcpworker "github.com/gardener/gardener-extension-provider-gcp/pkg/controller/worker"
+	"github.com/gardener/gardener-extension-provider-gcp/pkg/gcp"
+	gcpclient "github.com/gardener/gardener-extension-provider-gcp/pkg/gcp/client"
 )
 
+// ComputeClientFunc returns a GCP compute client for the given service account.
+type ComputeClientFunc func(ctx context.Context, serviceAccount *gcp.ServiceAccount) (gcpclient.ComputeClient, error)
+
+// projectComputeClient is a compute client for the GCP project of a shoot.
+type projectComputeClient struct {
+	gcpclient.ComputeClient
+	projectID string
+}
+
 type shoot struct {
-	client         client.Client
-	decoder        runtime.Decoder
-	lenientDecoder runtime.Decoder
+	client           client.Client
+	apiReader        client.Reader
+	decoder          runtime.Decoder
+	lenientDecoder   runtime.Decoder
+	newComputeClient ComputeClientFunc
+	machineTypes     *zoneCache[*compute.MachineType]
+	acceleratorTypes *zoneCache[*compute.AcceleratorType]
+	diskTypes        *zoneCache[*compute.DiskType]
 }
 
 // NewShootValidator returns a new instance of a shoot validator.
-func NewShootValidator(mgr manager.Manager) extensionswebhook.Validator {
+func NewShootValidator(mgr manager.Manager, newComputeClient ComputeClientFunc) extensionswebhook.Validator {
 	return &shoot{
-		client:         mgr.GetClient(),
-		decoder:        serializer.NewCodecFactory(mgr.GetScheme(), serializer.EnableStrict).UniversalDecoder(),
-		lenientDecoder: serializer.NewCodecFactory(mgr.GetScheme()).UniversalDecoder(),
+		client:           mgr.GetClient(),
+		apiReader:        mgr.GetAPIReader(),
+		decoder:          serializer.NewCodecFactory(mgr.GetScheme(), serializer.EnableStrict).UniversalDecoder(),
+		lenientDecoder:   serializer.NewCodecFactory(mgr.GetScheme()).UniversalDecoder(),
+		newComputeClient: newComputeClient,
+		machineTypes:     newZoneCache[*compute.MachineType](),
+		acceleratorTypes: newZoneCache[*compute.AcceleratorType](),
+		diskTypes:        newZoneCache[*compute.DiskType](),
 	}
 }
 
@@ -83,6 +114,24 @@ type validationContext struct {
 	cloudProfileConfig   *apisgcp.CloudProfileConfig
 }
 
+// isOverlayEnabled returns true if the overlay network of the given networking configuration is enabled, which is the
+// default if it is not configured.
+func isOverlayEnabled(networking *core.Networking) bool {
+	if networking == nil || networking.ProviderConfig == nil || networking.ProviderConfig.Raw == nil {
+		return true
+	}
+
+	var networkConfig struct {
+		Overlay *struct {
+			Enabled bool `json:"enabled"`
+		} `json:"overlay"`
+	}
+	if err := json.Unmarshal(networking.ProviderConfig.Raw, &networkConfig); err != nil || networkConfig.Overlay == nil {
+		return true
+	}
+	return networkConfig.Overlay.Enabled
+}
+
 func workersZones(workers []core.Worker) sets.Set[string] {
 	var workerZones = sets.New[string]()
 	for _, worker := range workers {
@@ -119,15 +168,23 @@ func (s *shoot) validateContext(valContext *validationContext) field.ErrorList {
 
 	allErrors = append(allErrors, gcpvalidation.ValidateWorkers(valContext.shoot.Spec.Provider.Workers, workersPath)...)
 	allErrors = append(allErrors, gcpvalidation.ValidateControlPlaneConfig(valContext.controlPlaneConfig, allowedZones, workersZones(valContext.shoot.Spec.Provider.Workers), valContext.shoot.Spec.Kubernetes.Version, controlPlaneConfigPath)...)
+	allErrors = append(allErrors, gcpvalidation.ValidateMaxVolumesPerNode(valContext.controlPlaneConfig.Storage, valContext.shoot.Spec.Provider.Workers, controlPlaneConfigPath.Child("storage", "maxVolumesPerNode"))...)
 
 	// WorkerConfig
+	var regionalDiskReplicaZones []string
 	for i, worker := range valContext.shoot.Spec.Provider.Workers {
 		workerFldPath := workersPath.Index(i)
 		workerConfig, err := admission.DecodeWorkerConfig(s.decoder, worker.ProviderConfig)
 		if err != nil {
 			allErrors = append(allErrors, field.Invalid(workerFldPath.Child("providerConfig"), err, "invalid providerConfig"))
 		} else {
-			allErrors = append(allErrors, gcpvalidation.ValidateWorkerConfig(workerConfig, worker.DataVolumes)...)
+			allErrors = append(allErrors, gcpvalidation.ValidateWorkerConfig(workerConfig, worker.DataVolumes, worker.Machine.Type)...)
+			allErrors = append(allErrors, gcpvalidation.ValidateWorkerConfigEncryptionLocation(workerConfig, valContext.shoot.Spec.Region)...)
+			allErrors = append(allErrors, gcpvalidation.ValidateWorkerConfigIPForwarding(workerConfig, isOverlayEnabled(valContext.shoot.Spec.Networking))...)
+			allErrors = append(allErrors, gcpvalidation.ValidateWorkerRegionalDisks(workerConfig, worker.Zones, &regionalDiskReplicaZones, workerFldPath)...)
+			if workerConfig != nil {
+				allErrors = append(allErrors, gcpvalidation.ValidateWorkerVolumeConfig(workerConfig.Volume, worker.Volume)...)
+			}
 		}
 	}
 
@@ -140,7 +197,19 @@ func (s *shoot) validateCreate(ctx context.Context, shoot *core.Shoot) error {
 		return err
 	}
 
-	return s.validateContext(validationContext).ToAggregate()
+	getComputeClient := sync.OnceValues(func() (*projectComputeClient, error) {
+		return s.computeClientForShoot(ctx, shoot)
+	})
+
+	allErrors := s.validateContext(validationContext)
+	allErrors = append(allErrors, s.validateServiceAccountScopes(validationContext, nil)...)
+	allErrors = append(allErrors, s.validateMachineFamilies(validationContext, nil)...)
+	allErrors = append(allErrors, s.validateMachineTypes(ctx, validationContext, nil, getComputeClient)...)
+	allErrors = append(allErrors, s.validateAccelerators(ctx, validationContext, nil, getComputeClient)...)
+	allErrors = append(allErrors, s.validateDiskTypes(ctx, validationContext, nil, getComputeClient)...)
+	allErrors = append(allErrors, s.validateNatIPNames(ctx, validationContext, nil, getComputeClient)...)
+
+	return allErrors.ToAggregate()
 }
 
 func (s *shoot) validateUpdate(ctx context.Context, oldShoot, currentShoot *core.Shoot) error {
@@ -170,6 +239,16 @@ func (s *shoot) validateUpdate(ctx context.Context, oldShoot, currentShoot *core
 
 	allErrors = append(allErrors, gcpvalidation.ValidateWorkersUpdate(oldValContext.shoot.Spec.Provider.Workers, currentValContext.shoot.Spec.Provider.Workers, workersPath)...)
 	allErrors = append(allErrors, s.validateContext(currentValContext)...)
+	getComputeClient := sync.OnceValues(func() (*projectComputeClient, error) {
+		return s.computeClientForShoot(ctx, currentShoot)
+	})
+
+	allErrors = append(allErrors, s.validateServiceAccountScopes(currentValContext, oldShoot.Spec.Provider.Workers)...)
+	allErrors = append(allErrors, s.validateMachineFamilies(currentValContext, oldShoot.Spec.Provider.Workers)...)
+	allErrors = append(allErrors, s.validateMachineTypes(ctx, currentValContext, oldShoot.Spec.Provider.Workers, getComputeClient)...)
+	allErrors = append(allErrors, s.validateAccelerators(ctx, currentValContext, oldShoot.Spec.Provider.Workers, getComputeClient)...)
+	allErrors = append(allErrors, s.validateDiskTypes(ctx, currentValContext, oldShoot.Spec.Provider.Workers, getComputeClient)...)
+	allErrors = append(allErrors, s.validateNatIPNames(ctx, currentValContext, oldInfrastructureConfig, getComputeClient)...)
 
 	return allErrors.ToAggregate()
 
@@ -221,3 +300,462 @@ func newValidationContext(ctx context.Context, decoder runtime.Decoder, c client
 		cloudProfileConfig:   cloudProfileConfig,
 	}, nil
 }
+
+// validateServiceAccountScopes forbids broad service account scopes unless they are allowed by the cloud profile or
+// have already been used by the same worker pool before.
+func (s *shoot) validateServiceAccountScopes(valContext *validationContext, oldWorkers []core.Worker) field.ErrorList {
+	var (
+		allErrors      = field.ErrorList{}
+		allowAllBroad  = valContext.cloudProfileConfig != nil && ptr.Deref(valContext.cloudProfileConfig.AllowBroadServiceAccountScopes, false)
+		oldPoolsScopes = make(map[string]sets.Set[string], len(oldWorkers))
+	)
+
+	if allowAllBroad {
+		return allErrors
+	}
+
+	for _, worker := range oldWorkers {
+		workerConfig, err := admission.DecodeWorkerConfig(s.lenientDecoder, worker.ProviderConfig)
+		if err != nil || workerConfig == nil || workerConfig.ServiceAccount == nil {
+			continue
+		}
+		oldPoolsScopes[worker.Name] = sets.New(gcpapihelper.NormalizeServiceAccountScopes(workerConfig.ServiceAccount.Scopes)...)
+	}
+
+	for i, worker := range valContext.shoot.Spec.Provider.Workers {
+		// decoding errors are already reported by validateContext
+		workerConfig, err := admission.DecodeWorkerConfig(s.decoder, worker.ProviderConfig)
+		if err != nil || workerConfig == nil {
+			continue
+		}
+		allErrors = append(allErrors, gcpvalidation.ValidateServiceAccountScopes(workerConfig.ServiceAccount, oldPoolsScopes[worker.Name], workersPath.Index(i).Child("providerConfig", "serviceAccount"))...)
+	}
+
+	return allErrors
+}
+
+// validateMachineFamilies checks that the minimum CPU platforms and the volume types of the worker pools are supported
+// by their machine families. Like validateMachineTypes, existing pools are only checked if the respective value or the
+// machine type was changed, so that they are not rejected if the known capabilities of a machine family are corrected.
+func (s *shoot) validateMachineFamilies(valContext *validationContext, oldWorkers []core.Worker) field.ErrorList {
+	var (
+		allErrors        = field.ErrorList{}
+		oldWorkersByName = make(map[string]core.Worker, len(oldWorkers))
+	)
+
+	for _, worker := range oldWorkers {
+		oldWorkersByName[worker.Name] = worker
+	}
+
+	for i, worker := range valContext.shoot.Spec.Provider.Workers {
+		// decoding errors are already reported by validateContext
+		workerConfig, err := admission.DecodeWorkerConfig(s.decoder, worker.ProviderConfig)
+		if err != nil {
+			continue
+		}
+
+		var (
+			workerFldPath     = workersPath.Index(i)
+			sameMachine       bool
+			oldMinCPUPlatform *string
+			oldVolumeTypes    = sets.New[string]()
+		)
+		if oldWorker, ok := oldWorkersByName[worker.Name]; ok {
+			oldWorkerConfig, err := admission.DecodeWorkerConfig(s.lenientDecoder, oldWorker.ProviderConfig)
+			if err == nil && oldWorker.Machine.Type == worker.Machine.Type &&
+				gcpvalidation.MachineFamily(oldWorkerConfig, oldWorker.Machine.Type) == gcpvalidation.MachineFamily(workerConfig, worker.Machine.Type) {
+				sameMachine = true
+				oldVolumeTypes = volumeTypes(oldWorker)
+				if oldWorkerConfig != nil {
+					oldMinCPUPlatform = oldWorkerConfig.MinCpuPlatform
+				}
+			}
+		}
+
+		if workerConfig != nil && (!sameMachine || !ptr.Equal(oldMinCPUPlatform, workerConfig.MinCpuPlatform)) {
+			allErrors = append(allErrors, gcpvalidation.ValidateWorkerMinCPUPlatform(workerConfig, worker.Machine.Type, workerFldPath.Child("providerConfig", "minCpuPlatform"))...)
+		}
+		allErrors = append(allErrors, gcpvalidation.ValidateWorkerVolumeTypes(worker, workerConfig, oldVolumeTypes, workerFldPath)...)
+	}
+
+	return allErrors
+}
+
+// validateMachineTypes checks that the machine types of the worker pools are either declared in the cloud profile or
+// exist in GCP, and that they are offered in all zones of the pools. The GCP lookup is best-effort: it is skipped if the
+// credentials of the shoot cannot be used, and it is only done for zones which were added to a worker pool or whose
+// machine type was changed. Custom machine types are not listed by GCP and hence not checked.
+func (s *shoot) validateMachineTypes(ctx context.Context, valContext *validationContext, oldWorkers []core.Worker, getComputeClient func() (*projectComputeClient, error)) field.ErrorList {
+	var (
+		allErrors        = field.ErrorList{}
+		declared         = sets.New[string]()
+		oldWorkersByName = make(map[string]core.Worker, len(oldWorkers))
+	)
+
+	for _, machineType := range valContext.cloudProfileSpec.MachineTypes {
+		declared.Insert(machineType.Name)
+	}
+	for _, worker := range oldWorkers {
+		oldWorkersByName[worker.Name] = worker
+	}
+
+	for i, worker := range valContext.shoot.Spec.Provider.Workers {
+		machineType := worker.Machine.Type
+		if len(machineType) == 0 || strings.Contains(machineType, "custom-") {
+			continue
+		}
+
+		checkedZones := sets.New[string]()
+		if oldWorker, ok := oldWorkersByName[worker.Name]; ok && oldWorker.Machine.Type == machineType {
+			checkedZones.Insert(oldWorker.Zones...)
+		}
+
+		var (
+			zoneErrors     = field.ErrorList{}
+			lookedUpZones  []string
+			availableZones int
+		)
+		for j, zone := range worker.Zones {
+			if checkedZones.Has(zone) {
+				continue
+			}
+
+			computeClient, err := getComputeClient()
+			if err != nil {
+				logger.V(1).Info("Skipping lookup of machine types in GCP", "shoot", client.ObjectKeyFromObject(valContext.shoot), "reason", err.Error())
+				return allErrors
+			}
+
+			machineTypes, err := s.machineTypes.get(ctx, computeClient.projectID, valContext.shoot.Spec.Region, zone, listMachineTypes(computeClient))
+			if err != nil {
+				logger.V(1).Info("Failed to look up machine types in GCP", "zone", zone, "reason", err.Error())
+				continue
+			}
+			lookedUpZones = append(lookedUpZones, zone)
+			if _, ok := machineTypes[machineType]; ok {
+				availableZones++
+				continue
+			}
+			zoneErrors = append(zoneErrors, field.Invalid(workersPath.Index(i).Child("zones").Index(j), zone, fmt.Sprintf("machine type %q is not available in this zone", machineType)))
+		}
+
+		// A machine type which is neither declared in the cloud profile nor offered in any of the zones most likely does
+		// not exist at all, hence the machine type is reported instead of every single zone.
+		if checkedZones.Len() == 0 && !declared.Has(machineType) && len(lookedUpZones) > 0 && availableZones == 0 {
+			allErrors = append(allErrors, field.Invalid(workersPath.Index(i).Child("machine", "type"), machineType, fmt.Sprintf("machine type is neither declared in the cloud profile nor available in zones %q", lookedUpZones)))
+			continue
+		}
+		allErrors = append(allErrors, zoneErrors...)
+	}
+
+	return allErrors
+}
+
+// validateAccelerators checks that the accelerator types of the worker pools are offered in all zones of the pools and
+// that the accelerator counts are supported. Like validateMachineTypes, the GCP lookup is best-effort and only done for
+// zones which were added to a worker pool or whose GPU configuration or machine type was changed.
+func (s *shoot) validateAccelerators(ctx context.Context, valContext *validationContext, oldWorkers []core.Worker, getComputeClient func() (*projectComputeClient, error)) field.ErrorList {
+	var (
+		allErrors        = field.ErrorList{}
+		oldWorkersByName = make(map[string]core.Worker, len(oldWorkers))
+	)
+
+	for _, worker := range oldWorkers {
+		oldWorkersByName[worker.Name] = worker
+	}
+
+	for i, worker := range valContext.shoot.Spec.Provider.Workers {
+		// decoding errors are already reported by validateContext
+		workerConfig, err := admission.DecodeWorkerConfig(s.decoder, worker.ProviderConfig)
+		if err != nil || workerConfig == nil || workerConfig.GPU == nil || workerConfig.GPU.AcceleratorType == "" {
+			continue
+		}
+		gpu, gpuPath := workerConfig.GPU, workersPath.Index(i).Child("providerConfig", "gpu")
+
+		checkedZones := sets.New[string]()
+		if oldWorker, ok := oldWorkersByName[worker.Name]; ok && oldWorker.Machine.Type == worker.Machine.Type {
+			oldWorkerConfig, err := admission.DecodeWorkerConfig(s.lenientDecoder, oldWorker.ProviderConfig)
+			if err == nil && oldWorkerConfig != nil && oldWorkerConfig.GPU != nil &&
+				oldWorkerConfig.GPU.AcceleratorType == gpu.AcceleratorType && oldWorkerConfig.GPU.Count == gpu.Count {
+				checkedZones.Insert(oldWorker.Zones...)
+			}
+		}
+
+		checkedMachineType := false
+		for _, zone := range worker.Zones {
+			if checkedZones.Has(zone) {
+				continue
+			}
+
+			computeClient, err := getComputeClient()
+			if err != nil {
+				logger.V(1).Info("Skipping lookup of accelerator types in GCP", "shoot", client.ObjectKeyFromObject(valContext.shoot), "reason", err.Error())
+				return allErrors
+			}
+
+			acceleratorTypes, err := s.acceleratorTypes.get(ctx, computeClient.projectID, valContext.shoot.Spec.Region, zone, listAcceleratorTypes(computeClient))
+			if err != nil {
+				logger.V(1).Info("Failed to look up accelerator types in GCP", "zone", zone, "reason", err.Error())
+				continue
+			}
+			acceleratorType, ok := acceleratorTypes[gpu.AcceleratorType]
+			if !ok {
+				allErrors = append(allErrors, field.Invalid(gpuPath.Child("acceleratorType"), gpu.AcceleratorType, fmt.Sprintf("accelerator type is not offered in zone %q", zone)))
+				continue
+			}
+			if maxCount := acceleratorType.MaximumCardsPerInstance; maxCount > 0 && int64(gpu.Count) > maxCount {
+				allErrors = append(allErrors, field.Invalid(gpuPath.Child("count"), gpu.Count, fmt.Sprintf("at most %d accelerators of type %q can be attached to a VM in zone %q", maxCount, gpu.AcceleratorType, zone)))
+			}
+
+			// Accelerator-optimized machine types come with a fixed number of accelerators, which is the same in all zones.
+			if checkedMachineType {
+				continue
+			}
+			machineTypes, err := s.machineTypes.get(ctx, computeClient.projectID, valContext.shoot.Spec.Region, zone, listMachineTypes(computeClient))
+			if err != nil {
+				continue
+			}
+			if machineType, ok := machineTypes[worker.Machine.Type]; ok {
+				checkedMachineType = true
+				for _, accelerator := range machineType.Accelerators {
+					if accelerator.GuestAcceleratorType == gpu.AcceleratorType && accelerator.GuestAcceleratorCount != int64(gpu.Count) {
+						allErrors = append(allErrors, field.Invalid(gpuPath.Child("count"), gpu.Count, fmt.Sprintf("machine type %q comes with %d accelerators of type %q", worker.Machine.Type, accelerator.GuestAcceleratorCount, gpu.AcceleratorType)))
+					}
+				}
+			}
+		}
+	}
+
+	return allErrors
+}
+
+// validateNatIPNames checks that the external IP addresses referenced by the CloudNAT configuration exist in the region
+// of the shoot and are not used by others than the cloud router of the shoot. Like validateMachineTypes, the GCP lookup
+// is best-effort and only done for addresses which were added to the configuration. The infrastructure controller
+// checks the addresses again before they are used.
+func (s *shoot) validateNatIPNames(ctx context.Context, valContext *validationContext, oldInfrastructureConfig *apisgcp.InfrastructureConfig, getComputeClient func() (*projectComputeClient, error)) field.ErrorList {
+	var (
+		allErrors = field.ErrorList{}
+		networks  = valContext.infrastructureConfig.Networks
+		oldNames  = sets.New[string]()
+	)
+
+	if networks.CloudNAT == nil || len(networks.CloudNAT.NatIPNames) == 0 {
+		return allErrors
+	}
+	if oldInfrastructureConfig != nil && oldInfrastructureConfig.Networks.CloudNAT != nil {
+		for _, natIP := range oldInfrastructureConfig.Networks.CloudNAT.NatIPNames {
+			oldNames.Insert(natIP.Name)
+		}
+	}
+
+	// The cloud router of new shoots does not exist yet, hence it cannot use any address.
+	var cloudRouterName string
+	if technicalID := valContext.shoot.Status.TechnicalID; len(technicalID) > 0 {
+		cloudRouterName = technicalID + "-cloud-router"
+	}
+	if networks.VPC != nil && networks.VPC.CloudRouter != nil && len(networks.VPC.CloudRouter.Name) > 0 {
+		cloudRouterName = networks.VPC.CloudRouter.Name
+	}
+
+	for i, natIP := range networks.CloudNAT.NatIPNames {
+		if len(natIP.Name) == 0 || oldNames.Has(natIP.Name) {
+			continue
+		}
+
+		computeClient, err := getComputeClient()
+		if err != nil {
+			logger.V(1).Info("Skipping lookup of NAT IP addresses in GCP", "shoot", client.ObjectKeyFromObject(valContext.shoot), "reason", err.Error())
+			return allErrors
+		}
+
+		natIPNamePath := infrastructureConfigPath.Child("networks", "cloudNAT", "natIPNames").Index(i).Child("name")
+		address, err := computeClient.GetAddress(ctx, valContext.shoot.Spec.Region, natIP.Name)
+		if err != nil {
+			logger.V(1).Info("Failed to look up NAT IP address in GCP", "address", natIP.Name, "reason", err.Error())
+			continue
+		}
+		if address == nil {
+			allErrors = append(allErrors, field.NotFound(natIPNamePath, natIP.Name))
+			continue
+		}
+		if address.AddressType != "EXTERNAL" {
+			allErrors = append(allErrors, field.Invalid(natIPNamePath, natIP.Name, fmt.Sprintf("must be an external IP address in region %q", valContext.shoot.Spec.Region)))
+			continue
+		}
+
+		var otherUsers []string
+		for _, user := range address.Users {
+			if userName := path.Base(user); userName != cloudRouterName {
+				otherUsers = append(otherUsers, userName)
+			}
+		}
+		if len(otherUsers) > 0 {
+			allErrors = append(allErrors, field.Invalid(natIPNamePath, natIP.Name, fmt.Sprintf("external IP address is already in use by %s", strings.Join(otherUsers, ","))))
+		}
+	}
+
+	return allErrors
+}
+
+// validateDiskTypes checks that the types of the root and data volumes of the worker pools are offered in all zones of
+// the pools. Like validateMachineTypes, the GCP lookup is best-effort and only done for zones which were added to a
+// worker pool or whose volume types were changed.
+func (s *shoot) validateDiskTypes(ctx context.Context, valContext *validationContext, oldWorkers []core.Worker, getComputeClient func() (*projectComputeClient, error)) field.ErrorList {
+	var (
+		allErrors        = field.ErrorList{}
+		oldWorkersByName = make(map[string]core.Worker, len(oldWorkers))
+	)
+
+	for _, worker := range oldWorkers {
+		oldWorkersByName[worker.Name] = worker
+	}
+
+	for i, worker := range valContext.shoot.Spec.Provider.Workers {
+		workerPath := workersPath.Index(i)
+		volumeTypePaths := map[string]*field.Path{}
+		if worker.Volume != nil && worker.Volume.Type != nil {
+			volumeTypePaths[*worker.Volume.Type] = workerPath.Child("volume", "type")
+		}
+		for j, dataVolume := range worker.DataVolumes {
+			if dataVolume.Type == nil || *dataVolume.Type == gcpworker.VolumeTypeScratch {
+				continue
+			}
+			if _, ok := volumeTypePaths[*dataVolume.Type]; !ok {
+				volumeTypePaths[*dataVolume.Type] = workerPath.Child("dataVolumes").Index(j).Child("type")
+			}
+		}
+
+		oldWorker, hasOldWorker := oldWorkersByName[worker.Name]
+		oldVolumeTypes := sets.New[string]()
+		if hasOldWorker {
+			oldVolumeTypes = volumeTypes(oldWorker)
+		}
+
+		for _, volumeType := range sets.List(sets.KeySet(volumeTypePaths)) {
+			checkedZones := sets.New[string]()
+			if oldVolumeTypes.Has(volumeType) {
+				checkedZones.Insert(oldWorker.Zones...)
+			}
+
+			for _, zone := range worker.Zones {
+				if checkedZones.Has(zone) {
+					continue
+				}
+
+				computeClient, err := getComputeClient()
+				if err != nil {
+					logger.V(1).Info("Skipping lookup of disk types in GCP", "shoot", client.ObjectKeyFromObject(valContext.shoot), "reason", err.Error())
+					return allErrors
+				}
+
+				diskTypes, err := s.diskTypes.get(ctx, computeClient.projectID, valContext.shoot.Spec.Region, zone, listDiskTypes(computeClient))
+				if err != nil {
+					logger.V(1).Info("Failed to look up disk types in GCP", "zone", zone, "reason", err.Error())
+					continue
+				}
+				if _, ok := diskTypes[volumeType]; !ok {
+					allErrors = append(allErrors, field.Invalid(volumeTypePaths[volumeType], volumeType, fmt.Sprintf("disk type is not available in zone %q", zone)))
+				}
+			}
+		}
+	}
+
+	return allErrors
+}
+
+// volumeTypes returns the types of the root and data volumes of the given worker pool.
+func volumeTypes(worker core.Worker) sets.Set[string] {
+	types := sets.New[string]()
+	if worker.Volume != nil && worker.Volume.Type != nil {
+		types.Insert(*worker.Volume.Type)
+	}
+	for _, dataVolume := range worker.DataVolumes {
+		if dataVolume.Type != nil {
+			types.Insert(*dataVolume.Type)
+		}
+	}
+	return types
+}
+
+func listDiskTypes(computeClient gcpclient.ComputeClient) func(context.Context, string) (map[string]*compute.DiskType, error) {
+	return func(ctx context.Context, zone string) (map[string]*compute.DiskType, error) {
+		diskTypes, err := computeClient.ListDiskTypes(ctx, zone)
+		if err != nil {
+			return nil, err
+		}
+		diskTypesByName := make(map[string]*compute.DiskType, len(diskTypes))
+		for _, diskType := range diskTypes {
+			diskTypesByName[diskType.Name] = diskType
+		}
+		return diskTypesByName, nil
+	}
+}
+
+func listAcceleratorTypes(computeClient gcpclient.ComputeClient) func(context.Context, string) (map[string]*compute.AcceleratorType, error) {
+	return func(ctx context.Context, zone string) (map[string]*compute.AcceleratorType, error) {
+		acceleratorTypes, err := computeClient.ListAcceleratorTypes(ctx, zone)
+		if err != nil {
+			return nil, err
+		}
+		acceleratorTypesByName := make(map[string]*compute.AcceleratorType, len(acceleratorTypes))
+		for _, acceleratorType := range acceleratorTypes {
+			acceleratorTypesByName[acceleratorType.Name] = acceleratorType
+		}
+		return acceleratorTypesByName, nil
+	}
+}
+
+func listMachineTypes(computeClient gcpclient.ComputeClient) func(context.Context, string) (map[string]*compute.MachineType, error) {
+	return func(ctx context.Context, zone string) (map[string]*compute.MachineType, error) {
+		machineTypes, err := computeClient.ListMachineTypes(ctx, zone)
+		if err != nil {
+			return nil, err
+		}
+		machineTypesByName := make(map[string]*compute.MachineType, len(machineTypes))
+		for _, machineType := range machineTypes {
+			machineTypesByName[machineType.Name] = machineType
+		}
+		return machineTypesByName, nil
+	}
+}
+
+func (s *shoot) computeClientForShoot(ctx context.Context, shoot *core.Shoot) (*projectComputeClient, error) {
+	var secretKey client.ObjectKey
+	switch {
+	case shoot.Spec.SecretBindingName != nil:
+		secretBinding := &gardencorev1beta1.SecretBinding{}
+		if err := s.apiReader.Get(ctx, client.ObjectKey{Namespace: shoot.Namespace, Name: *shoot.Spec.SecretBindingName}, secretBinding); err != nil {
+			return nil, err
+		}
+		secretKey = client.ObjectKey{Namespace: secretBinding.SecretRef.Namespace, Name: secretBinding.SecretRef.Name}
+	case shoot.Spec.CredentialsBindingName != nil:
+		credentialsBinding := &securityv1alpha1.CredentialsBinding{}
+		if err := s.apiReader.Get(ctx, client.ObjectKey{Namespace: shoot.Namespace, Name: *shoot.Spec.CredentialsBindingName}, credentialsBinding); err != nil {
+			return nil, err
+		}
+		if credentialsBinding.CredentialsRef.APIVersion != corev1.SchemeGroupVersion.String() || credentialsBinding.CredentialsRef.Kind != "Secret" {
+			return nil, fmt.Errorf("unsupported credentials reference: version %q, kind %q", credentialsBinding.CredentialsRef.APIVersion, credentialsBinding.CredentialsRef.Kind)
+		}
+		secretKey = client.ObjectKey{Namespace: credentialsBinding.CredentialsRef.Namespace, Name: credentialsBinding.CredentialsRef.Name}
+	default:
+		return nil, fmt.Errorf("shoot does not reference any credentials")
+	}
+
+	// Explicitly use the client.Reader to prevent controller-runtime to start Informer for Secrets
+	// under the hood. The latter increases the memory usage of the component.
+	secret := &corev1.Secret{}
+	if err := s.apiReader.Get(ctx, secretKey, secret); err != nil {
+		return nil, err
+	}
+	serviceAccount, err := gcp.GetServiceAccountFromSecret(secret)
+	if err != nil {
+		return nil, err
+	}
+
+	computeClient, err := s.newComputeClient(ctx, serviceAccount)
+	if err != nil {
+		return nil, err
+	}
+	return &projectComputeClient{ComputeClient: computeClient, projectID: serviceAccount.ProjectID}, nil
+}
@@ -7,6 +7,7 @@ package validator_test
 import (
 	"context"
 	"encoding/json"
+	"fmt"
 
 	extensionswebhook "github.com/gardener/gardener/extensions/pkg/webhook"
 	"github.com/gardener/gardener/pkg/apis/core"
@@ -16,7 +17,10 @@ import (
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 	. "github.com/onsi/gomega/gstruct"
+	gomegatypes "github.com/onsi/gomega/types"
 	"go.uber.org/mock/gomock"
+	"google.golang.org/api/compute/v1"
+	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/util/validation/field"
@@ -26,6 +30,9 @@ import (
 	"github.com/gardener/gardener-extension-provider-gcp/pkg/admission/validator"
 	apisgcp "github.com/gardener/gardener-extension-provider-gcp/pkg/apis/gcp"
 	apisgcpv1alpha1 "github.com/gardener/gardener-extension-provider-gcp/pkg/apis/gcp/v1alpha1"
+	"github.com/gardener/gardener-extension-provider-gcp/pkg/gcp"
+	gcpclient "github.com/gardener/gardener-extension-provider-gcp/pkg/gcp/client"
+	mockgcpclient "github.com/gardener/gardener-extension-provider-gcp/pkg/gcp/client/mock"
 )
 
 var _ = Describe("Shoot validator", func() {
@@ -35,11 +42,12 @@ var _ = Describe("Shoot validator", func() {
 		var (
 			shootValidator extensionswebhook.Validator
 
-			ctrl         *gomock.Controller
-			c            *mockclient.MockClient
-			mgr          *mockmanager.MockManager
-			cloudProfile *gardencorev1beta1.CloudProfile
-			shoot        *core.Shoot
+			ctrl          *gomock.Controller
+			c             *mockclient.MockClient
+			mgr           *mockmanager.MockManager
+			computeClient *mockgcpclient.MockComputeClient
+			cloudProfile  *gardencorev1beta1.CloudProfile
+			shoot         *core.Shoot
 
 			ctx = context.Background()
 		)
@@ -57,7 +65,11 @@ var _ = Describe("Shoot validator", func() {
 			mgr = mockmanager.NewMockManager(ctrl)
 			mgr.EXPECT().GetScheme().Return(scheme).Times(2)
 			mgr.EXPECT().GetClient().Return(c)
-			shootValidator = validator.NewShootValidator(mgr)
+			mgr.EXPECT().GetAPIReader().Return(c)
+			computeClient = mockgcpclient.NewMockComputeClient(ctrl)
+			shootValidator = validator.NewShootValidator(mgr, func(_ context.Context, _ *gcp.ServiceAccount) (gcpclient.ComputeClient, error) {
+				return computeClient, nil
+			})
 
 			cloudProfile = &gardencorev1beta1.CloudProfile{
 				ObjectMeta: metav1.ObjectMeta{
@@ -147,6 +159,7 @@ var _ = Describe("Shoot validator", func() {
 					},
 					Zones: []string{"zone1"},
 				}}
+				computeClient.EXPECT().ListDiskTypes(ctx, gomock.Any()).Return([]*compute.DiskType{{Name: "pd-standard"}, {Name: "pd-ssd"}}, nil).AnyTimes()
 			})
 
 			It("should return err when networking is invalid", func() {
@@ -165,6 +178,30 @@ var _ = Describe("Shoot validator", func() {
 				}))))
 			})
 
+			It("should forbid a control plane zone which is not part of the region", func() {
+				c.EXPECT().Get(ctx, client.ObjectKey{Name: "gcp"}, &gardencorev1beta1.CloudProfile{}).SetArg(2, *cloudProfile)
+
+				shoot.Spec.Provider.ControlPlaneConfig = &runtime.RawExtension{
+					Raw: encode(&apisgcpv1alpha1.ControlPlaneConfig{
+						TypeMeta: metav1.TypeMeta{
+							APIVersion: apisgcpv1alpha1.SchemeGroupVersion.String(),
+							Kind:       "ControlPlaneConfig",
+						},
+						Zone: "other-zone",
+					}),
+				}
+
+				err := shootValidator.Validate(ctx, shoot, nil)
+				Expect(err).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":     Equal(field.ErrorTypeNotSupported),
+					"Field":    Equal("spec.provider.controlPlaneConfig.zone"),
+					"BadValue": Equal("other-zone"),
+				})), PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("spec.provider.controlPlaneConfig.zone"),
+				}))))
+			})
+
 			It("should return err with IPv6-only networking", func() {
 				c.EXPECT().Get(ctx, client.ObjectKey{Name: "gcp"}, &gardencorev1beta1.CloudProfile{}).SetArg(2, *cloudProfile)
 
@@ -176,6 +213,493 @@ var _ = Describe("Shoot validator", func() {
 					"Field": Equal("spec.networking.ipFamilies"),
 				}))))
 			})
+
+			It("should forbid disabling IP forwarding if the overlay network is disabled", func() {
+				c.EXPECT().Get(ctx, client.ObjectKey{Name: "gcp"}, &gardencorev1beta1.CloudProfile{}).SetArg(2, *cloudProfile).Times(2)
+
+				shoot.Spec.Provider.Workers[0].ProviderConfig = &runtime.RawExtension{
+					Raw: encode(&apisgcpv1alpha1.WorkerConfig{
+						TypeMeta: metav1.TypeMeta{
+							APIVersion: apisgcpv1alpha1.SchemeGroupVersion.String(),
+							Kind:       "WorkerConfig",
+						},
+						CanIPForward: ptr.To(false),
+					}),
+				}
+				shoot.Spec.Networking.ProviderConfig = &runtime.RawExtension{Raw: []byte(`{"overlay":{"enabled":true}}`)}
+				Expect(shootValidator.Validate(ctx, shoot, nil)).To(Succeed())
+
+				shoot.Spec.Networking.ProviderConfig = &runtime.RawExtension{Raw: []byte(`{"overlay":{"enabled":false}}`)}
+				err := shootValidator.Validate(ctx, shoot, nil)
+				Expect(err).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeForbidden),
+					"Field": Equal("providerConfig.canIPForward"),
+				}))))
+			})
+
+			It("should forbid zones of the worker pool which are not replica zones of its regional disks", func() {
+				c.EXPECT().Get(ctx, client.ObjectKey{Name: "gcp"}, &gardencorev1beta1.CloudProfile{}).SetArg(2, *cloudProfile)
+
+				shoot.Spec.Provider.Workers[0].ProviderConfig = &runtime.RawExtension{
+					Raw: encode(&apisgcpv1alpha1.WorkerConfig{
+						TypeMeta: metav1.TypeMeta{
+							APIVersion: apisgcpv1alpha1.SchemeGroupVersion.String(),
+							Kind:       "WorkerConfig",
+						},
+						Volume: &apisgcpv1alpha1.Volume{
+							Regional: &apisgcpv1alpha1.RegionalDisk{ReplicaZones: []string{"other-zone-a", "other-zone-b"}},
+						},
+					}),
+				}
+				err := shootValidator.Validate(ctx, shoot, nil)
+				Expect(err).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("spec.provider.workers[0].zones[0]"),
+				}))))
+			})
+
+			Context("machine types", func() {
+				var (
+					secretBinding = &gardencorev1beta1.SecretBinding{
+						SecretRef: corev1.SecretReference{Namespace: namespace, Name: "secret"},
+					}
+					secret = &corev1.Secret{
+						Data: map[string][]byte{
+							gcp.ServiceAccountJSONField: []byte(`{"type":"service_account","project_id":"project"}`),
+						},
+					}
+				)
+
+				BeforeEach(func() {
+					cloudProfile.Spec.MachineTypes = []gardencorev1beta1.MachineType{{Name: "n2-standard-4"}, {Name: "e2-standard-4"}}
+					cloudProfile.Spec.Regions[0].Zones = append(cloudProfile.Spec.Regions[0].Zones, gardencorev1beta1.AvailabilityZone{Name: "zone2"})
+					shoot.Spec.SecretBindingName = ptr.To("secret-binding")
+					shoot.Spec.Provider.Workers[0].Zones = []string{"zone1", "zone2"}
+				})
+
+				expectCredentials := func() {
+					c.EXPECT().Get(ctx, client.ObjectKey{Namespace: namespace, Name: "secret-binding"}, &gardencorev1beta1.SecretBinding{}).SetArg(2, *secretBinding)
+					c.EXPECT().Get(ctx, client.ObjectKey{Namespace: namespace, Name: "secret"}, &corev1.Secret{}).SetArg(2, *secret)
+				}
+
+				DescribeTable("should validate that the machine type exists",
+					func(machineType string, matcher gomegatypes.GomegaMatcher) {
+						c.EXPECT().Get(ctx, client.ObjectKey{Name: "gcp"}, &gardencorev1beta1.CloudProfile{}).SetArg(2, *cloudProfile)
+						expectCredentials()
+						computeClient.EXPECT().ListMachineTypes(ctx, "zone1").Return([]*compute.MachineType{{Name: "n2-standard-4"}, {Name: "n2-standard-8"}}, nil)
+						computeClient.EXPECT().ListMachineTypes(ctx, "zone2").Return([]*compute.MachineType{{Name: "n2-standard-4"}, {Name: "n2-standard-8"}}, nil)
+						shoot.Spec.Provider.Workers[0].Machine.Type = machineType
+
+						err := shootValidator.Validate(ctx, shoot, nil)
+						Expect(err).To(matcher)
+					},
+					Entry("declared in the cloud profile", "n2-standard-4", Not(HaveOccurred())),
+					Entry("existing in GCP", "n2-standard-8", Not(HaveOccurred())),
+					Entry("nonexistent", "n2-standrad-8", ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":     Equal(field.ErrorTypeInvalid),
+						"Field":    Equal("spec.provider.workers[0].machine.type"),
+						"BadValue": Equal("n2-standrad-8"),
+					})))),
+				)
+
+				DescribeTable("should validate that the machine type is available in all zones",
+					func(machineType string, matcher gomegatypes.GomegaMatcher) {
+						c.EXPECT().Get(ctx, client.ObjectKey{Name: "gcp"}, &gardencorev1beta1.CloudProfile{}).SetArg(2, *cloudProfile)
+						expectCredentials()
+						computeClient.EXPECT().ListMachineTypes(ctx, "zone1").Return([]*compute.MachineType{{Name: "n2-standard-4"}, {Name: "n2-standard-8"}}, nil)
+						computeClient.EXPECT().ListMachineTypes(ctx, "zone2").Return([]*compute.MachineType{{Name: "n2-standard-4"}}, nil)
+						shoot.Spec.Provider.Workers[0].Machine.Type = machineType
+
+						err := shootValidator.Validate(ctx, shoot, nil)
+						Expect(err).To(matcher)
+					},
+					Entry("available in all zones", "n2-standard-4", Not(HaveOccurred())),
+					Entry("available in some zones", "n2-standard-8", ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":     Equal(field.ErrorTypeInvalid),
+						"Field":    Equal("spec.provider.workers[0].zones[1]"),
+						"BadValue": Equal("zone2"),
+						"Detail":   Equal(`machine type "n2-standard-8" is not available in this zone`),
+					})))),
+					Entry("declared in the cloud profile but not available in any zone", "e2-standard-4", ConsistOf(
+						PointTo(MatchFields(IgnoreExtras, Fields{
+							"Type":  Equal(field.ErrorTypeInvalid),
+							"Field": Equal("spec.provider.workers[0].zones[0]"),
+						})),
+						PointTo(MatchFields(IgnoreExtras, Fields{
+							"Type":  Equal(field.ErrorTypeInvalid),
+							"Field": Equal("spec.provider.workers[0].zones[1]"),
+						})),
+					)),
+				)
+
+				It("should cache the machine types of the zones", func() {
+					c.EXPECT().Get(ctx, client.ObjectKey{Name: "gcp"}, &gardencorev1beta1.CloudProfile{}).SetArg(2, *cloudProfile).Times(2)
+					expectCredentials()
+					expectCredentials()
+					computeClient.EXPECT().ListMachineTypes(ctx, "zone1").Return([]*compute.MachineType{{Name: "n2-standard-4"}}, nil)
+					computeClient.EXPECT().ListMachineTypes(ctx, "zone2").Return([]*compute.MachineType{{Name: "n2-standard-4"}}, nil)
+					shoot.Spec.Provider.Workers[0].Machine.Type = "n2-standard-4"
+
+					Expect(shootValidator.Validate(ctx, shoot, nil)).To(Succeed())
+					Expect(shootValidator.Validate(ctx, shoot, nil)).To(Succeed())
+				})
+
+				It("should not check zones whose lookup failed", func() {
+					c.EXPECT().Get(ctx, client.ObjectKey{Name: "gcp"}, &gardencorev1beta1.CloudProfile{}).SetArg(2, *cloudProfile)
+					expectCredentials()
+					computeClient.EXPECT().ListMachineTypes(ctx, "zone1").Return(nil, fmt.Errorf("rate limit exceeded"))
+					computeClient.EXPECT().ListMachineTypes(ctx, "zone2").Return([]*compute.MachineType{{Name: "n2-standard-4"}}, nil)
+					shoot.Spec.Provider.Workers[0].Machine.Type = "n2-standard-4"
+
+					Expect(shootValidator.Validate(ctx, shoot, nil)).To(Succeed())
+				})
+
+				It("should skip custom machine types", func() {
+					c.EXPECT().Get(ctx, client.ObjectKey{Name: "gcp"}, &gardencorev1beta1.CloudProfile{}).SetArg(2, *cloudProfile)
+					expectCredentials()
+					shoot.Spec.Provider.Workers[0].Machine.Type = "n2-custom-4-16384"
+
+					Expect(shootValidator.Validate(ctx, shoot, nil)).To(Succeed())
+				})
+
+				It("should skip the GCP lookup if the credentials cannot be read", func() {
+					c.EXPECT().Get(ctx, client.ObjectKey{Name: "gcp"}, &gardencorev1beta1.CloudProfile{}).SetArg(2, *cloudProfile)
+					c.EXPECT().Get(ctx, client.ObjectKey{Namespace: namespace, Name: "secret-binding"}, &gardencorev1beta1.SecretBinding{}).Return(fmt.Errorf("forbidden"))
+					shoot.Spec.Provider.Workers[0].Machine.Type = "n2-standard-8"
+
+					Expect(shootValidator.Validate(ctx, shoot, nil)).To(Succeed())
+				})
+
+				It("should not look up unchanged machine types on update", func() {
+					c.EXPECT().Get(ctx, client.ObjectKey{Name: "gcp"}, &gardencorev1beta1.CloudProfile{}).SetArg(2, *cloudProfile).Times(2)
+					shoot.Spec.Provider.Workers[0].Machine.Type = "n2-standard-8"
+
+					Expect(shootValidator.Validate(ctx, shoot, shoot.DeepCopy())).To(Succeed())
+				})
+
+				It("should only look up added zones on update", func() {
+					c.EXPECT().Get(ctx, client.ObjectKey{Name: "gcp"}, &gardencorev1beta1.CloudProfile{}).SetArg(2, *cloudProfile).Times(2)
+					expectCredentials()
+					computeClient.EXPECT().ListMachineTypes(ctx, "zone2").Return([]*compute.MachineType{{Name: "n2-standard-4"}}, nil)
+					shoot.Spec.Provider.Workers[0].Machine.Type = "n2-standard-8"
+					oldShoot := shoot.DeepCopy()
+					oldShoot.Spec.Provider.Workers[0].Zones = []string{"zone1"}
+
+					err := shootValidator.Validate(ctx, shoot, oldShoot)
+					Expect(err).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("spec.provider.workers[0].zones[1]"),
+					}))))
+				})
+
+				Context("disk types", func() {
+					BeforeEach(func() {
+						shoot.Spec.Provider.Workers[0].Machine.Type = "n2-custom-4-16384"
+						shoot.Spec.Provider.Workers[0].DataVolumes = []core.DataVolume{{Name: "data", Type: ptr.To("pd-extreme"), VolumeSize: "100Gi"}}
+					})
+
+					It("should validate that the volume types are available in all zones", func() {
+						c.EXPECT().Get(ctx, client.ObjectKey{Name: "gcp"}, &gardencorev1beta1.CloudProfile{}).SetArg(2, *cloudProfile)
+						expectCredentials()
+
+						err := shootValidator.Validate(ctx, shoot, nil)
+						Expect(err).To(ConsistOf(
+							PointTo(MatchFields(IgnoreExtras, Fields{
+								"Type":     Equal(field.ErrorTypeInvalid),
+								"Field":    Equal("spec.provider.workers[0].dataVolumes[0].type"),
+								"BadValue": Equal("pd-extreme"),
+								"Detail":   Equal(`disk type is not available in zone "zone1"`),
+							})),
+							PointTo(MatchFields(IgnoreExtras, Fields{
+								"Type":   Equal(field.ErrorTypeInvalid),
+								"Field":  Equal("spec.provider.workers[0].dataVolumes[0].type"),
+								"Detail": Equal(`disk type is not available in zone "zone2"`),
+							})),
+						))
+					})
+
+					It("should not look up unchanged volume types on update", func() {
+						c.EXPECT().Get(ctx, client.ObjectKey{Name: "gcp"}, &gardencorev1beta1.CloudProfile{}).SetArg(2, *cloudProfile).Times(2)
+
+						Expect(shootValidator.Validate(ctx, shoot, shoot.DeepCopy())).To(Succeed())
+					})
+
+					It("should reject volume types which are not supported by the machine family", func() {
+						c.EXPECT().Get(ctx, client.ObjectKey{Name: "gcp"}, &gardencorev1beta1.CloudProfile{}).SetArg(2, *cloudProfile).Times(2)
+						shoot.Spec.Provider.Workers[0].Machine.Type = "e2-standard-4"
+						c.EXPECT().Get(ctx, client.ObjectKey{Namespace: namespace, Name: "secret-binding"}, &gardencorev1beta1.SecretBinding{}).Return(fmt.Errorf("forbidden"))
+						oldShoot := shoot.DeepCopy()
+						shoot.Spec.Provider.Workers[0].DataVolumes = []core.DataVolume{{Name: "data", Type: ptr.To("hyperdisk-balanced"), VolumeSize: "100Gi"}}
+
+						err := shootValidator.Validate(ctx, shoot, oldShoot)
+						Expect(err).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+							"Type":     Equal(field.ErrorTypeInvalid),
+							"Field":    Equal("spec.provider.workers[0].dataVolumes[0].type"),
+							"BadValue": Equal("hyperdisk-balanced"),
+							"Detail":   Equal(`is not supported by machine family "e2", supported types are [pd-balanced pd-ssd pd-standard]`),
+						}))))
+					})
+
+					It("should not reject unchanged volume types which are not supported by the machine family", func() {
+						c.EXPECT().Get(ctx, client.ObjectKey{Name: "gcp"}, &gardencorev1beta1.CloudProfile{}).SetArg(2, *cloudProfile).Times(2)
+						shoot.Spec.Provider.Workers[0].Machine.Type = "e2-standard-4"
+						shoot.Spec.Provider.Workers[0].DataVolumes = []core.DataVolume{{Name: "data", Type: ptr.To("hyperdisk-balanced"), VolumeSize: "100Gi"}}
+
+						Expect(shootValidator.Validate(ctx, shoot, shoot.DeepCopy())).To(Succeed())
+					})
+				})
+
+				Context("min CPU platform", func() {
+					minCPUPlatformConfig := func(minCpuPlatform string) *runtime.RawExtension {
+						return &runtime.RawExtension{
+							Raw: encode(&apisgcpv1alpha1.WorkerConfig{
+								TypeMeta: metav1.TypeMeta{
+									APIVersion: apisgcpv1alpha1.SchemeGroupVersion.String(),
+									Kind:       "WorkerConfig",
+								},
+								MinCpuPlatform: &minCpuPlatform,
+							}),
+						}
+					}
+
+					BeforeEach(func() {
+						shoot.Spec.Provider.Workers[0].Machine.Type = "n2-custom-4-16384"
+						shoot.Spec.Provider.Workers[0].ProviderConfig = minCPUPlatformConfig("AMD Milan")
+					})
+
+					It("should reject min CPU platforms which are not supported by the machine family", func() {
+						c.EXPECT().Get(ctx, client.ObjectKey{Name: "gcp"}, &gardencorev1beta1.CloudProfile{}).SetArg(2, *cloudProfile)
+						expectCredentials()
+
+						err := shootValidator.Validate(ctx, shoot, nil)
+						Expect(err).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+							"Type":     Equal(field.ErrorTypeNotSupported),
+							"Field":    Equal("spec.provider.workers[0].providerConfig.minCpuPlatform"),
+							"BadValue": Equal("AMD Milan"),
+						}))))
+					})
+
+					It("should allow the automatic min CPU platform", func() {
+						c.EXPECT().Get(ctx, client.ObjectKey{Name: "gcp"}, &gardencorev1beta1.CloudProfile{}).SetArg(2, *cloudProfile)
+						expectCredentials()
+						shoot.Spec.Provider.Workers[0].ProviderConfig = minCPUPlatformConfig("Automatic")
+
+						Expect(shootValidator.Validate(ctx, shoot, nil)).To(Succeed())
+					})
+
+					It("should not reject an unchanged min CPU platform on update", func() {
+						c.EXPECT().Get(ctx, client.ObjectKey{Name: "gcp"}, &gardencorev1beta1.CloudProfile{}).SetArg(2, *cloudProfile).Times(2)
+
+						Expect(shootValidator.Validate(ctx, shoot, shoot.DeepCopy())).To(Succeed())
+					})
+
+					It("should reject an unsupported min CPU platform if the machine type is changed", func() {
+						c.EXPECT().Get(ctx, client.ObjectKey{Name: "gcp"}, &gardencorev1beta1.CloudProfile{}).SetArg(2, *cloudProfile).Times(2)
+						oldShoot := shoot.DeepCopy()
+						shoot.Spec.Provider.Workers[0].Machine.Type = "n2-custom-8-32768"
+
+						err := shootValidator.Validate(ctx, shoot, oldShoot)
+						Expect(err).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+							"Type":  Equal(field.ErrorTypeNotSupported),
+							"Field": Equal("spec.provider.workers[0].providerConfig.minCpuPlatform"),
+						}))))
+					})
+				})
+
+				Context("accelerators", func() {
+					gpuConfig := func(acceleratorType string, count int32) *runtime.RawExtension {
+						return &runtime.RawExtension{
+							Raw: encode(&apisgcpv1alpha1.WorkerConfig{
+								TypeMeta: metav1.TypeMeta{
+									APIVersion: apisgcpv1alpha1.SchemeGroupVersion.String(),
+									Kind:       "WorkerConfig",
+								},
+								GPU: &apisgcpv1alpha1.GPU{
+									AcceleratorType: acceleratorType,
+									Count:           count,
+								},
+							}),
+						}
+					}
+
+					DescribeTable("should validate that the accelerator type is offered in all zones",
+						func(machineType, acceleratorType string, count int32, matcher gomegatypes.GomegaMatcher) {
+							c.EXPECT().Get(ctx, client.ObjectKey{Name: "gcp"}, &gardencorev1beta1.CloudProfile{}).SetArg(2, *cloudProfile)
+							expectCredentials()
+							machineTypes := []*compute.MachineType{
+								{Name: "n1-standard-4"},
+								{Name: "a2-highgpu-1g", Accelerators: []*compute.MachineTypeAccelerators{{GuestAcceleratorType: "nvidia-tesla-a100", GuestAcceleratorCount: 1}}},
+							}
+							computeClient.EXPECT().ListMachineTypes(ctx, "zone1").Return(machineTypes, nil)
+							computeClient.EXPECT().ListMachineTypes(ctx, "zone2").Return(machineTypes, nil)
+							computeClient.EXPECT().ListAcceleratorTypes(ctx, "zone1").Return([]*compute.AcceleratorType{
+								{Name: "nvidia-tesla-t4", MaximumCardsPerInstance: 4},
+								{Name: "nvidia-tesla-a100", MaximumCardsPerInstance: 16},
+							}, nil)
+							computeClient.EXPECT().ListAcceleratorTypes(ctx, "zone2").Return([]*compute.AcceleratorType{
+								{Name: "nvidia-tesla-a100", MaximumCardsPerInstance: 16},
+							}, nil)
+							shoot.Spec.Provider.Workers[0].Machine.Type = machineType
+							shoot.Spec.Provider.Workers[0].ProviderConfig = gpuConfig(acceleratorType, count)
+
+							err := shootValidator.Validate(ctx, shoot, nil)
+							Expect(err).To(matcher)
+						},
+						Entry("offered in all zones", "n1-standard-4", "nvidia-tesla-a100", int32(2), Not(HaveOccurred())),
+						Entry("matching the accelerators of the machine type", "a2-highgpu-1g", "nvidia-tesla-a100", int32(1), Not(HaveOccurred())),
+						Entry("not offered in a zone", "n1-standard-4", "nvidia-tesla-t4", int32(1), ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+							"Type":   Equal(field.ErrorTypeInvalid),
+							"Field":  Equal("spec.provider.workers[0].providerConfig.gpu.acceleratorType"),
+							"Detail": Equal(`accelerator type is not offered in zone "zone2"`),
+						})))),
+						Entry("exceeding the maximum count", "n1-standard-4", "nvidia-tesla-t4", int32(8), ConsistOf(
+							PointTo(MatchFields(IgnoreExtras, Fields{
+								"Type":   Equal(field.ErrorTypeInvalid),
+								"Field":  Equal("spec.provider.workers[0].providerConfig.gpu.count"),
+								"Detail": Equal(`at most 4 accelerators of type "nvidia-tesla-t4" can be attached to a VM in zone "zone1"`),
+							})),
+							PointTo(MatchFields(IgnoreExtras, Fields{
+								"Type":  Equal(field.ErrorTypeInvalid),
+								"Field": Equal("spec.provider.workers[0].providerConfig.gpu.acceleratorType"),
+							})),
+						)),
+						Entry("not matching the accelerators of the machine type", "a2-highgpu-1g", "nvidia-tesla-a100", int32(2), ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+							"Type":   Equal(field.ErrorTypeInvalid),
+							"Field":  Equal("spec.provider.workers[0].providerConfig.gpu.count"),
+							"Detail": Equal(`machine type "a2-highgpu-1g" comes with 1 accelerators of type "nvidia-tesla-a100"`),
+						})))),
+					)
+
+					It("should not look up unchanged accelerators on update", func() {
+						c.EXPECT().Get(ctx, client.ObjectKey{Name: "gcp"}, &gardencorev1beta1.CloudProfile{}).SetArg(2, *cloudProfile).Times(2)
+						shoot.Spec.Provider.Workers[0].Machine.Type = "n1-standard-4"
+						shoot.Spec.Provider.Workers[0].ProviderConfig = gpuConfig("nvidia-tesla-t4", 1)
+
+						Expect(shootValidator.Validate(ctx, shoot, shoot.DeepCopy())).To(Succeed())
+					})
+				})
+			})
+
+			Context("NAT IP names", func() {
+				BeforeEach(func() {
+					shoot.Spec.SecretBindingName = ptr.To("secret-binding")
+					shoot.Spec.Provider.InfrastructureConfig = &runtime.RawExtension{
+						Raw: encode(&apisgcpv1alpha1.InfrastructureConfig{
+							TypeMeta: metav1.TypeMeta{
+								APIVersion: apisgcpv1alpha1.SchemeGroupVersion.String(),
+								Kind:       "InfrastructureConfig",
+							},
+							Networks: apisgcpv1alpha1.NetworkConfig{
+								Workers: "10.250.0.0/16",
+								CloudNAT: &apisgcpv1alpha1.CloudNAT{
+									NatIPNames: []apisgcpv1alpha1.NatIPName{{Name: "nat-ip"}},
+								},
+							},
+						}),
+					}
+				})
+
+				expectCredentials := func() {
+					c.EXPECT().Get(ctx, client.ObjectKey{Namespace: namespace, Name: "secret-binding"}, &gardencorev1beta1.SecretBinding{}).SetArg(2, gardencorev1beta1.SecretBinding{
+						SecretRef: corev1.SecretReference{Namespace: namespace, Name: "secret"},
+					})
+					c.EXPECT().Get(ctx, client.ObjectKey{Namespace: namespace, Name: "secret"}, &corev1.Secret{}).SetArg(2, corev1.Secret{
+						Data: map[string][]byte{
+							gcp.ServiceAccountJSONField: []byte(`{"type":"service_account","project_id":"project"}`),
+						},
+					})
+				}
+
+				DescribeTable("should validate the referenced addresses",
+					func(address *compute.Address, matcher gomegatypes.GomegaMatcher) {
+						c.EXPECT().Get(ctx, client.ObjectKey{Name: "gcp"}, &gardencorev1beta1.CloudProfile{}).SetArg(2, *cloudProfile)
+						expectCredentials()
+						computeClient.EXPECT().GetAddress(ctx, "us-west", "nat-ip").Return(address, nil)
+						shoot.Status.TechnicalID = "shoot--dev--foo"
+
+						Expect(shootValidator.Validate(ctx, shoot, nil)).To(matcher)
+					},
+					Entry("unused address", &compute.Address{Name: "nat-ip", AddressType: "EXTERNAL", Status: "RESERVED"}, Succeed()),
+					Entry("address used by the cloud router of the shoot", &compute.Address{Name: "nat-ip", AddressType: "EXTERNAL", Status: "IN_USE", Users: []string{
+						"https://www.googleapis.com/compute/v1/projects/project/regions/us-west/routers/shoot--dev--foo-cloud-router",
+					}}, Succeed()),
+					Entry("missing address", nil, ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeNotFound),
+						"Field": Equal("spec.provider.infrastructureConfig.networks.cloudNAT.natIPNames[0].name"),
+					})))),
+					Entry("internal address", &compute.Address{Name: "nat-ip", AddressType: "INTERNAL"}, ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":   Equal(field.ErrorTypeInvalid),
+						"Field":  Equal("spec.provider.infrastructureConfig.networks.cloudNAT.natIPNames[0].name"),
+						"Detail": Equal(`must be an external IP address in region "us-west"`),
+					})))),
+					Entry("address used elsewhere", &compute.Address{Name: "nat-ip", AddressType: "EXTERNAL", Status: "IN_USE", Users: []string{
+						"https://www.googleapis.com/compute/v1/projects/project/zones/zone1/instances/vm",
+					}}, ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":   Equal(field.ErrorTypeInvalid),
+						"Field":  Equal("spec.provider.infrastructureConfig.networks.cloudNAT.natIPNames[0].name"),
+						"Detail": Equal("external IP address is already in use by vm"),
+					})))),
+				)
+
+				It("should skip the GCP lookup if the credentials cannot be read", func() {
+					c.EXPECT().Get(ctx, client.ObjectKey{Name: "gcp"}, &gardencorev1beta1.CloudProfile{}).SetArg(2, *cloudProfile)
+					c.EXPECT().Get(ctx, client.ObjectKey{Namespace: namespace, Name: "secret-binding"}, &gardencorev1beta1.SecretBinding{}).Return(fmt.Errorf("forbidden"))
+
+					Expect(shootValidator.Validate(ctx, shoot, nil)).To(Succeed())
+				})
+
+				It("should not look up unchanged addresses on update", func() {
+					c.EXPECT().Get(ctx, client.ObjectKey{Name: "gcp"}, &gardencorev1beta1.CloudProfile{}).SetArg(2, *cloudProfile).Times(2)
+
+					Expect(shootValidator.Validate(ctx, shoot, shoot.DeepCopy())).To(Succeed())
+				})
+			})
+
+			Context("service account scopes", func() {
+				BeforeEach(func() {
+					shoot.Spec.Provider.Workers[0].ProviderConfig = &runtime.RawExtension{
+						Raw: encode(&apisgcpv1alpha1.WorkerConfig{
+							TypeMeta: metav1.TypeMeta{
+								APIVersion: apisgcpv1alpha1.SchemeGroupVersion.String(),
+								Kind:       "WorkerConfig",
+							},
+							ServiceAccount: &apisgcpv1alpha1.ServiceAccount{
+								Email:  "foo@bar.iam.gserviceaccount.com",
+								Scopes: []string{"https://www.googleapis.com/auth/compute", "https://www.googleapis.com/auth/cloud-platform"},
+							},
+						}),
+					}
+				})
+
+				It("should forbid broad scopes", func() {
+					c.EXPECT().Get(ctx, client.ObjectKey{Name: "gcp"}, &gardencorev1beta1.CloudProfile{}).SetArg(2, *cloudProfile)
+
+					err := shootValidator.Validate(ctx, shoot, nil)
+					Expect(err).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeForbidden),
+						"Field": Equal("spec.provider.workers[0].providerConfig.serviceAccount.scopes[1]"),
+					}))))
+				})
+
+				It("should allow broad scopes if the cloud profile allows them", func() {
+					cloudProfile.Spec.ProviderConfig.Raw = encode(&apisgcpv1alpha1.CloudProfileConfig{
+						TypeMeta: metav1.TypeMeta{
+							APIVersion: apisgcpv1alpha1.SchemeGroupVersion.String(),
+							Kind:       "CloudProfileConfig",
+						},
+						AllowBroadServiceAccountScopes: ptr.To(true),
+					})
+					c.EXPECT().Get(ctx, client.ObjectKey{Name: "gcp"}, &gardencorev1beta1.CloudProfile{}).SetArg(2, *cloudProfile)
+
+					Expect(shootValidator.Validate(ctx, shoot, nil)).To(Succeed())
+				})
+
+				It("should allow broad scopes already used by the worker pool", func() {
+					c.EXPECT().Get(ctx, client.ObjectKey{Name: "gcp"}, &gardencorev1beta1.CloudProfile{}).SetArg(2, *cloudProfile).Times(2)
+
+					Expect(shootValidator.Validate(ctx, shoot, shoot.DeepCopy())).To(Succeed())
+				})
+			})
 		})
 	})
 })
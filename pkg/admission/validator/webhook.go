@@ -5,6 +5,8 @@
 package validator
 
 import (
+	"context"
+
 	extensionswebhook "github.com/gardener/gardener/extensions/pkg/webhook"
 	"github.com/gardener/gardener/pkg/apis/core"
 	"github.com/gardener/gardener/pkg/apis/security"
@@ -14,6 +16,7 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/manager"
 
 	"github.com/gardener/gardener-extension-provider-gcp/pkg/gcp"
+	gcpclient "github.com/gardener/gardener-extension-provider-gcp/pkg/gcp/client"
 )
 
 const (
@@ -25,6 +28,13 @@ const (
 
 var logger = log.Log.WithName("gcp-validator-webhook")
 
+// newComputeClient returns a compute client using the public endpoint of the Compute API and the default compute
+// options. The client options of the controller configuration only apply to the seeds, the admission controller runs
+// in the garden cluster.
+func newComputeClient(ctx context.Context, serviceAccount *gcp.ServiceAccount) (gcpclient.ComputeClient, error) {
+	return gcpclient.NewComputeClient(ctx, serviceAccount, "", gcpclient.DefaultComputeOptions())
+}
+
 // New creates a new validation webhook for `core.gardener.cloud` and `security.gardener.cloud` resources.
 func New(mgr manager.Manager) (*extensionswebhook.Webhook, error) {
 	logger.Info("Setting up webhook", "name", Name)
@@ -34,12 +44,12 @@ func New(mgr manager.Manager) (*extensionswebhook.Webhook, error) {
 		Name:     Name,
 		Path:     "/webhooks/validate",
 		Validators: map[extensionswebhook.Validator][]extensionswebhook.Type{
-			NewShootValidator(mgr):                  {{Obj: &core.Shoot{}}},
-			NewCloudProfileValidator(mgr):           {{Obj: &core.CloudProfile{}}},
-			NewNamespacedCloudProfileValidator(mgr): {{Obj: &core.NamespacedCloudProfile{}}},
-			NewSecretBindingValidator(mgr):          {{Obj: &core.SecretBinding{}}},
-			NewCredentialsBindingValidator(mgr):     {{Obj: &security.CredentialsBinding{}}},
-			NewSeedValidator(mgr):                   {{Obj: &core.Seed{}}},
+			NewShootValidator(mgr, newComputeClient): {{Obj: &core.Shoot{}}},
+			NewCloudProfileValidator(mgr):            {{Obj: &core.CloudProfile{}}},
+			NewNamespacedCloudProfileValidator(mgr):  {{Obj: &core.NamespacedCloudProfile{}}},
+			NewSecretBindingValidator(mgr):           {{Obj: &core.SecretBinding{}}},
+			NewCredentialsBindingValidator(mgr):      {{Obj: &security.CredentialsBinding{}}},
+			NewSeedValidator(mgr):                    {{Obj: &core.Seed{}}},
 		},
 		Target: extensionswebhook.TargetSeed,
 		ObjectSelector: &metav1.LabelSelector{
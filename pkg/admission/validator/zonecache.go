@@ -0,0 +1,88 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package validator
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// zoneCacheTTL is the duration after which the cached resources of a region are looked up again.
+const zoneCacheTTL = time.Hour
+
+// zoneCache caches zonal GCP resources, e.g. the machine types offered in a zone, per project and region. The offerings
+// of GCP rarely change, hence the cache avoids looking them up for every admission request and running into rate limits.
+// They are cached per project, as they may differ between projects, e.g. due to organization policies or quotas.
+type zoneCache[T any] struct {
+	lock    sync.Mutex
+	now     func() time.Time
+	regions map[zoneCacheKey]*zoneCacheEntry[T]
+}
+
+type zoneCacheKey struct {
+	projectID string
+	region    string
+}
+
+type zoneCacheEntry[T any] struct {
+	expiresAt time.Time
+	zones     map[string]map[string]T
+}
+
+func newZoneCache[T any]() *zoneCache[T] {
+	return &zoneCache[T]{
+		now:     time.Now,
+		regions: make(map[zoneCacheKey]*zoneCacheEntry[T]),
+	}
+}
+
+// get returns the resources of the given zone of the given project mapped to their names. They are looked up with the
+// given list function if they are not cached yet or the cached resources of the region are expired. Errors are not cached. The lock is not
+// held during the lookup, so that slow GCP requests do not block admission requests for other zones. Concurrent lookups
+// of the same zone may hence be done more than once.
+func (c *zoneCache[T]) get(ctx context.Context, projectID, region, zone string, list func(context.Context, string) (map[string]T, error)) (map[string]T, error) {
+	key := zoneCacheKey{projectID: projectID, region: region}
+	if resources, ok := c.lookup(key, zone); ok {
+		return resources, nil
+	}
+
+	resources, err := list(ctx, zone)
+	if err != nil {
+		return nil, err
+	}
+
+	c.store(key, zone, resources)
+	return resources, nil
+}
+
+func (c *zoneCache[T]) lookup(key zoneCacheKey, zone string) (map[string]T, bool) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	entry, ok := c.regions[key]
+	if !ok || c.now().After(entry.expiresAt) {
+		return nil, false
+	}
+
+	resources, ok := entry.zones[zone]
+	return resources, ok
+}
+
+func (c *zoneCache[T]) store(key zoneCacheKey, zone string, resources map[string]T) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	entry, ok := c.regions[key]
+	if !ok || c.now().After(entry.expiresAt) {
+		entry = &zoneCacheEntry[T]{
+			expiresAt: c.now().Add(zoneCacheTTL),
+			zones:     make(map[string]map[string]T),
+		}
+		c.regions[key] = entry
+	}
+
+	entry.zones[zone] = resources
+}
@@ -0,0 +1,75 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package validator
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("zoneCache", func() {
+	var (
+		ctx   = context.TODO()
+		now   time.Time
+		cache *zoneCache[string]
+		calls map[string]int
+	)
+
+	list := func(_ context.Context, zone string) (map[string]string, error) {
+		calls[zone]++
+		return map[string]string{"foo": zone}, nil
+	}
+
+	BeforeEach(func() {
+		now = time.Now()
+		cache = newZoneCache[string]()
+		cache.now = func() time.Time { return now }
+		calls = map[string]int{}
+	})
+
+	It("should cache the resources of a zone until the region expires", func() {
+		Expect(cache.get(ctx, "project", "region", "zone1", list)).To(HaveKeyWithValue("foo", "zone1"))
+		Expect(cache.get(ctx, "project", "region", "zone1", list)).To(HaveKeyWithValue("foo", "zone1"))
+		Expect(calls).To(Equal(map[string]int{"zone1": 1}))
+
+		now = now.Add(zoneCacheTTL + time.Second)
+		Expect(cache.get(ctx, "project", "region", "zone1", list)).To(HaveKeyWithValue("foo", "zone1"))
+		Expect(calls).To(Equal(map[string]int{"zone1": 2}))
+	})
+
+	It("should not cache errors", func() {
+		_, err := cache.get(ctx, "project", "region", "zone1", func(context.Context, string) (map[string]string, error) {
+			return nil, fmt.Errorf("rate limit exceeded")
+		})
+		Expect(err).To(MatchError("rate limit exceeded"))
+
+		Expect(cache.get(ctx, "project", "region", "zone1", list)).To(HaveKeyWithValue("foo", "zone1"))
+		Expect(calls).To(Equal(map[string]int{"zone1": 1}))
+	})
+
+	It("should not hold the lock during the lookup", func() {
+		Expect(cache.get(ctx, "project", "region", "zone1", func(ctx context.Context, zone string) (map[string]string, error) {
+			Expect(cache.get(ctx, "project", "region", "zone2", list)).To(HaveKeyWithValue("foo", "zone2"))
+			return list(ctx, zone)
+		})).To(HaveKeyWithValue("foo", "zone1"))
+
+		Expect(cache.get(ctx, "project", "region", "zone2", list)).To(HaveKeyWithValue("foo", "zone2"))
+		Expect(calls).To(Equal(map[string]int{"zone1": 1, "zone2": 1}))
+	})
+
+	It("should cache the resources per project", func() {
+		Expect(cache.get(ctx, "project", "region", "zone1", list)).To(HaveKeyWithValue("foo", "zone1"))
+		Expect(cache.get(ctx, "other-project", "region", "zone1", list)).To(HaveKeyWithValue("foo", "zone1"))
+		Expect(calls).To(Equal(map[string]int{"zone1": 2}))
+
+		Expect(cache.get(ctx, "project", "region", "zone1", list)).To(HaveKeyWithValue("foo", "zone1"))
+		Expect(cache.get(ctx, "other-project", "region", "zone1", list)).To(HaveKeyWithValue("foo", "zone1"))
+		Expect(calls).To(Equal(map[string]int{"zone1": 2}))
+	})
+})
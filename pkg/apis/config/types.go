@@ -28,8 +28,210 @@ type ControllerConfiguration struct {
 	// or disable alpha/experimental features.
 	// Default: nil
 	FeatureGates map[string]bool
+	// Infrastructure is the configuration for the infrastructure controller.
+	Infrastructure *Infrastructure
+	// Worker is the configuration for the worker controller.
+	Worker *Worker
+	// ControlPlane is the configuration for the controlplane controller.
+	ControlPlane *ControlPlane
+	// Bastion is the configuration for the bastion controller.
+	Bastion *Bastion
+	// APIEndpoints overrides the endpoints of the GCP APIs used by the controllers, e.g. for restricted or private
+	// endpoints in air-gapped or VPC Service Controls environments.
+	APIEndpoints *APIEndpoints
+	// ComputeRetry configures the retries of calls to the Compute Engine API which fail with transient errors.
+	ComputeRetry *ComputeRetry
+	// ComputeRateLimit configures the pacing of calls to the Compute Engine API.
+	ComputeRateLimit *ComputeRateLimit
+	// ComputeOperationWait configures how long and how often operations of the Compute Engine API are polled.
+	ComputeOperationWait *ComputeOperationWait
+	// Node configures the operating system of the shoot nodes, which is managed by the controlplane webhook.
+	Node *Node
+	// StorageClasses configures the mutation of the storage classes which are created in the shoot clusters, which is
+	// done by the shoot webhook.
+	StorageClasses *StorageClasses
 }
 
+// StorageClasses configures the mutation of the storage classes in the shoot clusters.
+type StorageClasses struct {
+	// WaitForFirstConsumer switches storage classes of the GCP PD CSI driver which are created with the `Immediate`
+	// volume binding mode to `WaitForFirstConsumer`, so that zonal disks are provisioned in the zone of the pod using
+	// them. Defaults to false.
+	WaitForFirstConsumer *bool
+}
+
+// Node configures the operating system of the shoot nodes.
+type Node struct {
+	// Sysctls are additional kernel parameters which are set on all nodes. Only allow-listed parameters are supported.
+	Sysctls map[string]string
+	// GuestAgent configures the network settings of the Google guest agent on the nodes.
+	GuestAgent *GuestAgent
+}
+
+// GuestAgent configures the network settings of the Google guest agent, which are written to the
+// /etc/default/instance_configs.cfg file of the nodes.
+type GuestAgent struct {
+	// IPAliases controls whether the guest agent adds local routes for the alias IP ranges of the node. Native routing
+	// of the pod network requires them to be disabled. Defaults to false.
+	IPAliases *bool
+	// IPForwarding controls whether the guest agent adds local routes for the forwarded IPs of the node, e.g. of
+	// internal load balancers. If unset, the default of the guest agent is used.
+	IPForwarding *bool
+	// SetupNetwork controls whether the guest agent sets up the network interfaces of the node. If unset, the default
+	// of the guest agent is used.
+	SetupNetwork *bool
+}
+
+// ComputeOperationWait configures how the operations of the Compute Engine API are polled until they complete. The
+// interval between two polls grows exponentially.
+type ComputeOperationWait struct {
+	// InitialInterval is the time waited before an operation is polled again for the first time.
+	InitialInterval *metav1.Duration
+	// MaxInterval is the maximum time waited between two polls.
+	MaxInterval *metav1.Duration
+	// Timeout is the maximum time waited for the completion of an operation.
+	Timeout *metav1.Duration
+}
+
+// ComputeRateLimit configures the token buckets which pace the mutating and list calls to the Compute Engine API. There
+// is one bucket per project and region which is shared by all reconciliations.
+type ComputeRateLimit struct {
+	// QPS is the number of calls per second the bucket is refilled with. A value of 0 disables the pacing.
+	QPS *float32
+	// Burst is the size of the bucket, i.e. the number of calls which may be made at once.
+	Burst *int
+}
+
+// ComputeRetry configures the retries of calls to the Compute Engine API which fail with transient errors, i.e. rate
+// limits and temporary server-side failures. The waiting time between the attempts grows exponentially.
+type ComputeRetry struct {
+	// MaxAttempts is the maximum number of attempts of a call, including the first one. A value of 1 disables retries.
+	MaxAttempts *int
+	// InitialInterval is the time waited before the first retry.
+	InitialInterval *metav1.Duration
+	// MaxInterval is the maximum time waited before a retry.
+	MaxInterval *metav1.Duration
+}
+
+// APIEndpoints contains the endpoints of the GCP APIs. The public endpoint of an API is used if its endpoint is not set.
+type APIEndpoints struct {
+	// Compute is the endpoint of the Compute Engine API, e.g. `https://compute.googleapis.com/compute/v1/`.
+	Compute *string
+	// IAM is the endpoint of the IAM API, e.g. `https://iam.googleapis.com/`.
+	IAM *string
+	// DNS is the endpoint of the Cloud DNS API, e.g. `https://dns.googleapis.com/dns/v1/`.
+	DNS *string
+	// Storage is the endpoint of the Cloud Storage JSON API, e.g. `https://storage.googleapis.com/storage/v1/`.
+	Storage *string
+	// NetworkConnectivity is the endpoint of the Network Connectivity API, e.g.
+	// `https://networkconnectivity.googleapis.com/`.
+	NetworkConnectivity *string
+	// ResourceManager is the endpoint of the Cloud Resource Manager API, e.g.
+	// `https://cloudresourcemanager.googleapis.com/`.
+	ResourceManager *string
+	// ServiceNetworking is the endpoint of the Service Networking API, e.g.
+	// `https://servicenetworking.googleapis.com/`.
+	ServiceNetworking *string
+}
+
+// ControlPlane is the configuration for the controlplane controller.
+type ControlPlane struct {
+	// ImageRegistry is the registry, optionally followed by a path prefix, from which the cloud-controller-manager and
+	// CSI images are pulled instead of the registries of the image vector, e.g. `registry.example.com/mirror`. The
+	// repository paths of the images without their original registry are appended to it.
+	ImageRegistry *string
+}
+
+// Infrastructure is the configuration for the infrastructure controller.
+type Infrastructure struct {
+	// ConcurrencyLimits maps GCP resource types (e.g. `subnet` or `firewall`) to the maximum number of operations on
+	// resources of that type which are executed concurrently across all infrastructure reconciliations.
+	// Resource types without a limit are not limited.
+	ConcurrencyLimits map[string]int32
+	// CloudNAT contains the defaults of the CloudNATs which are created by the infrastructure controller. They apply if
+	// the InfrastructureConfig of a shoot does not configure the respective field.
+	CloudNAT *CloudNAT
+}
+
+// CloudNAT contains the defaults of the CloudNATs which are created by the infrastructure controller.
+type CloudNAT struct {
+	// MinPortsPerVM is the default minimum number of ports allocated to a VM.
+	MinPortsPerVM *int32
+	// MaxPortsPerVM is the default maximum number of ports allocated to a VM. It only applies if dynamic port
+	// allocation is enabled.
+	MaxPortsPerVM *int32
+	// EnableDynamicPortAllocation is the default of the dynamic port allocation. It only applies to shoots which do not
+	// configure a CloudNAT at all.
+	EnableDynamicPortAllocation *bool
+}
+
+const (
+	// ResourceTypeServiceAccount is the resource type of the service accounts of the infrastructure.
+	ResourceTypeServiceAccount = "serviceaccount"
+	// ResourceTypeVPC is the resource type of the VPC networks of the infrastructure.
+	ResourceTypeVPC = "vpc"
+	// ResourceTypeSubnet is the resource type of the subnets of the infrastructure.
+	ResourceTypeSubnet = "subnet"
+	// ResourceTypeRouter is the resource type of the CloudRouters of the infrastructure.
+	ResourceTypeRouter = "router"
+	// ResourceTypeAddress is the resource type of the IP addresses of the infrastructure.
+	ResourceTypeAddress = "address"
+	// ResourceTypeNAT is the resource type of the CloudNATs of the infrastructure.
+	ResourceTypeNAT = "nat"
+	// ResourceTypeFirewall is the resource type of the firewall rules of the infrastructure.
+	ResourceTypeFirewall = "firewall"
+	// ResourceTypeRoute is the resource type of the routes of the infrastructure.
+	ResourceTypeRoute = "route"
+	// ResourceTypeNCCSpoke is the resource type of the Network Connectivity Center spokes of the infrastructure.
+	ResourceTypeNCCSpoke = "nccspoke"
+)
+
+// Worker is the configuration for the worker controller.
+type Worker struct {
+	// DefaultServiceAccountScopes are the scopes of the service account which is attached to the VMs if the worker pool
+	// does not configure a service account itself. If nil, the `https://www.googleapis.com/auth/compute` scope is used.
+	// An empty list attaches the service account without any scopes.
+	DefaultServiceAccountScopes []string
+	// AllowProjectSSHKeys permits worker pools to allow project-wide SSH keys on their VMs, i.e. to set
+	// `blockProjectSSHKeys: false` in the worker configuration.
+	AllowProjectSSHKeys bool
+}
+
+// Bastion is the configuration for the bastion controller.
+type Bastion struct {
+	// Mode is the way users connect to the bastion instances, either `Public` or `IAP`. Defaults to `Public`.
+	Mode *BastionMode
+	// MachineType is the machine type of the bastion instances, e.g. `e2-micro`. If not set, the machine type is
+	// determined from the bastion section of the cloud profile.
+	MachineType *string
+	// Image is the boot image of the bastion instances, e.g. `projects/debian-cloud/global/images/family/debian-12`.
+	// If not set, the image is determined from the bastion section of the cloud profile.
+	Image *string
+	// DiskSizeGB is the size of the boot disk of the bastion instances in GB. Defaults to 10.
+	DiskSizeGB *int64
+	// DiskType is the type of the boot disk of the bastion instances, e.g. `pd-balanced`. If not set, the default
+	// disk type of GCP is used.
+	DiskType *string
+	// Subnet is the name of an existing subnet in the VPC and region of the shoots in which the bastion instances are
+	// placed, e.g. a dedicated management subnet. If not set, the nodes subnet of the shoot is used.
+	Subnet *string
+	// MaxLifetime is the maximum lifetime of bastions, e.g. `8h`. The SSH ingress of bastions which exist longer, e.g.
+	// because their client crashed before deleting them, is revoked. If not set, the lifetime of bastions is not limited.
+	MaxLifetime *metav1.Duration
+}
+
+// BastionMode is the way users connect to bastion instances.
+type BastionMode string
+
+const (
+	// BastionModePublic creates bastion instances with an external IP which is reachable from the ingress CIDRs of the
+	// Bastion resource.
+	BastionModePublic BastionMode = "Public"
+	// BastionModeIAP creates bastion instances without an external IP. SSH connections are only allowed from the
+	// Identity-Aware Proxy, i.e. users connect to the internal IP through IAP TCP forwarding.
+	BastionModeIAP BastionMode = "IAP"
+)
+
 // ETCD is an etcd configuration.
 type ETCD struct {
 	// ETCDStorage is the etcd storage configuration.
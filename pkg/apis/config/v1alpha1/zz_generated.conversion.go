@@ -16,6 +16,7 @@ import (
 	apisconfig "github.com/gardener/gardener/extensions/pkg/apis/config"
 	apisconfigv1alpha1 "github.com/gardener/gardener/extensions/pkg/apis/config/v1alpha1"
 	resource "k8s.io/apimachinery/pkg/api/resource"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	conversion "k8s.io/apimachinery/pkg/conversion"
 	runtime "k8s.io/apimachinery/pkg/runtime"
 	componentbaseconfig "k8s.io/component-base/config"
@@ -29,6 +30,76 @@ func init() {
 // RegisterConversions adds conversion functions to the given scheme.
 // Public to allow building arbitrary schemes.
 func RegisterConversions(s *runtime.Scheme) error {
+	if err := s.AddGeneratedConversionFunc((*APIEndpoints)(nil), (*config.APIEndpoints)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_APIEndpoints_To_config_APIEndpoints(a.(*APIEndpoints), b.(*config.APIEndpoints), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*config.APIEndpoints)(nil), (*APIEndpoints)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_config_APIEndpoints_To_v1alpha1_APIEndpoints(a.(*config.APIEndpoints), b.(*APIEndpoints), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*Bastion)(nil), (*config.Bastion)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_Bastion_To_config_Bastion(a.(*Bastion), b.(*config.Bastion), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*config.Bastion)(nil), (*Bastion)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_config_Bastion_To_v1alpha1_Bastion(a.(*config.Bastion), b.(*Bastion), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*CloudNAT)(nil), (*config.CloudNAT)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_CloudNAT_To_config_CloudNAT(a.(*CloudNAT), b.(*config.CloudNAT), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*config.CloudNAT)(nil), (*CloudNAT)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_config_CloudNAT_To_v1alpha1_CloudNAT(a.(*config.CloudNAT), b.(*CloudNAT), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*ComputeOperationWait)(nil), (*config.ComputeOperationWait)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_ComputeOperationWait_To_config_ComputeOperationWait(a.(*ComputeOperationWait), b.(*config.ComputeOperationWait), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*config.ComputeOperationWait)(nil), (*ComputeOperationWait)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_config_ComputeOperationWait_To_v1alpha1_ComputeOperationWait(a.(*config.ComputeOperationWait), b.(*ComputeOperationWait), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*ComputeRateLimit)(nil), (*config.ComputeRateLimit)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_ComputeRateLimit_To_config_ComputeRateLimit(a.(*ComputeRateLimit), b.(*config.ComputeRateLimit), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*config.ComputeRateLimit)(nil), (*ComputeRateLimit)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_config_ComputeRateLimit_To_v1alpha1_ComputeRateLimit(a.(*config.ComputeRateLimit), b.(*ComputeRateLimit), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*ComputeRetry)(nil), (*config.ComputeRetry)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_ComputeRetry_To_config_ComputeRetry(a.(*ComputeRetry), b.(*config.ComputeRetry), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*config.ComputeRetry)(nil), (*ComputeRetry)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_config_ComputeRetry_To_v1alpha1_ComputeRetry(a.(*config.ComputeRetry), b.(*ComputeRetry), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*ControlPlane)(nil), (*config.ControlPlane)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_ControlPlane_To_config_ControlPlane(a.(*ControlPlane), b.(*config.ControlPlane), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*config.ControlPlane)(nil), (*ControlPlane)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_config_ControlPlane_To_v1alpha1_ControlPlane(a.(*config.ControlPlane), b.(*ControlPlane), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*ControllerConfiguration)(nil), (*config.ControllerConfiguration)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_ControllerConfiguration_To_config_ControllerConfiguration(a.(*ControllerConfiguration), b.(*config.ControllerConfiguration), scope)
 	}); err != nil {
@@ -69,9 +140,237 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*GuestAgent)(nil), (*config.GuestAgent)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_GuestAgent_To_config_GuestAgent(a.(*GuestAgent), b.(*config.GuestAgent), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*config.GuestAgent)(nil), (*GuestAgent)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_config_GuestAgent_To_v1alpha1_GuestAgent(a.(*config.GuestAgent), b.(*GuestAgent), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*Infrastructure)(nil), (*config.Infrastructure)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_Infrastructure_To_config_Infrastructure(a.(*Infrastructure), b.(*config.Infrastructure), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*config.Infrastructure)(nil), (*Infrastructure)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_config_Infrastructure_To_v1alpha1_Infrastructure(a.(*config.Infrastructure), b.(*Infrastructure), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*Node)(nil), (*config.Node)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_Node_To_config_Node(a.(*Node), b.(*config.Node), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*config.Node)(nil), (*Node)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_config_Node_To_v1alpha1_Node(a.(*config.Node), b.(*Node), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*StorageClasses)(nil), (*config.StorageClasses)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_StorageClasses_To_config_StorageClasses(a.(*StorageClasses), b.(*config.StorageClasses), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*config.StorageClasses)(nil), (*StorageClasses)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_config_StorageClasses_To_v1alpha1_StorageClasses(a.(*config.StorageClasses), b.(*StorageClasses), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*Worker)(nil), (*config.Worker)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_Worker_To_config_Worker(a.(*Worker), b.(*config.Worker), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*config.Worker)(nil), (*Worker)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_config_Worker_To_v1alpha1_Worker(a.(*config.Worker), b.(*Worker), scope)
+	}); err != nil {
+		return err
+	}
+	return nil
+}
+
+func autoConvert_v1alpha1_APIEndpoints_To_config_APIEndpoints(in *APIEndpoints, out *config.APIEndpoints, s conversion.Scope) error {
+	out.Compute = (*string)(unsafe.Pointer(in.Compute))
+	out.IAM = (*string)(unsafe.Pointer(in.IAM))
+	out.DNS = (*string)(unsafe.Pointer(in.DNS))
+	out.Storage = (*string)(unsafe.Pointer(in.Storage))
+	out.NetworkConnectivity = (*string)(unsafe.Pointer(in.NetworkConnectivity))
+	out.ResourceManager = (*string)(unsafe.Pointer(in.ResourceManager))
+	out.ServiceNetworking = (*string)(unsafe.Pointer(in.ServiceNetworking))
+	return nil
+}
+
+// Convert_v1alpha1_APIEndpoints_To_config_APIEndpoints is an autogenerated conversion function.
+func Convert_v1alpha1_APIEndpoints_To_config_APIEndpoints(in *APIEndpoints, out *config.APIEndpoints, s conversion.Scope) error {
+	return autoConvert_v1alpha1_APIEndpoints_To_config_APIEndpoints(in, out, s)
+}
+
+func autoConvert_config_APIEndpoints_To_v1alpha1_APIEndpoints(in *config.APIEndpoints, out *APIEndpoints, s conversion.Scope) error {
+	out.Compute = (*string)(unsafe.Pointer(in.Compute))
+	out.IAM = (*string)(unsafe.Pointer(in.IAM))
+	out.DNS = (*string)(unsafe.Pointer(in.DNS))
+	out.Storage = (*string)(unsafe.Pointer(in.Storage))
+	out.NetworkConnectivity = (*string)(unsafe.Pointer(in.NetworkConnectivity))
+	out.ResourceManager = (*string)(unsafe.Pointer(in.ResourceManager))
+	out.ServiceNetworking = (*string)(unsafe.Pointer(in.ServiceNetworking))
+	return nil
+}
+
+// Convert_config_APIEndpoints_To_v1alpha1_APIEndpoints is an autogenerated conversion function.
+func Convert_config_APIEndpoints_To_v1alpha1_APIEndpoints(in *config.APIEndpoints, out *APIEndpoints, s conversion.Scope) error {
+	return autoConvert_config_APIEndpoints_To_v1alpha1_APIEndpoints(in, out, s)
+}
+
+func autoConvert_v1alpha1_Bastion_To_config_Bastion(in *Bastion, out *config.Bastion, s conversion.Scope) error {
+	out.Mode = (*config.BastionMode)(unsafe.Pointer(in.Mode))
+	out.MachineType = (*string)(unsafe.Pointer(in.MachineType))
+	out.Image = (*string)(unsafe.Pointer(in.Image))
+	out.DiskSizeGB = (*int64)(unsafe.Pointer(in.DiskSizeGB))
+	out.DiskType = (*string)(unsafe.Pointer(in.DiskType))
+	out.Subnet = (*string)(unsafe.Pointer(in.Subnet))
+	out.MaxLifetime = (*v1.Duration)(unsafe.Pointer(in.MaxLifetime))
+	return nil
+}
+
+// Convert_v1alpha1_Bastion_To_config_Bastion is an autogenerated conversion function.
+func Convert_v1alpha1_Bastion_To_config_Bastion(in *Bastion, out *config.Bastion, s conversion.Scope) error {
+	return autoConvert_v1alpha1_Bastion_To_config_Bastion(in, out, s)
+}
+
+func autoConvert_config_Bastion_To_v1alpha1_Bastion(in *config.Bastion, out *Bastion, s conversion.Scope) error {
+	out.Mode = (*BastionMode)(unsafe.Pointer(in.Mode))
+	out.MachineType = (*string)(unsafe.Pointer(in.MachineType))
+	out.Image = (*string)(unsafe.Pointer(in.Image))
+	out.DiskSizeGB = (*int64)(unsafe.Pointer(in.DiskSizeGB))
+	out.DiskType = (*string)(unsafe.Pointer(in.DiskType))
+	out.Subnet = (*string)(unsafe.Pointer(in.Subnet))
+	out.MaxLifetime = (*v1.Duration)(unsafe.Pointer(in.MaxLifetime))
+	return nil
+}
+
+// Convert_config_Bastion_To_v1alpha1_Bastion is an autogenerated conversion function.
+func Convert_config_Bastion_To_v1alpha1_Bastion(in *config.Bastion, out *Bastion, s conversion.Scope) error {
+	return autoConvert_config_Bastion_To_v1alpha1_Bastion(in, out, s)
+}
+
+func autoConvert_v1alpha1_CloudNAT_To_config_CloudNAT(in *CloudNAT, out *config.CloudNAT, s conversion.Scope) error {
+	out.MinPortsPerVM = (*int32)(unsafe.Pointer(in.MinPortsPerVM))
+	out.MaxPortsPerVM = (*int32)(unsafe.Pointer(in.MaxPortsPerVM))
+	out.EnableDynamicPortAllocation = (*bool)(unsafe.Pointer(in.EnableDynamicPortAllocation))
+	return nil
+}
+
+// Convert_v1alpha1_CloudNAT_To_config_CloudNAT is an autogenerated conversion function.
+func Convert_v1alpha1_CloudNAT_To_config_CloudNAT(in *CloudNAT, out *config.CloudNAT, s conversion.Scope) error {
+	return autoConvert_v1alpha1_CloudNAT_To_config_CloudNAT(in, out, s)
+}
+
+func autoConvert_config_CloudNAT_To_v1alpha1_CloudNAT(in *config.CloudNAT, out *CloudNAT, s conversion.Scope) error {
+	out.MinPortsPerVM = (*int32)(unsafe.Pointer(in.MinPortsPerVM))
+	out.MaxPortsPerVM = (*int32)(unsafe.Pointer(in.MaxPortsPerVM))
+	out.EnableDynamicPortAllocation = (*bool)(unsafe.Pointer(in.EnableDynamicPortAllocation))
+	return nil
+}
+
+// Convert_config_CloudNAT_To_v1alpha1_CloudNAT is an autogenerated conversion function.
+func Convert_config_CloudNAT_To_v1alpha1_CloudNAT(in *config.CloudNAT, out *CloudNAT, s conversion.Scope) error {
+	return autoConvert_config_CloudNAT_To_v1alpha1_CloudNAT(in, out, s)
+}
+
+func autoConvert_v1alpha1_ComputeOperationWait_To_config_ComputeOperationWait(in *ComputeOperationWait, out *config.ComputeOperationWait, s conversion.Scope) error {
+	out.InitialInterval = (*v1.Duration)(unsafe.Pointer(in.InitialInterval))
+	out.MaxInterval = (*v1.Duration)(unsafe.Pointer(in.MaxInterval))
+	out.Timeout = (*v1.Duration)(unsafe.Pointer(in.Timeout))
+	return nil
+}
+
+// Convert_v1alpha1_ComputeOperationWait_To_config_ComputeOperationWait is an autogenerated conversion function.
+func Convert_v1alpha1_ComputeOperationWait_To_config_ComputeOperationWait(in *ComputeOperationWait, out *config.ComputeOperationWait, s conversion.Scope) error {
+	return autoConvert_v1alpha1_ComputeOperationWait_To_config_ComputeOperationWait(in, out, s)
+}
+
+func autoConvert_config_ComputeOperationWait_To_v1alpha1_ComputeOperationWait(in *config.ComputeOperationWait, out *ComputeOperationWait, s conversion.Scope) error {
+	out.InitialInterval = (*v1.Duration)(unsafe.Pointer(in.InitialInterval))
+	out.MaxInterval = (*v1.Duration)(unsafe.Pointer(in.MaxInterval))
+	out.Timeout = (*v1.Duration)(unsafe.Pointer(in.Timeout))
+	return nil
+}
+
+// Convert_config_ComputeOperationWait_To_v1alpha1_ComputeOperationWait is an autogenerated conversion function.
+func Convert_config_ComputeOperationWait_To_v1alpha1_ComputeOperationWait(in *config.ComputeOperationWait, out *ComputeOperationWait, s conversion.Scope) error {
+	return autoConvert_config_ComputeOperationWait_To_v1alpha1_ComputeOperationWait(in, out, s)
+}
+
+func autoConvert_v1alpha1_ComputeRateLimit_To_config_ComputeRateLimit(in *ComputeRateLimit, out *config.ComputeRateLimit, s conversion.Scope) error {
+	out.QPS = (*float32)(unsafe.Pointer(in.QPS))
+	out.Burst = (*int)(unsafe.Pointer(in.Burst))
+	return nil
+}
+
+// Convert_v1alpha1_ComputeRateLimit_To_config_ComputeRateLimit is an autogenerated conversion function.
+func Convert_v1alpha1_ComputeRateLimit_To_config_ComputeRateLimit(in *ComputeRateLimit, out *config.ComputeRateLimit, s conversion.Scope) error {
+	return autoConvert_v1alpha1_ComputeRateLimit_To_config_ComputeRateLimit(in, out, s)
+}
+
+func autoConvert_config_ComputeRateLimit_To_v1alpha1_ComputeRateLimit(in *config.ComputeRateLimit, out *ComputeRateLimit, s conversion.Scope) error {
+	out.QPS = (*float32)(unsafe.Pointer(in.QPS))
+	out.Burst = (*int)(unsafe.Pointer(in.Burst))
 	return nil
 }
 
+// Convert_config_ComputeRateLimit_To_v1alpha1_ComputeRateLimit is an autogenerated conversion function.
+func Convert_config_ComputeRateLimit_To_v1alpha1_ComputeRateLimit(in *config.ComputeRateLimit, out *ComputeRateLimit, s conversion.Scope) error {
+	return autoConvert_config_ComputeRateLimit_To_v1alpha1_ComputeRateLimit(in, out, s)
+}
+
+func autoConvert_v1alpha1_ComputeRetry_To_config_ComputeRetry(in *ComputeRetry, out *config.ComputeRetry, s conversion.Scope) error {
+	out.MaxAttempts = (*int)(unsafe.Pointer(in.MaxAttempts))
+	out.InitialInterval = (*v1.Duration)(unsafe.Pointer(in.InitialInterval))
+	out.MaxInterval = (*v1.Duration)(unsafe.Pointer(in.MaxInterval))
+	return nil
+}
+
+// Convert_v1alpha1_ComputeRetry_To_config_ComputeRetry is an autogenerated conversion function.
+func Convert_v1alpha1_ComputeRetry_To_config_ComputeRetry(in *ComputeRetry, out *config.ComputeRetry, s conversion.Scope) error {
+	return autoConvert_v1alpha1_ComputeRetry_To_config_ComputeRetry(in, out, s)
+}
+
+func autoConvert_config_ComputeRetry_To_v1alpha1_ComputeRetry(in *config.ComputeRetry, out *ComputeRetry, s conversion.Scope) error {
+	out.MaxAttempts = (*int)(unsafe.Pointer(in.MaxAttempts))
+	out.InitialInterval = (*v1.Duration)(unsafe.Pointer(in.InitialInterval))
+	out.MaxInterval = (*v1.Duration)(unsafe.Pointer(in.MaxInterval))
+	return nil
+}
+
+// Convert_config_ComputeRetry_To_v1alpha1_ComputeRetry is an autogenerated conversion function.
+func Convert_config_ComputeRetry_To_v1alpha1_ComputeRetry(in *config.ComputeRetry, out *ComputeRetry, s conversion.Scope) error {
+	return autoConvert_config_ComputeRetry_To_v1alpha1_ComputeRetry(in, out, s)
+}
+
+func autoConvert_v1alpha1_ControlPlane_To_config_ControlPlane(in *ControlPlane, out *config.ControlPlane, s conversion.Scope) error {
+	out.ImageRegistry = (*string)(unsafe.Pointer(in.ImageRegistry))
+	return nil
+}
+
+// Convert_v1alpha1_ControlPlane_To_config_ControlPlane is an autogenerated conversion function.
+func Convert_v1alpha1_ControlPlane_To_config_ControlPlane(in *ControlPlane, out *config.ControlPlane, s conversion.Scope) error {
+	return autoConvert_v1alpha1_ControlPlane_To_config_ControlPlane(in, out, s)
+}
+
+func autoConvert_config_ControlPlane_To_v1alpha1_ControlPlane(in *config.ControlPlane, out *ControlPlane, s conversion.Scope) error {
+	out.ImageRegistry = (*string)(unsafe.Pointer(in.ImageRegistry))
+	return nil
+}
+
+// Convert_config_ControlPlane_To_v1alpha1_ControlPlane is an autogenerated conversion function.
+func Convert_config_ControlPlane_To_v1alpha1_ControlPlane(in *config.ControlPlane, out *ControlPlane, s conversion.Scope) error {
+	return autoConvert_config_ControlPlane_To_v1alpha1_ControlPlane(in, out, s)
+}
+
 func autoConvert_v1alpha1_ControllerConfiguration_To_config_ControllerConfiguration(in *ControllerConfiguration, out *config.ControllerConfiguration, s conversion.Scope) error {
 	out.ClientConnection = (*componentbaseconfig.ClientConnectionConfiguration)(unsafe.Pointer(in.ClientConnection))
 	if err := Convert_v1alpha1_ETCD_To_config_ETCD(&in.ETCD, &out.ETCD, s); err != nil {
@@ -79,6 +378,16 @@ func autoConvert_v1alpha1_ControllerConfiguration_To_config_ControllerConfigurat
 	}
 	out.HealthCheckConfig = (*apisconfig.HealthCheckConfig)(unsafe.Pointer(in.HealthCheckConfig))
 	out.FeatureGates = *(*map[string]bool)(unsafe.Pointer(&in.FeatureGates))
+	out.Infrastructure = (*config.Infrastructure)(unsafe.Pointer(in.Infrastructure))
+	out.Worker = (*config.Worker)(unsafe.Pointer(in.Worker))
+	out.ControlPlane = (*config.ControlPlane)(unsafe.Pointer(in.ControlPlane))
+	out.Bastion = (*config.Bastion)(unsafe.Pointer(in.Bastion))
+	out.APIEndpoints = (*config.APIEndpoints)(unsafe.Pointer(in.APIEndpoints))
+	out.ComputeRetry = (*config.ComputeRetry)(unsafe.Pointer(in.ComputeRetry))
+	out.ComputeRateLimit = (*config.ComputeRateLimit)(unsafe.Pointer(in.ComputeRateLimit))
+	out.ComputeOperationWait = (*config.ComputeOperationWait)(unsafe.Pointer(in.ComputeOperationWait))
+	out.Node = (*config.Node)(unsafe.Pointer(in.Node))
+	out.StorageClasses = (*config.StorageClasses)(unsafe.Pointer(in.StorageClasses))
 	return nil
 }
 
@@ -94,6 +403,16 @@ func autoConvert_config_ControllerConfiguration_To_v1alpha1_ControllerConfigurat
 	}
 	out.HealthCheckConfig = (*apisconfigv1alpha1.HealthCheckConfig)(unsafe.Pointer(in.HealthCheckConfig))
 	out.FeatureGates = *(*map[string]bool)(unsafe.Pointer(&in.FeatureGates))
+	out.Infrastructure = (*Infrastructure)(unsafe.Pointer(in.Infrastructure))
+	out.Worker = (*Worker)(unsafe.Pointer(in.Worker))
+	out.ControlPlane = (*ControlPlane)(unsafe.Pointer(in.ControlPlane))
+	out.Bastion = (*Bastion)(unsafe.Pointer(in.Bastion))
+	out.APIEndpoints = (*APIEndpoints)(unsafe.Pointer(in.APIEndpoints))
+	out.ComputeRetry = (*ComputeRetry)(unsafe.Pointer(in.ComputeRetry))
+	out.ComputeRateLimit = (*ComputeRateLimit)(unsafe.Pointer(in.ComputeRateLimit))
+	out.ComputeOperationWait = (*ComputeOperationWait)(unsafe.Pointer(in.ComputeOperationWait))
+	out.Node = (*Node)(unsafe.Pointer(in.Node))
+	out.StorageClasses = (*StorageClasses)(unsafe.Pointer(in.StorageClasses))
 	return nil
 }
 
@@ -173,3 +492,113 @@ func autoConvert_config_ETCDStorage_To_v1alpha1_ETCDStorage(in *config.ETCDStora
 func Convert_config_ETCDStorage_To_v1alpha1_ETCDStorage(in *config.ETCDStorage, out *ETCDStorage, s conversion.Scope) error {
 	return autoConvert_config_ETCDStorage_To_v1alpha1_ETCDStorage(in, out, s)
 }
+
+func autoConvert_v1alpha1_GuestAgent_To_config_GuestAgent(in *GuestAgent, out *config.GuestAgent, s conversion.Scope) error {
+	out.IPAliases = (*bool)(unsafe.Pointer(in.IPAliases))
+	out.IPForwarding = (*bool)(unsafe.Pointer(in.IPForwarding))
+	out.SetupNetwork = (*bool)(unsafe.Pointer(in.SetupNetwork))
+	return nil
+}
+
+// Convert_v1alpha1_GuestAgent_To_config_GuestAgent is an autogenerated conversion function.
+func Convert_v1alpha1_GuestAgent_To_config_GuestAgent(in *GuestAgent, out *config.GuestAgent, s conversion.Scope) error {
+	return autoConvert_v1alpha1_GuestAgent_To_config_GuestAgent(in, out, s)
+}
+
+func autoConvert_config_GuestAgent_To_v1alpha1_GuestAgent(in *config.GuestAgent, out *GuestAgent, s conversion.Scope) error {
+	out.IPAliases = (*bool)(unsafe.Pointer(in.IPAliases))
+	out.IPForwarding = (*bool)(unsafe.Pointer(in.IPForwarding))
+	out.SetupNetwork = (*bool)(unsafe.Pointer(in.SetupNetwork))
+	return nil
+}
+
+// Convert_config_GuestAgent_To_v1alpha1_GuestAgent is an autogenerated conversion function.
+func Convert_config_GuestAgent_To_v1alpha1_GuestAgent(in *config.GuestAgent, out *GuestAgent, s conversion.Scope) error {
+	return autoConvert_config_GuestAgent_To_v1alpha1_GuestAgent(in, out, s)
+}
+
+func autoConvert_v1alpha1_Infrastructure_To_config_Infrastructure(in *Infrastructure, out *config.Infrastructure, s conversion.Scope) error {
+	out.ConcurrencyLimits = *(*map[string]int32)(unsafe.Pointer(&in.ConcurrencyLimits))
+	out.CloudNAT = (*config.CloudNAT)(unsafe.Pointer(in.CloudNAT))
+	return nil
+}
+
+// Convert_v1alpha1_Infrastructure_To_config_Infrastructure is an autogenerated conversion function.
+func Convert_v1alpha1_Infrastructure_To_config_Infrastructure(in *Infrastructure, out *config.Infrastructure, s conversion.Scope) error {
+	return autoConvert_v1alpha1_Infrastructure_To_config_Infrastructure(in, out, s)
+}
+
+func autoConvert_config_Infrastructure_To_v1alpha1_Infrastructure(in *config.Infrastructure, out *Infrastructure, s conversion.Scope) error {
+	out.ConcurrencyLimits = *(*map[string]int32)(unsafe.Pointer(&in.ConcurrencyLimits))
+	out.CloudNAT = (*CloudNAT)(unsafe.Pointer(in.CloudNAT))
+	return nil
+}
+
+// Convert_config_Infrastructure_To_v1alpha1_Infrastructure is an autogenerated conversion function.
+func Convert_config_Infrastructure_To_v1alpha1_Infrastructure(in *config.Infrastructure, out *Infrastructure, s conversion.Scope) error {
+	return autoConvert_config_Infrastructure_To_v1alpha1_Infrastructure(in, out, s)
+}
+
+func autoConvert_v1alpha1_Node_To_config_Node(in *Node, out *config.Node, s conversion.Scope) error {
+	out.Sysctls = *(*map[string]string)(unsafe.Pointer(&in.Sysctls))
+	out.GuestAgent = (*config.GuestAgent)(unsafe.Pointer(in.GuestAgent))
+	return nil
+}
+
+// Convert_v1alpha1_Node_To_config_Node is an autogenerated conversion function.
+func Convert_v1alpha1_Node_To_config_Node(in *Node, out *config.Node, s conversion.Scope) error {
+	return autoConvert_v1alpha1_Node_To_config_Node(in, out, s)
+}
+
+func autoConvert_config_Node_To_v1alpha1_Node(in *config.Node, out *Node, s conversion.Scope) error {
+	out.Sysctls = *(*map[string]string)(unsafe.Pointer(&in.Sysctls))
+	out.GuestAgent = (*GuestAgent)(unsafe.Pointer(in.GuestAgent))
+	return nil
+}
+
+// Convert_config_Node_To_v1alpha1_Node is an autogenerated conversion function.
+func Convert_config_Node_To_v1alpha1_Node(in *config.Node, out *Node, s conversion.Scope) error {
+	return autoConvert_config_Node_To_v1alpha1_Node(in, out, s)
+}
+
+func autoConvert_v1alpha1_StorageClasses_To_config_StorageClasses(in *StorageClasses, out *config.StorageClasses, s conversion.Scope) error {
+	out.WaitForFirstConsumer = (*bool)(unsafe.Pointer(in.WaitForFirstConsumer))
+	return nil
+}
+
+// Convert_v1alpha1_StorageClasses_To_config_StorageClasses is an autogenerated conversion function.
+func Convert_v1alpha1_StorageClasses_To_config_StorageClasses(in *StorageClasses, out *config.StorageClasses, s conversion.Scope) error {
+	return autoConvert_v1alpha1_StorageClasses_To_config_StorageClasses(in, out, s)
+}
+
+func autoConvert_config_StorageClasses_To_v1alpha1_StorageClasses(in *config.StorageClasses, out *StorageClasses, s conversion.Scope) error {
+	out.WaitForFirstConsumer = (*bool)(unsafe.Pointer(in.WaitForFirstConsumer))
+	return nil
+}
+
+// Convert_config_StorageClasses_To_v1alpha1_StorageClasses is an autogenerated conversion function.
+func Convert_config_StorageClasses_To_v1alpha1_StorageClasses(in *config.StorageClasses, out *StorageClasses, s conversion.Scope) error {
+	return autoConvert_config_StorageClasses_To_v1alpha1_StorageClasses(in, out, s)
+}
+
+func autoConvert_v1alpha1_Worker_To_config_Worker(in *Worker, out *config.Worker, s conversion.Scope) error {
+	out.DefaultServiceAccountScopes = *(*[]string)(unsafe.Pointer(&in.DefaultServiceAccountScopes))
+	out.AllowProjectSSHKeys = in.AllowProjectSSHKeys
+	return nil
+}
+
+// Convert_v1alpha1_Worker_To_config_Worker is an autogenerated conversion function.
+func Convert_v1alpha1_Worker_To_config_Worker(in *Worker, out *config.Worker, s conversion.Scope) error {
+	return autoConvert_v1alpha1_Worker_To_config_Worker(in, out, s)
+}
+
+func autoConvert_config_Worker_To_v1alpha1_Worker(in *config.Worker, out *Worker, s conversion.Scope) error {
+	out.DefaultServiceAccountScopes = *(*[]string)(unsafe.Pointer(&in.DefaultServiceAccountScopes))
+	out.AllowProjectSSHKeys = in.AllowProjectSSHKeys
+	return nil
+}
+
+// Convert_config_Worker_To_v1alpha1_Worker is an autogenerated conversion function.
+func Convert_config_Worker_To_v1alpha1_Worker(in *config.Worker, out *Worker, s conversion.Scope) error {
+	return autoConvert_config_Worker_To_v1alpha1_Worker(in, out, s)
+}
@@ -0,0 +1,307 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package validation
+
+import (
+	"fmt"
+	"net/url"
+	"regexp"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"k8s.io/utils/ptr"
+
+	"github.com/gardener/gardener-extension-provider-gcp/pkg/apis/config"
+	gcpvalidation "github.com/gardener/gardener-extension-provider-gcp/pkg/apis/gcp/validation"
+)
+
+var (
+	// bastionMachineTypeRegex matches the names of GCP machine types.
+	bastionMachineTypeRegex = regexp.MustCompile(`^[a-z][-a-z0-9]*$`)
+	// bastionImageRegex matches the paths of GCP images and image families.
+	bastionImageRegex = regexp.MustCompile(`^projects/[a-z][-a-z0-9]*/global/images/(family/)?[a-z]([-a-z0-9]*[a-z0-9])?$`)
+	// bastionDiskTypes are the disk types supported for the boot disks of bastion instances.
+	bastionDiskTypes = sets.New("pd-standard", "pd-balanced", "pd-ssd", "hyperdisk-balanced")
+	// bastionSubnetRegex matches the names of GCP subnets.
+	bastionSubnetRegex = regexp.MustCompile(`^[a-z]([-a-z0-9]{0,61}[a-z0-9])?$`)
+	// bastionModes are the supported ways to connect to bastion instances.
+	bastionModes = sets.New(config.BastionModePublic, config.BastionModeIAP)
+	// nodeSysctls are the kernel parameters which may be set on the nodes in addition to the ones managed by Gardener.
+	nodeSysctls = sets.New(
+		"fs.inotify.max_user_instances",
+		"fs.inotify.max_user_watches",
+		"net.core.netdev_max_backlog",
+		"net.core.rmem_max",
+		"net.core.somaxconn",
+		"net.core.wmem_max",
+		"net.ipv4.ip_local_port_range",
+		"net.ipv4.tcp_keepalive_intvl",
+		"net.ipv4.tcp_keepalive_probes",
+		"net.ipv4.tcp_keepalive_time",
+		"net.ipv4.tcp_max_syn_backlog",
+		"net.netfilter.nf_conntrack_max",
+		"vm.max_map_count",
+	)
+	// infrastructureResourceTypes are the resource types whose concurrency can be limited.
+	infrastructureResourceTypes = sets.New(
+		config.ResourceTypeServiceAccount,
+		config.ResourceTypeVPC,
+		config.ResourceTypeSubnet,
+		config.ResourceTypeRouter,
+		config.ResourceTypeAddress,
+		config.ResourceTypeNAT,
+		config.ResourceTypeFirewall,
+		config.ResourceTypeRoute,
+		config.ResourceTypeNCCSpoke,
+	)
+	// nodeSysctlValueRegex matches one or more whitespace-separated numbers.
+	nodeSysctlValueRegex = regexp.MustCompile(`^[0-9]+([ \t]+[0-9]+)*$`)
+)
+
+const (
+	// minBastionDiskSizeGB and maxBastionDiskSizeGB bound the size of the boot disks of bastion instances.
+	minBastionDiskSizeGB = 10
+	maxBastionDiskSizeGB = 65536
+	// minBastionMaxLifetime is the shortest maximum lifetime of bastions, shorter ones would revoke the SSH ingress of
+	// bastions before users could connect.
+	minBastionMaxLifetime = 10 * time.Minute
+)
+
+// imageRegistryRegex matches a registry host with an optional port, followed by an optional repository path prefix.
+var imageRegistryRegex = regexp.MustCompile(`^[a-zA-Z0-9]([a-zA-Z0-9.-]*[a-zA-Z0-9])?(:[0-9]+)?(/[a-z0-9]+([._-][a-z0-9]+)*)*$`)
+
+// ValidateControllerConfiguration validates the given controller configuration.
+func ValidateControllerConfiguration(cfg *config.ControllerConfiguration) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if cfg.ControlPlane != nil {
+		allErrs = append(allErrs, validateControlPlane(cfg.ControlPlane, field.NewPath("controlPlane"))...)
+	}
+	if cfg.Bastion != nil {
+		allErrs = append(allErrs, validateBastion(cfg.Bastion, field.NewPath("bastion"))...)
+	}
+	if cfg.APIEndpoints != nil {
+		allErrs = append(allErrs, validateAPIEndpoints(cfg.APIEndpoints, field.NewPath("apiEndpoints"))...)
+	}
+	if cfg.ComputeRetry != nil {
+		allErrs = append(allErrs, validateComputeRetry(cfg.ComputeRetry, field.NewPath("computeRetry"))...)
+	}
+	if cfg.ComputeRateLimit != nil {
+		allErrs = append(allErrs, validateComputeRateLimit(cfg.ComputeRateLimit, field.NewPath("computeRateLimit"))...)
+	}
+	if cfg.ComputeOperationWait != nil {
+		allErrs = append(allErrs, validateComputeOperationWait(cfg.ComputeOperationWait, field.NewPath("computeOperationWait"))...)
+	}
+	if cfg.Node != nil {
+		allErrs = append(allErrs, validateNode(cfg.Node, field.NewPath("node"))...)
+	}
+	if cfg.Worker != nil {
+		allErrs = append(allErrs, validateWorker(cfg.Worker, field.NewPath("worker"))...)
+	}
+	if cfg.Infrastructure != nil {
+		allErrs = append(allErrs, validateInfrastructure(cfg.Infrastructure, field.NewPath("infrastructure"))...)
+	}
+
+	return allErrs
+}
+
+func validateAPIEndpoints(endpoints *config.APIEndpoints, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	for name, endpoint := range map[string]*string{
+		"compute":             endpoints.Compute,
+		"iam":                 endpoints.IAM,
+		"dns":                 endpoints.DNS,
+		"storage":             endpoints.Storage,
+		"networkConnectivity": endpoints.NetworkConnectivity,
+		"resourceManager":     endpoints.ResourceManager,
+		"serviceNetworking":   endpoints.ServiceNetworking,
+	} {
+		if endpoint == nil {
+			continue
+		}
+		if u, err := url.Parse(*endpoint); err != nil || u.Scheme != "https" || u.Host == "" || u.User != nil || u.RawQuery != "" || u.Fragment != "" {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child(name), *endpoint, "must be an absolute https URL without credentials, query or fragment, e.g. 'https://compute.example.com/compute/v1/'"))
+		}
+	}
+
+	return allErrs
+}
+
+func validateComputeRetry(retry *config.ComputeRetry, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if retry.MaxAttempts != nil && *retry.MaxAttempts < 1 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("maxAttempts"), *retry.MaxAttempts, "must be at least 1"))
+	}
+	if retry.InitialInterval != nil && retry.InitialInterval.Duration <= 0 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("initialInterval"), retry.InitialInterval.Duration.String(), "must be positive"))
+	}
+	if retry.MaxInterval != nil && retry.MaxInterval.Duration <= 0 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("maxInterval"), retry.MaxInterval.Duration.String(), "must be positive"))
+	}
+	if retry.InitialInterval != nil && retry.MaxInterval != nil && retry.MaxInterval.Duration < retry.InitialInterval.Duration {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("maxInterval"), retry.MaxInterval.Duration.String(), "must not be less than the initial interval"))
+	}
+
+	return allErrs
+}
+
+func validateComputeRateLimit(rateLimit *config.ComputeRateLimit, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if rateLimit.QPS != nil && *rateLimit.QPS < 0 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("qps"), *rateLimit.QPS, "must not be negative"))
+	}
+	if rateLimit.Burst != nil && *rateLimit.Burst < 1 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("burst"), *rateLimit.Burst, "must be at least 1"))
+	}
+
+	return allErrs
+}
+
+func validateComputeOperationWait(operationWait *config.ComputeOperationWait, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	for name, duration := range map[string]*metav1.Duration{
+		"initialInterval": operationWait.InitialInterval,
+		"maxInterval":     operationWait.MaxInterval,
+		"timeout":         operationWait.Timeout,
+	} {
+		if duration != nil && duration.Duration <= 0 {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child(name), duration.Duration.String(), "must be positive"))
+		}
+	}
+	if operationWait.InitialInterval != nil && operationWait.MaxInterval != nil && operationWait.MaxInterval.Duration < operationWait.InitialInterval.Duration {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("maxInterval"), operationWait.MaxInterval.Duration.String(), "must not be less than the initial interval"))
+	}
+
+	return allErrs
+}
+
+func validateBastion(bastion *config.Bastion, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if mode := bastion.Mode; mode != nil && !bastionModes.Has(*mode) {
+		allErrs = append(allErrs, field.NotSupported(fldPath.Child("mode"), *mode, sets.List(bastionModes)))
+	}
+	if machineType := bastion.MachineType; machineType != nil && !bastionMachineTypeRegex.MatchString(*machineType) {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("machineType"), *machineType, "must be the name of a machine type, e.g. 'e2-micro'"))
+	}
+	if image := bastion.Image; image != nil && !bastionImageRegex.MatchString(*image) {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("image"), *image, "must be the path of an image or image family, e.g. 'projects/debian-cloud/global/images/family/debian-12'"))
+	}
+	if diskSize := bastion.DiskSizeGB; diskSize != nil && (*diskSize < minBastionDiskSizeGB || *diskSize > maxBastionDiskSizeGB) {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("diskSizeGB"), *diskSize, fmt.Sprintf("must be between %d and %d", minBastionDiskSizeGB, maxBastionDiskSizeGB)))
+	}
+	if diskType := bastion.DiskType; diskType != nil && !bastionDiskTypes.Has(*diskType) {
+		allErrs = append(allErrs, field.NotSupported(fldPath.Child("diskType"), *diskType, sets.List(bastionDiskTypes)))
+	}
+	if subnet := bastion.Subnet; subnet != nil && !bastionSubnetRegex.MatchString(*subnet) {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("subnet"), *subnet, "must be the name of a subnet, e.g. 'management'"))
+	}
+	if maxLifetime := bastion.MaxLifetime; maxLifetime != nil && maxLifetime.Duration < minBastionMaxLifetime {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("maxLifetime"), maxLifetime.Duration.String(), fmt.Sprintf("must be at least %s", minBastionMaxLifetime)))
+	}
+
+	return allErrs
+}
+
+func validateNode(node *config.Node, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	for _, key := range sets.List(sets.KeySet(node.Sysctls)) {
+		keyPath := fldPath.Child("sysctls").Key(key)
+		if !nodeSysctls.Has(key) {
+			allErrs = append(allErrs, field.NotSupported(keyPath, key, sets.List(nodeSysctls)))
+		} else if value := node.Sysctls[key]; !nodeSysctlValueRegex.MatchString(value) {
+			allErrs = append(allErrs, field.Invalid(keyPath, value, "must be one or more whitespace-separated numbers"))
+		}
+	}
+
+	if guestAgent := node.GuestAgent; guestAgent != nil {
+		// the alias IP ranges are handled by the IP forwarding of the guest agent.
+		if ptr.Deref(guestAgent.IPAliases, false) && !ptr.Deref(guestAgent.IPForwarding, true) {
+			allErrs = append(allErrs, field.Forbidden(fldPath.Child("guestAgent", "ipAliases"), "must not be enabled if ipForwarding is disabled"))
+		}
+	}
+
+	return allErrs
+}
+
+func validateWorker(worker *config.Worker, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	existingScopes := sets.New[string]()
+	for i, scope := range worker.DefaultServiceAccountScopes {
+		scopePath := fldPath.Child("defaultServiceAccountScopes").Index(i)
+		switch {
+		case scope == "":
+			allErrs = append(allErrs, field.Required(scopePath, "must not be empty"))
+		case existingScopes.Has(scope):
+			allErrs = append(allErrs, field.Duplicate(scopePath, scope))
+		case gcpvalidation.BroadServiceAccountScopes.Has(scope):
+			// broad scopes may only be allowed per cloud profile, otherwise they would apply to all shoots of the seed.
+			allErrs = append(allErrs, field.Forbidden(scopePath, fmt.Sprintf("scope %q must not be used as default, rely on IAM roles of the service account or use narrower scopes instead", scope)))
+		}
+		existingScopes.Insert(scope)
+	}
+
+	return allErrs
+}
+
+func validateInfrastructure(infrastructure *config.Infrastructure, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	for _, resourceType := range sets.List(sets.KeySet(infrastructure.ConcurrencyLimits)) {
+		keyPath := fldPath.Child("concurrencyLimits").Key(resourceType)
+		if !infrastructureResourceTypes.Has(resourceType) {
+			allErrs = append(allErrs, field.NotSupported(keyPath, resourceType, sets.List(infrastructureResourceTypes)))
+		} else if limit := infrastructure.ConcurrencyLimits[resourceType]; limit < 1 {
+			allErrs = append(allErrs, field.Invalid(keyPath, limit, "must be at least 1"))
+		}
+	}
+
+	if infrastructure.CloudNAT != nil {
+		allErrs = append(allErrs, validateCloudNATDefaults(infrastructure.CloudNAT, fldPath.Child("cloudNAT"))...)
+	}
+
+	return allErrs
+}
+
+// validateCloudNATDefaults validates the defaults of the CloudNATs. The port counts may apply to CloudNATs with dynamic
+// port allocation, hence they have to satisfy its restrictions.
+func validateCloudNATDefaults(cloudNAT *config.CloudNAT, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	for name, ports := range map[string]*int32{"minPortsPerVM": cloudNAT.MinPortsPerVM, "maxPortsPerVM": cloudNAT.MaxPortsPerVM} {
+		if ports == nil {
+			continue
+		}
+		if *ports < 32 || *ports > 65536 {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child(name), *ports, "must be between 32 and 65536"))
+		} else if *ports&(*ports-1) != 0 {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child(name), *ports, "must be a power of two"))
+		}
+	}
+
+	if cloudNAT.MinPortsPerVM != nil && cloudNAT.MaxPortsPerVM != nil && *cloudNAT.MinPortsPerVM > *cloudNAT.MaxPortsPerVM {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("minPortsPerVM"), *cloudNAT.MinPortsPerVM, "must not be greater than maxPortsPerVM"))
+	}
+
+	return allErrs
+}
+
+func validateControlPlane(controlPlane *config.ControlPlane, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if registry := controlPlane.ImageRegistry; registry != nil && !imageRegistryRegex.MatchString(*registry) {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("imageRegistry"), *registry, "must be a registry host with an optional port and repository path prefix, e.g. 'registry.example.com/mirror'"))
+	}
+
+	return allErrs
+}
@@ -6,13 +6,101 @@ package helper
 
 import (
 	"fmt"
+	"regexp"
+	"strings"
 
 	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
+	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/utils/ptr"
 
 	api "github.com/gardener/gardener-extension-provider-gcp/pkg/apis/gcp"
 )
 
+// imageFamilyRegex matches references to image families of the form `family/<name>` or
+// `projects/<project>/global/images/family/<name>`.
+var imageFamilyRegex = regexp.MustCompile(`^(?:projects/([a-z][-a-z0-9]*)/global/images/)?family/([a-z](?:[-a-z0-9]*[a-z0-9])?)$`)
+
+// ImageFamily returns the project and the name of the image family the given image of a machine image version refers
+// to. The project is empty if the reference is not qualified with a project. It returns false if the image does not
+// refer to an image family.
+func ImageFamily(image string) (project, family string, ok bool) {
+	matches := imageFamilyRegex.FindStringSubmatch(image)
+	if matches == nil {
+		return "", "", false
+	}
+	return matches[1], matches[2], true
+}
+
+// QualifiedImage returns the fully qualified reference `projects/<project>/global/images/<image>` of the given image
+// or image family reference, e.g. `family/<name>`, in the given project.
+func QualifiedImage(project, image string) string {
+	return fmt.Sprintf("projects/%s/global/images/%s", project, image)
+}
+
+// ServiceAccountScopePrefix is the prefix of the OAuth scopes of Google APIs.
+const ServiceAccountScopePrefix = "https://www.googleapis.com/auth/"
+
+// serviceAccountScopeAliases maps the scope aliases known from `gcloud` to the names of the scopes they stand for.
+var serviceAccountScopeAliases = map[string]string{
+	"bigquery":              "bigquery",
+	"cloud-platform":        "cloud-platform",
+	"cloud-source-repos":    "source.full_control",
+	"cloud-source-repos-ro": "source.read_only",
+	"compute-ro":            "compute.readonly",
+	"compute-rw":            "compute",
+	"datastore":             "datastore",
+	"logging-write":         "logging.write",
+	"monitoring":            "monitoring",
+	"monitoring-read":       "monitoring.read",
+	"monitoring-write":      "monitoring.write",
+	"pubsub":                "pubsub",
+	"service-control":       "servicecontrol",
+	"service-management":    "service.management.readonly",
+	"sql-admin":             "sqlservice.admin",
+	"storage-full":          "devstorage.full_control",
+	"storage-ro":            "devstorage.read_only",
+	"storage-rw":            "devstorage.read_write",
+	"taskqueue":             "taskqueue",
+	"trace":                 "trace.append",
+	"userinfo-email":        "userinfo.email",
+}
+
+// IsKnownServiceAccountScopeShorthand returns true if the given scope is a `gcloud` alias, e.g. `storage-ro`, or the
+// name of a scope one of these aliases stands for, e.g. `compute`.
+func IsKnownServiceAccountScopeShorthand(scope string) bool {
+	if _, ok := serviceAccountScopeAliases[scope]; ok {
+		return true
+	}
+	for _, name := range serviceAccountScopeAliases {
+		if name == scope {
+			return true
+		}
+	}
+	return false
+}
+
+// NormalizeServiceAccountScope returns the full URL of the given service account scope. Aliases known from `gcloud`
+// and scope names without the URL prefix are expanded, all other scopes are returned unchanged.
+func NormalizeServiceAccountScope(scope string) string {
+	if name, ok := serviceAccountScopeAliases[scope]; ok {
+		return ServiceAccountScopePrefix + name
+	}
+	if scope != "" && !strings.Contains(scope, "/") {
+		return ServiceAccountScopePrefix + scope
+	}
+	return scope
+}
+
+// NormalizeServiceAccountScopes returns the full URLs of the given service account scopes without duplicates and in
+// sorted order. The given slice is not modified.
+func NormalizeServiceAccountScopes(scopes []string) []string {
+	normalized := sets.New[string]()
+	for _, scope := range scopes {
+		normalized.Insert(NormalizeServiceAccountScope(scope))
+	}
+	return sets.List(normalized)
+}
+
 // FindSubnetByPurpose takes a list of subnets and tries to find the first entry
 // whose purpose matches with the given purpose. If no such entry is found then an error will be
 // returned.
@@ -25,6 +113,17 @@ func FindSubnetByPurpose(subnets []api.Subnet, purpose api.SubnetPurpose) (*api.
 	return nil, fmt.Errorf("cannot find subnet with purpose %q", purpose)
 }
 
+// FindSubnetByName takes a list of subnets and tries to find the entry with the given name. If no such entry is found
+// then an error will be returned.
+func FindSubnetByName(subnets []api.Subnet, name string) (*api.Subnet, error) {
+	for _, subnet := range subnets {
+		if subnet.Name == name {
+			return &subnet, nil
+		}
+	}
+	return nil, fmt.Errorf("cannot find subnet with name %q", name)
+}
+
 // FindMachineImage takes a list of machine images and tries to find the first entry
 // whose name, version, architecture and zone matches with the given name, version, and zone. If no such entry is
 // found then an error will be returned.
@@ -51,6 +150,9 @@ func FindImageFromCloudProfile(cloudProfileConfig *api.CloudProfileConfig, image
 			}
 			for _, version := range machineImage.Versions {
 				if imageVersion == version.Version && ptr.Equal(architecture, version.Architecture) {
+					if version.ImageProjectID != nil {
+						return QualifiedImage(*version.ImageProjectID, version.Image), nil
+					}
 					return version.Image, nil
 				}
 			}
@@ -33,6 +33,50 @@ var _ = Describe("Helper", func() {
 		Entry("entry exists", []api.Subnet{{Name: "bar", Purpose: purpose}}, purpose, &api.Subnet{Name: "bar", Purpose: purpose}, false),
 	)
 
+	DescribeTable("#NormalizeServiceAccountScope",
+		func(scope, expected string) {
+			Expect(NormalizeServiceAccountScope(scope)).To(Equal(expected))
+		},
+
+		Entry("full URL", "https://www.googleapis.com/auth/compute", "https://www.googleapis.com/auth/compute"),
+		Entry("scope name", "compute", "https://www.googleapis.com/auth/compute"),
+		Entry("gcloud alias", "storage-ro", "https://www.googleapis.com/auth/devstorage.read_only"),
+		Entry("empty scope", "", ""),
+	)
+
+	It("#NormalizeServiceAccountScopes should expand, deduplicate and sort the scopes", func() {
+		scopes := []string{"storage-ro", "https://www.googleapis.com/auth/compute", "compute-rw", "devstorage.read_only", "cloud-platform"}
+
+		Expect(NormalizeServiceAccountScopes(scopes)).To(Equal([]string{
+			"https://www.googleapis.com/auth/cloud-platform",
+			"https://www.googleapis.com/auth/compute",
+			"https://www.googleapis.com/auth/devstorage.read_only",
+		}))
+		Expect(scopes).To(Equal([]string{"storage-ro", "https://www.googleapis.com/auth/compute", "compute-rw", "devstorage.read_only", "cloud-platform"}))
+	})
+
+	DescribeTable("#IsKnownServiceAccountScopeShorthand",
+		func(scope string, expected bool) {
+			Expect(IsKnownServiceAccountScopeShorthand(scope)).To(Equal(expected))
+		},
+
+		Entry("gcloud alias", "storage-ro", true),
+		Entry("scope name", "devstorage.read_only", true),
+		Entry("unknown scope name", "foo", false),
+	)
+
+	DescribeTable("#FindSubnetByName",
+		func(subnets []api.Subnet, name string, expectedSubnet *api.Subnet, expectErr bool) {
+			subnet, err := FindSubnetByName(subnets, name)
+			expectResults(subnet, expectedSubnet, err, expectErr)
+		},
+
+		Entry("list is nil", nil, "bar", nil, true),
+		Entry("empty list", []api.Subnet{}, "bar", nil, true),
+		Entry("entry not found", []api.Subnet{{Name: "baz", Purpose: purpose}}, "bar", nil, true),
+		Entry("entry exists", []api.Subnet{{Name: "baz", Purpose: purpose}, {Name: "bar", Purpose: purposeWrong}}, "bar", &api.Subnet{Name: "bar", Purpose: purposeWrong}, false),
+	)
+
 	DescribeTable("#FindMachineImage",
 		func(machineImages []api.MachineImage, name, version string, architecture *string, expectedMachineImage *api.MachineImage, expectErr bool) {
 			machineImage, err := FindMachineImage(machineImages, name, version, architecture)
@@ -69,6 +113,22 @@ var _ = Describe("Helper", func() {
 		Entry("profile entry not found (version does not exist)", makeProfileMachineImages("ubuntu", "2", ptr.To("foo")), "ubuntu", "1", ptr.To("foo"), ""),
 		Entry("profile entry not found (no architecture)", makeProfileMachineImages("ubuntu", "2", ptr.To("bar")), "ubuntu", "1", ptr.To("foo"), ""),
 		Entry("profile entry", makeProfileMachineImages("ubuntu", "1", ptr.To("foo")), "ubuntu", "1", ptr.To("foo"), profileImage),
+		Entry("profile entry in image project", []api.MachineImages{{Name: "ubuntu", Versions: []api.MachineImageVersion{{Version: "1", Image: "ubuntu-2404", ImageProjectID: ptr.To("images"), Architecture: ptr.To("foo")}}}}, "ubuntu", "1", ptr.To("foo"), "projects/images/global/images/ubuntu-2404"),
+		Entry("profile entry with image family in image project", []api.MachineImages{{Name: "ubuntu", Versions: []api.MachineImageVersion{{Version: "1", Image: "family/ubuntu", ImageProjectID: ptr.To("images"), Architecture: ptr.To("foo")}}}}, "ubuntu", "1", ptr.To("foo"), "projects/images/global/images/family/ubuntu"),
+	)
+
+	DescribeTable("#ImageFamily",
+		func(image, expectedProject, expectedFamily string, expectedOK bool) {
+			project, family, ok := ImageFamily(image)
+			Expect(project).To(Equal(expectedProject))
+			Expect(family).To(Equal(expectedFamily))
+			Expect(ok).To(Equal(expectedOK))
+		},
+		Entry("image", "projects/images/global/images/gardenlinux-1443-3", "", "", false),
+		Entry("family", "family/gardenlinux", "", "gardenlinux", true),
+		Entry("family with project", "projects/images/global/images/family/gardenlinux", "images", "gardenlinux", true),
+		Entry("empty family", "family/", "", "", false),
+		Entry("invalid family", "family/Gardenlinux", "", "", false),
 	)
 })
 
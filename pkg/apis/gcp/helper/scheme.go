@@ -49,6 +49,18 @@ func InfrastructureConfigFromInfrastructure(infra *extensionsv1alpha1.Infrastruc
 	return nil, fmt.Errorf("provider config is not set on the infrastructure resource")
 }
 
+// DNSRecordConfigFromDNSRecord extracts the DNSRecordConfig from the ProviderConfig section of the given DNSRecord.
+// It returns an empty configuration if the DNSRecord has no provider config.
+func DNSRecordConfigFromDNSRecord(dns *extensionsv1alpha1.DNSRecord) (*api.DNSRecordConfig, error) {
+	config := &api.DNSRecordConfig{}
+	if dns.Spec.ProviderConfig != nil && dns.Spec.ProviderConfig.Raw != nil {
+		if _, _, err := decoder.Decode(dns.Spec.ProviderConfig.Raw, nil, config); err != nil {
+			return nil, err
+		}
+	}
+	return config, nil
+}
+
 // InfrastructureStatusFromRaw extracts the InfrastructureStatus from the
 // ProviderStatus section of the given Infrastructure.
 func InfrastructureStatusFromRaw(raw *runtime.RawExtension) (*api.InfrastructureStatus, error) {
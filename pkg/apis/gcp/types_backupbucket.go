@@ -17,6 +17,72 @@ type BackupBucketConfig struct {
 
 	// Immutability defines the immutability config for the backup bucket.
 	Immutability *ImmutableConfig
+
+	// Versioning enables or disables the object versioning of the backup bucket. If not set, the versioning
+	// configuration of the bucket is not managed.
+	Versioning *bool
+
+	// StorageClass is the default storage class of the objects in the backup bucket, i.e. `STANDARD`, `NEARLINE`,
+	// `COLDLINE` or `ARCHIVE`. Defaults to `STANDARD` when the bucket is created.
+	StorageClass string
+
+	// Location overrides the location of the backup bucket, e.g. a multi-region like `EU` or a predefined dual-region
+	// like `EUR4`. Defaults to the region of the backup bucket. The location is only applied when the bucket is created.
+	Location string
+
+	// DualRegion are the two regions of a configurable dual-region bucket, e.g. `europe-west1` and `europe-west4`. It
+	// requires Location to be set to the multi-region containing both regions. It is only applied when the bucket is
+	// created.
+	DualRegion []string
+
+	// LifecycleRules are the lifecycle rules of the objects in the backup bucket, e.g. to delete old backups. If the
+	// provider config is set, the lifecycle configuration of the bucket is fully managed, i.e. other rules are removed.
+	LifecycleRules []LifecycleRule
+
+	// Encryption configures the default customer-managed encryption key of the objects in the backup bucket.
+	Encryption *BucketEncryption
+
+	// UniformBucketLevelAccess enables the uniform bucket-level access, i.e. disables ACLs on the objects of the backup
+	// bucket. Defaults to true when the bucket is created. If not set, the setting of an existing bucket is not managed.
+	UniformBucketLevelAccess *bool
+
+	// PublicAccessPrevention is the public access prevention of the backup bucket, i.e. `enforced` or `inherited`.
+	// Defaults to `enforced` when the bucket is created. If not set, the setting of an existing bucket is not managed.
+	PublicAccessPrevention *string
+
+	// TurboReplication enables the turbo replication of a dual-region backup bucket, i.e. a recovery point objective of
+	// 15 minutes. It requires Location to be a predefined dual-region or DualRegion to be set. If not set, the setting of
+	// an existing bucket is not managed.
+	TurboReplication *bool
+
+	// Labels are additional labels of the backup bucket, e.g. for cost attribution. The labels which are managed by
+	// Gardener cannot be overridden.
+	Labels map[string]string
+}
+
+// BucketEncryption is the encryption configuration of a backup bucket.
+type BucketEncryption struct {
+	// KmsKeyName is the resource name of the Cloud KMS key, i.e.
+	// `projects/<project>/locations/<location>/keyRings/<keyRing>/cryptoKeys/<key>`. The key must be located in the
+	// location of the bucket and the Cloud Storage service agent of the project must be granted the role
+	// `roles/cloudkms.cryptoKeyEncrypterDecrypter` for it.
+	KmsKeyName string
+}
+
+// LifecycleRule is a lifecycle rule of a backup bucket. The rule applies to the objects which match all configured
+// conditions.
+type LifecycleRule struct {
+	// Action is the action of the rule, i.e. `Delete` or `SetStorageClass`.
+	Action string
+	// StorageClass is the storage class which is set by the `SetStorageClass` action.
+	StorageClass string
+	// AgeDays is the condition on the minimum age of the objects in days.
+	AgeDays *int32
+	// MatchesStorageClass is the condition on the storage classes of the objects.
+	MatchesStorageClass []string
+	// NumberOfNewerVersions is the condition on the minimum number of newer versions of the objects. It only matches
+	// noncurrent object versions, see Versioning.
+	NumberOfNewerVersions *int32
 }
 
 // ImmutableConfig represents the immutability configuration for a backup bucket.
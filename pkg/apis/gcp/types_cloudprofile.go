@@ -18,6 +18,9 @@ type CloudProfileConfig struct {
 	// MachineImages is the list of machine images that are understood by the controller. It maps
 	// logical names and versions to provider-specific identifiers.
 	MachineImages []MachineImages
+	// AllowBroadServiceAccountScopes allows worker pools to request broad service account scopes like
+	// `https://www.googleapis.com/auth/cloud-platform`.
+	AllowBroadServiceAccountScopes *bool
 }
 
 // MachineImages is a mapping from logical names and versions to provider-specific identifiers.
@@ -34,6 +37,9 @@ type MachineImageVersion struct {
 	Version string
 	// Image is the path to the image.
 	Image string
+	// ImageProjectID is the ID of the project hosting the image. If set, the image must be the name of an image or a
+	// reference to an image family in this project, e.g. `family/<name>`.
+	ImageProjectID *string
 	// Architecture is the CPU architecture of the machine image.
 	Architecture *string
 }
@@ -23,12 +23,46 @@ type ControlPlaneConfig struct {
 
 	// Storage contains configuration for the storage in the cluster.
 	Storage *Storage
+
+	// Replicas contains overrides for the replicas of the control plane components.
+	Replicas *ControlPlaneReplicas
 }
 
 // CloudControllerManagerConfig contains configuration settings for the cloud-controller-manager.
 type CloudControllerManagerConfig struct {
 	// FeatureGates contains information about enabled feature gates.
 	FeatureGates map[string]bool
+	// InternalLoadBalancerSubnet is the name of the subnet which is used for internal load balancers. It must be one of
+	// the subnets of the infrastructure. Defaults to the internal subnet.
+	InternalLoadBalancerSubnet *string
+	// RouteReconciliationPeriod is the period for reconciling the routes of the nodes. Only relevant if the
+	// cloud-controller-manager configures cloud routes. Defaults to the cloud-controller-manager default of 10s.
+	RouteReconciliationPeriod *metav1.Duration
+	// ConcurrentRouteSyncs is the number of routes which are created or deleted concurrently. Only relevant if the
+	// cloud-controller-manager configures cloud routes.
+	ConcurrentRouteSyncs *int32
+	// AdditionalFlags are further flags of the cloud-controller-manager without the leading dashes, e.g.
+	// `concurrent-service-syncs`. Only an allow-listed set of flags is supported, flags managed by Gardener must not be
+	// overridden.
+	AdditionalFlags map[string]string
+	// NodeStatusUpdateFrequency is the period for updating the status of the nodes. Defaults to the
+	// cloud-controller-manager default of 5m.
+	NodeStatusUpdateFrequency *metav1.Duration
+	// LeaderElection contains the timings of the leader election of the cloud-controller-manager.
+	LeaderElection *LeaderElection
+}
+
+// LeaderElection contains the timings of the leader election. Unset timings default to the ones of the component.
+type LeaderElection struct {
+	// LeaseDuration is the duration that non-leader candidates wait before they try to acquire the leadership.
+	// Defaults to 15s.
+	LeaseDuration *metav1.Duration
+	// RenewDeadline is the duration that the leader retries to renew its leadership before it gives it up. It must be
+	// less than the lease duration. Defaults to 10s.
+	RenewDeadline *metav1.Duration
+	// RetryPeriod is the duration the candidates wait between tries to acquire or renew the leadership. It must be
+	// less than the renew deadline. Defaults to 2s.
+	RetryPeriod *metav1.Duration
 }
 
 // Storage contains settings for the default StorageClass and VolumeSnapshotClass
@@ -43,4 +77,46 @@ type Storage struct {
 	// not managed by Gardener to be set as default by the user.
 	// Defaults to true.
 	ManagedDefaultVolumeSnapshotClass *bool
+	// AdditionalStorageClasses are further StorageClasses which are managed by Gardener, e.g. for hyperdisk volumes
+	// with a provisioned performance.
+	AdditionalStorageClasses []StorageClassConfig
+	// SnapshotLocation is the GCP region or multi-region, e.g. `europe-west1` or `eu`, in which the snapshots of the
+	// 'default' VolumeSnapshotClass are stored. If empty, GCP stores them in the multi-region closest to the disk.
+	SnapshotLocation string
+	// EnableVolumeAttributesClass configures the CSI driver to support VolumeAttributesClasses. Only effective for
+	// Kubernetes versions >= 1.31.
+	EnableVolumeAttributesClass *bool
+	// FSGroupPolicy is the fsGroupPolicy of the CSIDriver, i.e. `ReadWriteOnceWithFSType`, `File` or `None`. `None`
+	// avoids the recursive change of the volume ownership on mount. Defaults to `ReadWriteOnceWithFSType`.
+	FSGroupPolicy *string
+	// MaxVolumesPerNode overrides the maximum number of volumes which the CSI driver reports as attachable to a node,
+	// e.g. to reserve slots for disks which are not managed by the CSI driver. It must not exceed the disk limit of the
+	// machine types of the worker pools. If not set, the driver derives the limit from the machine type.
+	MaxVolumesPerNode *int32
+}
+
+// StorageClassConfig is the configuration of an additional StorageClass.
+type StorageClassConfig struct {
+	// Name is the name of the StorageClass.
+	Name string
+	// Type is the disk type of the volumes, e.g. `hyperdisk-balanced`.
+	Type string
+	// Parameters are further parameters of the CSI driver, e.g. `provisioned-iops-on-create`.
+	Parameters map[string]string
+	// Default marks the StorageClass as default. This requires that the 'default' StorageClass is not marked as
+	// default, see ManagedDefaultStorageClass.
+	Default *bool
+	// AllowVolumeExpansion controls whether volumes of the StorageClass can be expanded. Defaults to true.
+	AllowVolumeExpansion *bool
+}
+
+// ControlPlaneReplicas contains overrides for the replicas of the control plane components. The overrides are capped
+// at a maximum of 5 replicas and are not effective if the control plane is scaled down, e.g. during hibernation.
+type ControlPlaneReplicas struct {
+	// CloudControllerManager is the number of replicas of the cloud-controller-manager.
+	CloudControllerManager *int32
+	// CSIController is the number of replicas of the CSI driver controller.
+	CSIController *int32
+	// CSISnapshotController is the number of replicas of the CSI snapshot controller.
+	CSISnapshotController *int32
 }
@@ -0,0 +1,62 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package gcp
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// +genclient
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// DNSRecordConfig contains configuration settings for the DNS record.
+type DNSRecordConfig struct {
+	metav1.TypeMeta
+
+	// ZoneVisibility is the visibility of the managed zone hosting the DNS record, i.e. `public` or `private`.
+	// Private managed zones must be bound to the VPC network of the shoot. Defaults to `public`.
+	ZoneVisibility *string
+
+	// TTL overrides the TTL of the DNS record in seconds, i.e. `spec.ttl` of the DNSRecord. TTLs exceeding one week are
+	// capped.
+	TTL *int64
+
+	// RoutingPolicy is the routing policy of the DNS record. If set, the routing policy determines the values of the
+	// DNS record instead of `spec.values` of the DNSRecord.
+	RoutingPolicy *DNSRoutingPolicy
+}
+
+// DNSRoutingPolicy is a routing policy of a DNS record.
+type DNSRoutingPolicy struct {
+	// WeightedRoundRobin are the targets of a weighted round robin routing policy. Each target is returned with a
+	// probability proportional to its weight relative to the sum of the weights of all targets.
+	WeightedRoundRobin []DNSWeightedTarget
+	// Geo are the targets of a geolocation routing policy. Queries are answered with the target of the region closest
+	// to the origin of the query, so that the closest target serves as fallback for regions without target.
+	Geo []DNSGeoTarget
+}
+
+// DNSWeightedTarget is a target of a weighted round robin routing policy.
+type DNSWeightedTarget struct {
+	// Weight is the non-negative weight of the target.
+	Weight int32
+	// Values are the values of the DNS record for this target, e.g. IP addresses.
+	Values []string
+}
+
+// DNSGeoTarget is a target of a geolocation routing policy.
+type DNSGeoTarget struct {
+	// Location is the GCP region of the target, e.g. `europe-west1`.
+	Location string
+	// Values are the values of the DNS record for this target, e.g. IP addresses.
+	Values []string
+}
+
+const (
+	// DNSZoneVisibilityPublic is the visibility of managed zones which are resolvable from the internet.
+	DNSZoneVisibilityPublic = "public"
+	// DNSZoneVisibilityPrivate is the visibility of managed zones which are only resolvable from bound VPC networks.
+	DNSZoneVisibilityPrivate = "private"
+)
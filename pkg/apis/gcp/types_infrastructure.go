@@ -25,8 +25,13 @@ type NetworkConfig struct {
 	VPC *VPC
 	// CloudNAT contains configuration about the CloudNAT resource
 	CloudNAT *CloudNAT
+	// DisableCloudNAT disables the creation of the CloudNAT, e.g. if the egress traffic of the nodes is routed through a
+	// network appliance of the user. The CloudRouter is still created.
+	DisableCloudNAT *bool
 	// Internal is a private subnet (used for internal load balancers).
 	Internal *string
+	// ProxyOnly is the CIDR of a proxy-only subnet (used for regional internal Application Load Balancers).
+	ProxyOnly *string
 	// Worker is the worker subnet range to create (used for the VMs).
 	// Deprecated - use `workers` instead.
 	Worker string
@@ -34,6 +39,14 @@ type NetworkConfig struct {
 	Workers string
 	// FlowLogs contains the flow log configuration for the subnet.
 	FlowLogs *FlowLogs
+	// NetworkConnectivityCenter contains the configuration to register the VPC as a spoke of a Network Connectivity
+	// Center hub.
+	NetworkConnectivityCenter *NetworkConnectivityCenter
+	// HealthChecks contains the configuration of the firewall rule which allows the health checks of Google Cloud load
+	// balancers.
+	HealthChecks *HealthChecks
+	// PrivateServiceAccess contains the configuration of the Private Service Access of the VPC, e.g. for Cloud SQL.
+	PrivateServiceAccess *PSAConfig
 }
 
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
@@ -69,6 +82,8 @@ const (
 	PurposeNodes SubnetPurpose = "nodes"
 	// PurposeInternal is a SubnetPurpose for internal use.
 	PurposeInternal SubnetPurpose = "internal"
+	// PurposeProxyOnly is a SubnetPurpose for the proxies of regional internal Application Load Balancers.
+	PurposeProxyOnly SubnetPurpose = "proxy-only"
 )
 
 // Subnet is a subnet that was created.
@@ -83,6 +98,10 @@ type Subnet struct {
 type VPC struct {
 	// Name is the VPC name.
 	Name string
+	// SelfLink is the self-link of the VPC. It is only set in the infrastructure status.
+	SelfLink string
+	// ID is the numeric ID of the VPC. It is only set in the infrastructure status.
+	ID string
 	// CloudRouter indicates whether to use an existing CloudRouter or create a new one
 	CloudRouter *CloudRouter
 }
@@ -146,6 +165,34 @@ type NatIPName struct {
 	Name string
 }
 
+// NetworkConnectivityCenter contains the configuration of the Network Connectivity Center spoke of the VPC.
+type NetworkConnectivityCenter struct {
+	// Hub is the resource name of the Network Connectivity Center hub the VPC is attached to, e.g.
+	// `projects/my-project/locations/global/hubs/my-hub`. The hub is not managed by Gardener.
+	Hub string
+}
+
+// HealthChecks contains the configuration of the firewall rule which allows the health checks of Google Cloud load
+// balancers.
+type HealthChecks struct {
+	// AdditionalPorts are TCP ports or port ranges, e.g. `8080` or `8000-8100`, which are opened for health checks in
+	// addition to the node port range. They are required if load balancers check the health of pods directly, e.g. for
+	// container-native load balancing with the Gateway API.
+	AdditionalPorts []string
+}
+
+// PSAConfig contains the configuration of the Private Service Access of the VPC. An internal address range is reserved
+// in the VPC and allocated to the peering with the service producers.
+type PSAConfig struct {
+	// Name is the name of the global internal address range which is reserved for the service producers.
+	Name string
+	// PrefixLength is the prefix length of the reserved address range.
+	PrefixLength int32
+	// Address is the first address of the reserved address range. If it is omitted, a free range of the VPC is
+	// chosen by GCP.
+	Address *string
+}
+
 // FlowLogs contains the configuration options for the vpc flow logs.
 type FlowLogs struct {
 	// AggregationInterval for collecting flow logs.
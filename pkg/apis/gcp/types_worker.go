@@ -39,6 +39,83 @@ type WorkerConfig struct {
 
 	// NodeTemplate contains resource information of the machine which is used by Cluster Autoscaler to generate nodeTemplate during scaling a nodeGroup from zero.
 	NodeTemplate *extensionsv1alpha1.NodeTemplate
+
+	// CustomMachine contains the shape of a custom machine type which is used instead of the machine type of the worker pool.
+	CustomMachine *CustomMachine
+
+	// ResourceManagerTags are the resource manager tags which are attached to the VMs. The keys must have the format
+	// `tagKeys/{tag_key_id}` and the values the format `tagValues/{tag_value_id}`.
+	// These tags are unrelated to the network tags of the VMs.
+	ResourceManagerTags map[string]string
+
+	// InstanceMetadata contains additional metadata entries which are added to the VMs. Keys which are managed by
+	// Gardener, e.g. `block-project-ssh-keys` or `user-data`, cannot be set.
+	InstanceMetadata map[string]string
+
+	// DNSSearchDomains are the DNS search domains which are configured on the VMs in addition to the ones provided by
+	// the VPC.
+	DNSSearchDomains []string
+
+	// BlockProjectSSHKeys controls whether project-wide SSH keys are blocked on the VMs. Defaults to true. Allowing
+	// project-wide SSH keys must be permitted by the operator in the controller configuration.
+	BlockProjectSSHKeys *bool
+
+	// GuestOSFeatures are the guest OS features of the boot disk, e.g. `GVNIC`. If not set, they are derived from the
+	// machine family, e.g. accelerator-optimized machines get `GVNIC`.
+	GuestOSFeatures []string
+
+	// ConfidentialCompute enables Confidential VMs. If not set, it is derived from the machine family, i.e. disabled for
+	// accelerator-optimized machines and left to the GCP default otherwise.
+	ConfidentialCompute *bool
+
+	// DeletionProtection enables the deletion protection of the VMs. Defaults to false. Only VMs which are created after
+	// the setting was changed are affected.
+	DeletionProtection *bool
+
+	// CanIPForward allows the VMs to send and receive packets with non-matching source or destination IPs. Defaults to
+	// true. It can only be disabled if the overlay network of the shoot is enabled, as pod traffic is routed natively
+	// otherwise.
+	CanIPForward *bool
+
+	// NetworkTags are additional network tags of the VMs, e.g. to match custom firewall rules. The network tags
+	// managed by Gardener are always set.
+	NetworkTags []string
+
+	// OnHostMaintenance is the behavior of the VMs on host maintenance events, either `MIGRATE` or `TERMINATE`. If not
+	// set, it is derived from the machine, i.e. `TERMINATE` for VMs with GPUs or Confidential VMs and `MIGRATE`
+	// otherwise. VMs which do not support live migration always use `TERMINATE`.
+	OnHostMaintenance OnHostMaintenancePolicy
+
+	// AutomaticRestart restarts the VMs automatically if they are terminated by Compute Engine, e.g. due to a host
+	// maintenance event or a hardware failure. Defaults to true.
+	AutomaticRestart *bool
+
+	// SubnetName is the name of the subnet of the infrastructure status the VMs are attached to. If not set, the nodes
+	// subnet is used.
+	SubnetName string
+
+	// EnableExternalIP assigns ephemeral external IPs to the VMs, so that their egress traffic does not pass the Cloud
+	// NAT. Defaults to false. It must not be enabled if the Cloud NAT uses user-provided IPs.
+	EnableExternalIP *bool
+
+	// InstanceLabels are additional labels of the VMs and their disks. Keys and values are sanitized to match the label
+	// restrictions of GCP. Labels which are managed by Gardener, e.g. `name` or the labels of the worker pool, cannot
+	// be overwritten.
+	InstanceLabels map[string]string
+
+	// DescriptionTemplate is a Go template for the description of the VMs. It can refer to `.Shoot`, `.Namespace`,
+	// `.Pool` and `.Zone`. If not set, a description referring to the shoot is used.
+	DescriptionTemplate string
+}
+
+// CustomMachine is the configuration of a custom machine type.
+type CustomMachine struct {
+	// VCPUs is the number of vCPUs of the machine.
+	VCPUs int32
+	// MemoryMiB is the memory of the machine in MiB.
+	MemoryMiB int32
+	// Family is the machine family of the custom machine type, e.g. `n2`. Defaults to the `n1` family if empty.
+	Family string
 }
 
 // Volume contains configuration for the additional disks attached to VMs.
@@ -46,8 +123,24 @@ type Volume struct {
 	// LocalSSDInterface is the interface of that the local ssd disk supports.
 	LocalSSDInterface *string
 
+	// LocalSSDCount is the number of local SSDs (375GB each) which are attached to the VMs in addition to the data
+	// volumes of type SCRATCH.
+	LocalSSDCount *int32
+
 	// Encryption refers to the disk encryption details for this volume
 	Encryption *DiskEncryption
+
+	// Regional configures the root volume as a regional persistent disk.
+	Regional *RegionalDisk
+
+	// ProvisionedIops of the root disk. Only allowed for hyperdisk types which can be used as boot disks, i.e.
+	// `hyperdisk-balanced`. If not set gcp calculates a default value taking the disk size into consideration.
+	ProvisionedIops *int64
+
+	// ProvisionedThroughput of the root disk in MiB per second. Only allowed for hyperdisk types which can be used as
+	// boot disks, i.e. `hyperdisk-balanced`. If not set gcp calculates a default value taking the disk size into
+	// consideration.
+	ProvisionedThroughput *int64
 }
 
 // DataVolume contains configuration for data volumes attached to VMs.
@@ -76,6 +169,16 @@ type DataVolume struct {
 	// If not set gcp calculates a default value taking the disk size into consideration.
 	// Hyperdisk Throughput volumes can't be used as boot disks.
 	ProvisionedThroughput *int64
+
+	// Regional configures the data volume as a regional persistent disk.
+	Regional *RegionalDisk
+}
+
+// RegionalDisk contains the configuration of a regional persistent disk which is synchronously replicated between two
+// zones.
+type RegionalDisk struct {
+	// ReplicaZones are the two zones the disk is replicated to. They must include all zones of the worker pool.
+	ReplicaZones []string
 }
 
 // DiskEncryption encapsulates the encryption configuration for a disk.
@@ -118,8 +221,40 @@ type GPU struct {
 	AcceleratorType string
 	// Count is the number of accelerator to be attached
 	Count int32
+	// DriverVersion is the version of the GPU driver which is requested for the nodes, e.g. `default` or `latest`.
+	DriverVersion string
+	// GpuSharingConfig contains the configuration for sharing the GPUs between multiple containers.
+	GpuSharingConfig *GpuSharing
 }
 
+// GpuSharing is the configuration for sharing GPUs between multiple containers.
+type GpuSharing struct {
+	// Strategy is the strategy used for sharing the GPUs.
+	Strategy GpuSharingStrategy
+	// MaxSharedClientsPerGpu is the maximum number of containers which can share a GPU.
+	MaxSharedClientsPerGpu int32
+}
+
+// GpuSharingStrategy is a strategy for sharing GPUs between multiple containers.
+type GpuSharingStrategy string
+
+const (
+	// GpuSharingStrategyTimeSharing is a GpuSharingStrategy which shares the GPUs by time-slicing.
+	GpuSharingStrategyTimeSharing GpuSharingStrategy = "time-sharing"
+	// GpuSharingStrategyMPS is a GpuSharingStrategy which shares the GPUs via the NVIDIA Multi-Process Service.
+	GpuSharingStrategyMPS GpuSharingStrategy = "mps"
+)
+
+// OnHostMaintenancePolicy is the behavior of VMs on host maintenance events.
+type OnHostMaintenancePolicy string
+
+const (
+	// OnHostMaintenanceMigrate is an OnHostMaintenancePolicy which live-migrates the VMs to another host.
+	OnHostMaintenanceMigrate OnHostMaintenancePolicy = "MIGRATE"
+	// OnHostMaintenanceTerminate is an OnHostMaintenancePolicy which stops the VMs.
+	OnHostMaintenanceTerminate OnHostMaintenancePolicy = "TERMINATE"
+)
+
 // MachineImage is a mapping from logical names and versions to GCP-specific identifiers.
 type MachineImage struct {
 	// Name is the logical name of the machine image.
@@ -18,6 +18,10 @@ type CloudProfileConfig struct {
 	// MachineImages is the list of machine images that are understood by the controller. It maps
 	// logical names and versions to provider-specific identifiers.
 	MachineImages []MachineImages `json:"machineImages"`
+	// AllowBroadServiceAccountScopes allows worker pools to request broad service account scopes like
+	// `https://www.googleapis.com/auth/cloud-platform`.
+	// +optional
+	AllowBroadServiceAccountScopes *bool `json:"allowBroadServiceAccountScopes,omitempty"`
 }
 
 // MachineImages is a mapping from logical names and versions to provider-specific identifiers.
@@ -34,6 +38,10 @@ type MachineImageVersion struct {
 	Version string `json:"version"`
 	// Image is the path to the image.
 	Image string `json:"image"`
+	// ImageProjectID is the ID of the project hosting the image. If set, the image must be the name of an image or a
+	// reference to an image family in this project.
+	// +optional
+	ImageProjectID *string `json:"imageProjectID,omitempty"`
 	// Architecture is the CPU architecture of the machine image.
 	// +optional
 	Architecture *string `json:"architecture,omitempty"`
@@ -24,6 +24,10 @@ type ControlPlaneConfig struct {
 
 	// Storage contains configuration for the storage in the cluster.
 	Storage *Storage `json:"storage,omitempty"`
+
+	// Replicas contains overrides for the replicas of the control plane components.
+	// +optional
+	Replicas *ControlPlaneReplicas `json:"replicas,omitempty"`
 }
 
 // CloudControllerManagerConfig contains configuration settings for the cloud-controller-manager.
@@ -31,6 +35,46 @@ type CloudControllerManagerConfig struct {
 	// FeatureGates contains information about enabled feature gates.
 	// +optional
 	FeatureGates map[string]bool `json:"featureGates,omitempty"`
+	// InternalLoadBalancerSubnet is the name of the subnet which is used for internal load balancers. It must be one of
+	// the subnets of the infrastructure. Defaults to the internal subnet.
+	// +optional
+	InternalLoadBalancerSubnet *string `json:"internalLoadBalancerSubnet,omitempty"`
+	// RouteReconciliationPeriod is the period for reconciling the routes of the nodes. Only relevant if the
+	// cloud-controller-manager configures cloud routes. Defaults to the cloud-controller-manager default of 10s.
+	// +optional
+	RouteReconciliationPeriod *metav1.Duration `json:"routeReconciliationPeriod,omitempty"`
+	// ConcurrentRouteSyncs is the number of routes which are created or deleted concurrently. Only relevant if the
+	// cloud-controller-manager configures cloud routes.
+	// +optional
+	ConcurrentRouteSyncs *int32 `json:"concurrentRouteSyncs,omitempty"`
+	// AdditionalFlags are further flags of the cloud-controller-manager without the leading dashes, e.g.
+	// `concurrent-service-syncs`. Only an allow-listed set of flags is supported, flags managed by Gardener must not be
+	// overridden.
+	// +optional
+	AdditionalFlags map[string]string `json:"additionalFlags,omitempty"`
+	// NodeStatusUpdateFrequency is the period for updating the status of the nodes. Defaults to the
+	// cloud-controller-manager default of 5m.
+	// +optional
+	NodeStatusUpdateFrequency *metav1.Duration `json:"nodeStatusUpdateFrequency,omitempty"`
+	// LeaderElection contains the timings of the leader election of the cloud-controller-manager.
+	// +optional
+	LeaderElection *LeaderElection `json:"leaderElection,omitempty"`
+}
+
+// LeaderElection contains the timings of the leader election. Unset timings default to the ones of the component.
+type LeaderElection struct {
+	// LeaseDuration is the duration that non-leader candidates wait before they try to acquire the leadership.
+	// Defaults to 15s.
+	// +optional
+	LeaseDuration *metav1.Duration `json:"leaseDuration,omitempty"`
+	// RenewDeadline is the duration that the leader retries to renew its leadership before it gives it up. It must be
+	// less than the lease duration. Defaults to 10s.
+	// +optional
+	RenewDeadline *metav1.Duration `json:"renewDeadline,omitempty"`
+	// RetryPeriod is the duration the candidates wait between tries to acquire or renew the leadership. It must be
+	// less than the renew deadline. Defaults to 2s.
+	// +optional
+	RetryPeriod *metav1.Duration `json:"retryPeriod,omitempty"`
 }
 
 // Storage contains settings for the default StorageClass and VolumeSnapshotClass
@@ -47,4 +91,57 @@ type Storage struct {
 	// Defaults to true.
 	// +optional
 	ManagedDefaultVolumeSnapshotClass *bool `json:"managedDefaultVolumeSnapshotClass,omitempty"`
+	// AdditionalStorageClasses are further StorageClasses which are managed by Gardener, e.g. for hyperdisk volumes
+	// with a provisioned performance.
+	// +optional
+	AdditionalStorageClasses []StorageClassConfig `json:"additionalStorageClasses,omitempty"`
+	// SnapshotLocation is the GCP region or multi-region, e.g. `europe-west1` or `eu`, in which the snapshots of the
+	// 'default' VolumeSnapshotClass are stored. If empty, GCP stores them in the multi-region closest to the disk.
+	// +optional
+	SnapshotLocation string `json:"snapshotLocation,omitempty"`
+	// EnableVolumeAttributesClass configures the CSI driver to support VolumeAttributesClasses. Only effective for
+	// Kubernetes versions >= 1.31.
+	// +optional
+	EnableVolumeAttributesClass *bool `json:"enableVolumeAttributesClass,omitempty"`
+	// FSGroupPolicy is the fsGroupPolicy of the CSIDriver, i.e. `ReadWriteOnceWithFSType`, `File` or `None`. `None`
+	// avoids the recursive change of the volume ownership on mount. Defaults to `ReadWriteOnceWithFSType`.
+	// +optional
+	FSGroupPolicy *string `json:"fsGroupPolicy,omitempty"`
+	// MaxVolumesPerNode overrides the maximum number of volumes which the CSI driver reports as attachable to a node,
+	// e.g. to reserve slots for disks which are not managed by the CSI driver. It must not exceed the disk limit of the
+	// machine types of the worker pools. If not set, the driver derives the limit from the machine type.
+	// +optional
+	MaxVolumesPerNode *int32 `json:"maxVolumesPerNode,omitempty"`
+}
+
+// StorageClassConfig is the configuration of an additional StorageClass.
+type StorageClassConfig struct {
+	// Name is the name of the StorageClass.
+	Name string `json:"name"`
+	// Type is the disk type of the volumes, e.g. `hyperdisk-balanced`.
+	Type string `json:"type"`
+	// Parameters are further parameters of the CSI driver, e.g. `provisioned-iops-on-create`.
+	// +optional
+	Parameters map[string]string `json:"parameters,omitempty"`
+	// Default marks the StorageClass as default. This requires that the 'default' StorageClass is not marked as
+	// default, see ManagedDefaultStorageClass.
+	// +optional
+	Default *bool `json:"default,omitempty"`
+	// AllowVolumeExpansion controls whether volumes of the StorageClass can be expanded. Defaults to true.
+	// +optional
+	AllowVolumeExpansion *bool `json:"allowVolumeExpansion,omitempty"`
+}
+
+// ControlPlaneReplicas contains overrides for the replicas of the control plane components. The overrides are capped
+// at a maximum of 5 replicas and are not effective if the control plane is scaled down, e.g. during hibernation.
+type ControlPlaneReplicas struct {
+	// CloudControllerManager is the number of replicas of the cloud-controller-manager.
+	// +optional
+	CloudControllerManager *int32 `json:"cloudControllerManager,omitempty"`
+	// CSIController is the number of replicas of the CSI driver controller.
+	// +optional
+	CSIController *int32 `json:"csiController,omitempty"`
+	// CSISnapshotController is the number of replicas of the CSI snapshot controller.
+	// +optional
+	CSISnapshotController *int32 `json:"csiSnapshotController,omitempty"`
 }
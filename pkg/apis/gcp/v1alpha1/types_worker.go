@@ -43,6 +43,100 @@ type WorkerConfig struct {
 	// NodeTemplate contains resource information of the machine which is used by Cluster Autoscaler to generate nodeTemplate during scaling a nodeGroup from zero.
 	// +optional
 	NodeTemplate *extensionsv1alpha1.NodeTemplate `json:"nodeTemplate,omitempty"`
+
+	// CustomMachine contains the shape of a custom machine type which is used instead of the machine type of the worker pool.
+	// +optional
+	CustomMachine *CustomMachine `json:"customMachine,omitempty"`
+
+	// ResourceManagerTags are the resource manager tags which are attached to the VMs. The keys must have the format
+	// `tagKeys/{tag_key_id}` and the values the format `tagValues/{tag_value_id}`.
+	// These tags are unrelated to the network tags of the VMs.
+	// +optional
+	ResourceManagerTags map[string]string `json:"resourceManagerTags,omitempty"`
+
+	// InstanceMetadata contains additional metadata entries which are added to the VMs. Keys which are managed by
+	// Gardener, e.g. `block-project-ssh-keys` or `user-data`, cannot be set.
+	// +optional
+	InstanceMetadata map[string]string `json:"instanceMetadata,omitempty"`
+
+	// DNSSearchDomains are the DNS search domains which are configured on the VMs in addition to the ones provided by
+	// the VPC.
+	// +optional
+	DNSSearchDomains []string `json:"dnsSearchDomains,omitempty"`
+
+	// BlockProjectSSHKeys controls whether project-wide SSH keys are blocked on the VMs. Defaults to true. Allowing
+	// project-wide SSH keys must be permitted by the operator in the controller configuration.
+	// +optional
+	BlockProjectSSHKeys *bool `json:"blockProjectSSHKeys,omitempty"`
+
+	// GuestOSFeatures are the guest OS features of the boot disk, e.g. `GVNIC`. If not set, they are derived from the
+	// machine family, e.g. accelerator-optimized machines get `GVNIC`.
+	// +optional
+	GuestOSFeatures []string `json:"guestOSFeatures,omitempty"`
+
+	// ConfidentialCompute enables Confidential VMs. If not set, it is derived from the machine family, i.e. disabled for
+	// accelerator-optimized machines and left to the GCP default otherwise.
+	// +optional
+	ConfidentialCompute *bool `json:"confidentialCompute,omitempty"`
+
+	// DeletionProtection enables the deletion protection of the VMs. Defaults to false. Only VMs which are created after
+	// the setting was changed are affected.
+	// +optional
+	DeletionProtection *bool `json:"deletionProtection,omitempty"`
+
+	// CanIPForward allows the VMs to send and receive packets with non-matching source or destination IPs. Defaults to
+	// true. It can only be disabled if the overlay network of the shoot is enabled, as pod traffic is routed natively
+	// otherwise.
+	// +optional
+	CanIPForward *bool `json:"canIPForward,omitempty"`
+
+	// NetworkTags are additional network tags of the VMs, e.g. to match custom firewall rules. The network tags
+	// managed by Gardener are always set.
+	// +optional
+	NetworkTags []string `json:"networkTags,omitempty"`
+
+	// OnHostMaintenance is the behavior of the VMs on host maintenance events, either `MIGRATE` or `TERMINATE`. If not
+	// set, it is derived from the machine, i.e. `TERMINATE` for VMs with GPUs or Confidential VMs and `MIGRATE`
+	// otherwise. VMs which do not support live migration always use `TERMINATE`.
+	// +optional
+	OnHostMaintenance OnHostMaintenancePolicy `json:"onHostMaintenance,omitempty"`
+
+	// AutomaticRestart restarts the VMs automatically if they are terminated by Compute Engine, e.g. due to a host
+	// maintenance event or a hardware failure. Defaults to true.
+	// +optional
+	AutomaticRestart *bool `json:"automaticRestart,omitempty"`
+
+	// SubnetName is the name of the subnet of the infrastructure status the VMs are attached to. If not set, the nodes
+	// subnet is used.
+	// +optional
+	SubnetName string `json:"subnetName,omitempty"`
+
+	// EnableExternalIP assigns ephemeral external IPs to the VMs, so that their egress traffic does not pass the Cloud
+	// NAT. Defaults to false. It must not be enabled if the Cloud NAT uses user-provided IPs.
+	// +optional
+	EnableExternalIP *bool `json:"enableExternalIP,omitempty"`
+
+	// InstanceLabels are additional labels of the VMs and their disks. Keys and values are sanitized to match the label
+	// restrictions of GCP. Labels which are managed by Gardener, e.g. `name` or the labels of the worker pool, cannot
+	// be overwritten.
+	// +optional
+	InstanceLabels map[string]string `json:"instanceLabels,omitempty"`
+
+	// DescriptionTemplate is a Go template for the description of the VMs. It can refer to `.Shoot`, `.Namespace`,
+	// `.Pool` and `.Zone`. If not set, a description referring to the shoot is used.
+	// +optional
+	DescriptionTemplate string `json:"descriptionTemplate,omitempty"`
+}
+
+// CustomMachine is the configuration of a custom machine type.
+type CustomMachine struct {
+	// VCPUs is the number of vCPUs of the machine.
+	VCPUs int32 `json:"vcpus"`
+	// MemoryMiB is the memory of the machine in MiB.
+	MemoryMiB int32 `json:"memoryMiB"`
+	// Family is the machine family of the custom machine type, e.g. `n2`. Defaults to the `n1` family if empty.
+	// +optional
+	Family string `json:"family,omitempty"`
 }
 
 // Volume contains configuration for the disks attached to VMs.
@@ -51,9 +145,29 @@ type Volume struct {
 	// +optional
 	LocalSSDInterface *string `json:"interface,omitempty"`
 
+	// LocalSSDCount is the number of local SSDs (375GB each) which are attached to the VMs in addition to the data
+	// volumes of type SCRATCH.
+	// +optional
+	LocalSSDCount *int32 `json:"localSSDCount,omitempty"`
+
 	// Encryption refers to the disk encryption details for this volume
 	// +optional
 	Encryption *DiskEncryption `json:"encryption,omitempty"`
+
+	// Regional configures the root volume as a regional persistent disk.
+	// +optional
+	Regional *RegionalDisk `json:"regional,omitempty"`
+
+	// ProvisionedIops of the root disk. Only allowed for hyperdisk types which can be used as boot disks, i.e.
+	// `hyperdisk-balanced`. If not set gcp calculates a default value taking the disk size into consideration.
+	// +optional
+	ProvisionedIops *int64 `json:"provisionedIops,omitempty"`
+
+	// ProvisionedThroughput of the root disk in MiB per second. Only allowed for hyperdisk types which can be used as
+	// boot disks, i.e. `hyperdisk-balanced`. If not set gcp calculates a default value taking the disk size into
+	// consideration.
+	// +optional
+	ProvisionedThroughput *int64 `json:"provisionedThroughput,omitempty"`
 }
 
 // DataVolume contains configuration for data volumes attached to VMs.
@@ -82,6 +196,17 @@ type DataVolume struct {
 	// If not set gcp calculates a default value taking the disk size into consideration.
 	// Hyperdisk Throughput volumes can't be used as boot disks.
 	ProvisionedThroughput *int64 `json:"provisionedThroughput"`
+
+	// Regional configures the data volume as a regional persistent disk.
+	// +optional
+	Regional *RegionalDisk `json:"regional,omitempty"`
+}
+
+// RegionalDisk contains the configuration of a regional persistent disk which is synchronously replicated between two
+// zones.
+type RegionalDisk struct {
+	// ReplicaZones are the two zones the disk is replicated to. They must include all zones of the worker pool.
+	ReplicaZones []string `json:"replicaZones"`
 }
 
 // DiskEncryption encapsulates the encryption configuration for a disk.
@@ -126,8 +251,42 @@ type GPU struct {
 	AcceleratorType string `json:"acceleratorType"`
 	// Count is the number of accelerator to be attached
 	Count int32 `json:"count"`
+	// DriverVersion is the version of the GPU driver which is requested for the nodes, e.g. `default` or `latest`.
+	// +optional
+	DriverVersion string `json:"driverVersion,omitempty"`
+	// GpuSharingConfig contains the configuration for sharing the GPUs between multiple containers.
+	// +optional
+	GpuSharingConfig *GpuSharing `json:"gpuSharingConfig,omitempty"`
+}
+
+// GpuSharing is the configuration for sharing GPUs between multiple containers.
+type GpuSharing struct {
+	// Strategy is the strategy used for sharing the GPUs.
+	Strategy GpuSharingStrategy `json:"strategy"`
+	// MaxSharedClientsPerGpu is the maximum number of containers which can share a GPU.
+	MaxSharedClientsPerGpu int32 `json:"maxSharedClientsPerGpu"`
 }
 
+// GpuSharingStrategy is a strategy for sharing GPUs between multiple containers.
+type GpuSharingStrategy string
+
+const (
+	// GpuSharingStrategyTimeSharing is a GpuSharingStrategy which shares the GPUs by time-slicing.
+	GpuSharingStrategyTimeSharing GpuSharingStrategy = "time-sharing"
+	// GpuSharingStrategyMPS is a GpuSharingStrategy which shares the GPUs via the NVIDIA Multi-Process Service.
+	GpuSharingStrategyMPS GpuSharingStrategy = "mps"
+)
+
+// OnHostMaintenancePolicy is the behavior of VMs on host maintenance events.
+type OnHostMaintenancePolicy string
+
+const (
+	// OnHostMaintenanceMigrate is an OnHostMaintenancePolicy which live-migrates the VMs to another host.
+	OnHostMaintenanceMigrate OnHostMaintenancePolicy = "MIGRATE"
+	// OnHostMaintenanceTerminate is an OnHostMaintenancePolicy which stops the VMs.
+	OnHostMaintenanceTerminate OnHostMaintenancePolicy = "TERMINATE"
+)
+
 // MachineImage is a mapping from logical names and versions to GCP-specific identifiers.
 type MachineImage struct {
 	// Name is the logical name of the machine image.
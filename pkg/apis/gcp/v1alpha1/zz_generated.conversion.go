@@ -14,6 +14,7 @@ import (
 
 	gcp "github.com/gardener/gardener-extension-provider-gcp/pkg/apis/gcp"
 	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	conversion "k8s.io/apimachinery/pkg/conversion"
 	runtime "k8s.io/apimachinery/pkg/runtime"
 )
@@ -35,6 +36,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*BucketEncryption)(nil), (*gcp.BucketEncryption)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_BucketEncryption_To_gcp_BucketEncryption(a.(*BucketEncryption), b.(*gcp.BucketEncryption), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*gcp.BucketEncryption)(nil), (*BucketEncryption)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_gcp_BucketEncryption_To_v1alpha1_BucketEncryption(a.(*gcp.BucketEncryption), b.(*BucketEncryption), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*CloudControllerManagerConfig)(nil), (*gcp.CloudControllerManagerConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_CloudControllerManagerConfig_To_gcp_CloudControllerManagerConfig(a.(*CloudControllerManagerConfig), b.(*gcp.CloudControllerManagerConfig), scope)
 	}); err != nil {
@@ -85,6 +96,66 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*ControlPlaneReplicas)(nil), (*gcp.ControlPlaneReplicas)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_ControlPlaneReplicas_To_gcp_ControlPlaneReplicas(a.(*ControlPlaneReplicas), b.(*gcp.ControlPlaneReplicas), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*gcp.ControlPlaneReplicas)(nil), (*ControlPlaneReplicas)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_gcp_ControlPlaneReplicas_To_v1alpha1_ControlPlaneReplicas(a.(*gcp.ControlPlaneReplicas), b.(*ControlPlaneReplicas), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*CustomMachine)(nil), (*gcp.CustomMachine)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_CustomMachine_To_gcp_CustomMachine(a.(*CustomMachine), b.(*gcp.CustomMachine), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*gcp.CustomMachine)(nil), (*CustomMachine)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_gcp_CustomMachine_To_v1alpha1_CustomMachine(a.(*gcp.CustomMachine), b.(*CustomMachine), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*DNSGeoTarget)(nil), (*gcp.DNSGeoTarget)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_DNSGeoTarget_To_gcp_DNSGeoTarget(a.(*DNSGeoTarget), b.(*gcp.DNSGeoTarget), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*gcp.DNSGeoTarget)(nil), (*DNSGeoTarget)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_gcp_DNSGeoTarget_To_v1alpha1_DNSGeoTarget(a.(*gcp.DNSGeoTarget), b.(*DNSGeoTarget), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*DNSRecordConfig)(nil), (*gcp.DNSRecordConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_DNSRecordConfig_To_gcp_DNSRecordConfig(a.(*DNSRecordConfig), b.(*gcp.DNSRecordConfig), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*gcp.DNSRecordConfig)(nil), (*DNSRecordConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_gcp_DNSRecordConfig_To_v1alpha1_DNSRecordConfig(a.(*gcp.DNSRecordConfig), b.(*DNSRecordConfig), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*DNSRoutingPolicy)(nil), (*gcp.DNSRoutingPolicy)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_DNSRoutingPolicy_To_gcp_DNSRoutingPolicy(a.(*DNSRoutingPolicy), b.(*gcp.DNSRoutingPolicy), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*gcp.DNSRoutingPolicy)(nil), (*DNSRoutingPolicy)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_gcp_DNSRoutingPolicy_To_v1alpha1_DNSRoutingPolicy(a.(*gcp.DNSRoutingPolicy), b.(*DNSRoutingPolicy), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*DNSWeightedTarget)(nil), (*gcp.DNSWeightedTarget)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_DNSWeightedTarget_To_gcp_DNSWeightedTarget(a.(*DNSWeightedTarget), b.(*gcp.DNSWeightedTarget), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*gcp.DNSWeightedTarget)(nil), (*DNSWeightedTarget)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_gcp_DNSWeightedTarget_To_v1alpha1_DNSWeightedTarget(a.(*gcp.DNSWeightedTarget), b.(*DNSWeightedTarget), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*DataVolume)(nil), (*gcp.DataVolume)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_DataVolume_To_gcp_DataVolume(a.(*DataVolume), b.(*gcp.DataVolume), scope)
 	}); err != nil {
@@ -135,6 +206,26 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*GpuSharing)(nil), (*gcp.GpuSharing)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_GpuSharing_To_gcp_GpuSharing(a.(*GpuSharing), b.(*gcp.GpuSharing), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*gcp.GpuSharing)(nil), (*GpuSharing)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_gcp_GpuSharing_To_v1alpha1_GpuSharing(a.(*gcp.GpuSharing), b.(*GpuSharing), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*HealthChecks)(nil), (*gcp.HealthChecks)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_HealthChecks_To_gcp_HealthChecks(a.(*HealthChecks), b.(*gcp.HealthChecks), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*gcp.HealthChecks)(nil), (*HealthChecks)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_gcp_HealthChecks_To_v1alpha1_HealthChecks(a.(*gcp.HealthChecks), b.(*HealthChecks), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*ImmutableConfig)(nil), (*gcp.ImmutableConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_ImmutableConfig_To_gcp_ImmutableConfig(a.(*ImmutableConfig), b.(*gcp.ImmutableConfig), scope)
 	}); err != nil {
@@ -175,6 +266,26 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*LeaderElection)(nil), (*gcp.LeaderElection)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_LeaderElection_To_gcp_LeaderElection(a.(*LeaderElection), b.(*gcp.LeaderElection), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*gcp.LeaderElection)(nil), (*LeaderElection)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_gcp_LeaderElection_To_v1alpha1_LeaderElection(a.(*gcp.LeaderElection), b.(*LeaderElection), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*LifecycleRule)(nil), (*gcp.LifecycleRule)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_LifecycleRule_To_gcp_LifecycleRule(a.(*LifecycleRule), b.(*gcp.LifecycleRule), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*gcp.LifecycleRule)(nil), (*LifecycleRule)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_gcp_LifecycleRule_To_v1alpha1_LifecycleRule(a.(*gcp.LifecycleRule), b.(*LifecycleRule), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*MachineImage)(nil), (*gcp.MachineImage)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_MachineImage_To_gcp_MachineImage(a.(*MachineImage), b.(*gcp.MachineImage), scope)
 	}); err != nil {
@@ -235,6 +346,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*NetworkConnectivityCenter)(nil), (*gcp.NetworkConnectivityCenter)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_NetworkConnectivityCenter_To_gcp_NetworkConnectivityCenter(a.(*NetworkConnectivityCenter), b.(*gcp.NetworkConnectivityCenter), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*gcp.NetworkConnectivityCenter)(nil), (*NetworkConnectivityCenter)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_gcp_NetworkConnectivityCenter_To_v1alpha1_NetworkConnectivityCenter(a.(*gcp.NetworkConnectivityCenter), b.(*NetworkConnectivityCenter), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*NetworkStatus)(nil), (*gcp.NetworkStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_NetworkStatus_To_gcp_NetworkStatus(a.(*NetworkStatus), b.(*gcp.NetworkStatus), scope)
 	}); err != nil {
@@ -245,6 +366,26 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*PSAConfig)(nil), (*gcp.PSAConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_PSAConfig_To_gcp_PSAConfig(a.(*PSAConfig), b.(*gcp.PSAConfig), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*gcp.PSAConfig)(nil), (*PSAConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_gcp_PSAConfig_To_v1alpha1_PSAConfig(a.(*gcp.PSAConfig), b.(*PSAConfig), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*RegionalDisk)(nil), (*gcp.RegionalDisk)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_RegionalDisk_To_gcp_RegionalDisk(a.(*RegionalDisk), b.(*gcp.RegionalDisk), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*gcp.RegionalDisk)(nil), (*RegionalDisk)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_gcp_RegionalDisk_To_v1alpha1_RegionalDisk(a.(*gcp.RegionalDisk), b.(*RegionalDisk), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*ServiceAccount)(nil), (*gcp.ServiceAccount)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_ServiceAccount_To_gcp_ServiceAccount(a.(*ServiceAccount), b.(*gcp.ServiceAccount), scope)
 	}); err != nil {
@@ -265,6 +406,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*StorageClassConfig)(nil), (*gcp.StorageClassConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_StorageClassConfig_To_gcp_StorageClassConfig(a.(*StorageClassConfig), b.(*gcp.StorageClassConfig), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*gcp.StorageClassConfig)(nil), (*StorageClassConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_gcp_StorageClassConfig_To_v1alpha1_StorageClassConfig(a.(*gcp.StorageClassConfig), b.(*StorageClassConfig), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*Subnet)(nil), (*gcp.Subnet)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_Subnet_To_gcp_Subnet(a.(*Subnet), b.(*gcp.Subnet), scope)
 	}); err != nil {
@@ -320,6 +471,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 
 func autoConvert_v1alpha1_BackupBucketConfig_To_gcp_BackupBucketConfig(in *BackupBucketConfig, out *gcp.BackupBucketConfig, s conversion.Scope) error {
 	out.Immutability = (*gcp.ImmutableConfig)(unsafe.Pointer(in.Immutability))
+	out.Versioning = (*bool)(unsafe.Pointer(in.Versioning))
+	out.StorageClass = in.StorageClass
+	out.Location = in.Location
+	out.DualRegion = *(*[]string)(unsafe.Pointer(&in.DualRegion))
+	out.LifecycleRules = *(*[]gcp.LifecycleRule)(unsafe.Pointer(&in.LifecycleRules))
+	out.Encryption = (*gcp.BucketEncryption)(unsafe.Pointer(in.Encryption))
+	out.UniformBucketLevelAccess = (*bool)(unsafe.Pointer(in.UniformBucketLevelAccess))
+	out.PublicAccessPrevention = (*string)(unsafe.Pointer(in.PublicAccessPrevention))
+	out.TurboReplication = (*bool)(unsafe.Pointer(in.TurboReplication))
+	out.Labels = *(*map[string]string)(unsafe.Pointer(&in.Labels))
 	return nil
 }
 
@@ -330,6 +491,16 @@ func Convert_v1alpha1_BackupBucketConfig_To_gcp_BackupBucketConfig(in *BackupBuc
 
 func autoConvert_gcp_BackupBucketConfig_To_v1alpha1_BackupBucketConfig(in *gcp.BackupBucketConfig, out *BackupBucketConfig, s conversion.Scope) error {
 	out.Immutability = (*ImmutableConfig)(unsafe.Pointer(in.Immutability))
+	out.Versioning = (*bool)(unsafe.Pointer(in.Versioning))
+	out.StorageClass = in.StorageClass
+	out.Location = in.Location
+	out.DualRegion = *(*[]string)(unsafe.Pointer(&in.DualRegion))
+	out.LifecycleRules = *(*[]LifecycleRule)(unsafe.Pointer(&in.LifecycleRules))
+	out.Encryption = (*BucketEncryption)(unsafe.Pointer(in.Encryption))
+	out.UniformBucketLevelAccess = (*bool)(unsafe.Pointer(in.UniformBucketLevelAccess))
+	out.PublicAccessPrevention = (*string)(unsafe.Pointer(in.PublicAccessPrevention))
+	out.TurboReplication = (*bool)(unsafe.Pointer(in.TurboReplication))
+	out.Labels = *(*map[string]string)(unsafe.Pointer(&in.Labels))
 	return nil
 }
 
@@ -338,8 +509,34 @@ func Convert_gcp_BackupBucketConfig_To_v1alpha1_BackupBucketConfig(in *gcp.Backu
 	return autoConvert_gcp_BackupBucketConfig_To_v1alpha1_BackupBucketConfig(in, out, s)
 }
 
+func autoConvert_v1alpha1_BucketEncryption_To_gcp_BucketEncryption(in *BucketEncryption, out *gcp.BucketEncryption, s conversion.Scope) error {
+	out.KmsKeyName = in.KmsKeyName
+	return nil
+}
+
+// Convert_v1alpha1_BucketEncryption_To_gcp_BucketEncryption is an autogenerated conversion function.
+func Convert_v1alpha1_BucketEncryption_To_gcp_BucketEncryption(in *BucketEncryption, out *gcp.BucketEncryption, s conversion.Scope) error {
+	return autoConvert_v1alpha1_BucketEncryption_To_gcp_BucketEncryption(in, out, s)
+}
+
+func autoConvert_gcp_BucketEncryption_To_v1alpha1_BucketEncryption(in *gcp.BucketEncryption, out *BucketEncryption, s conversion.Scope) error {
+	out.KmsKeyName = in.KmsKeyName
+	return nil
+}
+
+// Convert_gcp_BucketEncryption_To_v1alpha1_BucketEncryption is an autogenerated conversion function.
+func Convert_gcp_BucketEncryption_To_v1alpha1_BucketEncryption(in *gcp.BucketEncryption, out *BucketEncryption, s conversion.Scope) error {
+	return autoConvert_gcp_BucketEncryption_To_v1alpha1_BucketEncryption(in, out, s)
+}
+
 func autoConvert_v1alpha1_CloudControllerManagerConfig_To_gcp_CloudControllerManagerConfig(in *CloudControllerManagerConfig, out *gcp.CloudControllerManagerConfig, s conversion.Scope) error {
 	out.FeatureGates = *(*map[string]bool)(unsafe.Pointer(&in.FeatureGates))
+	out.InternalLoadBalancerSubnet = (*string)(unsafe.Pointer(in.InternalLoadBalancerSubnet))
+	out.RouteReconciliationPeriod = (*v1.Duration)(unsafe.Pointer(in.RouteReconciliationPeriod))
+	out.ConcurrentRouteSyncs = (*int32)(unsafe.Pointer(in.ConcurrentRouteSyncs))
+	out.AdditionalFlags = *(*map[string]string)(unsafe.Pointer(&in.AdditionalFlags))
+	out.NodeStatusUpdateFrequency = (*v1.Duration)(unsafe.Pointer(in.NodeStatusUpdateFrequency))
+	out.LeaderElection = (*gcp.LeaderElection)(unsafe.Pointer(in.LeaderElection))
 	return nil
 }
 
@@ -350,6 +547,12 @@ func Convert_v1alpha1_CloudControllerManagerConfig_To_gcp_CloudControllerManager
 
 func autoConvert_gcp_CloudControllerManagerConfig_To_v1alpha1_CloudControllerManagerConfig(in *gcp.CloudControllerManagerConfig, out *CloudControllerManagerConfig, s conversion.Scope) error {
 	out.FeatureGates = *(*map[string]bool)(unsafe.Pointer(&in.FeatureGates))
+	out.InternalLoadBalancerSubnet = (*string)(unsafe.Pointer(in.InternalLoadBalancerSubnet))
+	out.RouteReconciliationPeriod = (*v1.Duration)(unsafe.Pointer(in.RouteReconciliationPeriod))
+	out.ConcurrentRouteSyncs = (*int32)(unsafe.Pointer(in.ConcurrentRouteSyncs))
+	out.AdditionalFlags = *(*map[string]string)(unsafe.Pointer(&in.AdditionalFlags))
+	out.NodeStatusUpdateFrequency = (*v1.Duration)(unsafe.Pointer(in.NodeStatusUpdateFrequency))
+	out.LeaderElection = (*LeaderElection)(unsafe.Pointer(in.LeaderElection))
 	return nil
 }
 
@@ -398,6 +601,7 @@ func Convert_gcp_CloudNAT_To_v1alpha1_CloudNAT(in *gcp.CloudNAT, out *CloudNAT,
 
 func autoConvert_v1alpha1_CloudProfileConfig_To_gcp_CloudProfileConfig(in *CloudProfileConfig, out *gcp.CloudProfileConfig, s conversion.Scope) error {
 	out.MachineImages = *(*[]gcp.MachineImages)(unsafe.Pointer(&in.MachineImages))
+	out.AllowBroadServiceAccountScopes = (*bool)(unsafe.Pointer(in.AllowBroadServiceAccountScopes))
 	return nil
 }
 
@@ -408,6 +612,7 @@ func Convert_v1alpha1_CloudProfileConfig_To_gcp_CloudProfileConfig(in *CloudProf
 
 func autoConvert_gcp_CloudProfileConfig_To_v1alpha1_CloudProfileConfig(in *gcp.CloudProfileConfig, out *CloudProfileConfig, s conversion.Scope) error {
 	out.MachineImages = *(*[]MachineImages)(unsafe.Pointer(&in.MachineImages))
+	out.AllowBroadServiceAccountScopes = (*bool)(unsafe.Pointer(in.AllowBroadServiceAccountScopes))
 	return nil
 }
 
@@ -440,6 +645,7 @@ func autoConvert_v1alpha1_ControlPlaneConfig_To_gcp_ControlPlaneConfig(in *Contr
 	out.Zone = in.Zone
 	out.CloudControllerManager = (*gcp.CloudControllerManagerConfig)(unsafe.Pointer(in.CloudControllerManager))
 	out.Storage = (*gcp.Storage)(unsafe.Pointer(in.Storage))
+	out.Replicas = (*gcp.ControlPlaneReplicas)(unsafe.Pointer(in.Replicas))
 	return nil
 }
 
@@ -452,6 +658,7 @@ func autoConvert_gcp_ControlPlaneConfig_To_v1alpha1_ControlPlaneConfig(in *gcp.C
 	out.Zone = in.Zone
 	out.CloudControllerManager = (*CloudControllerManagerConfig)(unsafe.Pointer(in.CloudControllerManager))
 	out.Storage = (*Storage)(unsafe.Pointer(in.Storage))
+	out.Replicas = (*ControlPlaneReplicas)(unsafe.Pointer(in.Replicas))
 	return nil
 }
 
@@ -460,11 +667,150 @@ func Convert_gcp_ControlPlaneConfig_To_v1alpha1_ControlPlaneConfig(in *gcp.Contr
 	return autoConvert_gcp_ControlPlaneConfig_To_v1alpha1_ControlPlaneConfig(in, out, s)
 }
 
+func autoConvert_v1alpha1_ControlPlaneReplicas_To_gcp_ControlPlaneReplicas(in *ControlPlaneReplicas, out *gcp.ControlPlaneReplicas, s conversion.Scope) error {
+	out.CloudControllerManager = (*int32)(unsafe.Pointer(in.CloudControllerManager))
+	out.CSIController = (*int32)(unsafe.Pointer(in.CSIController))
+	out.CSISnapshotController = (*int32)(unsafe.Pointer(in.CSISnapshotController))
+	return nil
+}
+
+// Convert_v1alpha1_ControlPlaneReplicas_To_gcp_ControlPlaneReplicas is an autogenerated conversion function.
+func Convert_v1alpha1_ControlPlaneReplicas_To_gcp_ControlPlaneReplicas(in *ControlPlaneReplicas, out *gcp.ControlPlaneReplicas, s conversion.Scope) error {
+	return autoConvert_v1alpha1_ControlPlaneReplicas_To_gcp_ControlPlaneReplicas(in, out, s)
+}
+
+func autoConvert_gcp_ControlPlaneReplicas_To_v1alpha1_ControlPlaneReplicas(in *gcp.ControlPlaneReplicas, out *ControlPlaneReplicas, s conversion.Scope) error {
+	out.CloudControllerManager = (*int32)(unsafe.Pointer(in.CloudControllerManager))
+	out.CSIController = (*int32)(unsafe.Pointer(in.CSIController))
+	out.CSISnapshotController = (*int32)(unsafe.Pointer(in.CSISnapshotController))
+	return nil
+}
+
+// Convert_gcp_ControlPlaneReplicas_To_v1alpha1_ControlPlaneReplicas is an autogenerated conversion function.
+func Convert_gcp_ControlPlaneReplicas_To_v1alpha1_ControlPlaneReplicas(in *gcp.ControlPlaneReplicas, out *ControlPlaneReplicas, s conversion.Scope) error {
+	return autoConvert_gcp_ControlPlaneReplicas_To_v1alpha1_ControlPlaneReplicas(in, out, s)
+}
+
+func autoConvert_v1alpha1_CustomMachine_To_gcp_CustomMachine(in *CustomMachine, out *gcp.CustomMachine, s conversion.Scope) error {
+	out.VCPUs = in.VCPUs
+	out.MemoryMiB = in.MemoryMiB
+	out.Family = in.Family
+	return nil
+}
+
+// Convert_v1alpha1_CustomMachine_To_gcp_CustomMachine is an autogenerated conversion function.
+func Convert_v1alpha1_CustomMachine_To_gcp_CustomMachine(in *CustomMachine, out *gcp.CustomMachine, s conversion.Scope) error {
+	return autoConvert_v1alpha1_CustomMachine_To_gcp_CustomMachine(in, out, s)
+}
+
+func autoConvert_gcp_CustomMachine_To_v1alpha1_CustomMachine(in *gcp.CustomMachine, out *CustomMachine, s conversion.Scope) error {
+	out.VCPUs = in.VCPUs
+	out.MemoryMiB = in.MemoryMiB
+	out.Family = in.Family
+	return nil
+}
+
+// Convert_gcp_CustomMachine_To_v1alpha1_CustomMachine is an autogenerated conversion function.
+func Convert_gcp_CustomMachine_To_v1alpha1_CustomMachine(in *gcp.CustomMachine, out *CustomMachine, s conversion.Scope) error {
+	return autoConvert_gcp_CustomMachine_To_v1alpha1_CustomMachine(in, out, s)
+}
+
+func autoConvert_v1alpha1_DNSGeoTarget_To_gcp_DNSGeoTarget(in *DNSGeoTarget, out *gcp.DNSGeoTarget, s conversion.Scope) error {
+	out.Location = in.Location
+	out.Values = *(*[]string)(unsafe.Pointer(&in.Values))
+	return nil
+}
+
+// Convert_v1alpha1_DNSGeoTarget_To_gcp_DNSGeoTarget is an autogenerated conversion function.
+func Convert_v1alpha1_DNSGeoTarget_To_gcp_DNSGeoTarget(in *DNSGeoTarget, out *gcp.DNSGeoTarget, s conversion.Scope) error {
+	return autoConvert_v1alpha1_DNSGeoTarget_To_gcp_DNSGeoTarget(in, out, s)
+}
+
+func autoConvert_gcp_DNSGeoTarget_To_v1alpha1_DNSGeoTarget(in *gcp.DNSGeoTarget, out *DNSGeoTarget, s conversion.Scope) error {
+	out.Location = in.Location
+	out.Values = *(*[]string)(unsafe.Pointer(&in.Values))
+	return nil
+}
+
+// Convert_gcp_DNSGeoTarget_To_v1alpha1_DNSGeoTarget is an autogenerated conversion function.
+func Convert_gcp_DNSGeoTarget_To_v1alpha1_DNSGeoTarget(in *gcp.DNSGeoTarget, out *DNSGeoTarget, s conversion.Scope) error {
+	return autoConvert_gcp_DNSGeoTarget_To_v1alpha1_DNSGeoTarget(in, out, s)
+}
+
+func autoConvert_v1alpha1_DNSRecordConfig_To_gcp_DNSRecordConfig(in *DNSRecordConfig, out *gcp.DNSRecordConfig, s conversion.Scope) error {
+	out.ZoneVisibility = (*string)(unsafe.Pointer(in.ZoneVisibility))
+	out.TTL = (*int64)(unsafe.Pointer(in.TTL))
+	out.RoutingPolicy = (*gcp.DNSRoutingPolicy)(unsafe.Pointer(in.RoutingPolicy))
+	return nil
+}
+
+// Convert_v1alpha1_DNSRecordConfig_To_gcp_DNSRecordConfig is an autogenerated conversion function.
+func Convert_v1alpha1_DNSRecordConfig_To_gcp_DNSRecordConfig(in *DNSRecordConfig, out *gcp.DNSRecordConfig, s conversion.Scope) error {
+	return autoConvert_v1alpha1_DNSRecordConfig_To_gcp_DNSRecordConfig(in, out, s)
+}
+
+func autoConvert_gcp_DNSRecordConfig_To_v1alpha1_DNSRecordConfig(in *gcp.DNSRecordConfig, out *DNSRecordConfig, s conversion.Scope) error {
+	out.ZoneVisibility = (*string)(unsafe.Pointer(in.ZoneVisibility))
+	out.TTL = (*int64)(unsafe.Pointer(in.TTL))
+	out.RoutingPolicy = (*DNSRoutingPolicy)(unsafe.Pointer(in.RoutingPolicy))
+	return nil
+}
+
+// Convert_gcp_DNSRecordConfig_To_v1alpha1_DNSRecordConfig is an autogenerated conversion function.
+func Convert_gcp_DNSRecordConfig_To_v1alpha1_DNSRecordConfig(in *gcp.DNSRecordConfig, out *DNSRecordConfig, s conversion.Scope) error {
+	return autoConvert_gcp_DNSRecordConfig_To_v1alpha1_DNSRecordConfig(in, out, s)
+}
+
+func autoConvert_v1alpha1_DNSRoutingPolicy_To_gcp_DNSRoutingPolicy(in *DNSRoutingPolicy, out *gcp.DNSRoutingPolicy, s conversion.Scope) error {
+	out.WeightedRoundRobin = *(*[]gcp.DNSWeightedTarget)(unsafe.Pointer(&in.WeightedRoundRobin))
+	out.Geo = *(*[]gcp.DNSGeoTarget)(unsafe.Pointer(&in.Geo))
+	return nil
+}
+
+// Convert_v1alpha1_DNSRoutingPolicy_To_gcp_DNSRoutingPolicy is an autogenerated conversion function.
+func Convert_v1alpha1_DNSRoutingPolicy_To_gcp_DNSRoutingPolicy(in *DNSRoutingPolicy, out *gcp.DNSRoutingPolicy, s conversion.Scope) error {
+	return autoConvert_v1alpha1_DNSRoutingPolicy_To_gcp_DNSRoutingPolicy(in, out, s)
+}
+
+func autoConvert_gcp_DNSRoutingPolicy_To_v1alpha1_DNSRoutingPolicy(in *gcp.DNSRoutingPolicy, out *DNSRoutingPolicy, s conversion.Scope) error {
+	out.WeightedRoundRobin = *(*[]DNSWeightedTarget)(unsafe.Pointer(&in.WeightedRoundRobin))
+	out.Geo = *(*[]DNSGeoTarget)(unsafe.Pointer(&in.Geo))
+	return nil
+}
+
+// Convert_gcp_DNSRoutingPolicy_To_v1alpha1_DNSRoutingPolicy is an autogenerated conversion function.
+func Convert_gcp_DNSRoutingPolicy_To_v1alpha1_DNSRoutingPolicy(in *gcp.DNSRoutingPolicy, out *DNSRoutingPolicy, s conversion.Scope) error {
+	return autoConvert_gcp_DNSRoutingPolicy_To_v1alpha1_DNSRoutingPolicy(in, out, s)
+}
+
+func autoConvert_v1alpha1_DNSWeightedTarget_To_gcp_DNSWeightedTarget(in *DNSWeightedTarget, out *gcp.DNSWeightedTarget, s conversion.Scope) error {
+	out.Weight = in.Weight
+	out.Values = *(*[]string)(unsafe.Pointer(&in.Values))
+	return nil
+}
+
+// Convert_v1alpha1_DNSWeightedTarget_To_gcp_DNSWeightedTarget is an autogenerated conversion function.
+func Convert_v1alpha1_DNSWeightedTarget_To_gcp_DNSWeightedTarget(in *DNSWeightedTarget, out *gcp.DNSWeightedTarget, s conversion.Scope) error {
+	return autoConvert_v1alpha1_DNSWeightedTarget_To_gcp_DNSWeightedTarget(in, out, s)
+}
+
+func autoConvert_gcp_DNSWeightedTarget_To_v1alpha1_DNSWeightedTarget(in *gcp.DNSWeightedTarget, out *DNSWeightedTarget, s conversion.Scope) error {
+	out.Weight = in.Weight
+	out.Values = *(*[]string)(unsafe.Pointer(&in.Values))
+	return nil
+}
+
+// Convert_gcp_DNSWeightedTarget_To_v1alpha1_DNSWeightedTarget is an autogenerated conversion function.
+func Convert_gcp_DNSWeightedTarget_To_v1alpha1_DNSWeightedTarget(in *gcp.DNSWeightedTarget, out *DNSWeightedTarget, s conversion.Scope) error {
+	return autoConvert_gcp_DNSWeightedTarget_To_v1alpha1_DNSWeightedTarget(in, out, s)
+}
+
 func autoConvert_v1alpha1_DataVolume_To_gcp_DataVolume(in *DataVolume, out *gcp.DataVolume, s conversion.Scope) error {
 	out.Name = in.Name
 	out.SourceImage = (*string)(unsafe.Pointer(in.SourceImage))
 	out.ProvisionedIops = (*int64)(unsafe.Pointer(in.ProvisionedIops))
 	out.ProvisionedThroughput = (*int64)(unsafe.Pointer(in.ProvisionedThroughput))
+	out.Regional = (*gcp.RegionalDisk)(unsafe.Pointer(in.Regional))
 	return nil
 }
 
@@ -478,6 +824,7 @@ func autoConvert_gcp_DataVolume_To_v1alpha1_DataVolume(in *gcp.DataVolume, out *
 	out.SourceImage = (*string)(unsafe.Pointer(in.SourceImage))
 	out.ProvisionedIops = (*int64)(unsafe.Pointer(in.ProvisionedIops))
 	out.ProvisionedThroughput = (*int64)(unsafe.Pointer(in.ProvisionedThroughput))
+	out.Regional = (*RegionalDisk)(unsafe.Pointer(in.Regional))
 	return nil
 }
 
@@ -567,6 +914,8 @@ func Convert_gcp_FlowLogs_To_v1alpha1_FlowLogs(in *gcp.FlowLogs, out *FlowLogs,
 func autoConvert_v1alpha1_GPU_To_gcp_GPU(in *GPU, out *gcp.GPU, s conversion.Scope) error {
 	out.AcceleratorType = in.AcceleratorType
 	out.Count = in.Count
+	out.DriverVersion = in.DriverVersion
+	out.GpuSharingConfig = (*gcp.GpuSharing)(unsafe.Pointer(in.GpuSharingConfig))
 	return nil
 }
 
@@ -578,6 +927,8 @@ func Convert_v1alpha1_GPU_To_gcp_GPU(in *GPU, out *gcp.GPU, s conversion.Scope)
 func autoConvert_gcp_GPU_To_v1alpha1_GPU(in *gcp.GPU, out *GPU, s conversion.Scope) error {
 	out.AcceleratorType = in.AcceleratorType
 	out.Count = in.Count
+	out.DriverVersion = in.DriverVersion
+	out.GpuSharingConfig = (*GpuSharing)(unsafe.Pointer(in.GpuSharingConfig))
 	return nil
 }
 
@@ -586,6 +937,48 @@ func Convert_gcp_GPU_To_v1alpha1_GPU(in *gcp.GPU, out *GPU, s conversion.Scope)
 	return autoConvert_gcp_GPU_To_v1alpha1_GPU(in, out, s)
 }
 
+func autoConvert_v1alpha1_GpuSharing_To_gcp_GpuSharing(in *GpuSharing, out *gcp.GpuSharing, s conversion.Scope) error {
+	out.Strategy = gcp.GpuSharingStrategy(in.Strategy)
+	out.MaxSharedClientsPerGpu = in.MaxSharedClientsPerGpu
+	return nil
+}
+
+// Convert_v1alpha1_GpuSharing_To_gcp_GpuSharing is an autogenerated conversion function.
+func Convert_v1alpha1_GpuSharing_To_gcp_GpuSharing(in *GpuSharing, out *gcp.GpuSharing, s conversion.Scope) error {
+	return autoConvert_v1alpha1_GpuSharing_To_gcp_GpuSharing(in, out, s)
+}
+
+func autoConvert_gcp_GpuSharing_To_v1alpha1_GpuSharing(in *gcp.GpuSharing, out *GpuSharing, s conversion.Scope) error {
+	out.Strategy = GpuSharingStrategy(in.Strategy)
+	out.MaxSharedClientsPerGpu = in.MaxSharedClientsPerGpu
+	return nil
+}
+
+// Convert_gcp_GpuSharing_To_v1alpha1_GpuSharing is an autogenerated conversion function.
+func Convert_gcp_GpuSharing_To_v1alpha1_GpuSharing(in *gcp.GpuSharing, out *GpuSharing, s conversion.Scope) error {
+	return autoConvert_gcp_GpuSharing_To_v1alpha1_GpuSharing(in, out, s)
+}
+
+func autoConvert_v1alpha1_HealthChecks_To_gcp_HealthChecks(in *HealthChecks, out *gcp.HealthChecks, s conversion.Scope) error {
+	out.AdditionalPorts = *(*[]string)(unsafe.Pointer(&in.AdditionalPorts))
+	return nil
+}
+
+// Convert_v1alpha1_HealthChecks_To_gcp_HealthChecks is an autogenerated conversion function.
+func Convert_v1alpha1_HealthChecks_To_gcp_HealthChecks(in *HealthChecks, out *gcp.HealthChecks, s conversion.Scope) error {
+	return autoConvert_v1alpha1_HealthChecks_To_gcp_HealthChecks(in, out, s)
+}
+
+func autoConvert_gcp_HealthChecks_To_v1alpha1_HealthChecks(in *gcp.HealthChecks, out *HealthChecks, s conversion.Scope) error {
+	out.AdditionalPorts = *(*[]string)(unsafe.Pointer(&in.AdditionalPorts))
+	return nil
+}
+
+// Convert_gcp_HealthChecks_To_v1alpha1_HealthChecks is an autogenerated conversion function.
+func Convert_gcp_HealthChecks_To_v1alpha1_HealthChecks(in *gcp.HealthChecks, out *HealthChecks, s conversion.Scope) error {
+	return autoConvert_gcp_HealthChecks_To_v1alpha1_HealthChecks(in, out, s)
+}
+
 func autoConvert_v1alpha1_ImmutableConfig_To_gcp_ImmutableConfig(in *ImmutableConfig, out *gcp.ImmutableConfig, s conversion.Scope) error {
 	out.RetentionType = in.RetentionType
 	out.RetentionPeriod = in.RetentionPeriod
@@ -680,6 +1073,58 @@ func Convert_gcp_InfrastructureStatus_To_v1alpha1_InfrastructureStatus(in *gcp.I
 	return autoConvert_gcp_InfrastructureStatus_To_v1alpha1_InfrastructureStatus(in, out, s)
 }
 
+func autoConvert_v1alpha1_LeaderElection_To_gcp_LeaderElection(in *LeaderElection, out *gcp.LeaderElection, s conversion.Scope) error {
+	out.LeaseDuration = (*v1.Duration)(unsafe.Pointer(in.LeaseDuration))
+	out.RenewDeadline = (*v1.Duration)(unsafe.Pointer(in.RenewDeadline))
+	out.RetryPeriod = (*v1.Duration)(unsafe.Pointer(in.RetryPeriod))
+	return nil
+}
+
+// Convert_v1alpha1_LeaderElection_To_gcp_LeaderElection is an autogenerated conversion function.
+func Convert_v1alpha1_LeaderElection_To_gcp_LeaderElection(in *LeaderElection, out *gcp.LeaderElection, s conversion.Scope) error {
+	return autoConvert_v1alpha1_LeaderElection_To_gcp_LeaderElection(in, out, s)
+}
+
+func autoConvert_gcp_LeaderElection_To_v1alpha1_LeaderElection(in *gcp.LeaderElection, out *LeaderElection, s conversion.Scope) error {
+	out.LeaseDuration = (*v1.Duration)(unsafe.Pointer(in.LeaseDuration))
+	out.RenewDeadline = (*v1.Duration)(unsafe.Pointer(in.RenewDeadline))
+	out.RetryPeriod = (*v1.Duration)(unsafe.Pointer(in.RetryPeriod))
+	return nil
+}
+
+// Convert_gcp_LeaderElection_To_v1alpha1_LeaderElection is an autogenerated conversion function.
+func Convert_gcp_LeaderElection_To_v1alpha1_LeaderElection(in *gcp.LeaderElection, out *LeaderElection, s conversion.Scope) error {
+	return autoConvert_gcp_LeaderElection_To_v1alpha1_LeaderElection(in, out, s)
+}
+
+func autoConvert_v1alpha1_LifecycleRule_To_gcp_LifecycleRule(in *LifecycleRule, out *gcp.LifecycleRule, s conversion.Scope) error {
+	out.Action = in.Action
+	out.StorageClass = in.StorageClass
+	out.AgeDays = (*int32)(unsafe.Pointer(in.AgeDays))
+	out.MatchesStorageClass = *(*[]string)(unsafe.Pointer(&in.MatchesStorageClass))
+	out.NumberOfNewerVersions = (*int32)(unsafe.Pointer(in.NumberOfNewerVersions))
+	return nil
+}
+
+// Convert_v1alpha1_LifecycleRule_To_gcp_LifecycleRule is an autogenerated conversion function.
+func Convert_v1alpha1_LifecycleRule_To_gcp_LifecycleRule(in *LifecycleRule, out *gcp.LifecycleRule, s conversion.Scope) error {
+	return autoConvert_v1alpha1_LifecycleRule_To_gcp_LifecycleRule(in, out, s)
+}
+
+func autoConvert_gcp_LifecycleRule_To_v1alpha1_LifecycleRule(in *gcp.LifecycleRule, out *LifecycleRule, s conversion.Scope) error {
+	out.Action = in.Action
+	out.StorageClass = in.StorageClass
+	out.AgeDays = (*int32)(unsafe.Pointer(in.AgeDays))
+	out.MatchesStorageClass = *(*[]string)(unsafe.Pointer(&in.MatchesStorageClass))
+	out.NumberOfNewerVersions = (*int32)(unsafe.Pointer(in.NumberOfNewerVersions))
+	return nil
+}
+
+// Convert_gcp_LifecycleRule_To_v1alpha1_LifecycleRule is an autogenerated conversion function.
+func Convert_gcp_LifecycleRule_To_v1alpha1_LifecycleRule(in *gcp.LifecycleRule, out *LifecycleRule, s conversion.Scope) error {
+	return autoConvert_gcp_LifecycleRule_To_v1alpha1_LifecycleRule(in, out, s)
+}
+
 func autoConvert_v1alpha1_MachineImage_To_gcp_MachineImage(in *MachineImage, out *gcp.MachineImage, s conversion.Scope) error {
 	out.Name = in.Name
 	out.Version = in.Version
@@ -709,6 +1154,7 @@ func Convert_gcp_MachineImage_To_v1alpha1_MachineImage(in *gcp.MachineImage, out
 func autoConvert_v1alpha1_MachineImageVersion_To_gcp_MachineImageVersion(in *MachineImageVersion, out *gcp.MachineImageVersion, s conversion.Scope) error {
 	out.Version = in.Version
 	out.Image = in.Image
+	out.ImageProjectID = (*string)(unsafe.Pointer(in.ImageProjectID))
 	out.Architecture = (*string)(unsafe.Pointer(in.Architecture))
 	return nil
 }
@@ -721,6 +1167,7 @@ func Convert_v1alpha1_MachineImageVersion_To_gcp_MachineImageVersion(in *Machine
 func autoConvert_gcp_MachineImageVersion_To_v1alpha1_MachineImageVersion(in *gcp.MachineImageVersion, out *MachineImageVersion, s conversion.Scope) error {
 	out.Version = in.Version
 	out.Image = in.Image
+	out.ImageProjectID = (*string)(unsafe.Pointer(in.ImageProjectID))
 	out.Architecture = (*string)(unsafe.Pointer(in.Architecture))
 	return nil
 }
@@ -795,7 +1242,9 @@ func Convert_gcp_NatIPName_To_v1alpha1_NatIPName(in *gcp.NatIPName, out *NatIPNa
 func autoConvert_v1alpha1_NetworkConfig_To_gcp_NetworkConfig(in *NetworkConfig, out *gcp.NetworkConfig, s conversion.Scope) error {
 	out.VPC = (*gcp.VPC)(unsafe.Pointer(in.VPC))
 	out.CloudNAT = (*gcp.CloudNAT)(unsafe.Pointer(in.CloudNAT))
+	out.DisableCloudNAT = (*bool)(unsafe.Pointer(in.DisableCloudNAT))
 	out.Internal = (*string)(unsafe.Pointer(in.Internal))
+	out.ProxyOnly = (*string)(unsafe.Pointer(in.ProxyOnly))
 	out.Worker = in.Worker
 	out.Workers = in.Workers
 	if in.FlowLogs != nil {
@@ -807,6 +1256,9 @@ func autoConvert_v1alpha1_NetworkConfig_To_gcp_NetworkConfig(in *NetworkConfig,
 	} else {
 		out.FlowLogs = nil
 	}
+	out.NetworkConnectivityCenter = (*gcp.NetworkConnectivityCenter)(unsafe.Pointer(in.NetworkConnectivityCenter))
+	out.HealthChecks = (*gcp.HealthChecks)(unsafe.Pointer(in.HealthChecks))
+	out.PrivateServiceAccess = (*gcp.PSAConfig)(unsafe.Pointer(in.PrivateServiceAccess))
 	return nil
 }
 
@@ -818,7 +1270,9 @@ func Convert_v1alpha1_NetworkConfig_To_gcp_NetworkConfig(in *NetworkConfig, out
 func autoConvert_gcp_NetworkConfig_To_v1alpha1_NetworkConfig(in *gcp.NetworkConfig, out *NetworkConfig, s conversion.Scope) error {
 	out.VPC = (*VPC)(unsafe.Pointer(in.VPC))
 	out.CloudNAT = (*CloudNAT)(unsafe.Pointer(in.CloudNAT))
+	out.DisableCloudNAT = (*bool)(unsafe.Pointer(in.DisableCloudNAT))
 	out.Internal = (*string)(unsafe.Pointer(in.Internal))
+	out.ProxyOnly = (*string)(unsafe.Pointer(in.ProxyOnly))
 	out.Worker = in.Worker
 	out.Workers = in.Workers
 	if in.FlowLogs != nil {
@@ -830,6 +1284,9 @@ func autoConvert_gcp_NetworkConfig_To_v1alpha1_NetworkConfig(in *gcp.NetworkConf
 	} else {
 		out.FlowLogs = nil
 	}
+	out.NetworkConnectivityCenter = (*NetworkConnectivityCenter)(unsafe.Pointer(in.NetworkConnectivityCenter))
+	out.HealthChecks = (*HealthChecks)(unsafe.Pointer(in.HealthChecks))
+	out.PrivateServiceAccess = (*PSAConfig)(unsafe.Pointer(in.PrivateServiceAccess))
 	return nil
 }
 
@@ -838,6 +1295,26 @@ func Convert_gcp_NetworkConfig_To_v1alpha1_NetworkConfig(in *gcp.NetworkConfig,
 	return autoConvert_gcp_NetworkConfig_To_v1alpha1_NetworkConfig(in, out, s)
 }
 
+func autoConvert_v1alpha1_NetworkConnectivityCenter_To_gcp_NetworkConnectivityCenter(in *NetworkConnectivityCenter, out *gcp.NetworkConnectivityCenter, s conversion.Scope) error {
+	out.Hub = in.Hub
+	return nil
+}
+
+// Convert_v1alpha1_NetworkConnectivityCenter_To_gcp_NetworkConnectivityCenter is an autogenerated conversion function.
+func Convert_v1alpha1_NetworkConnectivityCenter_To_gcp_NetworkConnectivityCenter(in *NetworkConnectivityCenter, out *gcp.NetworkConnectivityCenter, s conversion.Scope) error {
+	return autoConvert_v1alpha1_NetworkConnectivityCenter_To_gcp_NetworkConnectivityCenter(in, out, s)
+}
+
+func autoConvert_gcp_NetworkConnectivityCenter_To_v1alpha1_NetworkConnectivityCenter(in *gcp.NetworkConnectivityCenter, out *NetworkConnectivityCenter, s conversion.Scope) error {
+	out.Hub = in.Hub
+	return nil
+}
+
+// Convert_gcp_NetworkConnectivityCenter_To_v1alpha1_NetworkConnectivityCenter is an autogenerated conversion function.
+func Convert_gcp_NetworkConnectivityCenter_To_v1alpha1_NetworkConnectivityCenter(in *gcp.NetworkConnectivityCenter, out *NetworkConnectivityCenter, s conversion.Scope) error {
+	return autoConvert_gcp_NetworkConnectivityCenter_To_v1alpha1_NetworkConnectivityCenter(in, out, s)
+}
+
 func autoConvert_v1alpha1_NetworkStatus_To_gcp_NetworkStatus(in *NetworkStatus, out *gcp.NetworkStatus, s conversion.Scope) error {
 	if err := Convert_v1alpha1_VPC_To_gcp_VPC(&in.VPC, &out.VPC, s); err != nil {
 		return err
@@ -866,6 +1343,50 @@ func Convert_gcp_NetworkStatus_To_v1alpha1_NetworkStatus(in *gcp.NetworkStatus,
 	return autoConvert_gcp_NetworkStatus_To_v1alpha1_NetworkStatus(in, out, s)
 }
 
+func autoConvert_v1alpha1_PSAConfig_To_gcp_PSAConfig(in *PSAConfig, out *gcp.PSAConfig, s conversion.Scope) error {
+	out.Name = in.Name
+	out.PrefixLength = in.PrefixLength
+	out.Address = (*string)(unsafe.Pointer(in.Address))
+	return nil
+}
+
+// Convert_v1alpha1_PSAConfig_To_gcp_PSAConfig is an autogenerated conversion function.
+func Convert_v1alpha1_PSAConfig_To_gcp_PSAConfig(in *PSAConfig, out *gcp.PSAConfig, s conversion.Scope) error {
+	return autoConvert_v1alpha1_PSAConfig_To_gcp_PSAConfig(in, out, s)
+}
+
+func autoConvert_gcp_PSAConfig_To_v1alpha1_PSAConfig(in *gcp.PSAConfig, out *PSAConfig, s conversion.Scope) error {
+	out.Name = in.Name
+	out.PrefixLength = in.PrefixLength
+	out.Address = (*string)(unsafe.Pointer(in.Address))
+	return nil
+}
+
+// Convert_gcp_PSAConfig_To_v1alpha1_PSAConfig is an autogenerated conversion function.
+func Convert_gcp_PSAConfig_To_v1alpha1_PSAConfig(in *gcp.PSAConfig, out *PSAConfig, s conversion.Scope) error {
+	return autoConvert_gcp_PSAConfig_To_v1alpha1_PSAConfig(in, out, s)
+}
+
+func autoConvert_v1alpha1_RegionalDisk_To_gcp_RegionalDisk(in *RegionalDisk, out *gcp.RegionalDisk, s conversion.Scope) error {
+	out.ReplicaZones = *(*[]string)(unsafe.Pointer(&in.ReplicaZones))
+	return nil
+}
+
+// Convert_v1alpha1_RegionalDisk_To_gcp_RegionalDisk is an autogenerated conversion function.
+func Convert_v1alpha1_RegionalDisk_To_gcp_RegionalDisk(in *RegionalDisk, out *gcp.RegionalDisk, s conversion.Scope) error {
+	return autoConvert_v1alpha1_RegionalDisk_To_gcp_RegionalDisk(in, out, s)
+}
+
+func autoConvert_gcp_RegionalDisk_To_v1alpha1_RegionalDisk(in *gcp.RegionalDisk, out *RegionalDisk, s conversion.Scope) error {
+	out.ReplicaZones = *(*[]string)(unsafe.Pointer(&in.ReplicaZones))
+	return nil
+}
+
+// Convert_gcp_RegionalDisk_To_v1alpha1_RegionalDisk is an autogenerated conversion function.
+func Convert_gcp_RegionalDisk_To_v1alpha1_RegionalDisk(in *gcp.RegionalDisk, out *RegionalDisk, s conversion.Scope) error {
+	return autoConvert_gcp_RegionalDisk_To_v1alpha1_RegionalDisk(in, out, s)
+}
+
 func autoConvert_v1alpha1_ServiceAccount_To_gcp_ServiceAccount(in *ServiceAccount, out *gcp.ServiceAccount, s conversion.Scope) error {
 	out.Email = in.Email
 	out.Scopes = *(*[]string)(unsafe.Pointer(&in.Scopes))
@@ -891,6 +1412,11 @@ func Convert_gcp_ServiceAccount_To_v1alpha1_ServiceAccount(in *gcp.ServiceAccoun
 func autoConvert_v1alpha1_Storage_To_gcp_Storage(in *Storage, out *gcp.Storage, s conversion.Scope) error {
 	out.ManagedDefaultStorageClass = (*bool)(unsafe.Pointer(in.ManagedDefaultStorageClass))
 	out.ManagedDefaultVolumeSnapshotClass = (*bool)(unsafe.Pointer(in.ManagedDefaultVolumeSnapshotClass))
+	out.AdditionalStorageClasses = *(*[]gcp.StorageClassConfig)(unsafe.Pointer(&in.AdditionalStorageClasses))
+	out.SnapshotLocation = in.SnapshotLocation
+	out.EnableVolumeAttributesClass = (*bool)(unsafe.Pointer(in.EnableVolumeAttributesClass))
+	out.FSGroupPolicy = (*string)(unsafe.Pointer(in.FSGroupPolicy))
+	out.MaxVolumesPerNode = (*int32)(unsafe.Pointer(in.MaxVolumesPerNode))
 	return nil
 }
 
@@ -902,6 +1428,11 @@ func Convert_v1alpha1_Storage_To_gcp_Storage(in *Storage, out *gcp.Storage, s co
 func autoConvert_gcp_Storage_To_v1alpha1_Storage(in *gcp.Storage, out *Storage, s conversion.Scope) error {
 	out.ManagedDefaultStorageClass = (*bool)(unsafe.Pointer(in.ManagedDefaultStorageClass))
 	out.ManagedDefaultVolumeSnapshotClass = (*bool)(unsafe.Pointer(in.ManagedDefaultVolumeSnapshotClass))
+	out.AdditionalStorageClasses = *(*[]StorageClassConfig)(unsafe.Pointer(&in.AdditionalStorageClasses))
+	out.SnapshotLocation = in.SnapshotLocation
+	out.EnableVolumeAttributesClass = (*bool)(unsafe.Pointer(in.EnableVolumeAttributesClass))
+	out.FSGroupPolicy = (*string)(unsafe.Pointer(in.FSGroupPolicy))
+	out.MaxVolumesPerNode = (*int32)(unsafe.Pointer(in.MaxVolumesPerNode))
 	return nil
 }
 
@@ -910,6 +1441,34 @@ func Convert_gcp_Storage_To_v1alpha1_Storage(in *gcp.Storage, out *Storage, s co
 	return autoConvert_gcp_Storage_To_v1alpha1_Storage(in, out, s)
 }
 
+func autoConvert_v1alpha1_StorageClassConfig_To_gcp_StorageClassConfig(in *StorageClassConfig, out *gcp.StorageClassConfig, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Type = in.Type
+	out.Parameters = *(*map[string]string)(unsafe.Pointer(&in.Parameters))
+	out.Default = (*bool)(unsafe.Pointer(in.Default))
+	out.AllowVolumeExpansion = (*bool)(unsafe.Pointer(in.AllowVolumeExpansion))
+	return nil
+}
+
+// Convert_v1alpha1_StorageClassConfig_To_gcp_StorageClassConfig is an autogenerated conversion function.
+func Convert_v1alpha1_StorageClassConfig_To_gcp_StorageClassConfig(in *StorageClassConfig, out *gcp.StorageClassConfig, s conversion.Scope) error {
+	return autoConvert_v1alpha1_StorageClassConfig_To_gcp_StorageClassConfig(in, out, s)
+}
+
+func autoConvert_gcp_StorageClassConfig_To_v1alpha1_StorageClassConfig(in *gcp.StorageClassConfig, out *StorageClassConfig, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Type = in.Type
+	out.Parameters = *(*map[string]string)(unsafe.Pointer(&in.Parameters))
+	out.Default = (*bool)(unsafe.Pointer(in.Default))
+	out.AllowVolumeExpansion = (*bool)(unsafe.Pointer(in.AllowVolumeExpansion))
+	return nil
+}
+
+// Convert_gcp_StorageClassConfig_To_v1alpha1_StorageClassConfig is an autogenerated conversion function.
+func Convert_gcp_StorageClassConfig_To_v1alpha1_StorageClassConfig(in *gcp.StorageClassConfig, out *StorageClassConfig, s conversion.Scope) error {
+	return autoConvert_gcp_StorageClassConfig_To_v1alpha1_StorageClassConfig(in, out, s)
+}
+
 func autoConvert_v1alpha1_Subnet_To_gcp_Subnet(in *Subnet, out *gcp.Subnet, s conversion.Scope) error {
 	out.Name = in.Name
 	out.Purpose = gcp.SubnetPurpose(in.Purpose)
@@ -934,6 +1493,8 @@ func Convert_gcp_Subnet_To_v1alpha1_Subnet(in *gcp.Subnet, out *Subnet, s conver
 
 func autoConvert_v1alpha1_VPC_To_gcp_VPC(in *VPC, out *gcp.VPC, s conversion.Scope) error {
 	out.Name = in.Name
+	out.SelfLink = in.SelfLink
+	out.ID = in.ID
 	out.CloudRouter = (*gcp.CloudRouter)(unsafe.Pointer(in.CloudRouter))
 	return nil
 }
@@ -945,6 +1506,8 @@ func Convert_v1alpha1_VPC_To_gcp_VPC(in *VPC, out *gcp.VPC, s conversion.Scope)
 
 func autoConvert_gcp_VPC_To_v1alpha1_VPC(in *gcp.VPC, out *VPC, s conversion.Scope) error {
 	out.Name = in.Name
+	out.SelfLink = in.SelfLink
+	out.ID = in.ID
 	out.CloudRouter = (*CloudRouter)(unsafe.Pointer(in.CloudRouter))
 	return nil
 }
@@ -956,7 +1519,11 @@ func Convert_gcp_VPC_To_v1alpha1_VPC(in *gcp.VPC, out *VPC, s conversion.Scope)
 
 func autoConvert_v1alpha1_Volume_To_gcp_Volume(in *Volume, out *gcp.Volume, s conversion.Scope) error {
 	out.LocalSSDInterface = (*string)(unsafe.Pointer(in.LocalSSDInterface))
+	out.LocalSSDCount = (*int32)(unsafe.Pointer(in.LocalSSDCount))
 	out.Encryption = (*gcp.DiskEncryption)(unsafe.Pointer(in.Encryption))
+	out.Regional = (*gcp.RegionalDisk)(unsafe.Pointer(in.Regional))
+	out.ProvisionedIops = (*int64)(unsafe.Pointer(in.ProvisionedIops))
+	out.ProvisionedThroughput = (*int64)(unsafe.Pointer(in.ProvisionedThroughput))
 	return nil
 }
 
@@ -967,7 +1534,11 @@ func Convert_v1alpha1_Volume_To_gcp_Volume(in *Volume, out *gcp.Volume, s conver
 
 func autoConvert_gcp_Volume_To_v1alpha1_Volume(in *gcp.Volume, out *Volume, s conversion.Scope) error {
 	out.LocalSSDInterface = (*string)(unsafe.Pointer(in.LocalSSDInterface))
+	out.LocalSSDCount = (*int32)(unsafe.Pointer(in.LocalSSDCount))
 	out.Encryption = (*DiskEncryption)(unsafe.Pointer(in.Encryption))
+	out.Regional = (*RegionalDisk)(unsafe.Pointer(in.Regional))
+	out.ProvisionedIops = (*int64)(unsafe.Pointer(in.ProvisionedIops))
+	out.ProvisionedThroughput = (*int64)(unsafe.Pointer(in.ProvisionedThroughput))
 	return nil
 }
 
@@ -983,6 +1554,22 @@ func autoConvert_v1alpha1_WorkerConfig_To_gcp_WorkerConfig(in *WorkerConfig, out
 	out.MinCpuPlatform = (*string)(unsafe.Pointer(in.MinCpuPlatform))
 	out.ServiceAccount = (*gcp.ServiceAccount)(unsafe.Pointer(in.ServiceAccount))
 	out.NodeTemplate = (*extensionsv1alpha1.NodeTemplate)(unsafe.Pointer(in.NodeTemplate))
+	out.CustomMachine = (*gcp.CustomMachine)(unsafe.Pointer(in.CustomMachine))
+	out.ResourceManagerTags = *(*map[string]string)(unsafe.Pointer(&in.ResourceManagerTags))
+	out.InstanceMetadata = *(*map[string]string)(unsafe.Pointer(&in.InstanceMetadata))
+	out.DNSSearchDomains = *(*[]string)(unsafe.Pointer(&in.DNSSearchDomains))
+	out.BlockProjectSSHKeys = (*bool)(unsafe.Pointer(in.BlockProjectSSHKeys))
+	out.GuestOSFeatures = *(*[]string)(unsafe.Pointer(&in.GuestOSFeatures))
+	out.ConfidentialCompute = (*bool)(unsafe.Pointer(in.ConfidentialCompute))
+	out.DeletionProtection = (*bool)(unsafe.Pointer(in.DeletionProtection))
+	out.CanIPForward = (*bool)(unsafe.Pointer(in.CanIPForward))
+	out.NetworkTags = *(*[]string)(unsafe.Pointer(&in.NetworkTags))
+	out.OnHostMaintenance = gcp.OnHostMaintenancePolicy(in.OnHostMaintenance)
+	out.AutomaticRestart = (*bool)(unsafe.Pointer(in.AutomaticRestart))
+	out.SubnetName = in.SubnetName
+	out.EnableExternalIP = (*bool)(unsafe.Pointer(in.EnableExternalIP))
+	out.InstanceLabels = *(*map[string]string)(unsafe.Pointer(&in.InstanceLabels))
+	out.DescriptionTemplate = in.DescriptionTemplate
 	return nil
 }
 
@@ -998,6 +1585,22 @@ func autoConvert_gcp_WorkerConfig_To_v1alpha1_WorkerConfig(in *gcp.WorkerConfig,
 	out.MinCpuPlatform = (*string)(unsafe.Pointer(in.MinCpuPlatform))
 	out.ServiceAccount = (*ServiceAccount)(unsafe.Pointer(in.ServiceAccount))
 	out.NodeTemplate = (*extensionsv1alpha1.NodeTemplate)(unsafe.Pointer(in.NodeTemplate))
+	out.CustomMachine = (*CustomMachine)(unsafe.Pointer(in.CustomMachine))
+	out.ResourceManagerTags = *(*map[string]string)(unsafe.Pointer(&in.ResourceManagerTags))
+	out.InstanceMetadata = *(*map[string]string)(unsafe.Pointer(&in.InstanceMetadata))
+	out.DNSSearchDomains = *(*[]string)(unsafe.Pointer(&in.DNSSearchDomains))
+	out.BlockProjectSSHKeys = (*bool)(unsafe.Pointer(in.BlockProjectSSHKeys))
+	out.GuestOSFeatures = *(*[]string)(unsafe.Pointer(&in.GuestOSFeatures))
+	out.ConfidentialCompute = (*bool)(unsafe.Pointer(in.ConfidentialCompute))
+	out.DeletionProtection = (*bool)(unsafe.Pointer(in.DeletionProtection))
+	out.CanIPForward = (*bool)(unsafe.Pointer(in.CanIPForward))
+	out.NetworkTags = *(*[]string)(unsafe.Pointer(&in.NetworkTags))
+	out.OnHostMaintenance = OnHostMaintenancePolicy(in.OnHostMaintenance)
+	out.AutomaticRestart = (*bool)(unsafe.Pointer(in.AutomaticRestart))
+	out.SubnetName = in.SubnetName
+	out.EnableExternalIP = (*bool)(unsafe.Pointer(in.EnableExternalIP))
+	out.InstanceLabels = *(*map[string]string)(unsafe.Pointer(&in.InstanceLabels))
+	out.DescriptionTemplate = in.DescriptionTemplate
 	return nil
 }
 
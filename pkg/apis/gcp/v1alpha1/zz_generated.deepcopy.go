@@ -11,6 +11,7 @@ package v1alpha1
 
 import (
 	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	runtime "k8s.io/apimachinery/pkg/runtime"
 )
 
@@ -23,6 +24,50 @@ func (in *BackupBucketConfig) DeepCopyInto(out *BackupBucketConfig) {
 		*out = new(ImmutableConfig)
 		**out = **in
 	}
+	if in.Versioning != nil {
+		in, out := &in.Versioning, &out.Versioning
+		*out = new(bool)
+		**out = **in
+	}
+	if in.DualRegion != nil {
+		in, out := &in.DualRegion, &out.DualRegion
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.LifecycleRules != nil {
+		in, out := &in.LifecycleRules, &out.LifecycleRules
+		*out = make([]LifecycleRule, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Encryption != nil {
+		in, out := &in.Encryption, &out.Encryption
+		*out = new(BucketEncryption)
+		**out = **in
+	}
+	if in.UniformBucketLevelAccess != nil {
+		in, out := &in.UniformBucketLevelAccess, &out.UniformBucketLevelAccess
+		*out = new(bool)
+		**out = **in
+	}
+	if in.PublicAccessPrevention != nil {
+		in, out := &in.PublicAccessPrevention, &out.PublicAccessPrevention
+		*out = new(string)
+		**out = **in
+	}
+	if in.TurboReplication != nil {
+		in, out := &in.TurboReplication, &out.TurboReplication
+		*out = new(bool)
+		**out = **in
+	}
+	if in.Labels != nil {
+		in, out := &in.Labels, &out.Labels
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
 	return
 }
 
@@ -44,6 +89,22 @@ func (in *BackupBucketConfig) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BucketEncryption) DeepCopyInto(out *BucketEncryption) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BucketEncryption.
+func (in *BucketEncryption) DeepCopy() *BucketEncryption {
+	if in == nil {
+		return nil
+	}
+	out := new(BucketEncryption)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *CloudControllerManagerConfig) DeepCopyInto(out *CloudControllerManagerConfig) {
 	*out = *in
@@ -54,6 +115,38 @@ func (in *CloudControllerManagerConfig) DeepCopyInto(out *CloudControllerManager
 			(*out)[key] = val
 		}
 	}
+	if in.InternalLoadBalancerSubnet != nil {
+		in, out := &in.InternalLoadBalancerSubnet, &out.InternalLoadBalancerSubnet
+		*out = new(string)
+		**out = **in
+	}
+	if in.RouteReconciliationPeriod != nil {
+		in, out := &in.RouteReconciliationPeriod, &out.RouteReconciliationPeriod
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.ConcurrentRouteSyncs != nil {
+		in, out := &in.ConcurrentRouteSyncs, &out.ConcurrentRouteSyncs
+		*out = new(int32)
+		**out = **in
+	}
+	if in.AdditionalFlags != nil {
+		in, out := &in.AdditionalFlags, &out.AdditionalFlags
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.NodeStatusUpdateFrequency != nil {
+		in, out := &in.NodeStatusUpdateFrequency, &out.NodeStatusUpdateFrequency
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.LeaderElection != nil {
+		in, out := &in.LeaderElection, &out.LeaderElection
+		*out = new(LeaderElection)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -139,6 +232,11 @@ func (in *CloudProfileConfig) DeepCopyInto(out *CloudProfileConfig) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.AllowBroadServiceAccountScopes != nil {
+		in, out := &in.AllowBroadServiceAccountScopes, &out.AllowBroadServiceAccountScopes
+		*out = new(bool)
+		**out = **in
+	}
 	return
 }
 
@@ -190,6 +288,11 @@ func (in *ControlPlaneConfig) DeepCopyInto(out *ControlPlaneConfig) {
 		*out = new(Storage)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Replicas != nil {
+		in, out := &in.Replicas, &out.Replicas
+		*out = new(ControlPlaneReplicas)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -211,6 +314,165 @@ func (in *ControlPlaneConfig) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ControlPlaneReplicas) DeepCopyInto(out *ControlPlaneReplicas) {
+	*out = *in
+	if in.CloudControllerManager != nil {
+		in, out := &in.CloudControllerManager, &out.CloudControllerManager
+		*out = new(int32)
+		**out = **in
+	}
+	if in.CSIController != nil {
+		in, out := &in.CSIController, &out.CSIController
+		*out = new(int32)
+		**out = **in
+	}
+	if in.CSISnapshotController != nil {
+		in, out := &in.CSISnapshotController, &out.CSISnapshotController
+		*out = new(int32)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ControlPlaneReplicas.
+func (in *ControlPlaneReplicas) DeepCopy() *ControlPlaneReplicas {
+	if in == nil {
+		return nil
+	}
+	out := new(ControlPlaneReplicas)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CustomMachine) DeepCopyInto(out *CustomMachine) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CustomMachine.
+func (in *CustomMachine) DeepCopy() *CustomMachine {
+	if in == nil {
+		return nil
+	}
+	out := new(CustomMachine)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DNSGeoTarget) DeepCopyInto(out *DNSGeoTarget) {
+	*out = *in
+	if in.Values != nil {
+		in, out := &in.Values, &out.Values
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DNSGeoTarget.
+func (in *DNSGeoTarget) DeepCopy() *DNSGeoTarget {
+	if in == nil {
+		return nil
+	}
+	out := new(DNSGeoTarget)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DNSRecordConfig) DeepCopyInto(out *DNSRecordConfig) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	if in.ZoneVisibility != nil {
+		in, out := &in.ZoneVisibility, &out.ZoneVisibility
+		*out = new(string)
+		**out = **in
+	}
+	if in.TTL != nil {
+		in, out := &in.TTL, &out.TTL
+		*out = new(int64)
+		**out = **in
+	}
+	if in.RoutingPolicy != nil {
+		in, out := &in.RoutingPolicy, &out.RoutingPolicy
+		*out = new(DNSRoutingPolicy)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DNSRecordConfig.
+func (in *DNSRecordConfig) DeepCopy() *DNSRecordConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(DNSRecordConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *DNSRecordConfig) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DNSRoutingPolicy) DeepCopyInto(out *DNSRoutingPolicy) {
+	*out = *in
+	if in.WeightedRoundRobin != nil {
+		in, out := &in.WeightedRoundRobin, &out.WeightedRoundRobin
+		*out = make([]DNSWeightedTarget, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Geo != nil {
+		in, out := &in.Geo, &out.Geo
+		*out = make([]DNSGeoTarget, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DNSRoutingPolicy.
+func (in *DNSRoutingPolicy) DeepCopy() *DNSRoutingPolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(DNSRoutingPolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DNSWeightedTarget) DeepCopyInto(out *DNSWeightedTarget) {
+	*out = *in
+	if in.Values != nil {
+		in, out := &in.Values, &out.Values
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DNSWeightedTarget.
+func (in *DNSWeightedTarget) DeepCopy() *DNSWeightedTarget {
+	if in == nil {
+		return nil
+	}
+	out := new(DNSWeightedTarget)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *DataVolume) DeepCopyInto(out *DataVolume) {
 	*out = *in
@@ -229,6 +491,11 @@ func (in *DataVolume) DeepCopyInto(out *DataVolume) {
 		*out = new(int64)
 		**out = **in
 	}
+	if in.Regional != nil {
+		in, out := &in.Regional, &out.Regional
+		*out = new(RegionalDisk)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -318,6 +585,11 @@ func (in *FlowLogs) DeepCopy() *FlowLogs {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *GPU) DeepCopyInto(out *GPU) {
 	*out = *in
+	if in.GpuSharingConfig != nil {
+		in, out := &in.GpuSharingConfig, &out.GpuSharingConfig
+		*out = new(GpuSharing)
+		**out = **in
+	}
 	return
 }
 
@@ -331,6 +603,43 @@ func (in *GPU) DeepCopy() *GPU {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GpuSharing) DeepCopyInto(out *GpuSharing) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GpuSharing.
+func (in *GpuSharing) DeepCopy() *GpuSharing {
+	if in == nil {
+		return nil
+	}
+	out := new(GpuSharing)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HealthChecks) DeepCopyInto(out *HealthChecks) {
+	*out = *in
+	if in.AdditionalPorts != nil {
+		in, out := &in.AdditionalPorts, &out.AdditionalPorts
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HealthChecks.
+func (in *HealthChecks) DeepCopy() *HealthChecks {
+	if in == nil {
+		return nil
+	}
+	out := new(HealthChecks)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ImmutableConfig) DeepCopyInto(out *ImmutableConfig) {
 	*out = *in
@@ -432,6 +741,68 @@ func (in *InfrastructureStatus) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LeaderElection) DeepCopyInto(out *LeaderElection) {
+	*out = *in
+	if in.LeaseDuration != nil {
+		in, out := &in.LeaseDuration, &out.LeaseDuration
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.RenewDeadline != nil {
+		in, out := &in.RenewDeadline, &out.RenewDeadline
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.RetryPeriod != nil {
+		in, out := &in.RetryPeriod, &out.RetryPeriod
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LeaderElection.
+func (in *LeaderElection) DeepCopy() *LeaderElection {
+	if in == nil {
+		return nil
+	}
+	out := new(LeaderElection)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LifecycleRule) DeepCopyInto(out *LifecycleRule) {
+	*out = *in
+	if in.AgeDays != nil {
+		in, out := &in.AgeDays, &out.AgeDays
+		*out = new(int32)
+		**out = **in
+	}
+	if in.MatchesStorageClass != nil {
+		in, out := &in.MatchesStorageClass, &out.MatchesStorageClass
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.NumberOfNewerVersions != nil {
+		in, out := &in.NumberOfNewerVersions, &out.NumberOfNewerVersions
+		*out = new(int32)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LifecycleRule.
+func (in *LifecycleRule) DeepCopy() *LifecycleRule {
+	if in == nil {
+		return nil
+	}
+	out := new(LifecycleRule)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *MachineImage) DeepCopyInto(out *MachineImage) {
 	*out = *in
@@ -456,6 +827,11 @@ func (in *MachineImage) DeepCopy() *MachineImage {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *MachineImageVersion) DeepCopyInto(out *MachineImageVersion) {
 	*out = *in
+	if in.ImageProjectID != nil {
+		in, out := &in.ImageProjectID, &out.ImageProjectID
+		*out = new(string)
+		**out = **in
+	}
 	if in.Architecture != nil {
 		in, out := &in.Architecture, &out.Architecture
 		*out = new(string)
@@ -542,16 +918,41 @@ func (in *NetworkConfig) DeepCopyInto(out *NetworkConfig) {
 		*out = new(CloudNAT)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.DisableCloudNAT != nil {
+		in, out := &in.DisableCloudNAT, &out.DisableCloudNAT
+		*out = new(bool)
+		**out = **in
+	}
 	if in.Internal != nil {
 		in, out := &in.Internal, &out.Internal
 		*out = new(string)
 		**out = **in
 	}
+	if in.ProxyOnly != nil {
+		in, out := &in.ProxyOnly, &out.ProxyOnly
+		*out = new(string)
+		**out = **in
+	}
 	if in.FlowLogs != nil {
 		in, out := &in.FlowLogs, &out.FlowLogs
 		*out = new(FlowLogs)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.NetworkConnectivityCenter != nil {
+		in, out := &in.NetworkConnectivityCenter, &out.NetworkConnectivityCenter
+		*out = new(NetworkConnectivityCenter)
+		**out = **in
+	}
+	if in.HealthChecks != nil {
+		in, out := &in.HealthChecks, &out.HealthChecks
+		*out = new(HealthChecks)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.PrivateServiceAccess != nil {
+		in, out := &in.PrivateServiceAccess, &out.PrivateServiceAccess
+		*out = new(PSAConfig)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -565,6 +966,22 @@ func (in *NetworkConfig) DeepCopy() *NetworkConfig {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NetworkConnectivityCenter) DeepCopyInto(out *NetworkConnectivityCenter) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkConnectivityCenter.
+func (in *NetworkConnectivityCenter) DeepCopy() *NetworkConnectivityCenter {
+	if in == nil {
+		return nil
+	}
+	out := new(NetworkConnectivityCenter)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *NetworkStatus) DeepCopyInto(out *NetworkStatus) {
 	*out = *in
@@ -592,6 +1009,48 @@ func (in *NetworkStatus) DeepCopy() *NetworkStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PSAConfig) DeepCopyInto(out *PSAConfig) {
+	*out = *in
+	if in.Address != nil {
+		in, out := &in.Address, &out.Address
+		*out = new(string)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PSAConfig.
+func (in *PSAConfig) DeepCopy() *PSAConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(PSAConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RegionalDisk) DeepCopyInto(out *RegionalDisk) {
+	*out = *in
+	if in.ReplicaZones != nil {
+		in, out := &in.ReplicaZones, &out.ReplicaZones
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RegionalDisk.
+func (in *RegionalDisk) DeepCopy() *RegionalDisk {
+	if in == nil {
+		return nil
+	}
+	out := new(RegionalDisk)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ServiceAccount) DeepCopyInto(out *ServiceAccount) {
 	*out = *in
@@ -626,6 +1085,28 @@ func (in *Storage) DeepCopyInto(out *Storage) {
 		*out = new(bool)
 		**out = **in
 	}
+	if in.AdditionalStorageClasses != nil {
+		in, out := &in.AdditionalStorageClasses, &out.AdditionalStorageClasses
+		*out = make([]StorageClassConfig, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.EnableVolumeAttributesClass != nil {
+		in, out := &in.EnableVolumeAttributesClass, &out.EnableVolumeAttributesClass
+		*out = new(bool)
+		**out = **in
+	}
+	if in.FSGroupPolicy != nil {
+		in, out := &in.FSGroupPolicy, &out.FSGroupPolicy
+		*out = new(string)
+		**out = **in
+	}
+	if in.MaxVolumesPerNode != nil {
+		in, out := &in.MaxVolumesPerNode, &out.MaxVolumesPerNode
+		*out = new(int32)
+		**out = **in
+	}
 	return
 }
 
@@ -639,6 +1120,39 @@ func (in *Storage) DeepCopy() *Storage {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *StorageClassConfig) DeepCopyInto(out *StorageClassConfig) {
+	*out = *in
+	if in.Parameters != nil {
+		in, out := &in.Parameters, &out.Parameters
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.Default != nil {
+		in, out := &in.Default, &out.Default
+		*out = new(bool)
+		**out = **in
+	}
+	if in.AllowVolumeExpansion != nil {
+		in, out := &in.AllowVolumeExpansion, &out.AllowVolumeExpansion
+		*out = new(bool)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new StorageClassConfig.
+func (in *StorageClassConfig) DeepCopy() *StorageClassConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(StorageClassConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Subnet) DeepCopyInto(out *Subnet) {
 	*out = *in
@@ -684,11 +1198,31 @@ func (in *Volume) DeepCopyInto(out *Volume) {
 		*out = new(string)
 		**out = **in
 	}
+	if in.LocalSSDCount != nil {
+		in, out := &in.LocalSSDCount, &out.LocalSSDCount
+		*out = new(int32)
+		**out = **in
+	}
 	if in.Encryption != nil {
 		in, out := &in.Encryption, &out.Encryption
 		*out = new(DiskEncryption)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Regional != nil {
+		in, out := &in.Regional, &out.Regional
+		*out = new(RegionalDisk)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ProvisionedIops != nil {
+		in, out := &in.ProvisionedIops, &out.ProvisionedIops
+		*out = new(int64)
+		**out = **in
+	}
+	if in.ProvisionedThroughput != nil {
+		in, out := &in.ProvisionedThroughput, &out.ProvisionedThroughput
+		*out = new(int64)
+		**out = **in
+	}
 	return
 }
 
@@ -709,7 +1243,7 @@ func (in *WorkerConfig) DeepCopyInto(out *WorkerConfig) {
 	if in.GPU != nil {
 		in, out := &in.GPU, &out.GPU
 		*out = new(GPU)
-		**out = **in
+		(*in).DeepCopyInto(*out)
 	}
 	if in.Volume != nil {
 		in, out := &in.Volume, &out.Volume
@@ -738,6 +1272,77 @@ func (in *WorkerConfig) DeepCopyInto(out *WorkerConfig) {
 		*out = new(extensionsv1alpha1.NodeTemplate)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.CustomMachine != nil {
+		in, out := &in.CustomMachine, &out.CustomMachine
+		*out = new(CustomMachine)
+		**out = **in
+	}
+	if in.ResourceManagerTags != nil {
+		in, out := &in.ResourceManagerTags, &out.ResourceManagerTags
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.InstanceMetadata != nil {
+		in, out := &in.InstanceMetadata, &out.InstanceMetadata
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.DNSSearchDomains != nil {
+		in, out := &in.DNSSearchDomains, &out.DNSSearchDomains
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.BlockProjectSSHKeys != nil {
+		in, out := &in.BlockProjectSSHKeys, &out.BlockProjectSSHKeys
+		*out = new(bool)
+		**out = **in
+	}
+	if in.GuestOSFeatures != nil {
+		in, out := &in.GuestOSFeatures, &out.GuestOSFeatures
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.ConfidentialCompute != nil {
+		in, out := &in.ConfidentialCompute, &out.ConfidentialCompute
+		*out = new(bool)
+		**out = **in
+	}
+	if in.DeletionProtection != nil {
+		in, out := &in.DeletionProtection, &out.DeletionProtection
+		*out = new(bool)
+		**out = **in
+	}
+	if in.CanIPForward != nil {
+		in, out := &in.CanIPForward, &out.CanIPForward
+		*out = new(bool)
+		**out = **in
+	}
+	if in.NetworkTags != nil {
+		in, out := &in.NetworkTags, &out.NetworkTags
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.AutomaticRestart != nil {
+		in, out := &in.AutomaticRestart, &out.AutomaticRestart
+		*out = new(bool)
+		**out = **in
+	}
+	if in.EnableExternalIP != nil {
+		in, out := &in.EnableExternalIP, &out.EnableExternalIP
+		*out = new(bool)
+		**out = **in
+	}
+	if in.InstanceLabels != nil {
+		in, out := &in.InstanceLabels, &out.InstanceLabels
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
 	return
 }
 
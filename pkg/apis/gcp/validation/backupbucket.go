@@ -5,11 +5,44 @@
 package validation
 
 import (
+	"fmt"
+	"regexp"
+	"strings"
 	"time"
 
+	apivalidation "k8s.io/apimachinery/pkg/api/validation"
+	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/apimachinery/pkg/util/validation/field"
+	"k8s.io/utils/ptr"
 
 	apisgcp "github.com/gardener/gardener-extension-provider-gcp/pkg/apis/gcp"
+	"github.com/gardener/gardener-extension-provider-gcp/pkg/gcp"
+)
+
+const (
+	lifecycleActionDelete          = "Delete"
+	lifecycleActionSetStorageClass = "SetStorageClass"
+
+	publicAccessPreventionEnforced  = "enforced"
+	publicAccessPreventionInherited = "inherited"
+)
+
+var (
+	// backupBucketStorageClasses are the supported storage classes of backup buckets.
+	backupBucketStorageClasses = sets.New("STANDARD", "NEARLINE", "COLDLINE", "ARCHIVE")
+	// backupBucketMultiRegions are the multi-regions of GCS. They are also the locations of configurable dual-region
+	// buckets.
+	backupBucketMultiRegions = sets.New("ASIA", "EU", "US")
+	// backupBucketDualRegions are the predefined dual-regions of GCS.
+	backupBucketDualRegions = sets.New("ASIA1", "EUR4", "EUR5", "EUR7", "EUR8", "NAM4")
+	// backupBucketLifecycleActions are the supported actions of lifecycle rules.
+	backupBucketLifecycleActions = sets.New(lifecycleActionDelete, lifecycleActionSetStorageClass)
+	// backupBucketPublicAccessPreventions are the supported public access prevention settings.
+	backupBucketPublicAccessPreventions = sets.New(publicAccessPreventionEnforced, publicAccessPreventionInherited)
+	// backupBucketReservedLabels are the labels of backup buckets which are managed by Gardener.
+	backupBucketReservedLabels = sets.New(gcp.BucketLabelKeyManagedBy, gcp.BucketLabelKeyBackupBucket)
+	// backupBucketRegionRegex matches the names of GCP regions.
+	backupBucketRegionRegex = regexp.MustCompile(`^[a-z]+-[a-z]+[0-9]+$`)
 )
 
 // ValidateBackupBucketConfig validates a BackupBucketConfig object.
@@ -29,5 +62,202 @@ func ValidateBackupBucketConfig(config *apisgcp.BackupBucketConfig, fldPath *fie
 		}
 	}
 
+	if config != nil && config.StorageClass != "" && !backupBucketStorageClasses.Has(config.StorageClass) {
+		allErrs = append(allErrs, field.NotSupported(fldPath.Child("storageClass"), config.StorageClass, sets.List(backupBucketStorageClasses)))
+	}
+
+	if config != nil && ptr.Deref(config.TurboReplication, false) && len(config.DualRegion) == 0 && !backupBucketDualRegions.Has(strings.ToUpper(config.Location)) {
+		allErrs = append(allErrs, field.Forbidden(fldPath.Child("turboReplication"), "is only supported for dual-region buckets"))
+	}
+
+	if config != nil {
+		allErrs = append(allErrs, validateBackupBucketLabels(config.Labels, fldPath.Child("labels"))...)
+		allErrs = append(allErrs, validateBackupBucketLocation(config, fldPath)...)
+		allErrs = append(allErrs, validateLifecycleRules(config, fldPath.Child("lifecycleRules"))...)
+	}
+
+	if config != nil && config.PublicAccessPrevention != nil && !backupBucketPublicAccessPreventions.Has(*config.PublicAccessPrevention) {
+		allErrs = append(allErrs, field.NotSupported(fldPath.Child("publicAccessPrevention"), *config.PublicAccessPrevention, sets.List(backupBucketPublicAccessPreventions)))
+	}
+
+	// A locked retention policy guarantees that backups cannot be tampered with, hence the access to the bucket must not
+	// be loosened either.
+	if config != nil && config.Immutability != nil && config.Immutability.Locked {
+		if config.UniformBucketLevelAccess != nil && !*config.UniformBucketLevelAccess {
+			allErrs = append(allErrs, field.Forbidden(fldPath.Child("uniformBucketLevelAccess"), "must not be disabled if the retention policy is locked"))
+		}
+		if ptr.Deref(config.PublicAccessPrevention, publicAccessPreventionEnforced) != publicAccessPreventionEnforced {
+			allErrs = append(allErrs, field.Forbidden(fldPath.Child("publicAccessPrevention"), "must be enforced if the retention policy is locked"))
+		}
+	}
+
+	if config != nil && config.Encryption != nil {
+		kmsKeyNamePath := fldPath.Child("encryption", "kmsKeyName")
+		if errs := ValidateKMSKeyName(config.Encryption.KmsKeyName, false, kmsKeyNamePath); len(errs) > 0 {
+			allErrs = append(allErrs, errs...)
+		} else if config.Location != "" {
+			allErrs = append(allErrs, ValidateBackupBucketEncryptionLocation(config.Encryption, config.Location, kmsKeyNamePath)...)
+		}
+	}
+
+	return allErrs
+}
+
+// ValidateBackupBucketConfigUpdate validates updates of a BackupBucketConfig object.
+func ValidateBackupBucketConfigUpdate(oldConfig, newConfig *apisgcp.BackupBucketConfig, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if oldConfig == nil {
+		oldConfig = &apisgcp.BackupBucketConfig{}
+	}
+	if newConfig == nil {
+		newConfig = &apisgcp.BackupBucketConfig{}
+	}
+
+	// The location of a bucket cannot be changed after its creation.
+	allErrs = append(allErrs, apivalidation.ValidateImmutableField(newConfig.Location, oldConfig.Location, fldPath.Child("location"))...)
+	allErrs = append(allErrs, apivalidation.ValidateImmutableField(newConfig.DualRegion, oldConfig.DualRegion, fldPath.Child("dualRegion"))...)
+
+	return allErrs
+}
+
+// ValidateBackupBucketEncryptionLocation validates that the KMS key of the given encryption configuration can be used
+// for a bucket in the given location.
+func ValidateBackupBucketEncryptionLocation(encryption *apisgcp.BucketEncryption, location string, fldPath *field.Path) field.ErrorList {
+	if encryption == nil || location == "" {
+		return nil
+	}
+
+	// Keys of multi-region buckets are located in the respective multi-region of Cloud KMS, keys of all other buckets
+	// in the same location as the bucket.
+	expectedLocation := strings.ToLower(location)
+	if strings.EqualFold(location, "EU") {
+		expectedLocation = "europe"
+	}
+
+	return ValidateKMSKeyLocation(encryption.KmsKeyName, []string{expectedLocation}, fmt.Sprintf("a bucket in %q", location), fldPath)
+}
+
+func validateBackupBucketLabels(labels map[string]string, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	// GCS allows 64 labels per bucket, some of them are managed by Gardener.
+	if maxLabels := 64 - len(backupBucketReservedLabels); len(labels) > maxLabels {
+		allErrs = append(allErrs, field.TooMany(fldPath, len(labels), maxLabels))
+	}
+
+	for _, key := range sets.List(sets.KeySet(labels)) {
+		keyPath := fldPath.Key(key)
+		value := labels[key]
+
+		switch {
+		case backupBucketReservedLabels.Has(key):
+			allErrs = append(allErrs, field.Forbidden(keyPath, "label is managed by Gardener"))
+		case key == "" || gcp.SanitizeGcpLabel(key) != key:
+			allErrs = append(allErrs, field.Invalid(keyPath, key, fmt.Sprintf("must be a valid GCP label key, e.g. %q", gcp.SanitizeGcpLabel(key))))
+		}
+		if gcp.SanitizeGcpLabelValue(value) != value {
+			allErrs = append(allErrs, field.Invalid(keyPath, value, fmt.Sprintf("must be a valid GCP label value, e.g. %q", gcp.SanitizeGcpLabelValue(value))))
+		}
+	}
+
+	return allErrs
+}
+
+func validateBackupBucketLocation(config *apisgcp.BackupBucketConfig, fldPath *field.Path) field.ErrorList {
+	var (
+		allErrs        = field.ErrorList{}
+		locationPath   = fldPath.Child("location")
+		dualRegionPath = fldPath.Child("dualRegion")
+		location       = strings.ToUpper(config.Location)
+	)
+
+	if config.Location != "" && !backupBucketMultiRegions.Has(location) && !backupBucketDualRegions.Has(location) && !backupBucketRegionRegex.MatchString(config.Location) {
+		allErrs = append(allErrs, field.Invalid(locationPath, config.Location, "must be a region, a multi-region or a predefined dual-region"))
+	}
+
+	if len(config.DualRegion) == 0 {
+		return allErrs
+	}
+
+	if len(config.DualRegion) != 2 {
+		allErrs = append(allErrs, field.Invalid(dualRegionPath, config.DualRegion, "must contain exactly two regions"))
+	}
+	if !backupBucketMultiRegions.Has(location) {
+		allErrs = append(allErrs, field.Invalid(locationPath, config.Location, "must be one of the multi-regions "+strings.Join(sets.List(backupBucketMultiRegions), ", ")+" for a dual-region bucket"))
+	}
+
+	regions := sets.New[string]()
+	for i, region := range config.DualRegion {
+		regionPath := dualRegionPath.Index(i)
+		switch {
+		case !backupBucketRegionRegex.MatchString(region):
+			allErrs = append(allErrs, field.Invalid(regionPath, region, "must be a region"))
+		case regions.Has(region):
+			allErrs = append(allErrs, field.Duplicate(regionPath, region))
+		case backupBucketMultiRegions.Has(location) && multiRegionOfRegion(region) != location:
+			allErrs = append(allErrs, field.Invalid(regionPath, region, "must be located in the multi-region "+location))
+		}
+		regions.Insert(region)
+	}
+
+	return allErrs
+}
+
+func validateLifecycleRules(config *apisgcp.BackupBucketConfig, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	for i, rule := range config.LifecycleRules {
+		rulePath := fldPath.Index(i)
+
+		switch rule.Action {
+		case lifecycleActionDelete:
+			if rule.StorageClass != "" {
+				allErrs = append(allErrs, field.Forbidden(rulePath.Child("storageClass"), "must not be set for the Delete action"))
+			}
+		case lifecycleActionSetStorageClass:
+			if !backupBucketStorageClasses.Has(rule.StorageClass) {
+				allErrs = append(allErrs, field.NotSupported(rulePath.Child("storageClass"), rule.StorageClass, sets.List(backupBucketStorageClasses)))
+			}
+		default:
+			allErrs = append(allErrs, field.NotSupported(rulePath.Child("action"), rule.Action, sets.List(backupBucketLifecycleActions)))
+		}
+
+		if rule.AgeDays == nil && rule.NumberOfNewerVersions == nil && len(rule.MatchesStorageClass) == 0 {
+			allErrs = append(allErrs, field.Required(rulePath, "must specify at least one condition"))
+		}
+		if rule.AgeDays != nil && *rule.AgeDays < 1 {
+			allErrs = append(allErrs, field.Invalid(rulePath.Child("ageDays"), *rule.AgeDays, "must be at least 1"))
+		}
+		if rule.NumberOfNewerVersions != nil && *rule.NumberOfNewerVersions < 1 {
+			allErrs = append(allErrs, field.Invalid(rulePath.Child("numberOfNewerVersions"), *rule.NumberOfNewerVersions, "must be at least 1"))
+		}
+		for j, storageClass := range rule.MatchesStorageClass {
+			if !backupBucketStorageClasses.Has(storageClass) {
+				allErrs = append(allErrs, field.NotSupported(rulePath.Child("matchesStorageClass").Index(j), storageClass, sets.List(backupBucketStorageClasses)))
+			}
+		}
+
+		// Objects cannot be deleted before the retention period expired, hence such rules are most likely a mistake.
+		if rule.Action == lifecycleActionDelete && rule.AgeDays != nil && config.Immutability != nil &&
+			time.Duration(*rule.AgeDays)*24*time.Hour <= config.Immutability.RetentionPeriod.Duration {
+			allErrs = append(allErrs, field.Invalid(rulePath.Child("ageDays"), *rule.AgeDays, "must be greater than the retention period of the immutability configuration"))
+		}
+	}
+
 	return allErrs
 }
+
+// multiRegionOfRegion returns the GCS multi-region containing the given region or an empty string if the region is not
+// part of any multi-region.
+func multiRegionOfRegion(region string) string {
+	switch {
+	case strings.HasPrefix(region, "europe-"):
+		return "EU"
+	case strings.HasPrefix(region, "us-"), strings.HasPrefix(region, "northamerica-"):
+		return "US"
+	case strings.HasPrefix(region, "asia-"):
+		return "ASIA"
+	}
+	return ""
+}
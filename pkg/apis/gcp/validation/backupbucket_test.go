@@ -9,8 +9,10 @@ import (
 
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gstruct"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/util/validation/field"
+	"k8s.io/utils/ptr"
 
 	apisgcp "github.com/gardener/gardener-extension-provider-gcp/pkg/apis/gcp"
 )
@@ -81,5 +83,193 @@ var _ = Describe("ValidateBackupBucketConfig", func() {
 					RetentionPeriod: metav1.Duration{Duration: 23 * time.Hour},
 				},
 			}, true, "must be a positive duration greater than 24h"),
+		Entry("valid storageClass",
+			&apisgcp.BackupBucketConfig{
+				StorageClass: "COLDLINE",
+			}, false, ""),
+		Entry("invalid storageClass",
+			&apisgcp.BackupBucketConfig{
+				StorageClass: "MULTI_REGIONAL",
+			}, true, "Unsupported value: \"MULTI_REGIONAL\""),
+		Entry("valid multi-region location",
+			&apisgcp.BackupBucketConfig{
+				Location: "EU",
+			}, false, ""),
+		Entry("valid predefined dual-region location",
+			&apisgcp.BackupBucketConfig{
+				Location: "eur4",
+			}, false, ""),
+		Entry("invalid location",
+			&apisgcp.BackupBucketConfig{
+				Location: "europe",
+			}, true, "must be a region, a multi-region or a predefined dual-region"),
+		Entry("valid dual-region",
+			&apisgcp.BackupBucketConfig{
+				Location:   "EU",
+				DualRegion: []string{"europe-west1", "europe-west4"},
+			}, false, ""),
+		Entry("dual-region without multi-region location",
+			&apisgcp.BackupBucketConfig{
+				DualRegion: []string{"europe-west1", "europe-west4"},
+			}, true, "for a dual-region bucket"),
+		Entry("dual-region with a single region",
+			&apisgcp.BackupBucketConfig{
+				Location:   "EU",
+				DualRegion: []string{"europe-west1"},
+			}, true, "must contain exactly two regions"),
+		Entry("dual-region with duplicate regions",
+			&apisgcp.BackupBucketConfig{
+				Location:   "EU",
+				DualRegion: []string{"europe-west1", "europe-west1"},
+			}, true, "Duplicate value"),
+		Entry("dual-region across continents",
+			&apisgcp.BackupBucketConfig{
+				Location:   "EU",
+				DualRegion: []string{"europe-west1", "us-east1"},
+			}, true, "must be located in the multi-region EU"),
+		Entry("valid lifecycle rules",
+			&apisgcp.BackupBucketConfig{
+				Immutability: &apisgcp.ImmutableConfig{
+					RetentionType:   "bucket",
+					RetentionPeriod: metav1.Duration{Duration: 24 * time.Hour},
+				},
+				LifecycleRules: []apisgcp.LifecycleRule{
+					{Action: "Delete", AgeDays: ptr.To[int32](30)},
+					{Action: "Delete", NumberOfNewerVersions: ptr.To[int32](3)},
+					{Action: "SetStorageClass", StorageClass: "COLDLINE", AgeDays: ptr.To[int32](7), MatchesStorageClass: []string{"STANDARD"}},
+				},
+			}, false, ""),
+		Entry("lifecycle rule with unsupported action",
+			&apisgcp.BackupBucketConfig{
+				LifecycleRules: []apisgcp.LifecycleRule{{Action: "AbortIncompleteMultipartUpload", AgeDays: ptr.To[int32](1)}},
+			}, true, "Unsupported value: \"AbortIncompleteMultipartUpload\""),
+		Entry("lifecycle rule without condition",
+			&apisgcp.BackupBucketConfig{
+				LifecycleRules: []apisgcp.LifecycleRule{{Action: "Delete"}},
+			}, true, "must specify at least one condition"),
+		Entry("lifecycle rule without target storage class",
+			&apisgcp.BackupBucketConfig{
+				LifecycleRules: []apisgcp.LifecycleRule{{Action: "SetStorageClass", AgeDays: ptr.To[int32](1)}},
+			}, true, "spec.lifecycleRules[0].storageClass: Unsupported value"),
+		Entry("lifecycle rule deleting objects within the retention period",
+			&apisgcp.BackupBucketConfig{
+				Immutability: &apisgcp.ImmutableConfig{
+					RetentionType:   "bucket",
+					RetentionPeriod: metav1.Duration{Duration: 7 * 24 * time.Hour},
+				},
+				LifecycleRules: []apisgcp.LifecycleRule{{Action: "Delete", AgeDays: ptr.To[int32](7)}},
+			}, true, "must be greater than the retention period"),
+		Entry("valid encryption",
+			&apisgcp.BackupBucketConfig{
+				Encryption: &apisgcp.BucketEncryption{KmsKeyName: "projects/foo/locations/europe-west1/keyRings/bar/cryptoKeys/baz"},
+			}, false, ""),
+		Entry("valid encryption of a multi-region bucket",
+			&apisgcp.BackupBucketConfig{
+				Location:   "EU",
+				Encryption: &apisgcp.BucketEncryption{KmsKeyName: "projects/foo/locations/europe/keyRings/bar/cryptoKeys/baz"},
+			}, false, ""),
+		Entry("invalid KMS key name",
+			&apisgcp.BackupBucketConfig{
+				Encryption: &apisgcp.BucketEncryption{KmsKeyName: "baz"},
+			}, true, "must have the format"),
+		Entry("KMS key version",
+			&apisgcp.BackupBucketConfig{
+				Encryption: &apisgcp.BucketEncryption{KmsKeyName: "projects/foo/locations/europe-west1/keyRings/bar/cryptoKeys/baz/cryptoKeyVersions/1"},
+			}, true, "must have the format"),
+		Entry("KMS key in another location",
+			&apisgcp.BackupBucketConfig{
+				Location:   "EUR4",
+				Encryption: &apisgcp.BucketEncryption{KmsKeyName: "projects/foo/locations/europe/keyRings/bar/cryptoKeys/baz"},
+			}, true, "must be located in \"eur4\""),
+		Entry("valid access settings",
+			&apisgcp.BackupBucketConfig{
+				UniformBucketLevelAccess: ptr.To(false),
+				PublicAccessPrevention:   ptr.To("inherited"),
+			}, false, ""),
+		Entry("invalid public access prevention",
+			&apisgcp.BackupBucketConfig{
+				PublicAccessPrevention: ptr.To("unspecified"),
+			}, true, "Unsupported value: \"unspecified\""),
+		Entry("disabled uniform bucket-level access with locked retention policy",
+			&apisgcp.BackupBucketConfig{
+				Immutability: &apisgcp.ImmutableConfig{
+					RetentionType:   "bucket",
+					RetentionPeriod: metav1.Duration{Duration: 24 * time.Hour},
+					Locked:          true,
+				},
+				UniformBucketLevelAccess: ptr.To(false),
+			}, true, "must not be disabled if the retention policy is locked"),
+		Entry("inherited public access prevention with locked retention policy",
+			&apisgcp.BackupBucketConfig{
+				Immutability: &apisgcp.ImmutableConfig{
+					RetentionType:   "bucket",
+					RetentionPeriod: metav1.Duration{Duration: 24 * time.Hour},
+					Locked:          true,
+				},
+				PublicAccessPrevention: ptr.To("inherited"),
+			}, true, "must be enforced if the retention policy is locked"),
+		Entry("turbo replication of a predefined dual-region bucket",
+			&apisgcp.BackupBucketConfig{
+				Location:         "EUR4",
+				TurboReplication: ptr.To(true),
+			}, false, ""),
+		Entry("turbo replication of a configurable dual-region bucket",
+			&apisgcp.BackupBucketConfig{
+				Location:         "EU",
+				DualRegion:       []string{"europe-west1", "europe-west4"},
+				TurboReplication: ptr.To(true),
+			}, false, ""),
+		Entry("disabled turbo replication of a regional bucket",
+			&apisgcp.BackupBucketConfig{
+				TurboReplication: ptr.To(false),
+			}, false, ""),
+		Entry("turbo replication of a multi-region bucket",
+			&apisgcp.BackupBucketConfig{
+				Location:         "EU",
+				TurboReplication: ptr.To(true),
+			}, true, "is only supported for dual-region buckets"),
+		Entry("valid labels",
+			&apisgcp.BackupBucketConfig{
+				Labels: map[string]string{"cost-center": "1234", "team": ""},
+			}, false, ""),
+		Entry("reserved label",
+			&apisgcp.BackupBucketConfig{
+				Labels: map[string]string{"managed-by": "me"},
+			}, true, "label is managed by Gardener"),
+		Entry("invalid label key",
+			&apisgcp.BackupBucketConfig{
+				Labels: map[string]string{"Cost.Center": "1234"},
+			}, true, "must be a valid GCP label key, e.g. \"cost_center\""),
+		Entry("invalid label value",
+			&apisgcp.BackupBucketConfig{
+				Labels: map[string]string{"team": "Gardener"},
+			}, true, "must be a valid GCP label value, e.g. \"gardener\""),
 	)
 })
+
+var _ = Describe("ValidateBackupBucketConfigUpdate", func() {
+	var fldPath *field.Path
+
+	BeforeEach(func() {
+		fldPath = field.NewPath("spec")
+	})
+
+	It("should allow unchanged locations", func() {
+		config := &apisgcp.BackupBucketConfig{Location: "EU", DualRegion: []string{"europe-west1", "europe-west4"}}
+
+		Expect(ValidateBackupBucketConfigUpdate(config, config.DeepCopy(), fldPath)).To(BeEmpty())
+	})
+
+	It("should forbid changing the location", func() {
+		oldConfig := &apisgcp.BackupBucketConfig{Location: "EU", DualRegion: []string{"europe-west1", "europe-west4"}}
+		newConfig := &apisgcp.BackupBucketConfig{Location: "EU", DualRegion: []string{"europe-west1", "europe-west3"}}
+
+		Expect(ValidateBackupBucketConfigUpdate(oldConfig, newConfig, fldPath)).To(ConsistOf(
+			PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeInvalid),
+				"Field": Equal("spec.dualRegion"),
+			})),
+		))
+		Expect(ValidateBackupBucketConfigUpdate(oldConfig, nil, fldPath)).To(HaveLen(2))
+	})
+})
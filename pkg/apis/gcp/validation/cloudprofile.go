@@ -6,7 +6,9 @@ package validation
 
 import (
 	"fmt"
+	"regexp"
 	"slices"
+	"strings"
 
 	"github.com/gardener/gardener/extensions/pkg/util"
 	"github.com/gardener/gardener/pkg/apis/core"
@@ -16,6 +18,14 @@ import (
 	"k8s.io/utils/ptr"
 
 	apisgcp "github.com/gardener/gardener-extension-provider-gcp/pkg/apis/gcp"
+	"github.com/gardener/gardener-extension-provider-gcp/pkg/apis/gcp/helper"
+)
+
+var (
+	// imageNameRegex matches the names of images.
+	imageNameRegex = regexp.MustCompile(`^[a-z](?:[-a-z0-9]{0,61}[a-z0-9])?$`)
+	// qualifiedImageRegex matches the fully qualified references to images and image families in a project.
+	qualifiedImageRegex = regexp.MustCompile(`^projects/[a-z][-a-z0-9]*[a-z0-9]/global/images/(?:family/)?[a-z](?:[-a-z0-9]{0,61}[a-z0-9])?$`)
 )
 
 // ValidateCloudProfileConfig validates a CloudProfileConfig object.
@@ -71,6 +81,22 @@ func ValidateCloudProfileConfig(cpConfig *apisgcp.CloudProfileConfig, machineIma
 					imageVersionPath.Child("image"),
 					fmt.Sprintf("must provide the image field for image version %s@%s and architecture: %s",
 						providerImage.Name, version.Version, versionArch)))
+			} else if _, _, isFamily := helper.ImageFamily(version.Image); !isFamily && strings.Contains(version.Image, "family/") {
+				// an image either refers to a concrete image or to an image family, but never to both
+				allErrs = append(allErrs, field.Invalid(imageVersionPath.Child("image"), version.Image,
+					"must either refer to a concrete image or to an image family of the form 'family/<name>' or 'projects/<project>/global/images/family/<name>'"))
+			} else if strings.HasPrefix(version.Image, "projects/") && !qualifiedImageRegex.MatchString(version.Image) {
+				allErrs = append(allErrs, field.Invalid(imageVersionPath.Child("image"), version.Image,
+					"must be of the form 'projects/<project>/global/images/<name>'"))
+			}
+			if version.ImageProjectID != nil {
+				if !projectIDRegexp.MatchString(*version.ImageProjectID) {
+					allErrs = append(allErrs, field.Invalid(imageVersionPath.Child("imageProjectID"), *version.ImageProjectID, "must be a valid project ID"))
+				}
+				if project, _, isFamily := helper.ImageFamily(version.Image); !imageNameRegex.MatchString(version.Image) && (!isFamily || project != "") {
+					allErrs = append(allErrs, field.Invalid(imageVersionPath.Child("image"), version.Image,
+						"must be the name of an image or an image family of the form 'family/<name>' if the imageProjectID is set"))
+				}
 			}
 			// validate architecture field
 			if !slices.Contains(v1beta1constants.ValidArchitectures, versionArch) {
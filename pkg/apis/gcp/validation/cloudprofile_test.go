@@ -128,6 +128,70 @@ var _ = Describe("CloudProfileConfig validation", func() {
 					})),
 				))
 			})
+
+			It("should allow images referring to image families", func() {
+				cloudProfileConfig.MachineImages[0].Versions = append(cloudProfileConfig.MachineImages[0].Versions,
+					apisgcp.MachineImageVersion{Version: "2.0.0", Image: "family/ubuntu-2404-lts"},
+					apisgcp.MachineImageVersion{Version: "3.0.0", Image: "projects/ubuntu-os-cloud/global/images/family/ubuntu-2404-lts"},
+				)
+				errorList := ValidateCloudProfileConfig(cloudProfileConfig, machineImages, nilPath)
+
+				Expect(errorList).To(BeEmpty())
+			})
+
+			It("should allow images in image projects", func() {
+				cloudProfileConfig.MachineImages[0].Versions = append(cloudProfileConfig.MachineImages[0].Versions,
+					apisgcp.MachineImageVersion{Version: "2.0.0", Image: "ubuntu-2404-noble-v20261001", ImageProjectID: ptr.To("my-image-project")},
+					apisgcp.MachineImageVersion{Version: "3.0.0", Image: "family/ubuntu-2404-lts", ImageProjectID: ptr.To("my-image-project")},
+					apisgcp.MachineImageVersion{Version: "4.0.0", Image: "projects/my-image-project/global/images/ubuntu-2404-noble-v20261001"},
+				)
+				errorList := ValidateCloudProfileConfig(cloudProfileConfig, machineImages, nilPath)
+
+				Expect(errorList).To(BeEmpty())
+			})
+
+			It("should forbid invalid references to images in image projects", func() {
+				cloudProfileConfig.MachineImages[0].Versions[0].Image = "projects/my-image-project/images/ubuntu-2404-noble-v20261001"
+				cloudProfileConfig.MachineImages[0].Versions = append(cloudProfileConfig.MachineImages[0].Versions,
+					apisgcp.MachineImageVersion{Version: "2.0.0", Image: "projects/my-image-project/global/images/ubuntu-2404-noble-v20261001", ImageProjectID: ptr.To("my-image-project")},
+					apisgcp.MachineImageVersion{Version: "3.0.0", Image: "ubuntu-2404-noble-v20261001", ImageProjectID: ptr.To("Project")},
+				)
+				errorList := ValidateCloudProfileConfig(cloudProfileConfig, machineImages, nilPath)
+
+				Expect(errorList).To(ConsistOf(
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("providerConfig.machineImages[0].versions[0].image"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("providerConfig.machineImages[0].versions[1].image"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("providerConfig.machineImages[0].versions[2].imageProjectID"),
+					})),
+				))
+			})
+
+			It("should forbid images referring to both a concrete image and an image family", func() {
+				cloudProfileConfig.MachineImages[0].Versions[0].Image = "projects/ubuntu-os-cloud/global/images/family/ubuntu-2404-lts/ubuntu-2404-noble-v20261001"
+				cloudProfileConfig.MachineImages[0].Versions = append(cloudProfileConfig.MachineImages[0].Versions,
+					apisgcp.MachineImageVersion{Version: "2.0.0", Image: "family/"},
+				)
+				errorList := ValidateCloudProfileConfig(cloudProfileConfig, machineImages, nilPath)
+
+				Expect(errorList).To(ConsistOf(
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("providerConfig.machineImages[0].versions[0].image"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("providerConfig.machineImages[0].versions[1].image"),
+					})),
+				))
+			})
 		})
 	})
 })
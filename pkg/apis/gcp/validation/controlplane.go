@@ -5,12 +5,110 @@
 package validation
 
 import (
+	"fmt"
+	"regexp"
+	"slices"
+	"time"
+
+	"github.com/gardener/gardener/pkg/apis/core"
 	featurevalidation "github.com/gardener/gardener/pkg/utils/validation/features"
+	storagev1 "k8s.io/api/storage/v1"
 	apivalidation "k8s.io/apimachinery/pkg/api/validation"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/apimachinery/pkg/util/validation"
 	"k8s.io/apimachinery/pkg/util/validation/field"
+	"k8s.io/utils/ptr"
 
 	apisgcp "github.com/gardener/gardener-extension-provider-gcp/pkg/apis/gcp"
+	"github.com/gardener/gardener-extension-provider-gcp/pkg/controller/worker"
+)
+
+const (
+	// storageClassParameterType is the parameter of the CSI driver for the disk type.
+	storageClassParameterType = "type"
+	// storageClassParameterProvisionedIops is the parameter of the CSI driver for the provisioned IOPS.
+	storageClassParameterProvisionedIops = "provisioned-iops-on-create"
+	// storageClassParameterProvisionedThroughput is the parameter of the CSI driver for the provisioned throughput.
+	storageClassParameterProvisionedThroughput = "provisioned-throughput-on-create"
+
+	// defaultLeaseDuration, defaultRenewDeadline and defaultRetryPeriod are the leader election defaults of the
+	// cloud-controller-manager.
+	defaultLeaseDuration = 15 * time.Second
+	defaultRenewDeadline = 10 * time.Second
+	defaultRetryPeriod   = 2 * time.Second
+
+	// maxVolumesPerNode and maxVolumesPerSharedCoreNode are the maximum numbers of disks which can be attached to a
+	// VM in addition to its boot disk.
+	// See https://cloud.google.com/compute/docs/machine-resource#machine_type_comparison
+	maxVolumesPerNode           = 127
+	maxVolumesPerSharedCoreNode = 15
+)
+
+var (
+	// snapshotLocationRegex matches GCP regions, e.g. `europe-west1`, and multi-regions, i.e. `asia`, `eu` and `us`.
+	snapshotLocationRegex = regexp.MustCompile(`^([a-z]+-[a-z]+[0-9]+|asia|eu|us)$`)
+
+	// allowedCCMAdditionalFlags are the flags of the cloud-controller-manager which can be configured by users.
+	allowedCCMAdditionalFlags = sets.New(
+		"concurrent-service-syncs",
+		"concurrent-node-syncs",
+		"node-monitor-period",
+		"node-status-update-frequency",
+		"node-sync-period",
+		"min-resync-period",
+		"kube-api-qps",
+		"kube-api-burst",
+		"v",
+	)
+	// managedCCMFlags are the flags of the cloud-controller-manager which are managed by Gardener.
+	managedCCMFlags = sets.New(
+		"allocate-node-cidrs",
+		"authentication-kubeconfig",
+		"authorization-kubeconfig",
+		"cloud-config",
+		"cloud-provider",
+		"cluster-cidr",
+		"cluster-name",
+		"concurrent-route-syncs",
+		"configure-cloud-routes",
+		"feature-gates",
+		"kubeconfig",
+		"leader-elect",
+		"leader-elect-lease-duration",
+		"leader-elect-renew-deadline",
+		"leader-elect-retry-period",
+		"node-cidr-mask-size-ipv4",
+		"route-reconciliation-period",
+		"secure-port",
+		"tls-cert-file",
+		"tls-cipher-suites",
+		"tls-private-key-file",
+		"use-service-account-credentials",
+	)
+
+	validFSGroupPolicies = sets.New(
+		string(storagev1.ReadWriteOnceWithFSTypeFSGroupPolicy),
+		string(storagev1.FileFSGroupPolicy),
+		string(storagev1.NoneFSGroupPolicy),
+	)
+
+	// sharedCoreMachineTypes are the machine types which share a physical core and support fewer attached disks.
+	sharedCoreMachineTypes = sets.New("e2-micro", "e2-small", "e2-medium", "f1-micro", "g1-small")
+
+	// managedStorageClassNames are the names of the StorageClasses which are always managed by Gardener.
+	managedStorageClassNames = sets.New("default", "gce-sc-hdd", "gce-sc-fast", "gce-sc-regional")
+	// storageClassTypes are the disk types which can be used for additional StorageClasses.
+	storageClassTypes = sets.New(
+		"pd-standard",
+		"pd-balanced",
+		"pd-ssd",
+		"pd-extreme",
+		"hyperdisk-balanced",
+		"hyperdisk-extreme",
+		"hyperdisk-throughput",
+		"hyperdisk-ml",
+	)
 )
 
 // ValidateControlPlaneConfig validates a ControlPlaneConfig object.
@@ -29,6 +127,180 @@ func ValidateControlPlaneConfig(controlPlaneConfig *apisgcp.ControlPlaneConfig,
 
 	if controlPlaneConfig.CloudControllerManager != nil {
 		allErrs = append(allErrs, featurevalidation.ValidateFeatureGates(controlPlaneConfig.CloudControllerManager.FeatureGates, version, fldPath.Child("cloudControllerManager", "featureGates"))...)
+		if subnet := controlPlaneConfig.CloudControllerManager.InternalLoadBalancerSubnet; subnet != nil && len(*subnet) == 0 {
+			allErrs = append(allErrs, field.Required(fldPath.Child("cloudControllerManager", "internalLoadBalancerSubnet"), "must not be empty if set"))
+		}
+		if period := controlPlaneConfig.CloudControllerManager.RouteReconciliationPeriod; period != nil && period.Duration <= 0 {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("cloudControllerManager", "routeReconciliationPeriod"), period.Duration.String(), "must be positive"))
+		}
+		if syncs := controlPlaneConfig.CloudControllerManager.ConcurrentRouteSyncs; syncs != nil && *syncs <= 0 {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("cloudControllerManager", "concurrentRouteSyncs"), *syncs, "must be positive"))
+		}
+		allErrs = append(allErrs, validateCCMAdditionalFlags(controlPlaneConfig.CloudControllerManager.AdditionalFlags, fldPath.Child("cloudControllerManager", "additionalFlags"))...)
+		if frequency := controlPlaneConfig.CloudControllerManager.NodeStatusUpdateFrequency; frequency != nil {
+			if frequency.Duration <= 0 {
+				allErrs = append(allErrs, field.Invalid(fldPath.Child("cloudControllerManager", "nodeStatusUpdateFrequency"), frequency.Duration.String(), "must be positive"))
+			}
+			if _, ok := controlPlaneConfig.CloudControllerManager.AdditionalFlags["node-status-update-frequency"]; ok {
+				allErrs = append(allErrs, field.Forbidden(fldPath.Child("cloudControllerManager", "additionalFlags").Key("node-status-update-frequency"), "must not be set if nodeStatusUpdateFrequency is configured"))
+			}
+		}
+		if leaderElection := controlPlaneConfig.CloudControllerManager.LeaderElection; leaderElection != nil {
+			allErrs = append(allErrs, validateLeaderElection(leaderElection, fldPath.Child("cloudControllerManager", "leaderElection"))...)
+		}
+	}
+
+	if controlPlaneConfig.Storage != nil {
+		allErrs = append(allErrs, validateStorage(controlPlaneConfig.Storage, fldPath.Child("storage"))...)
+	}
+
+	if replicas := controlPlaneConfig.Replicas; replicas != nil {
+		replicasPath := fldPath.Child("replicas")
+		for name, value := range map[string]*int32{
+			"cloudControllerManager": replicas.CloudControllerManager,
+			"csiController":          replicas.CSIController,
+			"csiSnapshotController":  replicas.CSISnapshotController,
+		} {
+			if value != nil && *value < 1 {
+				allErrs = append(allErrs, field.Invalid(replicasPath.Child(name), *value, "must be at least 1"))
+			}
+		}
+	}
+
+	return allErrs
+}
+
+func validateCCMAdditionalFlags(flags map[string]string, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	for _, flag := range sets.List(sets.KeySet(flags)) {
+		flagPath := fldPath.Key(flag)
+		switch {
+		case managedCCMFlags.Has(flag):
+			allErrs = append(allErrs, field.Forbidden(flagPath, "flag is managed by Gardener"))
+		case !allowedCCMAdditionalFlags.Has(flag):
+			allErrs = append(allErrs, field.NotSupported(flagPath, flag, sets.List(allowedCCMAdditionalFlags)))
+		case flags[flag] == "":
+			allErrs = append(allErrs, field.Required(flagPath, "must provide a value"))
+		}
+	}
+
+	return allErrs
+}
+
+func validateLeaderElection(leaderElection *apisgcp.LeaderElection, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	for _, timing := range []struct {
+		name  string
+		value *metav1.Duration
+	}{
+		{"leaseDuration", leaderElection.LeaseDuration},
+		{"renewDeadline", leaderElection.RenewDeadline},
+		{"retryPeriod", leaderElection.RetryPeriod},
+	} {
+		if timing.value != nil && timing.value.Duration <= 0 {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child(timing.name), timing.value.Duration.String(), "must be positive"))
+		}
+	}
+	if len(allErrs) > 0 {
+		return allErrs
+	}
+
+	// unset timings are checked with the defaults of the component, as they are combined with the configured ones.
+	var (
+		leaseDuration = ptr.Deref(leaderElection.LeaseDuration, metav1.Duration{Duration: defaultLeaseDuration}).Duration
+		renewDeadline = ptr.Deref(leaderElection.RenewDeadline, metav1.Duration{Duration: defaultRenewDeadline}).Duration
+		retryPeriod   = ptr.Deref(leaderElection.RetryPeriod, metav1.Duration{Duration: defaultRetryPeriod}).Duration
+	)
+	if renewDeadline >= leaseDuration {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("renewDeadline"), renewDeadline.String(), fmt.Sprintf("must be less than the lease duration (%s)", leaseDuration)))
+	}
+	if retryPeriod >= renewDeadline {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("retryPeriod"), retryPeriod.String(), fmt.Sprintf("must be less than the renew deadline (%s)", renewDeadline)))
+	}
+
+	return allErrs
+}
+
+func validateStorage(storage *apisgcp.Storage, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	names := sets.New[string]()
+	defaultClasses := 0
+	if ptr.Deref(storage.ManagedDefaultStorageClass, true) {
+		defaultClasses++
+	}
+
+	for i, class := range storage.AdditionalStorageClasses {
+		classPath := fldPath.Child("additionalStorageClasses").Index(i)
+
+		switch {
+		case class.Name == "":
+			allErrs = append(allErrs, field.Required(classPath.Child("name"), "must provide a name"))
+		case managedStorageClassNames.Has(class.Name):
+			allErrs = append(allErrs, field.Invalid(classPath.Child("name"), class.Name, "must not be the name of a StorageClass managed by Gardener"))
+		case names.Has(class.Name):
+			allErrs = append(allErrs, field.Duplicate(classPath.Child("name"), class.Name))
+		default:
+			for _, msg := range validation.IsDNS1123Subdomain(class.Name) {
+				allErrs = append(allErrs, field.Invalid(classPath.Child("name"), class.Name, msg))
+			}
+		}
+		names.Insert(class.Name)
+
+		if !storageClassTypes.Has(class.Type) {
+			allErrs = append(allErrs, field.NotSupported(classPath.Child("type"), class.Type, sets.List(storageClassTypes)))
+		}
+
+		parametersPath := classPath.Child("parameters")
+		if _, ok := class.Parameters[storageClassParameterType]; ok {
+			allErrs = append(allErrs, field.Forbidden(parametersPath.Key(storageClassParameterType), "must be configured via the type field"))
+		}
+		if _, ok := class.Parameters[storageClassParameterProvisionedIops]; ok && !slices.Contains(worker.AllowedTypesIops, class.Type) {
+			allErrs = append(allErrs, field.Forbidden(parametersPath.Key(storageClassParameterProvisionedIops), fmt.Sprintf("is only allowed for types: %v", worker.AllowedTypesIops)))
+		}
+		if _, ok := class.Parameters[storageClassParameterProvisionedThroughput]; ok && !slices.Contains(worker.AllowedTypesThroughput, class.Type) {
+			allErrs = append(allErrs, field.Forbidden(parametersPath.Key(storageClassParameterProvisionedThroughput), fmt.Sprintf("is only allowed for types: %v", worker.AllowedTypesThroughput)))
+		}
+
+		if ptr.Deref(class.Default, false) {
+			defaultClasses++
+			if defaultClasses > 1 {
+				allErrs = append(allErrs, field.Forbidden(classPath.Child("default"), "at most one StorageClass must be marked as default, consider disabling managedDefaultStorageClass"))
+			}
+		}
+	}
+
+	if policy := storage.FSGroupPolicy; policy != nil && !validFSGroupPolicies.Has(*policy) {
+		allErrs = append(allErrs, field.NotSupported(fldPath.Child("fsGroupPolicy"), *policy, sets.List(validFSGroupPolicies)))
+	}
+
+	if location := storage.SnapshotLocation; location != "" && !snapshotLocationRegex.MatchString(location) {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("snapshotLocation"), location, "must be a GCP region, e.g. 'europe-west1', or multi-region, i.e. 'asia', 'eu' or 'us'"))
+	}
+
+	if maxVolumes := storage.MaxVolumesPerNode; maxVolumes != nil && (*maxVolumes < 1 || *maxVolumes > maxVolumesPerNode) {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("maxVolumesPerNode"), *maxVolumes, fmt.Sprintf("must be between 1 and %d", maxVolumesPerNode)))
+	}
+
+	return allErrs
+}
+
+// ValidateMaxVolumesPerNode validates that the configured maximum number of volumes per node can be attached to the
+// machines of all given worker pools. Only the disk limits of shared-core machine types are known to be lower than
+// the general limit, which is validated with the ControlPlaneConfig.
+func ValidateMaxVolumesPerNode(storage *apisgcp.Storage, workers []core.Worker, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if storage == nil || storage.MaxVolumesPerNode == nil || *storage.MaxVolumesPerNode <= maxVolumesPerSharedCoreNode {
+		return allErrs
+	}
+
+	for _, pool := range workers {
+		if sharedCoreMachineTypes.Has(pool.Machine.Type) {
+			allErrs = append(allErrs, field.Invalid(fldPath, *storage.MaxVolumesPerNode, fmt.Sprintf("must not be greater than %d as worker pool %q uses the shared-core machine type %q", maxVolumesPerSharedCoreNode, pool.Name, pool.Machine.Type)))
+		}
 	}
 
 	return allErrs
@@ -5,11 +5,16 @@
 package validation_test
 
 import (
+	"time"
+
+	"github.com/gardener/gardener/pkg/apis/core"
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 	. "github.com/onsi/gomega/gstruct"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/apimachinery/pkg/util/validation/field"
+	"k8s.io/utils/ptr"
 
 	apisgcp "github.com/gardener/gardener-extension-provider-gcp/pkg/apis/gcp"
 	. "github.com/gardener/gardener-extension-provider-gcp/pkg/apis/gcp/validation"
@@ -94,6 +99,400 @@ var _ = Describe("ControlPlaneConfig validation", func() {
 				})),
 			))
 		})
+
+		It("should forbid an empty internal load balancer subnet", func() {
+			controlPlane.CloudControllerManager = &apisgcp.CloudControllerManagerConfig{
+				InternalLoadBalancerSubnet: ptr.To(""),
+			}
+
+			errorList := ValidateControlPlaneConfig(controlPlane, allowedZones, workerZones, "1.28.2", fldPath)
+
+			Expect(errorList).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeRequired),
+					"Field": Equal("cloudControllerManager.internalLoadBalancerSubnet"),
+				})),
+			))
+		})
+
+		Context("additional storage classes", func() {
+			It("should allow valid additional storage classes", func() {
+				controlPlane.Storage = &apisgcp.Storage{
+					ManagedDefaultStorageClass: ptr.To(false),
+					AdditionalStorageClasses: []apisgcp.StorageClassConfig{
+						{
+							Name: "hyperdisk",
+							Type: "hyperdisk-balanced",
+							Parameters: map[string]string{
+								"provisioned-iops-on-create":       "5000",
+								"provisioned-throughput-on-create": "250Mi",
+							},
+							Default: ptr.To(true),
+						},
+						{Name: "extreme", Type: "hyperdisk-extreme"},
+					},
+				}
+
+				Expect(ValidateControlPlaneConfig(controlPlane, allowedZones, workerZones, "1.28.2", fldPath)).To(BeEmpty())
+			})
+
+			It("should forbid invalid names and types", func() {
+				controlPlane.Storage = &apisgcp.Storage{
+					AdditionalStorageClasses: []apisgcp.StorageClassConfig{
+						{Name: "", Type: "hyperdisk-balanced"},
+						{Name: "gce-sc-fast", Type: "hyperdisk-balanced"},
+						{Name: "Invalid_Name", Type: "hyperdisk-balanced"},
+						{Name: "hyperdisk", Type: "hyperdisk-balanced"},
+						{Name: "hyperdisk", Type: "unknown"},
+					},
+				}
+
+				Expect(ValidateControlPlaneConfig(controlPlane, allowedZones, workerZones, "1.28.2", fldPath)).To(ConsistOf(
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeRequired),
+						"Field": Equal("storage.additionalStorageClasses[0].name"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("storage.additionalStorageClasses[1].name"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("storage.additionalStorageClasses[2].name"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeDuplicate),
+						"Field": Equal("storage.additionalStorageClasses[4].name"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeNotSupported),
+						"Field": Equal("storage.additionalStorageClasses[4].type"),
+					})),
+				))
+			})
+
+			It("should forbid parameters which are not supported by the type", func() {
+				controlPlane.Storage = &apisgcp.Storage{
+					AdditionalStorageClasses: []apisgcp.StorageClassConfig{
+						{
+							Name: "throughput",
+							Type: "hyperdisk-throughput",
+							Parameters: map[string]string{
+								"type":                       "pd-ssd",
+								"provisioned-iops-on-create": "5000",
+							},
+						},
+						{
+							Name:       "extreme",
+							Type:       "hyperdisk-extreme",
+							Parameters: map[string]string{"provisioned-throughput-on-create": "250Mi"},
+						},
+					},
+				}
+
+				Expect(ValidateControlPlaneConfig(controlPlane, allowedZones, workerZones, "1.28.2", fldPath)).To(ConsistOf(
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeForbidden),
+						"Field": Equal("storage.additionalStorageClasses[0].parameters[type]"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeForbidden),
+						"Field": Equal("storage.additionalStorageClasses[0].parameters[provisioned-iops-on-create]"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeForbidden),
+						"Field": Equal("storage.additionalStorageClasses[1].parameters[provisioned-throughput-on-create]"),
+					})),
+				))
+			})
+
+			It("should forbid more than one default storage class", func() {
+				controlPlane.Storage = &apisgcp.Storage{
+					AdditionalStorageClasses: []apisgcp.StorageClassConfig{
+						{Name: "hyperdisk", Type: "hyperdisk-balanced", Default: ptr.To(true)},
+					},
+				}
+
+				Expect(ValidateControlPlaneConfig(controlPlane, allowedZones, workerZones, "1.28.2", fldPath)).To(ConsistOf(
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeForbidden),
+						"Field": Equal("storage.additionalStorageClasses[0].default"),
+					})),
+				))
+			})
+		})
+
+		It("should allow supported fsGroupPolicies", func() {
+			for _, policy := range []string{"ReadWriteOnceWithFSType", "File", "None"} {
+				controlPlane.Storage = &apisgcp.Storage{FSGroupPolicy: ptr.To(policy)}
+				Expect(ValidateControlPlaneConfig(controlPlane, allowedZones, workerZones, "1.28.2", fldPath)).To(BeEmpty())
+			}
+		})
+
+		It("should forbid unsupported fsGroupPolicies", func() {
+			controlPlane.Storage = &apisgcp.Storage{FSGroupPolicy: ptr.To("Always")}
+
+			Expect(ValidateControlPlaneConfig(controlPlane, allowedZones, workerZones, "1.28.2", fldPath)).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeNotSupported),
+					"Field": Equal("storage.fsGroupPolicy"),
+				})),
+			))
+		})
+
+		DescribeTable("snapshot location",
+			func(location string, valid bool) {
+				controlPlane.Storage = &apisgcp.Storage{SnapshotLocation: location}
+
+				errorList := ValidateControlPlaneConfig(controlPlane, allowedZones, workerZones, "1.28.2", fldPath)
+				if valid {
+					Expect(errorList).To(BeEmpty())
+				} else {
+					Expect(errorList).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("storage.snapshotLocation"),
+					}))))
+				}
+			},
+			Entry("empty", "", true),
+			Entry("region", "europe-west1", true),
+			Entry("region with long name", "northamerica-northeast2", true),
+			Entry("multi-region", "eu", true),
+			Entry("zone", "europe-west1-b", false),
+			Entry("unknown multi-region", "europe", false),
+			Entry("upper case", "EU", false),
+		)
+
+		DescribeTable("max volumes per node",
+			func(maxVolumes int32, valid bool) {
+				controlPlane.Storage = &apisgcp.Storage{MaxVolumesPerNode: ptr.To(maxVolumes)}
+
+				errorList := ValidateControlPlaneConfig(controlPlane, allowedZones, workerZones, "1.28.2", fldPath)
+				if valid {
+					Expect(errorList).To(BeEmpty())
+				} else {
+					Expect(errorList).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("storage.maxVolumesPerNode"),
+					}))))
+				}
+			},
+			Entry("minimum", int32(1), true),
+			Entry("maximum", int32(127), true),
+			Entry("zero", int32(0), false),
+			Entry("including the boot disk", int32(128), false),
+		)
+
+		It("should allow positive route reconciliation settings", func() {
+			controlPlane.CloudControllerManager = &apisgcp.CloudControllerManagerConfig{
+				RouteReconciliationPeriod: &metav1.Duration{Duration: time.Minute},
+				ConcurrentRouteSyncs:      ptr.To[int32](20),
+			}
+
+			Expect(ValidateControlPlaneConfig(controlPlane, allowedZones, workerZones, "1.28.2", fldPath)).To(BeEmpty())
+		})
+
+		It("should forbid non-positive route reconciliation settings", func() {
+			controlPlane.CloudControllerManager = &apisgcp.CloudControllerManagerConfig{
+				RouteReconciliationPeriod: &metav1.Duration{},
+				ConcurrentRouteSyncs:      ptr.To[int32](-1),
+			}
+
+			errorList := ValidateControlPlaneConfig(controlPlane, allowedZones, workerZones, "1.28.2", fldPath)
+
+			Expect(errorList).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("cloudControllerManager.routeReconciliationPeriod"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("cloudControllerManager.concurrentRouteSyncs"),
+				})),
+			))
+		})
+
+		It("should allow valid leader election and node status timings", func() {
+			controlPlane.CloudControllerManager = &apisgcp.CloudControllerManagerConfig{
+				NodeStatusUpdateFrequency: &metav1.Duration{Duration: 10 * time.Minute},
+				LeaderElection: &apisgcp.LeaderElection{
+					LeaseDuration: &metav1.Duration{Duration: time.Minute},
+					RenewDeadline: &metav1.Duration{Duration: 40 * time.Second},
+					RetryPeriod:   &metav1.Duration{Duration: 5 * time.Second},
+				},
+			}
+
+			Expect(ValidateControlPlaneConfig(controlPlane, allowedZones, workerZones, "1.28.2", fldPath)).To(BeEmpty())
+		})
+
+		It("should forbid non-positive leader election and node status timings", func() {
+			controlPlane.CloudControllerManager = &apisgcp.CloudControllerManagerConfig{
+				NodeStatusUpdateFrequency: &metav1.Duration{},
+				LeaderElection: &apisgcp.LeaderElection{
+					RetryPeriod: &metav1.Duration{Duration: -time.Second},
+				},
+			}
+
+			errorList := ValidateControlPlaneConfig(controlPlane, allowedZones, workerZones, "1.28.2", fldPath)
+
+			Expect(errorList).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("cloudControllerManager.nodeStatusUpdateFrequency"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("cloudControllerManager.leaderElection.retryPeriod"),
+				})),
+			))
+		})
+
+		It("should forbid a renew deadline which is not less than the lease duration", func() {
+			controlPlane.CloudControllerManager = &apisgcp.CloudControllerManagerConfig{
+				LeaderElection: &apisgcp.LeaderElection{
+					RenewDeadline: &metav1.Duration{Duration: 20 * time.Second},
+				},
+			}
+
+			errorList := ValidateControlPlaneConfig(controlPlane, allowedZones, workerZones, "1.28.2", fldPath)
+
+			Expect(errorList).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":   Equal(field.ErrorTypeInvalid),
+				"Field":  Equal("cloudControllerManager.leaderElection.renewDeadline"),
+				"Detail": Equal("must be less than the lease duration (15s)"),
+			}))))
+		})
+
+		It("should forbid a retry period which is not less than the renew deadline", func() {
+			controlPlane.CloudControllerManager = &apisgcp.CloudControllerManagerConfig{
+				LeaderElection: &apisgcp.LeaderElection{
+					RenewDeadline: &metav1.Duration{Duration: 5 * time.Second},
+					RetryPeriod:   &metav1.Duration{Duration: 5 * time.Second},
+				},
+			}
+
+			errorList := ValidateControlPlaneConfig(controlPlane, allowedZones, workerZones, "1.28.2", fldPath)
+
+			Expect(errorList).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeInvalid),
+				"Field": Equal("cloudControllerManager.leaderElection.retryPeriod"),
+			}))))
+		})
+
+		It("should forbid configuring the node status update frequency twice", func() {
+			controlPlane.CloudControllerManager = &apisgcp.CloudControllerManagerConfig{
+				NodeStatusUpdateFrequency: &metav1.Duration{Duration: 10 * time.Minute},
+				AdditionalFlags:           map[string]string{"node-status-update-frequency": "5m"},
+			}
+
+			errorList := ValidateControlPlaneConfig(controlPlane, allowedZones, workerZones, "1.28.2", fldPath)
+
+			Expect(errorList).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeForbidden),
+				"Field": Equal("cloudControllerManager.additionalFlags[node-status-update-frequency]"),
+			}))))
+		})
+
+		It("should forbid non-positive replicas overrides", func() {
+			controlPlane.Replicas = &apisgcp.ControlPlaneReplicas{
+				CloudControllerManager: ptr.To[int32](2),
+				CSIController:          ptr.To[int32](0),
+				CSISnapshotController:  ptr.To[int32](-1),
+			}
+
+			errorList := ValidateControlPlaneConfig(controlPlane, allowedZones, workerZones, "1.28.2", fldPath)
+
+			Expect(errorList).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("replicas.csiController"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("replicas.csiSnapshotController"),
+				})),
+			))
+		})
+
+		It("should allow allow-listed additional flags", func() {
+			controlPlane.CloudControllerManager = &apisgcp.CloudControllerManagerConfig{
+				AdditionalFlags: map[string]string{
+					"concurrent-service-syncs": "20",
+					"kube-api-qps":             "50",
+				},
+			}
+
+			Expect(ValidateControlPlaneConfig(controlPlane, allowedZones, workerZones, "1.28.2", fldPath)).To(BeEmpty())
+		})
+
+		It("should forbid managed, unknown and empty additional flags", func() {
+			controlPlane.CloudControllerManager = &apisgcp.CloudControllerManagerConfig{
+				AdditionalFlags: map[string]string{
+					"cloud-provider":         "external",
+					"configure-cloud-routes": "false",
+					"allocate-node-cidrs":    "false",
+					"foo":                    "bar",
+					"kube-api-burst":         "",
+				},
+			}
+
+			errorList := ValidateControlPlaneConfig(controlPlane, allowedZones, workerZones, "1.28.2", fldPath)
+
+			Expect(errorList).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeForbidden),
+					"Field": Equal("cloudControllerManager.additionalFlags[allocate-node-cidrs]"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeForbidden),
+					"Field": Equal("cloudControllerManager.additionalFlags[cloud-provider]"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeForbidden),
+					"Field": Equal("cloudControllerManager.additionalFlags[configure-cloud-routes]"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeNotSupported),
+					"Field": Equal("cloudControllerManager.additionalFlags[foo]"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeRequired),
+					"Field": Equal("cloudControllerManager.additionalFlags[kube-api-burst]"),
+				})),
+			))
+		})
+	})
+
+	Describe("#ValidateMaxVolumesPerNode", func() {
+		var (
+			maxVolumesPath = field.NewPath("storage", "maxVolumesPerNode")
+			workers        []core.Worker
+		)
+
+		BeforeEach(func() {
+			workers = []core.Worker{
+				{Name: "pool-1", Machine: core.Machine{Type: "n2-standard-4"}},
+				{Name: "pool-2", Machine: core.Machine{Type: "e2-medium"}},
+			}
+		})
+
+		It("should allow an unset limit", func() {
+			Expect(ValidateMaxVolumesPerNode(&apisgcp.Storage{}, workers, maxVolumesPath)).To(BeEmpty())
+		})
+
+		It("should allow a limit which all machine types support", func() {
+			Expect(ValidateMaxVolumesPerNode(&apisgcp.Storage{MaxVolumesPerNode: ptr.To[int32](15)}, workers, maxVolumesPath)).To(BeEmpty())
+		})
+
+		It("should forbid a limit which exceeds the disk limit of shared-core machine types", func() {
+			Expect(ValidateMaxVolumesPerNode(&apisgcp.Storage{MaxVolumesPerNode: ptr.To[int32](16)}, workers, maxVolumesPath)).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":   Equal(field.ErrorTypeInvalid),
+					"Field":  Equal("storage.maxVolumesPerNode"),
+					"Detail": ContainSubstring(`worker pool "pool-2"`),
+				})),
+			))
+		})
 	})
 
 	Describe("#ValidateControlPlaneConfigUpdate", func() {
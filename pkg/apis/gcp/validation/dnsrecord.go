@@ -0,0 +1,112 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package validation
+
+import (
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+
+	apisgcp "github.com/gardener/gardener-extension-provider-gcp/pkg/apis/gcp"
+)
+
+var (
+	supportedDNSZoneVisibilities = []string{apisgcp.DNSZoneVisibilityPublic, apisgcp.DNSZoneVisibilityPrivate}
+
+	// knownGCPRegions are the GCP regions which can be used as locations of geo routing policies.
+	knownGCPRegions = sets.New(
+		"africa-south1",
+		"asia-east1", "asia-east2", "asia-northeast1", "asia-northeast2", "asia-northeast3", "asia-south1", "asia-south2",
+		"asia-southeast1", "asia-southeast2",
+		"australia-southeast1", "australia-southeast2",
+		"europe-central2", "europe-north1", "europe-north2", "europe-southwest1", "europe-west1", "europe-west2",
+		"europe-west3", "europe-west4", "europe-west6", "europe-west8", "europe-west9", "europe-west10", "europe-west12",
+		"me-central1", "me-central2", "me-west1",
+		"northamerica-northeast1", "northamerica-northeast2", "northamerica-south1",
+		"southamerica-east1", "southamerica-west1",
+		"us-central1", "us-east1", "us-east4", "us-east5", "us-south1", "us-west1", "us-west2", "us-west3", "us-west4",
+	)
+)
+
+// ValidateDNSRecordConfig validates a DNSRecordConfig object.
+func ValidateDNSRecordConfig(config *apisgcp.DNSRecordConfig, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if config == nil {
+		return allErrs
+	}
+
+	if config.ZoneVisibility != nil {
+		switch *config.ZoneVisibility {
+		case apisgcp.DNSZoneVisibilityPublic, apisgcp.DNSZoneVisibilityPrivate:
+		default:
+			allErrs = append(allErrs, field.NotSupported(fldPath.Child("zoneVisibility"), *config.ZoneVisibility, supportedDNSZoneVisibilities))
+		}
+	}
+
+	if config.TTL != nil && *config.TTL < 0 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("ttl"), *config.TTL, "must not be negative"))
+	}
+
+	if config.RoutingPolicy != nil {
+		allErrs = append(allErrs, validateDNSRoutingPolicy(config.RoutingPolicy, fldPath.Child("routingPolicy"))...)
+	}
+
+	return allErrs
+}
+
+func validateDNSRoutingPolicy(policy *apisgcp.DNSRoutingPolicy, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	switch {
+	case len(policy.WeightedRoundRobin) == 0 && len(policy.Geo) == 0:
+		return append(allErrs, field.Required(fldPath, "a routing policy must be specified"))
+	case len(policy.WeightedRoundRobin) > 0 && len(policy.Geo) > 0:
+		return append(allErrs, field.Forbidden(fldPath, "only one routing policy can be specified"))
+	case len(policy.Geo) > 0:
+		return validateDNSGeoRoutingPolicy(policy.Geo, fldPath.Child("geo"))
+	}
+
+	wrrPath := fldPath.Child("weightedRoundRobin")
+	var sum int64
+	for i, target := range policy.WeightedRoundRobin {
+		if target.Weight < 0 {
+			allErrs = append(allErrs, field.Invalid(wrrPath.Index(i).Child("weight"), target.Weight, "must not be negative"))
+		} else {
+			sum += int64(target.Weight)
+		}
+		if len(target.Values) == 0 {
+			allErrs = append(allErrs, field.Required(wrrPath.Index(i).Child("values"), "at least one value must be specified"))
+		}
+	}
+	if sum == 0 {
+		allErrs = append(allErrs, field.Invalid(wrrPath, sum, "the sum of the weights must be greater than 0"))
+	}
+
+	return allErrs
+}
+
+func validateDNSGeoRoutingPolicy(targets []apisgcp.DNSGeoTarget, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	locations := sets.New[string]()
+	for i, target := range targets {
+		idxPath := fldPath.Index(i)
+		switch {
+		case target.Location == "":
+			allErrs = append(allErrs, field.Required(idxPath.Child("location"), "location must be specified"))
+		case !knownGCPRegions.Has(target.Location):
+			allErrs = append(allErrs, field.NotSupported(idxPath.Child("location"), target.Location, sets.List(knownGCPRegions)))
+		case locations.Has(target.Location):
+			allErrs = append(allErrs, field.Duplicate(idxPath.Child("location"), target.Location))
+		}
+		locations.Insert(target.Location)
+
+		if len(target.Values) == 0 {
+			allErrs = append(allErrs, field.Required(idxPath.Child("values"), "at least one value must be specified"))
+		}
+	}
+
+	return allErrs
+}
@@ -0,0 +1,150 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package validation_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gstruct"
+	gomegatypes "github.com/onsi/gomega/types"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"k8s.io/utils/ptr"
+
+	apisgcp "github.com/gardener/gardener-extension-provider-gcp/pkg/apis/gcp"
+	. "github.com/gardener/gardener-extension-provider-gcp/pkg/apis/gcp/validation"
+)
+
+var _ = Describe("DNSRecordConfig validation", func() {
+	var fldPath *field.Path
+
+	BeforeEach(func() {
+		fldPath = field.NewPath("spec", "providerConfig")
+	})
+
+	Describe("#ValidateDNSRecordConfig", func() {
+		It("should allow an empty configuration", func() {
+			Expect(ValidateDNSRecordConfig(nil, fldPath)).To(BeEmpty())
+			Expect(ValidateDNSRecordConfig(&apisgcp.DNSRecordConfig{}, fldPath)).To(BeEmpty())
+		})
+
+		DescribeTable("zone visibility",
+			func(visibility string, matcher gomegatypes.GomegaMatcher) {
+				config := &apisgcp.DNSRecordConfig{ZoneVisibility: ptr.To(visibility)}
+				Expect(ValidateDNSRecordConfig(config, fldPath)).To(matcher)
+			},
+			Entry("public", "public", BeEmpty()),
+			Entry("private", "private", BeEmpty()),
+			Entry("unknown", "internal", ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeNotSupported),
+				"Field": Equal("spec.providerConfig.zoneVisibility"),
+			})))),
+		)
+
+		It("should allow non-negative TTLs", func() {
+			Expect(ValidateDNSRecordConfig(&apisgcp.DNSRecordConfig{TTL: ptr.To[int64](0)}, fldPath)).To(BeEmpty())
+			Expect(ValidateDNSRecordConfig(&apisgcp.DNSRecordConfig{TTL: ptr.To[int64](300)}, fldPath)).To(BeEmpty())
+		})
+
+		It("should allow a weighted round robin routing policy", func() {
+			config := &apisgcp.DNSRecordConfig{RoutingPolicy: &apisgcp.DNSRoutingPolicy{
+				WeightedRoundRobin: []apisgcp.DNSWeightedTarget{
+					{Weight: 90, Values: []string{"1.2.3.4"}},
+					{Weight: 0, Values: []string{"5.6.7.8"}},
+				},
+			}}
+			Expect(ValidateDNSRecordConfig(config, fldPath)).To(BeEmpty())
+		})
+
+		It("should require targets of a routing policy", func() {
+			config := &apisgcp.DNSRecordConfig{RoutingPolicy: &apisgcp.DNSRoutingPolicy{}}
+			Expect(ValidateDNSRecordConfig(config, fldPath)).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeRequired),
+				"Field": Equal("spec.providerConfig.routingPolicy"),
+			}))))
+		})
+
+		It("should forbid negative weights, weights summing up to 0 and targets without values", func() {
+			config := &apisgcp.DNSRecordConfig{RoutingPolicy: &apisgcp.DNSRoutingPolicy{
+				WeightedRoundRobin: []apisgcp.DNSWeightedTarget{
+					{Weight: -1, Values: []string{"1.2.3.4"}},
+					{Weight: 0},
+				},
+			}}
+			Expect(ValidateDNSRecordConfig(config, fldPath)).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("spec.providerConfig.routingPolicy.weightedRoundRobin[0].weight"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeRequired),
+					"Field": Equal("spec.providerConfig.routingPolicy.weightedRoundRobin[1].values"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":   Equal(field.ErrorTypeInvalid),
+					"Field":  Equal("spec.providerConfig.routingPolicy.weightedRoundRobin"),
+					"Detail": Equal("the sum of the weights must be greater than 0"),
+				})),
+			))
+		})
+
+		It("should allow a geo routing policy", func() {
+			config := &apisgcp.DNSRecordConfig{RoutingPolicy: &apisgcp.DNSRoutingPolicy{
+				Geo: []apisgcp.DNSGeoTarget{
+					{Location: "europe-west1", Values: []string{"1.2.3.4"}},
+					{Location: "us-east1", Values: []string{"5.6.7.8"}},
+				},
+			}}
+			Expect(ValidateDNSRecordConfig(config, fldPath)).To(BeEmpty())
+		})
+
+		It("should forbid unknown and duplicate locations and targets without values", func() {
+			config := &apisgcp.DNSRecordConfig{RoutingPolicy: &apisgcp.DNSRoutingPolicy{
+				Geo: []apisgcp.DNSGeoTarget{
+					{Location: "europe-west1", Values: []string{"1.2.3.4"}},
+					{Location: "europe-west1", Values: []string{"5.6.7.8"}},
+					{Location: "moon-base1", Values: []string{"5.6.7.8"}},
+					{Location: "", Values: []string{"5.6.7.8"}},
+					{Location: "us-east1"},
+				},
+			}}
+			Expect(ValidateDNSRecordConfig(config, fldPath)).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeDuplicate),
+					"Field": Equal("spec.providerConfig.routingPolicy.geo[1].location"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeNotSupported),
+					"Field": Equal("spec.providerConfig.routingPolicy.geo[2].location"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeRequired),
+					"Field": Equal("spec.providerConfig.routingPolicy.geo[3].location"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeRequired),
+					"Field": Equal("spec.providerConfig.routingPolicy.geo[4].values"),
+				})),
+			))
+		})
+
+		It("should forbid specifying multiple routing policies", func() {
+			config := &apisgcp.DNSRecordConfig{RoutingPolicy: &apisgcp.DNSRoutingPolicy{
+				WeightedRoundRobin: []apisgcp.DNSWeightedTarget{{Weight: 1, Values: []string{"1.2.3.4"}}},
+				Geo:                []apisgcp.DNSGeoTarget{{Location: "europe-west1", Values: []string{"1.2.3.4"}}},
+			}}
+			Expect(ValidateDNSRecordConfig(config, fldPath)).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeForbidden),
+				"Field": Equal("spec.providerConfig.routingPolicy"),
+			}))))
+		})
+
+		It("should forbid negative TTLs", func() {
+			Expect(ValidateDNSRecordConfig(&apisgcp.DNSRecordConfig{TTL: ptr.To[int64](-1)}, fldPath)).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeInvalid),
+				"Field": Equal("spec.providerConfig.ttl"),
+			}))))
+		})
+	})
+})
@@ -5,15 +5,38 @@
 package validation
 
 import (
+	"fmt"
+	"net"
 	"reflect"
+	"regexp"
+	"strconv"
+	"strings"
 
 	cidrvalidation "github.com/gardener/gardener/pkg/utils/validation/cidr"
 	apivalidation "k8s.io/apimachinery/pkg/api/validation"
+	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/apimachinery/pkg/util/validation/field"
+	"k8s.io/utils/ptr"
 
 	apisgcp "github.com/gardener/gardener-extension-provider-gcp/pkg/apis/gcp"
 )
 
+const (
+	// minNATTimeoutSec and maxNATTimeoutSec are the bounds of the connection timeouts of the CloudNAT.
+	minNATTimeoutSec = 1
+	maxNATTimeoutSec = 86400
+	// minPSAPrefixLength and maxPSAPrefixLength are the bounds of the prefix length of the address range which is
+	// reserved for Private Service Access.
+	minPSAPrefixLength = 8
+	maxPSAPrefixLength = 24
+)
+
+var (
+	nccHubRegex = regexp.MustCompile(`^projects/[a-z][-a-z0-9]{4,28}[a-z0-9]/locations/global/hubs/[a-z]([-a-z0-9]*[a-z0-9])?$`)
+	// resourceNameRegex matches the names of GCP resources.
+	resourceNameRegex = regexp.MustCompile(`^[a-z](?:[-a-z0-9]{0,61}[a-z0-9])?$`)
+)
+
 // ValidateInfrastructureConfig validates a InfrastructureConfig object.
 func ValidateInfrastructureConfig(infra *apisgcp.InfrastructureConfig, nodesCIDR, podsCIDR, servicesCIDR *string, fldPath *field.Path) field.ErrorList {
 	allErrs := field.ErrorList{}
@@ -54,8 +77,9 @@ func ValidateInfrastructureConfig(infra *apisgcp.InfrastructureConfig, nodesCIDR
 		allErrs = append(allErrs, cidrvalidation.ValidateCIDRIsCanonical(networksPath.Child("workers"), infra.Networks.Workers)...)
 	}
 
+	var internalCIDR cidrvalidation.CIDR
 	if infra.Networks.Internal != nil {
-		internalCIDR := cidrvalidation.NewCIDR(*infra.Networks.Internal, networksPath.Child("internal"))
+		internalCIDR = cidrvalidation.NewCIDR(*infra.Networks.Internal, networksPath.Child("internal"))
 		allErrs = append(allErrs, cidrvalidation.ValidateCIDRParse(internalCIDR)...)
 		allErrs = append(allErrs, cidrvalidation.ValidateCIDRIsCanonical(networksPath.Child("internal"), *infra.Networks.Internal)...)
 		if pods != nil {
@@ -67,11 +91,35 @@ func ValidateInfrastructureConfig(infra *apisgcp.InfrastructureConfig, nodesCIDR
 		if nodes != nil {
 			allErrs = append(allErrs, nodes.ValidateNotOverlap(internalCIDR)...)
 		}
-		allErrs = append(allErrs, workerCIDR.ValidateNotOverlap(internalCIDR)...)
+		if workerCIDR != nil {
+			allErrs = append(allErrs, workerCIDR.ValidateNotOverlap(internalCIDR)...)
+		}
+	}
+
+	var proxyOnlyCIDR cidrvalidation.CIDR
+	if infra.Networks.ProxyOnly != nil {
+		proxyOnlyCIDR = cidrvalidation.NewCIDR(*infra.Networks.ProxyOnly, networksPath.Child("proxyOnly"))
+		allErrs = append(allErrs, cidrvalidation.ValidateCIDRParse(proxyOnlyCIDR)...)
+		allErrs = append(allErrs, cidrvalidation.ValidateCIDRIsCanonical(networksPath.Child("proxyOnly"), *infra.Networks.ProxyOnly)...)
+		for _, cidr := range []cidrvalidation.CIDR{pods, services, nodes, workerCIDR, internalCIDR} {
+			if cidr != nil {
+				allErrs = append(allErrs, cidr.ValidateNotOverlap(proxyOnlyCIDR)...)
+			}
+		}
 	}
 
-	if nodes != nil {
-		allErrs = append(allErrs, nodes.ValidateSubset(workerCIDR)...)
+	if workerCIDR != nil {
+		// Pods and services are routed within the VPC, hence their networks must not overlap with the worker subnet.
+		// CIDRs of different IP families never overlap, so this also holds for dual-stack networking.
+		if pods != nil {
+			allErrs = append(allErrs, pods.ValidateNotOverlap(workerCIDR)...)
+		}
+		if services != nil {
+			allErrs = append(allErrs, services.ValidateNotOverlap(workerCIDR)...)
+		}
+		if nodes != nil {
+			allErrs = append(allErrs, nodes.ValidateSubset(workerCIDR)...)
+		}
 	}
 
 	if infra.Networks.VPC != nil && len(infra.Networks.VPC.Name) == 0 {
@@ -92,6 +140,14 @@ func ValidateInfrastructureConfig(infra *apisgcp.InfrastructureConfig, nodesCIDR
 		}
 	}
 
+	if infra.Networks.VPC != nil && len(infra.Networks.VPC.SelfLink) > 0 {
+		allErrs = append(allErrs, field.Forbidden(networksPath.Child("vpc", "selfLink"), "self-link is only reported in the infrastructure status"))
+	}
+
+	if infra.Networks.VPC != nil && len(infra.Networks.VPC.ID) > 0 {
+		allErrs = append(allErrs, field.Forbidden(networksPath.Child("vpc", "id"), "id is only reported in the infrastructure status"))
+	}
+
 	if infra.Networks.FlowLogs != nil {
 		if infra.Networks.FlowLogs.AggregationInterval == nil && infra.Networks.FlowLogs.FlowSampling == nil && infra.Networks.FlowLogs.Metadata == nil {
 			allErrs = append(allErrs, field.Required(networksPath.Child("flowLogs"), "at least one VPC flow log parameter must be specified when VPC flow log section is provided"))
@@ -119,9 +175,105 @@ func ValidateInfrastructureConfig(infra *apisgcp.InfrastructureConfig, nodesCIDR
 		allErrs = append(allErrs, ValidateCloudNatConfig(infra.Networks.CloudNAT, networksPath)...)
 	}
 
+	if ptr.Deref(infra.Networks.DisableCloudNAT, false) && infra.Networks.CloudNAT != nil {
+		allErrs = append(allErrs, field.Forbidden(networksPath.Child("cloudNAT"), "cloudNAT must not be configured when the CloudNAT is disabled"))
+	}
+
+	if ncc := infra.Networks.NetworkConnectivityCenter; ncc != nil && !nccHubRegex.MatchString(ncc.Hub) {
+		allErrs = append(allErrs, field.Invalid(networksPath.Child("networkConnectivityCenter", "hub"), ncc.Hub, "must be a hub resource name of the form projects/<project>/locations/global/hubs/<hub>"))
+	}
+
+	if infra.Networks.HealthChecks != nil {
+		allErrs = append(allErrs, validateHealthCheckPorts(infra.Networks.HealthChecks.AdditionalPorts, networksPath.Child("healthChecks", "additionalPorts"))...)
+	}
+
+	if infra.Networks.PrivateServiceAccess != nil {
+		allErrs = append(allErrs, validatePrivateServiceAccess(infra.Networks.PrivateServiceAccess, []cidrvalidation.CIDR{pods, services, nodes, workerCIDR, internalCIDR, proxyOnlyCIDR}, networksPath.Child("privateServiceAccess"))...)
+	}
+
+	return allErrs
+}
+
+func validatePrivateServiceAccess(psa *apisgcp.PSAConfig, cidrs []cidrvalidation.CIDR, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if !resourceNameRegex.MatchString(psa.Name) {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("name"), psa.Name, fmt.Sprintf("must match the regex %s", resourceNameRegex)))
+	}
+
+	if psa.PrefixLength < minPSAPrefixLength || psa.PrefixLength > maxPSAPrefixLength {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("prefixLength"), psa.PrefixLength, fmt.Sprintf("must be between %d and %d", minPSAPrefixLength, maxPSAPrefixLength)))
+		return allErrs
+	}
+
+	if psa.Address == nil {
+		return allErrs
+	}
+
+	addressPath := fldPath.Child("address")
+	if ip := net.ParseIP(*psa.Address); ip == nil || ip.To4() == nil {
+		return append(allErrs, field.Invalid(addressPath, *psa.Address, "must be a valid IPv4 address"))
+	}
+
+	// the reserved range must neither overlap the subnets of the VPC nor the pod and service networks routed within it.
+	psaRange := fmt.Sprintf("%s/%d", *psa.Address, psa.PrefixLength)
+	if errs := cidrvalidation.ValidateCIDRIsCanonical(addressPath, psaRange); len(errs) > 0 {
+		return append(allErrs, errs...)
+	}
+	psaCIDR := cidrvalidation.NewCIDR(psaRange, addressPath)
+	for _, cidr := range cidrs {
+		if cidr != nil {
+			allErrs = append(allErrs, cidr.ValidateNotOverlap(psaCIDR)...)
+		}
+	}
+
+	return allErrs
+}
+
+func validateHealthCheckPorts(ports []string, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+	seen := sets.New[string]()
+
+	for i, port := range ports {
+		idxPath := fldPath.Index(i)
+		if err := validatePortRange(port); err != nil {
+			allErrs = append(allErrs, field.Invalid(idxPath, port, err.Error()))
+			continue
+		}
+		if seen.Has(port) {
+			allErrs = append(allErrs, field.Duplicate(idxPath, port))
+		}
+		seen.Insert(port)
+	}
+
 	return allErrs
 }
 
+// validatePortRange checks that the given value is either a single port or a range of ports of the form "<from>-<to>".
+func validatePortRange(value string) error {
+	from, to, isRange := strings.Cut(value, "-")
+	if !isRange {
+		to = from
+	}
+
+	fromPort, err := strconv.Atoi(from)
+	if err != nil {
+		return fmt.Errorf("must be a port or a port range of the form <from>-<to>")
+	}
+	toPort, err := strconv.Atoi(to)
+	if err != nil {
+		return fmt.Errorf("must be a port or a port range of the form <from>-<to>")
+	}
+	if fromPort < 1 || toPort > 65535 {
+		return fmt.Errorf("ports must be between 1 and 65535")
+	}
+	if fromPort > toPort {
+		return fmt.Errorf("the first port of a range must not be greater than the last one")
+	}
+
+	return nil
+}
+
 // ValidateCloudNatConfig validates the config of the CloudNat. We intentionally keep the validation light, only
 // checking for gotchas (e.g. the port counts having to be powers of two) and obvious errors.
 func ValidateCloudNatConfig(config *apisgcp.CloudNAT, fldPath *field.Path) field.ErrorList {
@@ -153,6 +305,21 @@ func ValidateCloudNatConfig(config *apisgcp.CloudNAT, fldPath *field.Path) field
 		}
 	}
 
+	for _, timeout := range []struct {
+		name  string
+		value *int32
+	}{
+		{"icmpIdleTimeoutSec", config.IcmpIdleTimeoutSec},
+		{"tcpEstablishedIdleTimeoutSec", config.TcpEstablishedIdleTimeoutSec},
+		{"tcpTimeWaitTimeoutSec", config.TcpTimeWaitTimeoutSec},
+		{"tcpTransitoryIdleTimeoutSec", config.TcpTransitoryIdleTimeoutSec},
+		{"udpIdleTimeoutSec", config.UdpIdleTimeoutSec},
+	} {
+		if timeout.value != nil && (*timeout.value < minNATTimeoutSec || *timeout.value > maxNATTimeoutSec) {
+			allErrs = append(allErrs, field.Invalid(cloudNatPath.Child(timeout.name), *timeout.value, fmt.Sprintf("%s must be between %d and %d.", timeout.name, minNATTimeoutSec, maxNATTimeoutSec)))
+		}
+	}
+
 	return allErrs
 }
 
@@ -181,7 +348,20 @@ func ValidateInfrastructureConfigUpdate(oldConfig, newConfig *apisgcp.Infrastruc
 	if oldVPC != nil && newVPC != nil {
 		allErrs = append(allErrs, apivalidation.ValidateImmutableField(newVPC.Name, oldVPC.Name, vpcPath.Child("name"))...)
 		allErrs = append(allErrs, apivalidation.ValidateImmutableField(newVPC.CloudRouter, oldVPC.CloudRouter, vpcPath.Child("cloudRouter"))...)
-		allErrs = append(allErrs, apivalidation.ValidateImmutableField(newConfig.Networks.Internal, oldConfig.Networks.Internal, networksPath.Child("internal"))...)
+		// a dedicated subnet for internal load balancers may be added later on, but not changed or removed.
+		if oldConfig.Networks.Internal != nil {
+			allErrs = append(allErrs, apivalidation.ValidateImmutableField(newConfig.Networks.Internal, oldConfig.Networks.Internal, networksPath.Child("internal"))...)
+		}
+	}
+
+	// the CIDR of a proxy-only subnet cannot be expanded, hence the subnet may only be added or removed.
+	if oldConfig.Networks.ProxyOnly != nil && newConfig.Networks.ProxyOnly != nil {
+		allErrs = append(allErrs, apivalidation.ValidateImmutableField(newConfig.Networks.ProxyOnly, oldConfig.Networks.ProxyOnly, networksPath.Child("proxyOnly"))...)
+	}
+
+	// the address range of Private Service Access cannot be changed, hence it may only be added or removed.
+	if oldConfig.Networks.PrivateServiceAccess != nil && newConfig.Networks.PrivateServiceAccess != nil {
+		allErrs = append(allErrs, apivalidation.ValidateImmutableField(newConfig.Networks.PrivateServiceAccess, oldConfig.Networks.PrivateServiceAccess, networksPath.Child("privateServiceAccess"))...)
 	}
 
 	newWorkerCIDR := newConfig.Networks.Worker
@@ -9,6 +9,7 @@ import (
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 	. "github.com/onsi/gomega/gstruct"
+	gomegatypes "github.com/onsi/gomega/types"
 	"k8s.io/apimachinery/pkg/util/validation/field"
 	"k8s.io/utils/ptr"
 
@@ -112,6 +113,139 @@ var _ = Describe("InfrastructureConfig validation", func() {
 				}))
 			})
 
+			It("should allow a proxy-only CIDR which does not overlap with the other CIDRs", func() {
+				infrastructureConfig.Networks.ProxyOnly = ptr.To("10.251.0.0/23")
+
+				Expect(ValidateInfrastructureConfig(infrastructureConfig, &nodes, &pods, &services, fldPath)).To(BeEmpty())
+			})
+
+			It("should forbid invalid proxy-only CIDR", func() {
+				infrastructureConfig.Networks.ProxyOnly = ptr.To("10.251.0.1/23")
+
+				errorList := ValidateInfrastructureConfig(infrastructureConfig, &nodes, &pods, &services, fldPath)
+
+				Expect(errorList).To(ConsistOfFields(Fields{
+					"Type":   Equal(field.ErrorTypeInvalid),
+					"Field":  Equal("networks.proxyOnly"),
+					"Detail": Equal("must be valid canonical CIDR"),
+				}))
+			})
+
+			It("should forbid proxy-only CIDR to overlap with the other CIDRs", func() {
+				infrastructureConfig.Networks.Internal = ptr.To("10.251.0.0/24")
+				infrastructureConfig.Networks.ProxyOnly = ptr.To("10.250.0.0/15")
+
+				errorList := ValidateInfrastructureConfig(infrastructureConfig, &nodes, &pods, &services, fldPath)
+
+				Expect(errorList).To(ConsistOfFields(Fields{
+					"Type":   Equal(field.ErrorTypeInvalid),
+					"Field":  Equal("networks.proxyOnly"),
+					"Detail": Equal(`must not overlap with "networking.nodes" ("10.250.0.0/16")`),
+				}, Fields{
+					"Type":   Equal(field.ErrorTypeInvalid),
+					"Field":  Equal("networks.proxyOnly"),
+					"Detail": Equal(`must not overlap with "networks.workers" ("10.250.0.0/16")`),
+				}, Fields{
+					"Type":   Equal(field.ErrorTypeInvalid),
+					"Field":  Equal("networks.proxyOnly"),
+					"Detail": Equal(`must not overlap with "networks.internal" ("10.251.0.0/24")`),
+				}))
+			})
+
+			It("should allow a private service access range without address", func() {
+				infrastructureConfig.Networks.PrivateServiceAccess = &apisgcp.PSAConfig{Name: "psa-range", PrefixLength: 16}
+
+				Expect(ValidateInfrastructureConfig(infrastructureConfig, &nodes, &pods, &services, fldPath)).To(BeEmpty())
+			})
+
+			It("should forbid an invalid private service access name and prefix length", func() {
+				infrastructureConfig.Networks.PrivateServiceAccess = &apisgcp.PSAConfig{Name: "PSA_range", PrefixLength: 28}
+
+				errorList := ValidateInfrastructureConfig(infrastructureConfig, &nodes, &pods, &services, fldPath)
+
+				Expect(errorList).To(ConsistOfFields(Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("networks.privateServiceAccess.name"),
+				}, Fields{
+					"Type":   Equal(field.ErrorTypeInvalid),
+					"Field":  Equal("networks.privateServiceAccess.prefixLength"),
+					"Detail": Equal("must be between 8 and 24"),
+				}))
+			})
+
+			It("should forbid a non-canonical private service access range", func() {
+				infrastructureConfig.Networks.PrivateServiceAccess = &apisgcp.PSAConfig{Name: "psa-range", PrefixLength: 16, Address: ptr.To("10.252.1.0")}
+
+				errorList := ValidateInfrastructureConfig(infrastructureConfig, &nodes, &pods, &services, fldPath)
+
+				Expect(errorList).To(ConsistOfFields(Fields{
+					"Type":   Equal(field.ErrorTypeInvalid),
+					"Field":  Equal("networks.privateServiceAccess.address"),
+					"Detail": Equal("must be valid canonical CIDR"),
+				}))
+			})
+
+			It("should forbid a private service access range which overlaps with the other CIDRs", func() {
+				infrastructureConfig.Networks.PrivateServiceAccess = &apisgcp.PSAConfig{Name: "psa-range", PrefixLength: 11, Address: ptr.To("100.96.0.0")}
+
+				errorList := ValidateInfrastructureConfig(infrastructureConfig, &nodes, &pods, &services, fldPath)
+
+				Expect(errorList).To(ConsistOfFields(Fields{
+					"Type":   Equal(field.ErrorTypeInvalid),
+					"Field":  Equal("networks.privateServiceAccess.address"),
+					"Detail": Equal(`must not overlap with "networking.pods" ("100.96.0.0/11")`),
+				}))
+			})
+
+			DescribeTable("should validate that the worker and internal CIDRs do not overlap with the pod and service CIDRs",
+				func(workers, internal, pods, services string, matcher gomegatypes.GomegaMatcher) {
+					infrastructureConfig.Networks.Worker = ""
+					infrastructureConfig.Networks.Workers = workers
+					infrastructureConfig.Networks.Internal = &internal
+
+					Expect(ValidateInfrastructureConfig(infrastructureConfig, nil, &pods, &services, fldPath)).To(matcher)
+				},
+				Entry("IPv4 without overlap", "10.250.0.0/16", "10.10.0.0/24", "100.96.0.0/11", "100.64.0.0/13", BeEmpty()),
+				Entry("IPv6 without overlap", "2001:db8:1::/48", "2001:db8:2::/48", "2001:db8:3::/48", "2001:db8:4::/112", BeEmpty()),
+				Entry("different IP families", "10.250.0.0/16", "10.10.0.0/24", "2001:db8:3::/48", "2001:db8:4::/112", BeEmpty()),
+				Entry("IPv4 pods overlapping with workers", "10.250.0.0/16", "10.10.0.0/24", "10.250.128.0/17", "100.64.0.0/13", ConsistOfFields(Fields{
+					"Type":   Equal(field.ErrorTypeInvalid),
+					"Field":  Equal("networks.workers"),
+					"Detail": Equal(`must not overlap with "networking.pods" ("10.250.128.0/17")`),
+				})),
+				Entry("IPv4 services overlapping with workers and internal", "10.0.0.0/16", "10.0.128.0/24", "100.96.0.0/11", "10.0.0.0/8", ConsistOfFields(Fields{
+					"Type":   Equal(field.ErrorTypeInvalid),
+					"Field":  Equal("networks.workers"),
+					"Detail": Equal(`must not overlap with "networking.services" ("10.0.0.0/8")`),
+				}, Fields{
+					"Type":   Equal(field.ErrorTypeInvalid),
+					"Field":  Equal("networks.internal"),
+					"Detail": Equal(`must not overlap with "networking.services" ("10.0.0.0/8")`),
+				}, Fields{
+					"Type":   Equal(field.ErrorTypeInvalid),
+					"Field":  Equal("networks.internal"),
+					"Detail": Equal(`must not overlap with "networks.workers" ("10.0.0.0/16")`),
+				})),
+				Entry("IPv6 pods overlapping with internal", "2001:db8:1::/48", "2001:db8:2::/48", "2001:db8::/32", "2001:db8:4::/112", ConsistOfFields(Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("networks.workers"),
+				}, Fields{
+					"Type":   Equal(field.ErrorTypeInvalid),
+					"Field":  Equal("networks.internal"),
+					"Detail": Equal(`must not overlap with "networking.pods" ("2001:db8::/32")`),
+				})),
+			)
+
+			It("should not panic if the worker CIDR is missing", func() {
+				infrastructureConfig.Networks.Worker = ""
+				infrastructureConfig.Networks.Workers = ""
+
+				Expect(ValidateInfrastructureConfig(infrastructureConfig, &nodes, &pods, &services, fldPath)).To(ConsistOfFields(Fields{
+					"Type":  Equal(field.ErrorTypeRequired),
+					"Field": Equal("networks.workers"),
+				}))
+			})
+
 			It("should forbid non canonical CIDRs", func() {
 				nodeCIDR := "10.250.0.3/16"
 				podCIDR := "100.96.0.4/11"
@@ -332,6 +466,164 @@ var _ = Describe("InfrastructureConfig validation", func() {
 					"Detail": Equal("nat IP names cannot be empty."),
 				}))
 			})
+			It("should allow enabling endpoint independent mapping without dynamic port allocation", func() {
+				newInfrastructureConfig := infrastructureConfig.DeepCopy()
+				newInfrastructureConfig.Networks.CloudNAT = &apisgcp.CloudNAT{
+					EndpointIndependentMapping: &apisgcp.EndpointIndependentMapping{Enabled: true},
+				}
+
+				errorList := ValidateInfrastructureConfig(newInfrastructureConfig, &nodes, &pods, &services, fldPath)
+				Expect(errorList).To(BeEmpty())
+			})
+			It("should forbid enabling endpoint independent mapping and dynamic port allocation at the same time", func() {
+				newInfrastructureConfig := infrastructureConfig.DeepCopy()
+				newInfrastructureConfig.Networks.CloudNAT = &apisgcp.CloudNAT{
+					EnableDynamicPortAllocation: true,
+					EndpointIndependentMapping:  &apisgcp.EndpointIndependentMapping{Enabled: true},
+				}
+
+				errorList := ValidateInfrastructureConfig(newInfrastructureConfig, &nodes, &pods, &services, fldPath)
+				Expect(errorList).To(ConsistOfFields(Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("networks.cloudNAT.enableDynamicPortAllocation"),
+				}))
+			})
+			It("should allow valid CloudNAT timeouts", func() {
+				newInfrastructureConfig := infrastructureConfig.DeepCopy()
+				newInfrastructureConfig.Networks.CloudNAT = &apisgcp.CloudNAT{
+					IcmpIdleTimeoutSec:           ptr.To[int32](60),
+					TcpEstablishedIdleTimeoutSec: ptr.To[int32](3600),
+					TcpTimeWaitTimeoutSec:        ptr.To[int32](60),
+					TcpTransitoryIdleTimeoutSec:  ptr.To[int32](60),
+					UdpIdleTimeoutSec:            ptr.To[int32](60),
+				}
+
+				errorList := ValidateInfrastructureConfig(newInfrastructureConfig, &nodes, &pods, &services, fldPath)
+				Expect(errorList).To(BeEmpty())
+			})
+			It("should forbid CloudNAT timeouts out of range", func() {
+				newInfrastructureConfig := infrastructureConfig.DeepCopy()
+				newInfrastructureConfig.Networks.CloudNAT = &apisgcp.CloudNAT{
+					IcmpIdleTimeoutSec:    ptr.To[int32](0),
+					TcpTimeWaitTimeoutSec: ptr.To[int32](-1),
+					UdpIdleTimeoutSec:     ptr.To[int32](86401),
+				}
+
+				errorList := ValidateInfrastructureConfig(newInfrastructureConfig, &nodes, &pods, &services, fldPath)
+				Expect(errorList).To(ConsistOf(
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("networks.cloudNAT.icmpIdleTimeoutSec"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("networks.cloudNAT.tcpTimeWaitTimeoutSec"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("networks.cloudNAT.udpIdleTimeoutSec"),
+					})),
+				))
+			})
+			It("should allow a valid Network Connectivity Center hub", func() {
+				newInfrastructureConfig := infrastructureConfig.DeepCopy()
+				newInfrastructureConfig.Networks.NetworkConnectivityCenter = &apisgcp.NetworkConnectivityCenter{
+					Hub: "projects/my-project/locations/global/hubs/my-hub",
+				}
+
+				errorList := ValidateInfrastructureConfig(newInfrastructureConfig, &nodes, &pods, &services, fldPath)
+				Expect(errorList).To(BeEmpty())
+			})
+			It("should forbid an invalid Network Connectivity Center hub", func() {
+				newInfrastructureConfig := infrastructureConfig.DeepCopy()
+				newInfrastructureConfig.Networks.NetworkConnectivityCenter = &apisgcp.NetworkConnectivityCenter{
+					Hub: "my-hub",
+				}
+
+				errorList := ValidateInfrastructureConfig(newInfrastructureConfig, &nodes, &pods, &services, fldPath)
+				Expect(errorList).To(ConsistOfFields(Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("networks.networkConnectivityCenter.hub"),
+				}))
+			})
+			It("should allow disabling the CloudNAT", func() {
+				newInfrastructureConfig := infrastructureConfig.DeepCopy()
+				newInfrastructureConfig.Networks.CloudNAT = nil
+				newInfrastructureConfig.Networks.DisableCloudNAT = ptr.To(true)
+
+				errorList := ValidateInfrastructureConfig(newInfrastructureConfig, &nodes, &pods, &services, fldPath)
+				Expect(errorList).To(BeEmpty())
+			})
+			It("should forbid configuring a disabled CloudNAT", func() {
+				newInfrastructureConfig := infrastructureConfig.DeepCopy()
+				newInfrastructureConfig.Networks.DisableCloudNAT = ptr.To(true)
+
+				errorList := ValidateInfrastructureConfig(newInfrastructureConfig, &nodes, &pods, &services, fldPath)
+				Expect(errorList).To(ConsistOfFields(Fields{
+					"Type":  Equal(field.ErrorTypeForbidden),
+					"Field": Equal("networks.cloudNAT"),
+				}))
+			})
+			It("should forbid setting the self-link or id of the VPC", func() {
+				newInfrastructureConfig := infrastructureConfig.DeepCopy()
+				newInfrastructureConfig.Networks.VPC.SelfLink = "https://www.googleapis.com/compute/v1/projects/foo/global/networks/hugo"
+				newInfrastructureConfig.Networks.VPC.ID = "1234"
+
+				errorList := ValidateInfrastructureConfig(newInfrastructureConfig, &nodes, &pods, &services, fldPath)
+				Expect(errorList).To(ConsistOf(
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeForbidden),
+						"Field": Equal("networks.vpc.selfLink"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeForbidden),
+						"Field": Equal("networks.vpc.id"),
+					})),
+				))
+			})
+			It("should allow additional health check ports", func() {
+				newInfrastructureConfig := infrastructureConfig.DeepCopy()
+				newInfrastructureConfig.Networks.HealthChecks = &apisgcp.HealthChecks{
+					AdditionalPorts: []string{"8080", "9000-9100"},
+				}
+
+				errorList := ValidateInfrastructureConfig(newInfrastructureConfig, &nodes, &pods, &services, fldPath)
+				Expect(errorList).To(BeEmpty())
+			})
+			It("should forbid invalid or duplicate health check ports", func() {
+				newInfrastructureConfig := infrastructureConfig.DeepCopy()
+				newInfrastructureConfig.Networks.HealthChecks = &apisgcp.HealthChecks{
+					AdditionalPorts: []string{"http", "0", "8000-70000", "9100-9000", "8080", "8080", "8000-"},
+				}
+
+				errorList := ValidateInfrastructureConfig(newInfrastructureConfig, &nodes, &pods, &services, fldPath)
+				Expect(errorList).To(ConsistOf(
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("networks.healthChecks.additionalPorts[0]"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("networks.healthChecks.additionalPorts[1]"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("networks.healthChecks.additionalPorts[2]"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("networks.healthChecks.additionalPorts[3]"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeDuplicate),
+						"Field": Equal("networks.healthChecks.additionalPorts[5]"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("networks.healthChecks.additionalPorts[6]"),
+					})),
+				))
+			})
 		})
 	})
 
@@ -384,6 +676,47 @@ var _ = Describe("InfrastructureConfig validation", func() {
 			}))
 		})
 
+		It("should allow adding an internal subnet to an existing VPC", func() {
+			oldInfrastructureConfig := infrastructureConfig.DeepCopy()
+			oldInfrastructureConfig.Networks.Internal = nil
+
+			Expect(ValidateInfrastructureConfigUpdate(oldInfrastructureConfig, infrastructureConfig, fldPath)).To(BeEmpty())
+			Expect(ValidateInfrastructureConfigUpdate(infrastructureConfig, oldInfrastructureConfig, fldPath)).To(ConsistOfFields(Fields{
+				"Type":  Equal(field.ErrorTypeInvalid),
+				"Field": Equal("networks.internal"),
+			}))
+		})
+
+		It("should allow adding or removing a proxy-only subnet but forbid changing its CIDR", func() {
+			oldInfrastructureConfig := infrastructureConfig.DeepCopy()
+			newInfrastructureConfig := infrastructureConfig.DeepCopy()
+			newInfrastructureConfig.Networks.ProxyOnly = ptr.To("10.251.0.0/23")
+
+			Expect(ValidateInfrastructureConfigUpdate(oldInfrastructureConfig, newInfrastructureConfig, fldPath)).To(BeEmpty())
+			Expect(ValidateInfrastructureConfigUpdate(newInfrastructureConfig, oldInfrastructureConfig, fldPath)).To(BeEmpty())
+
+			oldInfrastructureConfig.Networks.ProxyOnly = ptr.To("10.252.0.0/23")
+			Expect(ValidateInfrastructureConfigUpdate(oldInfrastructureConfig, newInfrastructureConfig, fldPath)).To(ConsistOfFields(Fields{
+				"Type":  Equal(field.ErrorTypeInvalid),
+				"Field": Equal("networks.proxyOnly"),
+			}))
+		})
+
+		It("should allow adding or removing private service access but forbid changing it", func() {
+			oldInfrastructureConfig := infrastructureConfig.DeepCopy()
+			newInfrastructureConfig := infrastructureConfig.DeepCopy()
+			newInfrastructureConfig.Networks.PrivateServiceAccess = &apisgcp.PSAConfig{Name: "psa-range", PrefixLength: 16}
+
+			Expect(ValidateInfrastructureConfigUpdate(oldInfrastructureConfig, newInfrastructureConfig, fldPath)).To(BeEmpty())
+			Expect(ValidateInfrastructureConfigUpdate(newInfrastructureConfig, oldInfrastructureConfig, fldPath)).To(BeEmpty())
+
+			oldInfrastructureConfig.Networks.PrivateServiceAccess = &apisgcp.PSAConfig{Name: "psa-range", PrefixLength: 20}
+			Expect(ValidateInfrastructureConfigUpdate(oldInfrastructureConfig, newInfrastructureConfig, fldPath)).To(ConsistOfFields(Fields{
+				"Type":  Equal(field.ErrorTypeInvalid),
+				"Field": Equal("networks.privateServiceAccess"),
+			}))
+		})
+
 		It("should forbid updating VPC value to nil", func() {
 			newInfrastructureConfig := infrastructureConfig.DeepCopy()
 			newInfrastructureConfig.Networks.VPC = nil
@@ -0,0 +1,69 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package validation
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+// kmsKeyNameRegex matches the resource name of a Cloud KMS key or one of its versions. The first submatch is the
+// location of the key, the second one the version suffix.
+var kmsKeyNameRegex = regexp.MustCompile(`^projects/[^/]+/locations/([^/]+)/keyRings/[^/]+/cryptoKeys/[^/]+(/cryptoKeyVersions/[^/]+)?$`)
+
+// ValidateKMSKeyName validates that the given name is the resource name of a Cloud KMS key, i.e. it has the format
+// `projects/<project>/locations/<location>/keyRings/<keyRing>/cryptoKeys/<key>`. The resource name of a key version,
+// i.e. with the suffix `/cryptoKeyVersions/<version>`, is only accepted if allowVersion is true.
+func ValidateKMSKeyName(name string, allowVersion bool, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	format := "projects/<project>/locations/<location>/keyRings/<keyRing>/cryptoKeys/<key>"
+	if allowVersion {
+		format += "[/cryptoKeyVersions/<version>]"
+	}
+
+	match := kmsKeyNameRegex.FindStringSubmatch(name)
+	if match == nil || (!allowVersion && match[2] != "") {
+		allErrs = append(allErrs, field.Invalid(fldPath, name, fmt.Sprintf("must have the format '%s'", format)))
+	}
+
+	return allErrs
+}
+
+// ValidateKMSKeyLocation validates that the Cloud KMS key with the given name is located in one of the given locations,
+// so that it can be used for the described resource. Malformed names are ignored, they are reported by
+// ValidateKMSKeyName.
+func ValidateKMSKeyLocation(name string, locations []string, resource string, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	keyLocation := kmsKeyLocation(name)
+	if keyLocation == "" || len(locations) == 0 {
+		return allErrs
+	}
+
+	quotedLocations := make([]string, 0, len(locations))
+	for _, location := range locations {
+		if keyLocation == location {
+			return allErrs
+		}
+		quotedLocations = append(quotedLocations, fmt.Sprintf("%q", location))
+	}
+
+	allErrs = append(allErrs, field.Invalid(fldPath, name, fmt.Sprintf("must be located in %s to be used for %s", strings.Join(quotedLocations, " or "), resource)))
+	return allErrs
+}
+
+// kmsKeyLocation returns the location of the Cloud KMS key with the given name or an empty string if the name is
+// malformed.
+func kmsKeyLocation(name string) string {
+	match := kmsKeyNameRegex.FindStringSubmatch(name)
+	if match == nil {
+		return ""
+	}
+	return match[1]
+}
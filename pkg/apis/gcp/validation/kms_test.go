@@ -0,0 +1,64 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package validation_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gstruct"
+	gomegatypes "github.com/onsi/gomega/types"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+
+	. "github.com/gardener/gardener-extension-provider-gcp/pkg/apis/gcp/validation"
+)
+
+var _ = Describe("KMS key validation", func() {
+	var fldPath *field.Path
+
+	BeforeEach(func() {
+		fldPath = field.NewPath("encryption", "kmsKeyName")
+	})
+
+	DescribeTable("#ValidateKMSKeyName",
+		func(name string, allowVersion bool, matcher gomegatypes.GomegaMatcher) {
+			Expect(ValidateKMSKeyName(name, allowVersion, fldPath)).To(matcher)
+		},
+		Entry("valid key", "projects/project/locations/europe-west1/keyRings/ring/cryptoKeys/key", false, BeEmpty()),
+		Entry("valid key version", "projects/project/locations/global/keyRings/ring/cryptoKeys/key/cryptoKeyVersions/1", true, BeEmpty()),
+		Entry("key version if versions are not allowed", "projects/project/locations/global/keyRings/ring/cryptoKeys/key/cryptoKeyVersions/1", false, ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+			"Type":   Equal(field.ErrorTypeInvalid),
+			"Field":  Equal("encryption.kmsKeyName"),
+			"Detail": Equal("must have the format 'projects/<project>/locations/<location>/keyRings/<keyRing>/cryptoKeys/<key>'"),
+		})))),
+		Entry("key ring", "projects/project/locations/global/keyRings/ring", true, ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+			"Type":   Equal(field.ErrorTypeInvalid),
+			"Field":  Equal("encryption.kmsKeyName"),
+			"Detail": Equal("must have the format 'projects/<project>/locations/<location>/keyRings/<keyRing>/cryptoKeys/<key>[/cryptoKeyVersions/<version>]'"),
+		})))),
+		Entry("empty segment", "projects//locations/global/keyRings/ring/cryptoKeys/key", false, ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+			"Type":  Equal(field.ErrorTypeInvalid),
+			"Field": Equal("encryption.kmsKeyName"),
+		})))),
+		Entry("trailing slash", "projects/project/locations/global/keyRings/ring/cryptoKeys/key/", true, ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+			"Type":  Equal(field.ErrorTypeInvalid),
+			"Field": Equal("encryption.kmsKeyName"),
+		})))),
+	)
+
+	DescribeTable("#ValidateKMSKeyLocation",
+		func(name string, locations []string, matcher gomegatypes.GomegaMatcher) {
+			Expect(ValidateKMSKeyLocation(name, locations, "disks in region \"europe-west1\"", fldPath)).To(matcher)
+		},
+		Entry("key in the expected location", "projects/project/locations/europe-west1/keyRings/ring/cryptoKeys/key", []string{"europe-west1", "global"}, BeEmpty()),
+		Entry("key version in the expected location", "projects/project/locations/global/keyRings/ring/cryptoKeys/key/cryptoKeyVersions/1", []string{"europe-west1", "global"}, BeEmpty()),
+		Entry("malformed key", "key", []string{"europe-west1"}, BeEmpty()),
+		Entry("no expected locations", "projects/project/locations/europe-west4/keyRings/ring/cryptoKeys/key", nil, BeEmpty()),
+		Entry("key in another location", "projects/project/locations/europe-west4/keyRings/ring/cryptoKeys/key", []string{"europe-west1", "global"}, ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+			"Type":   Equal(field.ErrorTypeInvalid),
+			"Field":  Equal("encryption.kmsKeyName"),
+			"Detail": Equal(`must be located in "europe-west1" or "global" to be used for disks in region "europe-west1"`),
+		})))),
+	)
+})
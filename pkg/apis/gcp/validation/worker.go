@@ -6,6 +6,7 @@ package validation
 
 import (
 	"fmt"
+	"regexp"
 	"strings"
 
 	"github.com/gardener/gardener/pkg/apis/core"
@@ -14,22 +15,136 @@ import (
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/resource"
 	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/apimachinery/pkg/util/validation"
 	"k8s.io/apimachinery/pkg/util/validation/field"
+	"k8s.io/utils/ptr"
 
 	"github.com/gardener/gardener-extension-provider-gcp/pkg/apis/gcp"
+	"github.com/gardener/gardener-extension-provider-gcp/pkg/apis/gcp/helper"
 	"github.com/gardener/gardener-extension-provider-gcp/pkg/controller/worker"
+	gcpinternal "github.com/gardener/gardener-extension-provider-gcp/pkg/gcp"
+)
+
+const (
+	maxResourceManagerTags = 50
+	minSharedClientsPerGpu = 2
+	maxSharedClientsPerGpu = 48
+	// regionalDiskReplicaZones is the number of zones a regional persistent disk is replicated to.
+	regionalDiskReplicaZones = 2
+	// minCPUPlatformAutomatic is the minimum CPU platform which lets GCP choose the CPU platform of a VM.
+	minCPUPlatformAutomatic = "Automatic"
 )
 
 var (
 	validVolumeLocalSSDInterfacesTypes = sets.New("NVME", "SCSI")
+	// validLocalSSDCounts are the numbers of local SSDs which can be attached to a VM.
+	// See https://cloud.google.com/compute/docs/disks/local-ssd#choose_number_local_ssds
+	validLocalSSDCounts = sets.New[int32](1, 2, 3, 4, 5, 6, 7, 8, 16, 24)
+	// localSSDUnsupportedMachineFamilies are the machine families which do not support attaching local SSDs.
+	localSSDUnsupportedMachineFamilies = sets.New("e2", "t2a", "t2d", "n4", "c4", "h3")
+
+	// customMachineFamilies contains the constraints of the machine families which support custom machine types.
+	// See https://cloud.google.com/compute/docs/instances/creating-instance-with-custom-machine-type
+	customMachineFamilies = map[string]customMachineConstraints{
+		worker.CustomMachineDefaultFamily: {maxVCPUs: 96, singleVCPU: true, minMemoryPerVCPUMiB: 922, maxMemoryPerVCPUMiB: 6656},
+		"n2":                              {maxVCPUs: 128, minMemoryPerVCPUMiB: 512, maxMemoryPerVCPUMiB: 8192},
+		"n2d":                             {maxVCPUs: 96, minMemoryPerVCPUMiB: 512, maxMemoryPerVCPUMiB: 8192},
+		"e2":                              {maxVCPUs: 32, minMemoryPerVCPUMiB: 512, maxMemoryPerVCPUMiB: 8192},
+	}
+
+	// minCPUPlatformsByMachineFamily contains the minimum CPU platforms which can be requested for a machine family.
+	// Families with an empty set do not support a minimum CPU platform, unknown families are not checked.
+	// See https://cloud.google.com/compute/docs/instances/specify-min-cpu-platform#availablezones
+	minCPUPlatformsByMachineFamily = map[string]sets.Set[string]{
+		"n1":  sets.New("Intel Sandy Bridge", "Intel Ivy Bridge", "Intel Haswell", "Intel Broadwell", "Intel Skylake"),
+		"n2":  sets.New("Intel Cascade Lake", "Intel Ice Lake"),
+		"n2d": sets.New("AMD Rome", "AMD Milan"),
+		"c2":  sets.New("Intel Cascade Lake"),
+		"c2d": sets.New("AMD Milan"),
+		"c3":  sets.New("Intel Sapphire Rapids"),
+		"c3d": sets.New("AMD Genoa"),
+		"m1":  sets.New("Intel Broadwell", "Intel Skylake"),
+		"m2":  sets.New("Intel Cascade Lake"),
+		"m3":  sets.New("Intel Ice Lake"),
+		"a2":  sets.New("Intel Cascade Lake"),
+		"t2d": sets.New("AMD Milan"),
+		"e2":  sets.New[string](),
+		"t2a": sets.New[string](),
+	}
+
+	// diskTypesByMachineFamily contains the persistent disk and hyperdisk types which can be attached to the machines of
+	// a machine family. Unknown families are not checked.
+	// See https://cloud.google.com/compute/docs/machine-resource#machine_type_comparison
+	diskTypesByMachineFamily = map[string]sets.Set[string]{
+		"e2":  sets.New("pd-standard", "pd-balanced", "pd-ssd"),
+		"n1":  sets.New("pd-standard", "pd-balanced", "pd-ssd"),
+		"n2":  sets.New("pd-standard", "pd-balanced", "pd-ssd", "pd-extreme", "hyperdisk-extreme", "hyperdisk-throughput"),
+		"n2d": sets.New("pd-standard", "pd-balanced", "pd-ssd", "hyperdisk-throughput"),
+		"c2":  sets.New("pd-standard", "pd-balanced", "pd-ssd"),
+		"c2d": sets.New("pd-standard", "pd-balanced", "pd-ssd"),
+		"t2d": sets.New("pd-standard", "pd-balanced", "pd-ssd", "hyperdisk-throughput"),
+		"t2a": sets.New("pd-standard", "pd-balanced", "pd-ssd", "hyperdisk-throughput"),
+		"c3":  sets.New("pd-balanced", "pd-ssd", "hyperdisk-balanced", "hyperdisk-balanced-high-availability", "hyperdisk-extreme", "hyperdisk-throughput"),
+		"c3d": sets.New("pd-balanced", "pd-ssd", "hyperdisk-balanced", "hyperdisk-balanced-high-availability", "hyperdisk-extreme", "hyperdisk-throughput"),
+		"n4":  sets.New("hyperdisk-balanced"),
+		"c4":  sets.New("hyperdisk-balanced", "hyperdisk-extreme"),
+		"c4a": sets.New("hyperdisk-balanced", "hyperdisk-extreme"),
+	}
+
+	// BroadServiceAccountScopes are service account scopes granting access to (almost) all Google Cloud APIs. They
+	// are only allowed if the cloud profile permits them.
+	BroadServiceAccountScopes = sets.New("https://www.googleapis.com/auth/cloud-platform")
+
+	regionalDiskTypes = sets.New("pd-standard", "pd-balanced", "pd-ssd", "hyperdisk-balanced-high-availability")
+
+	// bootDiskProvisionedIopsRanges and bootDiskProvisionedThroughputRanges contain the allowed provisioned performance
+	// of the hyperdisk types which can be used as boot disks.
+	// See https://cloud.google.com/compute/docs/disks/hyperdisks#hyperdisk-performance-limits
+	bootDiskProvisionedIopsRanges       = map[string]provisionedRange{"hyperdisk-balanced": {min: 3000, max: 160000}}
+	bootDiskProvisionedThroughputRanges = map[string]provisionedRange{"hyperdisk-balanced": {min: 140, max: 2400}}
+
+	validGuestOSFeatures = sets.New(
+		"GVNIC",
+		"IDPF",
+		"MULTI_IP_SUBNET",
+		"SEV_CAPABLE",
+		"SEV_LIVE_MIGRATABLE_V2",
+		"SEV_SNP_CAPABLE",
+		"TDX_CAPABLE",
+		"UEFI_COMPATIBLE",
+		"VIRTIO_SCSI_MULTIQUEUE",
+	)
+
+	validGpuSharingStrategies = sets.New(string(gcp.GpuSharingStrategyTimeSharing), string(gcp.GpuSharingStrategyMPS))
+
+	validOnHostMaintenancePolicies = sets.New(string(gcp.OnHostMaintenanceMigrate), string(gcp.OnHostMaintenanceTerminate))
+
+	resourceManagerTagKeyRegex   = regexp.MustCompile(`^tagKeys/[0-9]+$`)
+	resourceManagerTagValueRegex = regexp.MustCompile(`^tagValues/[0-9]+$`)
+	networkTagRegex              = regexp.MustCompile(`^[a-z](?:[-a-z0-9]{0,61}[a-z0-9])?$`)
+	subnetNameRegex              = regexp.MustCompile(`^[a-z](?:[-a-z0-9]{0,61}[a-z0-9])?$`)
 
 	providerFldPath   = field.NewPath("providerConfig")
 	volumeFldPath     = providerFldPath.Child("volume")
 	dataVolumeFldPath = providerFldPath.Child("dataVolume")
 )
 
+// customMachineConstraints are the constraints for custom machine types of a machine family.
+type customMachineConstraints struct {
+	maxVCPUs            int32
+	singleVCPU          bool
+	minMemoryPerVCPUMiB int32
+	maxMemoryPerVCPUMiB int32
+}
+
+// provisionedRange is the inclusive range of the provisioned IOPS or throughput of a disk type.
+type provisionedRange struct {
+	min int64
+	max int64
+}
+
 // ValidateWorkerConfig validates a WorkerConfig object.
-func ValidateWorkerConfig(workerConfig *gcp.WorkerConfig, dataVolumes []core.DataVolume) field.ErrorList {
+func ValidateWorkerConfig(workerConfig *gcp.WorkerConfig, dataVolumes []core.DataVolume, machineType string) field.ErrorList {
 	allErrs := field.ErrorList{}
 
 	for i, dataVolume := range dataVolumes {
@@ -42,8 +157,40 @@ func ValidateWorkerConfig(workerConfig *gcp.WorkerConfig, dataVolumes []core.Dat
 		allErrs = append(allErrs, validateServiceAccount(workerConfig.ServiceAccount, providerFldPath.Child("serviceAccount"))...)
 		if workerConfig.Volume != nil {
 			allErrs = append(allErrs, validateDiskEncryption(workerConfig.Volume.Encryption, volumeFldPath.Child("encryption"))...)
+			if regional := workerConfig.Volume.Regional; regional != nil {
+				allErrs = append(allErrs, validateRegionalDisk(regional, volumeFldPath.Child("regional"))...)
+			}
+			allErrs = append(allErrs, validateLocalSSDs(workerConfig.Volume, dataVolumes, machineType)...)
 		}
 		allErrs = append(allErrs, validateNodeTemplate(workerConfig.NodeTemplate, providerFldPath.Child("nodeTemplate"))...)
+		allErrs = append(allErrs, validateCustomMachine(workerConfig.CustomMachine, providerFldPath.Child("customMachine"))...)
+		allErrs = append(allErrs, validateResourceManagerTags(workerConfig.ResourceManagerTags, providerFldPath.Child("resourceManagerTags"))...)
+		allErrs = append(allErrs, validateInstanceMetadata(workerConfig.InstanceMetadata, providerFldPath.Child("instanceMetadata"))...)
+		allErrs = append(allErrs, validateInstanceLabels(workerConfig.InstanceLabels, providerFldPath.Child("instanceLabels"))...)
+		allErrs = append(allErrs, validateDescriptionTemplate(workerConfig.DescriptionTemplate, providerFldPath.Child("descriptionTemplate"))...)
+		allErrs = append(allErrs, validateDNSSearchDomains(workerConfig.DNSSearchDomains, providerFldPath.Child("dnsSearchDomains"))...)
+		allErrs = append(allErrs, validateNetworkTags(workerConfig.NetworkTags, providerFldPath.Child("networkTags"))...)
+		allErrs = append(allErrs, validateGuestOSFeatures(workerConfig.GuestOSFeatures, providerFldPath.Child("guestOSFeatures"))...)
+		if subnetName := workerConfig.SubnetName; subnetName != "" && !subnetNameRegex.MatchString(subnetName) {
+			allErrs = append(allErrs, field.Invalid(providerFldPath.Child("subnetName"), subnetName, "must consist of at most 63 lowercase letters, digits or '-', start with a letter and end with a letter or digit"))
+		}
+		_, isAcceleratorOptimized := worker.AcceleratorOptimizedMachineFamilies[worker.MachineFamily(machineType)]
+		if isAcceleratorOptimized && ptr.Deref(workerConfig.ConfidentialCompute, false) {
+			allErrs = append(allErrs, field.Forbidden(providerFldPath.Child("confidentialCompute"), fmt.Sprintf("is not supported by accelerator-optimized machine type %q", machineType)))
+		}
+		if policy := workerConfig.OnHostMaintenance; policy != "" {
+			onHostMaintenancePath := providerFldPath.Child("onHostMaintenance")
+			if !validOnHostMaintenancePolicies.Has(string(policy)) {
+				allErrs = append(allErrs, field.NotSupported(onHostMaintenancePath, policy, sets.List(validOnHostMaintenancePolicies)))
+			} else if policy == gcp.OnHostMaintenanceMigrate && (isAcceleratorOptimized || workerConfig.GPU != nil || ptr.Deref(workerConfig.ConfidentialCompute, false)) {
+				allErrs = append(allErrs, field.Forbidden(onHostMaintenancePath, "live migration is not supported by VMs with GPUs or Confidential VMs"))
+			}
+		}
+		if len(workerConfig.DNSSearchDomains) > 0 {
+			if _, ok := workerConfig.InstanceMetadata[worker.MetadataKeyStartupScript]; ok {
+				allErrs = append(allErrs, field.Forbidden(providerFldPath.Child("instanceMetadata").Key(worker.MetadataKeyStartupScript), "key must not be set if dnsSearchDomains are configured"))
+			}
+		}
 		if workerConfig.DataVolumes != nil {
 			allErrs = append(allErrs, validateDataVolumeConfigs(dataVolumes, workerConfig.DataVolumes)...)
 		}
@@ -52,6 +199,125 @@ func ValidateWorkerConfig(workerConfig *gcp.WorkerConfig, dataVolumes []core.Dat
 	return allErrs
 }
 
+// ValidateWorkerVolumeConfig validates the root volume configuration of a WorkerConfig against the root volume of the
+// worker pool.
+func ValidateWorkerVolumeConfig(volumeConfig *gcp.Volume, volume *core.Volume) field.ErrorList {
+	allErrs := field.ErrorList{}
+	if volumeConfig == nil {
+		return allErrs
+	}
+
+	var volumeType string
+	if volume != nil {
+		volumeType = ptr.Deref(volume.Type, "")
+	}
+
+	if iops := volumeConfig.ProvisionedIops; iops != nil {
+		allErrs = append(allErrs, validateProvisionedPerformance(*iops, volumeType, bootDiskProvisionedIopsRanges, volumeFldPath.Child("provisionedIops"))...)
+	}
+	if throughput := volumeConfig.ProvisionedThroughput; throughput != nil {
+		allErrs = append(allErrs, validateProvisionedPerformance(*throughput, volumeType, bootDiskProvisionedThroughputRanges, volumeFldPath.Child("provisionedThroughput"))...)
+	}
+	// the type of the root volume is only known if it is set explicitly.
+	if volumeConfig.Regional != nil && volumeType != "" && !regionalDiskTypes.Has(volumeType) {
+		allErrs = append(allErrs, field.Forbidden(volumeFldPath.Child("regional"), fmt.Sprintf("is only supported for volume types %v", sets.List(regionalDiskTypes))))
+	}
+
+	return allErrs
+}
+
+// ValidateWorkerRegionalDisks validates that all zones of the given worker pool are replica zones of its regional
+// disks. Furthermore, the regional disks of all worker pools must share the same replica zones which are collected in
+// replicaZones, so that the regional storage class of the shoot is restricted to the zones of the nodes.
+func ValidateWorkerRegionalDisks(workerConfig *gcp.WorkerConfig, zones []string, replicaZones *[]string, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+	if workerConfig == nil {
+		return allErrs
+	}
+
+	type regionalDisk struct {
+		disk *gcp.RegionalDisk
+		path *field.Path
+	}
+	var regionalDisks []regionalDisk
+	if workerConfig.Volume != nil && workerConfig.Volume.Regional != nil {
+		regionalDisks = append(regionalDisks, regionalDisk{workerConfig.Volume.Regional, fldPath.Child("providerConfig", "volume", "regional", "replicaZones")})
+	}
+	for i, dataVolume := range workerConfig.DataVolumes {
+		if dataVolume.Regional != nil {
+			regionalDisks = append(regionalDisks, regionalDisk{dataVolume.Regional, fldPath.Child("providerConfig", "dataVolumes").Index(i).Child("regional", "replicaZones")})
+		}
+	}
+
+	for _, regional := range regionalDisks {
+		for i, zone := range zones {
+			if !slices.Contains(regional.disk.ReplicaZones, zone) {
+				allErrs = append(allErrs, field.Invalid(fldPath.Child("zones").Index(i), zone, fmt.Sprintf("must be a replica zone %v of the regional disks of the worker pool", regional.disk.ReplicaZones)))
+			}
+		}
+
+		sortedZones := slices.Clone(regional.disk.ReplicaZones)
+		slices.Sort(sortedZones)
+		if *replicaZones == nil {
+			*replicaZones = sortedZones
+		} else if !slices.Equal(*replicaZones, sortedZones) {
+			allErrs = append(allErrs, field.Invalid(regional.path, regional.disk.ReplicaZones, fmt.Sprintf("must be equal to the replica zones %v of the other regional disks of the shoot", *replicaZones)))
+		}
+	}
+
+	return allErrs
+}
+
+func validateProvisionedPerformance(value int64, volumeType string, ranges map[string]provisionedRange, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	r, ok := ranges[volumeType]
+	if !ok {
+		return append(allErrs, field.Forbidden(fldPath, fmt.Sprintf("is only allowed for root volumes of types: %v", sets.List(sets.KeySet(ranges)))))
+	}
+	if value < r.min || value > r.max {
+		allErrs = append(allErrs, field.Invalid(fldPath, value, fmt.Sprintf("must be between %d and %d for volume type %q", r.min, r.max, volumeType)))
+	}
+
+	return allErrs
+}
+
+func validateRegionalDisk(regional *gcp.RegionalDisk, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+	zonesPath := fldPath.Child("replicaZones")
+
+	if len(regional.ReplicaZones) != regionalDiskReplicaZones {
+		allErrs = append(allErrs, field.Invalid(zonesPath, regional.ReplicaZones, fmt.Sprintf("must contain exactly %d zones", regionalDiskReplicaZones)))
+	}
+	for i, zone := range regional.ReplicaZones {
+		if zone == "" {
+			allErrs = append(allErrs, field.Required(zonesPath.Index(i), "must not be empty"))
+		} else if slices.Contains(regional.ReplicaZones[:i], zone) {
+			allErrs = append(allErrs, field.Duplicate(zonesPath.Index(i), zone))
+		}
+	}
+
+	return allErrs
+}
+
+func validateGuestOSFeatures(features []string, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	existing := sets.New[string]()
+	for i, feature := range features {
+		switch {
+		case !validGuestOSFeatures.Has(feature):
+			allErrs = append(allErrs, field.NotSupported(fldPath.Index(i), feature, sets.List(validGuestOSFeatures)))
+		case existing.Has(feature):
+			allErrs = append(allErrs, field.Duplicate(fldPath.Index(i), feature))
+		default:
+			existing.Insert(feature)
+		}
+	}
+
+	return allErrs
+}
+
 func validateGPU(gpu *gcp.GPU, fldPath *field.Path) field.ErrorList {
 	allErrs := field.ErrorList{}
 
@@ -67,6 +333,40 @@ func validateGPU(gpu *gcp.GPU, fldPath *field.Path) field.ErrorList {
 		allErrs = append(allErrs, field.Forbidden(fldPath.Child("count"), "must be > 0 when providing gpu"))
 	}
 
+	if gpu.DriverVersion != "" {
+		for _, msg := range validation.IsValidLabelValue(gpu.DriverVersion) {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("driverVersion"), gpu.DriverVersion, msg))
+		}
+	}
+
+	if sharing := gpu.GpuSharingConfig; sharing != nil {
+		sharingPath := fldPath.Child("gpuSharingConfig")
+		if !validGpuSharingStrategies.Has(string(sharing.Strategy)) {
+			allErrs = append(allErrs, field.NotSupported(sharingPath.Child("strategy"), sharing.Strategy, sets.List(validGpuSharingStrategies)))
+		}
+		if sharing.MaxSharedClientsPerGpu < minSharedClientsPerGpu || sharing.MaxSharedClientsPerGpu > maxSharedClientsPerGpu {
+			allErrs = append(allErrs, field.Invalid(sharingPath.Child("maxSharedClientsPerGpu"), sharing.MaxSharedClientsPerGpu, fmt.Sprintf("must be between %d and %d", minSharedClientsPerGpu, maxSharedClientsPerGpu)))
+		}
+	}
+
+	return allErrs
+}
+
+// ValidateServiceAccountScopes validates that the scopes of the given service account do not contain broad scopes
+// unless they are contained in the given set of allowed broad scopes.
+func ValidateServiceAccountScopes(sa *gcp.ServiceAccount, allowedBroadScopes sets.Set[string], fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if sa == nil {
+		return allErrs
+	}
+
+	for i, scope := range sa.Scopes {
+		if scope = helper.NormalizeServiceAccountScope(scope); BroadServiceAccountScopes.Has(scope) && !allowedBroadScopes.Has(scope) {
+			allErrs = append(allErrs, field.Forbidden(fldPath.Child("scopes").Index(i), fmt.Sprintf("scope %q is not allowed by the cloud profile, rely on IAM roles of the service account or use narrower scopes instead", scope)))
+		}
+	}
+
 	return allErrs
 }
 
@@ -87,13 +387,18 @@ func validateServiceAccount(sa *gcp.ServiceAccount, fldPath *field.Path) field.E
 		existingScopes := sets.NewString()
 
 		for i, scope := range sa.Scopes {
+			idxPath := fldPath.Child("scopes").Index(i)
 			switch {
 			case scope == "":
-				allErrs = append(allErrs, field.Required(fldPath.Child("scopes").Index(i), "must not be empty"))
-			case existingScopes.Has(scope):
-				allErrs = append(allErrs, field.Duplicate(fldPath.Child("scopes").Index(i), scope))
+				allErrs = append(allErrs, field.Required(idxPath, "must not be empty"))
+			case strings.Contains(scope, "/") && !strings.HasPrefix(scope, helper.ServiceAccountScopePrefix):
+				allErrs = append(allErrs, field.Invalid(idxPath, scope, fmt.Sprintf("must be a scope of Google APIs starting with %q", helper.ServiceAccountScopePrefix)))
+			case !strings.Contains(scope, "/") && !helper.IsKnownServiceAccountScopeShorthand(scope):
+				allErrs = append(allErrs, field.Invalid(idxPath, scope, "unknown scope, use the full URL of the scope instead"))
+			case existingScopes.Has(helper.NormalizeServiceAccountScope(scope)):
+				allErrs = append(allErrs, field.Duplicate(idxPath, scope))
 			default:
-				existingScopes.Insert(scope)
+				existingScopes.Insert(helper.NormalizeServiceAccountScope(scope))
 			}
 		}
 	}
@@ -113,6 +418,255 @@ func validateDiskEncryption(encryption *gcp.DiskEncryption, fldPath *field.Path)
 		// Currently DiskEncryption only contains CMEK fields. Hence if not nil, then kmsKeyName is a must
 		// Validation logic will need to be modified when CSEK fields are possibly added to gcp.DiskEncryption in the future.
 		allErrs = append(allErrs, field.Required(fldPath.Child("kmsKeyName"), "must be specified when configuring disk encryption"))
+	} else {
+		allErrs = append(allErrs, ValidateKMSKeyName(*encryption.KmsKeyName, true, fldPath.Child("kmsKeyName"))...)
+	}
+
+	return allErrs
+}
+
+// ValidateWorkerConfigEncryptionLocation validates that the KMS key of the disk encryption configuration of the given
+// WorkerConfig can be used for disks in the given region, i.e. that it is located in the region or is a global key.
+func ValidateWorkerConfigEncryptionLocation(workerConfig *gcp.WorkerConfig, region string) field.ErrorList {
+	if workerConfig == nil || workerConfig.Volume == nil || workerConfig.Volume.Encryption == nil || workerConfig.Volume.Encryption.KmsKeyName == nil {
+		return nil
+	}
+
+	return ValidateKMSKeyLocation(*workerConfig.Volume.Encryption.KmsKeyName, []string{region, "global"}, fmt.Sprintf("disks in region %q", region), volumeFldPath.Child("encryption", "kmsKeyName"))
+}
+
+// ValidateWorkerConfigIPForwarding validates that IP forwarding is not disabled by the given WorkerConfig if the overlay
+// network of the shoot is disabled, as the pod traffic is then routed natively via the VMs.
+func ValidateWorkerConfigIPForwarding(workerConfig *gcp.WorkerConfig, overlayEnabled bool) field.ErrorList {
+	if workerConfig == nil || ptr.Deref(workerConfig.CanIPForward, true) || overlayEnabled {
+		return nil
+	}
+
+	return field.ErrorList{field.Forbidden(providerFldPath.Child("canIPForward"), "must not be disabled if the overlay network is disabled")}
+}
+
+func validateCustomMachine(customMachine *gcp.CustomMachine, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if customMachine == nil {
+		return allErrs
+	}
+
+	family := customMachine.Family
+	if family == "" {
+		family = worker.CustomMachineDefaultFamily
+	}
+	constraints, ok := customMachineFamilies[family]
+	if !ok {
+		allErrs = append(allErrs, field.NotSupported(fldPath.Child("family"), customMachine.Family, sets.List(sets.KeySet(customMachineFamilies))))
+		return allErrs
+	}
+
+	vcpus := customMachine.VCPUs
+	vcpusPath := fldPath.Child("vcpus")
+	switch {
+	case vcpus <= 0:
+		allErrs = append(allErrs, field.Invalid(vcpusPath, vcpus, "must be greater than 0"))
+	case vcpus > constraints.maxVCPUs:
+		allErrs = append(allErrs, field.Invalid(vcpusPath, vcpus, fmt.Sprintf("must not be greater than %d for machine family %s", constraints.maxVCPUs, family)))
+	case vcpus == 1 && !constraints.singleVCPU:
+		allErrs = append(allErrs, field.Invalid(vcpusPath, vcpus, fmt.Sprintf("must be an even number for machine family %s", family)))
+	case vcpus > 1 && vcpus%2 != 0:
+		allErrs = append(allErrs, field.Invalid(vcpusPath, vcpus, "must be 1 or an even number"))
+	}
+
+	memoryPath := fldPath.Child("memoryMiB")
+	if customMachine.MemoryMiB <= 0 || customMachine.MemoryMiB%256 != 0 {
+		allErrs = append(allErrs, field.Invalid(memoryPath, customMachine.MemoryMiB, "must be a positive multiple of 256"))
+	} else if vcpus > 0 {
+		minMemory, maxMemory := vcpus*constraints.minMemoryPerVCPUMiB, vcpus*constraints.maxMemoryPerVCPUMiB
+		if customMachine.MemoryMiB < minMemory || customMachine.MemoryMiB > maxMemory {
+			allErrs = append(allErrs, field.Invalid(memoryPath, customMachine.MemoryMiB, fmt.Sprintf("must be between %d and %d MiB for %d vCPUs of machine family %s", minMemory, maxMemory, vcpus, family)))
+		}
+	}
+
+	return allErrs
+}
+
+// ValidateWorkerMinCPUPlatform validates that the minimum CPU platform of the given WorkerConfig can be requested for
+// the machines of the given machine type. The platform "Automatic" lets GCP choose the platform and is always valid.
+func ValidateWorkerMinCPUPlatform(workerConfig *gcp.WorkerConfig, machineType string, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if workerConfig == nil || workerConfig.MinCpuPlatform == nil || *workerConfig.MinCpuPlatform == minCPUPlatformAutomatic {
+		return allErrs
+	}
+
+	family := MachineFamily(workerConfig, machineType)
+	platforms, ok := minCPUPlatformsByMachineFamily[family]
+	if !ok {
+		return allErrs
+	}
+
+	if platforms.Len() == 0 {
+		allErrs = append(allErrs, field.Forbidden(fldPath, fmt.Sprintf("machine family %q does not support a minimum CPU platform", family)))
+	} else if !platforms.Has(*workerConfig.MinCpuPlatform) {
+		allErrs = append(allErrs, field.NotSupported(fldPath, *workerConfig.MinCpuPlatform, sets.List(platforms)))
+	}
+
+	return allErrs
+}
+
+// ValidateWorkerVolumeTypes validates that the types of the root and data volumes of the given worker pool can be
+// attached to the machines of the pool. The given old volume types were already used by the machines of the pool and
+// are not validated again.
+func ValidateWorkerVolumeTypes(pool core.Worker, workerConfig *gcp.WorkerConfig, oldVolumeTypes sets.Set[string], fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	family := MachineFamily(workerConfig, pool.Machine.Type)
+	diskTypes, ok := diskTypesByMachineFamily[family]
+	if !ok {
+		return allErrs
+	}
+
+	validateVolumeType := func(volumeType *string, fldPath *field.Path) {
+		if volumeType == nil || *volumeType == worker.VolumeTypeScratch || diskTypes.Has(*volumeType) || oldVolumeTypes.Has(*volumeType) {
+			return
+		}
+		allErrs = append(allErrs, field.Invalid(fldPath, *volumeType, fmt.Sprintf("is not supported by machine family %q, supported types are %v", family, sets.List(diskTypes))))
+	}
+
+	if pool.Volume != nil {
+		validateVolumeType(pool.Volume.Type, fldPath.Child("volume", "type"))
+	}
+	for i, dataVolume := range pool.DataVolumes {
+		validateVolumeType(dataVolume.Type, fldPath.Child("dataVolumes").Index(i).Child("type"))
+	}
+
+	return allErrs
+}
+
+// MachineFamily returns the machine family of the machines of a worker pool with the given WorkerConfig and machine
+// type.
+func MachineFamily(workerConfig *gcp.WorkerConfig, machineType string) string {
+	if workerConfig != nil && workerConfig.CustomMachine != nil {
+		if workerConfig.CustomMachine.Family != "" {
+			return workerConfig.CustomMachine.Family
+		}
+		return worker.CustomMachineDefaultFamily
+	}
+	return worker.MachineFamily(machineType)
+}
+
+func validateResourceManagerTags(tags map[string]string, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	// See https://cloud.google.com/resource-manager/docs/tags/tags-overview#attaching
+	if len(tags) > maxResourceManagerTags {
+		allErrs = append(allErrs, field.TooMany(fldPath, len(tags), maxResourceManagerTags))
+	}
+
+	for _, key := range sets.List(sets.KeySet(tags)) {
+		if !resourceManagerTagKeyRegex.MatchString(key) {
+			allErrs = append(allErrs, field.Invalid(fldPath, key, "key must have the format 'tagKeys/{tag_key_id}'"))
+		}
+		if value := tags[key]; !resourceManagerTagValueRegex.MatchString(value) {
+			allErrs = append(allErrs, field.Invalid(fldPath.Key(key), value, "value must have the format 'tagValues/{tag_value_id}'"))
+		}
+	}
+
+	return allErrs
+}
+
+func validateInstanceMetadata(metadata map[string]string, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	for _, key := range sets.List(sets.KeySet(metadata)) {
+		if worker.ReservedInstanceMetadataKeys.Has(key) {
+			allErrs = append(allErrs, field.Forbidden(fldPath.Key(key), "key is managed by Gardener and must not be set"))
+		}
+	}
+
+	return allErrs
+}
+
+func validateInstanceLabels(labels map[string]string, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if len(labels) > worker.MaxInstanceLabels {
+		allErrs = append(allErrs, field.TooMany(fldPath, len(labels), worker.MaxInstanceLabels))
+	}
+
+	seen := sets.New[string]()
+	for _, key := range sets.List(sets.KeySet(labels)) {
+		label := gcpinternal.SanitizeGcpLabel(key)
+		switch {
+		case label == "":
+			allErrs = append(allErrs, field.Invalid(fldPath.Key(key), key, "must contain a letter"))
+		case worker.ReservedInstanceLabels.Has(label):
+			allErrs = append(allErrs, field.Forbidden(fldPath.Key(key), "label is managed by Gardener and must not be set"))
+		case seen.Has(label):
+			allErrs = append(allErrs, field.Duplicate(fldPath.Key(key), label))
+		}
+		seen.Insert(label)
+	}
+
+	return allErrs
+}
+
+func validateDescriptionTemplate(descriptionTemplate string, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if descriptionTemplate == "" {
+		return allErrs
+	}
+
+	if _, err := worker.RenderInstanceDescription(descriptionTemplate, worker.InstanceDescriptionData{
+		Shoot:     "shoot",
+		Namespace: "shoot--project--shoot",
+		Pool:      "pool",
+		Zone:      "europe-west1-b",
+	}); err != nil {
+		allErrs = append(allErrs, field.Invalid(fldPath, descriptionTemplate, err.Error()))
+	}
+
+	return allErrs
+}
+
+func validateDNSSearchDomains(domains []string, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if len(domains) > worker.MaxDNSSearchDomains {
+		allErrs = append(allErrs, field.TooMany(fldPath, len(domains), worker.MaxDNSSearchDomains))
+	}
+
+	seen := sets.New[string]()
+	for i, domain := range domains {
+		idxPath := fldPath.Index(i)
+		for _, msg := range validation.IsDNS1123Subdomain(domain) {
+			allErrs = append(allErrs, field.Invalid(idxPath, domain, msg))
+		}
+		if seen.Has(domain) {
+			allErrs = append(allErrs, field.Duplicate(idxPath, domain))
+		}
+		seen.Insert(domain)
+	}
+
+	return allErrs
+}
+
+func validateNetworkTags(tags []string, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if len(tags) > worker.MaxNetworkTags {
+		allErrs = append(allErrs, field.TooMany(fldPath, len(tags), worker.MaxNetworkTags))
+	}
+
+	seen := sets.New[string]()
+	for i, tag := range tags {
+		idxPath := fldPath.Index(i)
+		if !networkTagRegex.MatchString(tag) {
+			allErrs = append(allErrs, field.Invalid(idxPath, tag, "must consist of at most 63 lowercase letters, digits or '-', start with a letter and end with a letter or digit"))
+		}
+		if seen.Has(tag) {
+			allErrs = append(allErrs, field.Duplicate(idxPath, tag))
+		}
+		seen.Insert(tag)
 	}
 
 	return allErrs
@@ -150,14 +704,54 @@ func validateScratchDisk(volumeType string, workerConfig *gcp.WorkerConfig) fiel
 			allErrs = append(allErrs, field.Invalid(encryptionPath, *workerConfig.Volume.Encryption, fmt.Sprintf("must not be set in combination with %s volumes", worker.VolumeTypeScratch)))
 		}
 	} else {
-		// LocalSSDInterface only allowed for type SCRATCH
-		if workerConfig != nil && workerConfig.Volume != nil && workerConfig.Volume.LocalSSDInterface != nil {
+		// LocalSSDInterface only allowed for type SCRATCH or in combination with local SSDs
+		if workerConfig != nil && workerConfig.Volume != nil && workerConfig.Volume.LocalSSDInterface != nil && workerConfig.Volume.LocalSSDCount == nil {
 			allErrs = append(allErrs, field.Invalid(encryptionPath, *workerConfig.Volume.LocalSSDInterface, fmt.Sprintf("is only allowed for type %s", worker.VolumeTypeScratch)))
 		}
 	}
 	return allErrs
 }
 
+func validateLocalSSDs(volume *gcp.Volume, dataVolumes []core.DataVolume, machineType string) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if volume.LocalSSDCount == nil {
+		return allErrs
+	}
+
+	countPath := volumeFldPath.Child("localSSDCount")
+	interfacePath := volumeFldPath.Child("interface")
+	count := *volume.LocalSSDCount
+
+	if !validLocalSSDCounts.Has(count) {
+		allErrs = append(allErrs, field.Invalid(countPath, count, fmt.Sprintf("must be one of %v", sets.List(validLocalSSDCounts))))
+	}
+
+	scratchVolumes := int32(0)
+	for _, dataVolume := range dataVolumes {
+		if dataVolume.Type != nil && *dataVolume.Type == worker.VolumeTypeScratch {
+			scratchVolumes++
+		}
+	}
+	if maxCount := sets.List(validLocalSSDCounts)[validLocalSSDCounts.Len()-1]; count+scratchVolumes > maxCount {
+		allErrs = append(allErrs, field.Invalid(countPath, count, fmt.Sprintf("must not exceed %d local SSDs together with the %d data volumes of type %s", maxCount, scratchVolumes, worker.VolumeTypeScratch)))
+	}
+
+	if volume.LocalSSDInterface == nil {
+		allErrs = append(allErrs, field.Required(interfacePath, "must be set when using local SSDs"))
+	} else if !validVolumeLocalSSDInterfacesTypes.Has(*volume.LocalSSDInterface) {
+		allErrs = append(allErrs, field.NotSupported(interfacePath, *volume.LocalSSDInterface, validVolumeLocalSSDInterfacesTypes.UnsortedList()))
+	}
+
+	if family := worker.MachineFamily(machineType); localSSDUnsupportedMachineFamilies.Has(family) {
+		allErrs = append(allErrs, field.Forbidden(countPath, fmt.Sprintf("local SSDs are not supported by machine family %q", family)))
+	} else if strings.HasSuffix(machineType, "-lssd") {
+		allErrs = append(allErrs, field.Forbidden(countPath, fmt.Sprintf("machine type %q comes with a fixed number of local SSDs", machineType)))
+	}
+
+	return allErrs
+}
+
 func validateHyperDisk(dataVolume core.DataVolume, config gcp.DataVolume) field.ErrorList {
 	allErrs := field.ErrorList{}
 
@@ -194,6 +788,13 @@ func validateDataVolumeConfigs(dataVolumes []core.DataVolume, configs []gcp.Data
 		}
 		volumeNames.Insert(volumeName)
 		allErrs = append(allErrs, validateHyperDisk(dataVolumes[idx], configDataVolume)...)
+		if regional := configDataVolume.Regional; regional != nil {
+			regionalPath := dataVolumeFldPath.Index(i).Child("regional")
+			allErrs = append(allErrs, validateRegionalDisk(regional, regionalPath)...)
+			if volumeType := ptr.Deref(dataVolumes[idx].Type, ""); !regionalDiskTypes.Has(volumeType) {
+				allErrs = append(allErrs, field.Forbidden(regionalPath, fmt.Sprintf("is only supported for volume types %v", sets.List(regionalDiskTypes))))
+			}
+		}
 	}
 
 	return allErrs
@@ -5,13 +5,18 @@
 package validation_test
 
 import (
+	"fmt"
+	"strings"
+
 	"github.com/gardener/gardener/pkg/apis/core"
 	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 	. "github.com/onsi/gomega/gstruct"
+	gomegatypes "github.com/onsi/gomega/types"
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/resource"
+	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/apimachinery/pkg/util/validation/field"
 	"k8s.io/utils/ptr"
 
@@ -135,9 +140,9 @@ var _ = Describe("#ValidateWorkers", func() {
 		errorList := ValidateWorkerConfig(&gcp.WorkerConfig{
 			ServiceAccount: &gcp.ServiceAccount{
 				Email:  "",
-				Scopes: []string{"scope-1"},
+				Scopes: []string{"compute"},
 			},
-		}, nil)
+		}, nil, "")
 
 		Expect(errorList).To(ConsistOf(
 			PointTo(MatchFields(IgnoreExtras, Fields{
@@ -154,7 +159,7 @@ var _ = Describe("#ValidateWorkers", func() {
 					KmsKeyName: ptr.To("  "),
 				},
 			},
-		}, nil)
+		}, nil, "")
 
 		Expect(errorList).To(ConsistOf(
 			PointTo(MatchFields(IgnoreExtras, Fields{
@@ -164,13 +169,94 @@ var _ = Describe("#ValidateWorkers", func() {
 		))
 	})
 
+	It("should forbid because volume.encryption.kmsKeyName has an invalid format", func() {
+		errorList := ValidateWorkerConfig(&gcp.WorkerConfig{
+			Volume: &gcp.Volume{
+				Encryption: &gcp.DiskEncryption{
+					KmsKeyName: ptr.To("projects/project/locations/global/keyRings/ring"),
+				},
+			},
+		}, nil, "")
+
+		Expect(errorList).To(ConsistOf(
+			PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeInvalid),
+				"Field": Equal("providerConfig.volume.encryption.kmsKeyName"),
+			})),
+		))
+	})
+
+	It("should allow a valid volume.encryption.kmsKeyName", func() {
+		Expect(ValidateWorkerConfig(&gcp.WorkerConfig{
+			Volume: &gcp.Volume{
+				Encryption: &gcp.DiskEncryption{
+					KmsKeyName:           ptr.To("projects/project/locations/europe-west1/keyRings/ring/cryptoKeys/key"),
+					KmsKeyServiceAccount: ptr.To("kms@project.iam.gserviceaccount.com"),
+				},
+			},
+		}, nil, "")).To(BeEmpty())
+	})
+
+	It("should allow a volume.encryption.kmsKeyName of a key version", func() {
+		Expect(ValidateWorkerConfig(&gcp.WorkerConfig{
+			Volume: &gcp.Volume{
+				Encryption: &gcp.DiskEncryption{
+					KmsKeyName: ptr.To("projects/project/locations/europe-west1/keyRings/ring/cryptoKeys/key/cryptoKeyVersions/1"),
+				},
+			},
+		}, nil, "")).To(BeEmpty())
+	})
+
+	Describe("#ValidateWorkerConfigEncryptionLocation", func() {
+		workerConfigWithKey := func(kmsKeyName string) *gcp.WorkerConfig {
+			return &gcp.WorkerConfig{
+				Volume: &gcp.Volume{
+					Encryption: &gcp.DiskEncryption{KmsKeyName: ptr.To(kmsKeyName)},
+				},
+			}
+		}
+
+		It("should allow keys in the region of the shoot or global keys", func() {
+			Expect(ValidateWorkerConfigEncryptionLocation(nil, "europe-west1")).To(BeEmpty())
+			Expect(ValidateWorkerConfigEncryptionLocation(workerConfigWithKey("projects/project/locations/europe-west1/keyRings/ring/cryptoKeys/key"), "europe-west1")).To(BeEmpty())
+			Expect(ValidateWorkerConfigEncryptionLocation(workerConfigWithKey("projects/project/locations/global/keyRings/ring/cryptoKeys/key"), "europe-west1")).To(BeEmpty())
+		})
+
+		It("should forbid keys in other regions", func() {
+			Expect(ValidateWorkerConfigEncryptionLocation(workerConfigWithKey("projects/project/locations/europe-west4/keyRings/ring/cryptoKeys/key"), "europe-west1")).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":   Equal(field.ErrorTypeInvalid),
+					"Field":  Equal("providerConfig.volume.encryption.kmsKeyName"),
+					"Detail": Equal(`must be located in "europe-west1" or "global" to be used for disks in region "europe-west1"`),
+				})),
+			))
+		})
+	})
+
+	Describe("#ValidateWorkerConfigIPForwarding", func() {
+		It("should allow disabling IP forwarding if the overlay network is enabled", func() {
+			Expect(ValidateWorkerConfigIPForwarding(nil, false)).To(BeEmpty())
+			Expect(ValidateWorkerConfigIPForwarding(&gcp.WorkerConfig{}, false)).To(BeEmpty())
+			Expect(ValidateWorkerConfigIPForwarding(&gcp.WorkerConfig{CanIPForward: ptr.To(false)}, true)).To(BeEmpty())
+		})
+
+		It("should forbid disabling IP forwarding if the overlay network is disabled", func() {
+			Expect(ValidateWorkerConfigIPForwarding(&gcp.WorkerConfig{CanIPForward: ptr.To(false)}, false)).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeForbidden),
+					"Field": Equal("providerConfig.canIPForward"),
+				})),
+			))
+		})
+	})
+
 	It("should forbid because service account scope is empty", func() {
 		errorList := ValidateWorkerConfig(&gcp.WorkerConfig{
 			ServiceAccount: &gcp.ServiceAccount{
 				Email:  "foo",
 				Scopes: []string{},
 			},
-		}, nil)
+		}, nil, "")
 
 		Expect(errorList).To(ConsistOf(
 			PointTo(MatchFields(IgnoreExtras, Fields{
@@ -184,9 +270,9 @@ var _ = Describe("#ValidateWorkers", func() {
 		errorList := ValidateWorkerConfig(&gcp.WorkerConfig{
 			ServiceAccount: &gcp.ServiceAccount{
 				Email:  "foo",
-				Scopes: []string{"baz", ""},
+				Scopes: []string{"compute", ""},
 			},
-		}, nil)
+		}, nil, "")
 
 		Expect(errorList).To(ConsistOf(
 			PointTo(MatchFields(IgnoreExtras, Fields{
@@ -200,13 +286,49 @@ var _ = Describe("#ValidateWorkers", func() {
 		errorList := ValidateWorkerConfig(&gcp.WorkerConfig{
 			ServiceAccount: &gcp.ServiceAccount{
 				Email:  "foo",
-				Scopes: []string{"baz", "bar", "baz"},
+				Scopes: []string{"compute", "storage-ro", "compute"},
+			},
+		}, nil, "")
+
+		Expect(errorList).To(ConsistOf(
+			PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeDuplicate),
+				"Field": Equal("providerConfig.serviceAccount.scopes[2]"),
+			})),
+		))
+	})
+
+	It("should forbid because service account scopes are duplicated after expanding shorthands", func() {
+		errorList := ValidateWorkerConfig(&gcp.WorkerConfig{
+			ServiceAccount: &gcp.ServiceAccount{
+				Email:  "foo",
+				Scopes: []string{"storage-ro", "https://www.googleapis.com/auth/devstorage.read_only"},
 			},
-		}, nil)
+		}, nil, "")
 
 		Expect(errorList).To(ConsistOf(
 			PointTo(MatchFields(IgnoreExtras, Fields{
 				"Type":  Equal(field.ErrorTypeDuplicate),
+				"Field": Equal("providerConfig.serviceAccount.scopes[1]"),
+			})),
+		))
+	})
+
+	It("should forbid because service account scopes are unknown", func() {
+		errorList := ValidateWorkerConfig(&gcp.WorkerConfig{
+			ServiceAccount: &gcp.ServiceAccount{
+				Email:  "foo",
+				Scopes: []string{"https://www.googleapis.com/auth/compute", "foo", "https://example.com/auth/compute"},
+			},
+		}, nil, "")
+
+		Expect(errorList).To(ConsistOf(
+			PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeInvalid),
+				"Field": Equal("providerConfig.serviceAccount.scopes[1]"),
+			})),
+			PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeInvalid),
 				"Field": Equal("providerConfig.serviceAccount.scopes[2]"),
 			})),
 		))
@@ -216,9 +338,9 @@ var _ = Describe("#ValidateWorkers", func() {
 		errorList := ValidateWorkerConfig(&gcp.WorkerConfig{
 			ServiceAccount: &gcp.ServiceAccount{
 				Email:  "foo",
-				Scopes: []string{"baz"},
+				Scopes: []string{"compute"},
 			},
-		}, nil)
+		}, nil, "")
 
 		Expect(errorList).To(BeEmpty())
 	})
@@ -231,10 +353,11 @@ var _ = Describe("#ValidateWorkers", func() {
 					Count:           1},
 				ServiceAccount: &gcp.ServiceAccount{
 					Email:  "foo",
-					Scopes: []string{"baz"},
+					Scopes: []string{"compute"},
 				},
 			},
 			nil,
+			"",
 		)
 
 		Expect(errorList).To(ConsistOf(
@@ -253,10 +376,11 @@ var _ = Describe("#ValidateWorkers", func() {
 					Count:           0},
 				ServiceAccount: &gcp.ServiceAccount{
 					Email:  "foo",
-					Scopes: []string{"baz"},
+					Scopes: []string{"compute"},
 				},
 			},
 			nil,
+			"",
 		)
 
 		Expect(errorList).To(ConsistOf(
@@ -267,6 +391,59 @@ var _ = Describe("#ValidateWorkers", func() {
 		))
 	})
 
+	It("should allow a valid gpu driver version and sharing config", func() {
+		errorList := ValidateWorkerConfig(
+			&gcp.WorkerConfig{
+				GPU: &gcp.GPU{
+					AcceleratorType: "nvidia-l4",
+					Count:           1,
+					DriverVersion:   "latest",
+					GpuSharingConfig: &gcp.GpuSharing{
+						Strategy:               gcp.GpuSharingStrategyTimeSharing,
+						MaxSharedClientsPerGpu: 4,
+					},
+				},
+			},
+			nil,
+			"",
+		)
+
+		Expect(errorList).To(BeEmpty())
+	})
+
+	It("should forbid an invalid gpu driver version and sharing config", func() {
+		errorList := ValidateWorkerConfig(
+			&gcp.WorkerConfig{
+				GPU: &gcp.GPU{
+					AcceleratorType: "nvidia-l4",
+					Count:           1,
+					DriverVersion:   "not a label value",
+					GpuSharingConfig: &gcp.GpuSharing{
+						Strategy:               "foo",
+						MaxSharedClientsPerGpu: 1,
+					},
+				},
+			},
+			nil,
+			"",
+		)
+
+		Expect(errorList).To(ConsistOf(
+			PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeInvalid),
+				"Field": Equal("providerConfig.gpu.driverVersion"),
+			})),
+			PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeNotSupported),
+				"Field": Equal("providerConfig.gpu.gpuSharingConfig.strategy"),
+			})),
+			PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeInvalid),
+				"Field": Equal("providerConfig.gpu.gpuSharingConfig.maxSharedClientsPerGpu"),
+			})),
+		))
+	})
+
 	It("should fail because WorkerConfig NodeTemplate is specified with empty capacity", func() {
 		errorList := ValidateWorkerConfig(
 			&gcp.WorkerConfig{
@@ -275,6 +452,7 @@ var _ = Describe("#ValidateWorkers", func() {
 				},
 			},
 			nil,
+			"",
 		)
 
 		Expect(errorList).To(ConsistOf(
@@ -296,6 +474,7 @@ var _ = Describe("#ValidateWorkers", func() {
 				},
 			},
 			nil,
+			"",
 		)
 
 		Expect(errorList).To(BeEmpty())
@@ -311,6 +490,7 @@ var _ = Describe("#ValidateWorkers", func() {
 				},
 			},
 			nil,
+			"",
 		)
 
 		Expect(errorList).To(ConsistOf(
@@ -330,10 +510,11 @@ var _ = Describe("#ValidateWorkers", func() {
 					Count:           1},
 				ServiceAccount: &gcp.ServiceAccount{
 					Email:  "foo",
-					Scopes: []string{"baz"},
+					Scopes: []string{"compute"},
 				},
 			},
 			nil,
+			"",
 		)
 
 		Expect(errorList).To(BeEmpty())
@@ -488,7 +669,7 @@ var _ = Describe("#ValidateWorkers", func() {
 				Volume: &gcp.Volume{
 					LocalSSDInterface: ptr.To("NVME"),
 					Encryption: &gcp.DiskEncryption{
-						KmsKeyName: ptr.To("KmsKey"),
+						KmsKeyName: ptr.To("projects/project/locations/global/keyRings/ring/cryptoKeys/key"),
 					},
 				},
 			})
@@ -512,6 +693,707 @@ var _ = Describe("#ValidateWorkers", func() {
 		})
 	})
 
+	Describe("#Local SSDs", func() {
+		It("should pass because local SSDs are configured correctly", func() {
+			errorList := ValidateWorkerConfig(&gcp.WorkerConfig{
+				Volume: &gcp.Volume{
+					LocalSSDInterface: ptr.To("NVME"),
+					LocalSSDCount:     ptr.To[int32](2),
+				},
+			}, workers[0].DataVolumes, "n2-standard-8")
+			Expect(errorList).To(BeEmpty())
+		})
+
+		It("should forbid an unsupported number of local SSDs", func() {
+			errorList := ValidateWorkerConfig(&gcp.WorkerConfig{
+				Volume: &gcp.Volume{
+					LocalSSDInterface: ptr.To("NVME"),
+					LocalSSDCount:     ptr.To[int32](9),
+				},
+			}, nil, "n2-standard-8")
+			Expect(errorList).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("providerConfig.volume.localSSDCount"),
+				})),
+			))
+		})
+
+		It("should forbid exceeding the maximum number of local SSDs together with SCRATCH data volumes", func() {
+			workers[0].DataVolumes[0].Type = ptr.To(worker.VolumeTypeScratch)
+			errorList := ValidateWorkerConfig(&gcp.WorkerConfig{
+				Volume: &gcp.Volume{
+					LocalSSDInterface: ptr.To("NVME"),
+					LocalSSDCount:     ptr.To[int32](24),
+				},
+			}, workers[0].DataVolumes, "n2-standard-80")
+			Expect(errorList).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":   Equal(field.ErrorTypeInvalid),
+					"Field":  Equal("providerConfig.volume.localSSDCount"),
+					"Detail": ContainSubstring("must not exceed 24 local SSDs"),
+				})),
+			))
+		})
+
+		It("should require the interface", func() {
+			errorList := ValidateWorkerConfig(&gcp.WorkerConfig{
+				Volume: &gcp.Volume{
+					LocalSSDCount: ptr.To[int32](1),
+				},
+			}, nil, "n2-standard-8")
+			Expect(errorList).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeRequired),
+					"Field": Equal("providerConfig.volume.interface"),
+				})),
+			))
+		})
+
+		DescribeTable("should forbid local SSDs for unsupported machine types",
+			func(machineType string) {
+				errorList := ValidateWorkerConfig(&gcp.WorkerConfig{
+					Volume: &gcp.Volume{
+						LocalSSDInterface: ptr.To("NVME"),
+						LocalSSDCount:     ptr.To[int32](1),
+					},
+				}, nil, machineType)
+				Expect(errorList).To(ConsistOf(
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeForbidden),
+						"Field": Equal("providerConfig.volume.localSSDCount"),
+					})),
+				))
+			},
+			Entry("e2 machine family", "e2-standard-4"),
+			Entry("machine type with bundled local SSDs", "c3-standard-8-lssd"),
+		)
+	})
+
+	Describe("#CustomMachine", func() {
+		DescribeTable("should validate the custom machine shape",
+			func(customMachine *gcp.CustomMachine, matcher gomegatypes.GomegaMatcher) {
+				errorList := ValidateWorkerConfig(&gcp.WorkerConfig{CustomMachine: customMachine}, nil, "")
+				Expect(errorList).To(matcher)
+			},
+			Entry("valid n1 shape", &gcp.CustomMachine{VCPUs: 4, MemoryMiB: 16384}, BeEmpty()),
+			Entry("valid single vCPU n1 shape", &gcp.CustomMachine{VCPUs: 1, MemoryMiB: 1024}, BeEmpty()),
+			Entry("valid n2 shape", &gcp.CustomMachine{VCPUs: 8, MemoryMiB: 65536, Family: "n2"}, BeEmpty()),
+			Entry("unsupported family", &gcp.CustomMachine{VCPUs: 4, MemoryMiB: 16384, Family: "c2"}, ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeNotSupported),
+					"Field": Equal("providerConfig.customMachine.family"),
+				})),
+			)),
+			Entry("odd vCPU count", &gcp.CustomMachine{VCPUs: 3, MemoryMiB: 3072}, ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("providerConfig.customMachine.vcpus"),
+				})),
+			)),
+			Entry("single vCPU for a family requiring even counts", &gcp.CustomMachine{VCPUs: 1, MemoryMiB: 1024, Family: "e2"}, ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("providerConfig.customMachine.vcpus"),
+				})),
+			)),
+			Entry("too many vCPUs", &gcp.CustomMachine{VCPUs: 34, MemoryMiB: 34816, Family: "e2"}, ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("providerConfig.customMachine.vcpus"),
+				})),
+			)),
+			Entry("memory not a multiple of 256 MiB", &gcp.CustomMachine{VCPUs: 2, MemoryMiB: 4000}, ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("providerConfig.customMachine.memoryMiB"),
+				})),
+			)),
+			Entry("too much memory per vCPU", &gcp.CustomMachine{VCPUs: 2, MemoryMiB: 16384}, ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":   Equal(field.ErrorTypeInvalid),
+					"Field":  Equal("providerConfig.customMachine.memoryMiB"),
+					"Detail": ContainSubstring("must be between 1844 and 13312 MiB"),
+				})),
+			)),
+			Entry("too little memory per vCPU", &gcp.CustomMachine{VCPUs: 4, MemoryMiB: 1024, Family: "n2"}, ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("providerConfig.customMachine.memoryMiB"),
+				})),
+			)),
+		)
+	})
+
+	Describe("#ValidateWorkerMinCPUPlatform", func() {
+		fldPath := field.NewPath("workers").Index(0).Child("providerConfig", "minCpuPlatform")
+
+		DescribeTable("should validate the min CPU platform against the machine family",
+			func(machineType string, customMachine *gcp.CustomMachine, minCpuPlatform string, matcher gomegatypes.GomegaMatcher) {
+				errorList := ValidateWorkerMinCPUPlatform(&gcp.WorkerConfig{
+					MinCpuPlatform: &minCpuPlatform,
+					CustomMachine:  customMachine,
+				}, machineType, fldPath)
+				Expect(errorList).To(matcher)
+			},
+			Entry("supported platform", "n2-standard-4", nil, "Intel Ice Lake", BeEmpty()),
+			Entry("unknown machine family", "x9-standard-4", nil, "Intel Ice Lake", BeEmpty()),
+			Entry("automatic platform", "n2-standard-4", nil, "Automatic", BeEmpty()),
+			Entry("automatic platform for a machine family without min CPU platform support", "e2-standard-4", nil, "Automatic", BeEmpty()),
+			Entry("custom machine type with default family", "n1-standard-2", &gcp.CustomMachine{VCPUs: 2, MemoryMiB: 4096}, "Intel Skylake", BeEmpty()),
+			Entry("unsupported platform", "n2d-standard-4", nil, "Intel Ice Lake", ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeNotSupported),
+					"Field": Equal("workers[0].providerConfig.minCpuPlatform"),
+				})),
+			)),
+			Entry("unsupported platform for the custom machine family", "n1-standard-2", &gcp.CustomMachine{VCPUs: 2, MemoryMiB: 4096, Family: "n2"}, "Intel Skylake", ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeNotSupported),
+					"Field": Equal("workers[0].providerConfig.minCpuPlatform"),
+				})),
+			)),
+			Entry("machine family without min CPU platform support", "e2-standard-4", nil, "Intel Skylake", ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeForbidden),
+					"Field": Equal("workers[0].providerConfig.minCpuPlatform"),
+				})),
+			)),
+		)
+	})
+
+	Describe("#ValidateWorkerVolumeTypes", func() {
+		fldPath := field.NewPath("workers").Index(0)
+
+		DescribeTable("should validate the volume types against the machine family",
+			func(machineType, volumeType, dataVolumeType string, customMachine *gcp.CustomMachine, oldVolumeTypes sets.Set[string], matcher gomegatypes.GomegaMatcher) {
+				pool := core.Worker{
+					Machine:     core.Machine{Type: machineType},
+					Volume:      &core.Volume{Type: &volumeType},
+					DataVolumes: []core.DataVolume{{Name: "data", Type: &dataVolumeType}},
+				}
+				Expect(ValidateWorkerVolumeTypes(pool, &gcp.WorkerConfig{CustomMachine: customMachine}, oldVolumeTypes, fldPath)).To(matcher)
+			},
+			Entry("supported types", "c3-standard-4", "hyperdisk-balanced", "hyperdisk-extreme", nil, nil, BeEmpty()),
+			Entry("unknown machine family", "x9-standard-4", "hyperdisk-balanced", "pd-standard", nil, nil, BeEmpty()),
+			Entry("unsupported types which were already used by the pool", "e2-standard-4", "pd-balanced", "hyperdisk-extreme", nil, sets.New("pd-balanced", "hyperdisk-extreme"), BeEmpty()),
+			Entry("SCRATCH data volume", "n1-standard-4", "pd-balanced", worker.VolumeTypeScratch, nil, nil, BeEmpty()),
+			Entry("hyperdisk-extreme on e2", "e2-standard-4", "pd-balanced", "hyperdisk-extreme", nil, nil, ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":     Equal(field.ErrorTypeInvalid),
+					"Field":    Equal("workers[0].dataVolumes[0].type"),
+					"BadValue": Equal("hyperdisk-extreme"),
+				})),
+			)),
+			Entry("persistent disks on n4", "n4-standard-4", "pd-balanced", "pd-ssd", nil, nil, ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":   Equal(field.ErrorTypeInvalid),
+					"Field":  Equal("workers[0].volume.type"),
+					"Detail": Equal(`is not supported by machine family "n4", supported types are [hyperdisk-balanced]`),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("workers[0].dataVolumes[0].type"),
+				})),
+			)),
+			Entry("hyperdisk-balanced on the default custom machine family", "n2-standard-4", "hyperdisk-balanced", "pd-ssd", &gcp.CustomMachine{VCPUs: 2, MemoryMiB: 4096}, nil, ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("workers[0].volume.type"),
+				})),
+			)),
+			Entry("hyperdisk-throughput on c4", "c4-standard-8", "hyperdisk-balanced", "hyperdisk-throughput", nil, nil, ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("workers[0].dataVolumes[0].type"),
+				})),
+			)),
+		)
+	})
+
+	Describe("#ResourceManagerTags", func() {
+		It("should allow valid resource manager tags", func() {
+			errorList := ValidateWorkerConfig(&gcp.WorkerConfig{
+				ResourceManagerTags: map[string]string{"tagKeys/123": "tagValues/456"},
+			}, nil, "")
+			Expect(errorList).To(BeEmpty())
+		})
+
+		It("should forbid invalid keys and values", func() {
+			errorList := ValidateWorkerConfig(&gcp.WorkerConfig{
+				ResourceManagerTags: map[string]string{
+					"env":         "tagValues/456",
+					"tagKeys/123": "production",
+				},
+			}, nil, "")
+			Expect(errorList).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":     Equal(field.ErrorTypeInvalid),
+					"Field":    Equal("providerConfig.resourceManagerTags"),
+					"BadValue": Equal("env"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":     Equal(field.ErrorTypeInvalid),
+					"Field":    Equal("providerConfig.resourceManagerTags[tagKeys/123]"),
+					"BadValue": Equal("production"),
+				})),
+			))
+		})
+
+		It("should forbid too many resource manager tags", func() {
+			tags := map[string]string{}
+			for i := range 51 {
+				tags[fmt.Sprintf("tagKeys/%d", i)] = fmt.Sprintf("tagValues/%d", i)
+			}
+			errorList := ValidateWorkerConfig(&gcp.WorkerConfig{ResourceManagerTags: tags}, nil, "")
+			Expect(errorList).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeTooMany),
+					"Field": Equal("providerConfig.resourceManagerTags"),
+				})),
+			))
+		})
+	})
+
+	Describe("#InstanceMetadata", func() {
+		It("should allow custom instance metadata", func() {
+			errorList := ValidateWorkerConfig(&gcp.WorkerConfig{
+				InstanceMetadata: map[string]string{"enable-oslogin": "TRUE"},
+			}, nil, "")
+			Expect(errorList).To(BeEmpty())
+		})
+
+		It("should forbid keys managed by Gardener", func() {
+			errorList := ValidateWorkerConfig(&gcp.WorkerConfig{
+				InstanceMetadata: map[string]string{
+					"block-project-ssh-keys": "FALSE",
+					"user-data":              "foo",
+					"serial-port-enable":     "TRUE",
+				},
+			}, nil, "")
+			Expect(errorList).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeForbidden),
+					"Field": Equal("providerConfig.instanceMetadata[block-project-ssh-keys]"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeForbidden),
+					"Field": Equal("providerConfig.instanceMetadata[user-data]"),
+				})),
+			))
+		})
+	})
+
+	Describe("#NetworkTags", func() {
+		It("should allow valid network tags", func() {
+			errorList := ValidateWorkerConfig(&gcp.WorkerConfig{
+				NetworkTags: []string{"allow-monitoring", "web"},
+			}, nil, "")
+			Expect(errorList).To(BeEmpty())
+		})
+
+		It("should forbid invalid and duplicate network tags", func() {
+			errorList := ValidateWorkerConfig(&gcp.WorkerConfig{
+				NetworkTags: []string{"web", "Web", "web-", strings.Repeat("a", 64), "web"},
+			}, nil, "")
+			Expect(errorList).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("providerConfig.networkTags[1]"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("providerConfig.networkTags[2]"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("providerConfig.networkTags[3]"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeDuplicate),
+					"Field": Equal("providerConfig.networkTags[4]"),
+				})),
+			))
+		})
+
+		It("should forbid too many network tags", func() {
+			var tags []string
+			for i := range 62 {
+				tags = append(tags, fmt.Sprintf("tag-%d", i))
+			}
+			errorList := ValidateWorkerConfig(&gcp.WorkerConfig{NetworkTags: tags}, nil, "")
+			Expect(errorList).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeTooMany),
+					"Field": Equal("providerConfig.networkTags"),
+				})),
+			))
+		})
+	})
+
+	Describe("#InstanceLabels", func() {
+		It("should allow valid instance labels", func() {
+			Expect(ValidateWorkerConfig(&gcp.WorkerConfig{InstanceLabels: map[string]string{"cost-center": "Team A", "Owner": "foo"}}, nil, "n2-standard-4")).To(BeEmpty())
+		})
+
+		It("should forbid invalid, reserved and colliding instance labels", func() {
+			errorList := ValidateWorkerConfig(&gcp.WorkerConfig{InstanceLabels: map[string]string{
+				"123":   "foo",
+				"name":  "foo",
+				"owner": "foo",
+				"Owner": "bar",
+			}}, nil, "n2-standard-4")
+			Expect(errorList).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("providerConfig.instanceLabels[123]"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeForbidden),
+					"Field": Equal("providerConfig.instanceLabels[name]"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeDuplicate),
+					"Field": Equal("providerConfig.instanceLabels[owner]"),
+				})),
+			))
+		})
+
+		It("should forbid too many instance labels", func() {
+			labels := map[string]string{}
+			for i := range 63 {
+				labels[fmt.Sprintf("label-%d", i)] = "foo"
+			}
+			Expect(ValidateWorkerConfig(&gcp.WorkerConfig{InstanceLabels: labels}, nil, "n2-standard-4")).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeTooMany),
+					"Field": Equal("providerConfig.instanceLabels"),
+				})),
+			))
+		})
+	})
+
+	Describe("#DescriptionTemplate", func() {
+		It("should allow a valid template", func() {
+			Expect(ValidateWorkerConfig(&gcp.WorkerConfig{DescriptionTemplate: "{{ .Pool }} of {{ .Shoot }} in {{ .Zone }}"}, nil, "n2-standard-4")).To(BeEmpty())
+		})
+
+		DescribeTable("should forbid invalid templates",
+			func(descriptionTemplate string) {
+				Expect(ValidateWorkerConfig(&gcp.WorkerConfig{DescriptionTemplate: descriptionTemplate}, nil, "n2-standard-4")).To(ConsistOf(
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("providerConfig.descriptionTemplate"),
+					})),
+				))
+			},
+			Entry("syntax error", "{{ .Pool }"),
+			Entry("unknown field", "{{ .Project }}"),
+			Entry("too long", strings.Repeat("a", 2049)),
+		)
+	})
+
+	Describe("#SubnetName", func() {
+		It("should allow a valid subnet name", func() {
+			Expect(ValidateWorkerConfig(&gcp.WorkerConfig{SubnetName: "my-subnet-1"}, nil, "n2-standard-4")).To(BeEmpty())
+		})
+
+		It("should forbid an invalid subnet name", func() {
+			errorList := ValidateWorkerConfig(&gcp.WorkerConfig{SubnetName: "My_Subnet"}, nil, "n2-standard-4")
+			Expect(errorList).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("providerConfig.subnetName"),
+				})),
+			))
+		})
+	})
+
+	Describe("#OnHostMaintenance", func() {
+		It("should allow the supported policies", func() {
+			Expect(ValidateWorkerConfig(&gcp.WorkerConfig{OnHostMaintenance: gcp.OnHostMaintenanceMigrate}, nil, "n2-standard-4")).To(BeEmpty())
+			Expect(ValidateWorkerConfig(&gcp.WorkerConfig{OnHostMaintenance: gcp.OnHostMaintenanceTerminate}, nil, "g2-standard-4")).To(BeEmpty())
+		})
+
+		It("should forbid unsupported policies", func() {
+			errorList := ValidateWorkerConfig(&gcp.WorkerConfig{OnHostMaintenance: "RESTART"}, nil, "n2-standard-4")
+			Expect(errorList).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeNotSupported),
+					"Field": Equal("providerConfig.onHostMaintenance"),
+				})),
+			))
+		})
+
+		DescribeTable("should forbid live migration for VMs which do not support it",
+			func(workerConfig *gcp.WorkerConfig, machineType string) {
+				workerConfig.OnHostMaintenance = gcp.OnHostMaintenanceMigrate
+				Expect(ValidateWorkerConfig(workerConfig, nil, machineType)).To(ConsistOf(
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeForbidden),
+						"Field": Equal("providerConfig.onHostMaintenance"),
+					})),
+				))
+			},
+			Entry("accelerator-optimized machine type", &gcp.WorkerConfig{}, "a2-highgpu-1g"),
+			Entry("attached GPUs", &gcp.WorkerConfig{GPU: &gcp.GPU{AcceleratorType: "nvidia-tesla-t4", Count: 1}}, "n1-standard-4"),
+			Entry("Confidential VM", &gcp.WorkerConfig{ConfidentialCompute: ptr.To(true)}, "n2d-standard-4"),
+		)
+	})
+
+	Describe("#DNSSearchDomains", func() {
+		It("should allow valid DNS search domains", func() {
+			errorList := ValidateWorkerConfig(&gcp.WorkerConfig{
+				DNSSearchDomains: []string{"corp.example.com", "example.com"},
+			}, nil, "")
+			Expect(errorList).To(BeEmpty())
+		})
+
+		It("should forbid invalid and duplicate DNS search domains", func() {
+			errorList := ValidateWorkerConfig(&gcp.WorkerConfig{
+				DNSSearchDomains: []string{"example.com", "Invalid_Domain", "example.com"},
+			}, nil, "")
+			Expect(errorList).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("providerConfig.dnsSearchDomains[1]"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeDuplicate),
+					"Field": Equal("providerConfig.dnsSearchDomains[2]"),
+				})),
+			))
+		})
+
+		It("should forbid too many DNS search domains", func() {
+			var domains []string
+			for i := range 7 {
+				domains = append(domains, fmt.Sprintf("domain%d.example.com", i))
+			}
+			errorList := ValidateWorkerConfig(&gcp.WorkerConfig{DNSSearchDomains: domains}, nil, "")
+			Expect(errorList).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeTooMany),
+					"Field": Equal("providerConfig.dnsSearchDomains"),
+				})),
+			))
+		})
+
+		It("should forbid a custom startup script if DNS search domains are configured", func() {
+			errorList := ValidateWorkerConfig(&gcp.WorkerConfig{
+				DNSSearchDomains: []string{"example.com"},
+				InstanceMetadata: map[string]string{"startup-script": "echo foo"},
+			}, nil, "")
+			Expect(errorList).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeForbidden),
+					"Field": Equal("providerConfig.instanceMetadata[startup-script]"),
+				})),
+			))
+		})
+	})
+
+	Describe("#RegionalDisk", func() {
+		It("should allow regional disks with two replica zones", func() {
+			errorList := ValidateWorkerConfig(&gcp.WorkerConfig{
+				Volume:      &gcp.Volume{Regional: &gcp.RegionalDisk{ReplicaZones: []string{"zone-a", "zone-b"}}},
+				DataVolumes: []gcp.DataVolume{{Name: "data", Regional: &gcp.RegionalDisk{ReplicaZones: []string{"zone-a", "zone-b"}}}},
+			}, []core.DataVolume{{Name: "data", Type: ptr.To("pd-balanced"), VolumeSize: "20Gi"}}, "")
+			Expect(errorList).To(BeEmpty())
+		})
+
+		It("should forbid invalid replica zones and unsupported volume types", func() {
+			errorList := ValidateWorkerConfig(&gcp.WorkerConfig{
+				Volume:      &gcp.Volume{Regional: &gcp.RegionalDisk{ReplicaZones: []string{"zone-a", "zone-a"}}},
+				DataVolumes: []gcp.DataVolume{{Name: "data", Regional: &gcp.RegionalDisk{ReplicaZones: []string{"zone-a"}}}},
+			}, []core.DataVolume{{Name: "data", Type: ptr.To("pd-extreme"), VolumeSize: "20Gi"}}, "")
+			Expect(errorList).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeDuplicate),
+					"Field": Equal("providerConfig.volume.regional.replicaZones[1]"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("providerConfig.dataVolume[0].regional.replicaZones"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeForbidden),
+					"Field": Equal("providerConfig.dataVolume[0].regional"),
+				})),
+			))
+		})
+	})
+
+	Describe("#AcceleratorOptimized", func() {
+		It("should forbid invalid and duplicate guest OS features", func() {
+			errorList := ValidateWorkerConfig(&gcp.WorkerConfig{
+				GuestOSFeatures: []string{"GVNIC", "FOO", "GVNIC"},
+			}, nil, "a2-highgpu-1g")
+			Expect(errorList).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeNotSupported),
+					"Field": Equal("providerConfig.guestOSFeatures[1]"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeDuplicate),
+					"Field": Equal("providerConfig.guestOSFeatures[2]"),
+				})),
+			))
+		})
+
+		It("should forbid Confidential VMs for accelerator-optimized machine types", func() {
+			Expect(ValidateWorkerConfig(&gcp.WorkerConfig{ConfidentialCompute: ptr.To(true)}, nil, "n2d-standard-4")).To(BeEmpty())
+			Expect(ValidateWorkerConfig(&gcp.WorkerConfig{ConfidentialCompute: ptr.To(true)}, nil, "g2-standard-4")).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeForbidden),
+					"Field": Equal("providerConfig.confidentialCompute"),
+				})),
+			))
+		})
+	})
+
+	Describe("#ValidateWorkerVolumeConfig", func() {
+		var volume *core.Volume
+
+		BeforeEach(func() {
+			volume = &core.Volume{Type: ptr.To("hyperdisk-balanced"), VolumeSize: "50Gi"}
+		})
+
+		It("should allow provisioned performance for hyperdisk-balanced root volumes", func() {
+			Expect(ValidateWorkerVolumeConfig(&gcp.Volume{
+				ProvisionedIops:       ptr.To[int64](3000),
+				ProvisionedThroughput: ptr.To[int64](2400),
+			}, volume)).To(BeEmpty())
+		})
+
+		It("should forbid provisioned performance for other root volume types", func() {
+			volume.Type = ptr.To("pd-balanced")
+			Expect(ValidateWorkerVolumeConfig(&gcp.Volume{
+				ProvisionedIops:       ptr.To[int64](3000),
+				ProvisionedThroughput: ptr.To[int64](140),
+			}, volume)).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeForbidden),
+					"Field": Equal("providerConfig.volume.provisionedIops"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeForbidden),
+					"Field": Equal("providerConfig.volume.provisionedThroughput"),
+				})),
+			))
+		})
+
+		It("should forbid provisioned performance if the root volume type is not set", func() {
+			Expect(ValidateWorkerVolumeConfig(&gcp.Volume{ProvisionedIops: ptr.To[int64](3000)}, nil)).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeForbidden),
+					"Field": Equal("providerConfig.volume.provisionedIops"),
+				})),
+			))
+		})
+
+		It("should forbid regional root volumes of unsupported types", func() {
+			regional := &gcp.RegionalDisk{ReplicaZones: []string{"zone-a", "zone-b"}}
+			Expect(ValidateWorkerVolumeConfig(&gcp.Volume{Regional: regional}, &core.Volume{Type: ptr.To("pd-balanced")})).To(BeEmpty())
+			Expect(ValidateWorkerVolumeConfig(&gcp.Volume{Regional: regional}, volume)).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeForbidden),
+					"Field": Equal("providerConfig.volume.regional"),
+				})),
+			))
+		})
+
+		It("should reject provisioned performance out of range", func() {
+			Expect(ValidateWorkerVolumeConfig(&gcp.Volume{
+				ProvisionedIops:       ptr.To[int64](2999),
+				ProvisionedThroughput: ptr.To[int64](2401),
+			}, volume)).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("providerConfig.volume.provisionedIops"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("providerConfig.volume.provisionedThroughput"),
+				})),
+			))
+		})
+	})
+
+	Describe("#ValidateWorkerRegionalDisks", func() {
+		var fldPath = field.NewPath("workers").Index(0)
+
+		It("should allow regional disks whose replica zones contain the zones of the worker pool", func() {
+			var replicaZones []string
+			Expect(ValidateWorkerRegionalDisks(&gcp.WorkerConfig{
+				Volume:      &gcp.Volume{Regional: &gcp.RegionalDisk{ReplicaZones: []string{"zone-b", "zone-a"}}},
+				DataVolumes: []gcp.DataVolume{{Name: "data", Regional: &gcp.RegionalDisk{ReplicaZones: []string{"zone-a", "zone-b"}}}},
+			}, []string{"zone-a"}, &replicaZones, fldPath)).To(BeEmpty())
+			Expect(replicaZones).To(Equal([]string{"zone-a", "zone-b"}))
+
+			Expect(ValidateWorkerRegionalDisks(&gcp.WorkerConfig{}, []string{"zone-c"}, &replicaZones, fldPath)).To(BeEmpty())
+		})
+
+		It("should forbid zones of the worker pool which are not replica zones", func() {
+			var replicaZones []string
+			Expect(ValidateWorkerRegionalDisks(&gcp.WorkerConfig{
+				Volume: &gcp.Volume{Regional: &gcp.RegionalDisk{ReplicaZones: []string{"zone-a", "zone-b"}}},
+			}, []string{"zone-a", "zone-c"}, &replicaZones, fldPath)).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("workers[0].zones[1]"),
+				})),
+			))
+		})
+
+		It("should forbid regional disks with differing replica zones", func() {
+			replicaZones := []string{"zone-a", "zone-b"}
+			Expect(ValidateWorkerRegionalDisks(&gcp.WorkerConfig{
+				DataVolumes: []gcp.DataVolume{
+					{Name: "data1", Regional: &gcp.RegionalDisk{ReplicaZones: []string{"zone-b", "zone-a"}}},
+					{Name: "data2", Regional: &gcp.RegionalDisk{ReplicaZones: []string{"zone-a", "zone-c"}}},
+				},
+			}, []string{"zone-a"}, &replicaZones, fldPath)).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("workers[0].providerConfig.dataVolumes[1].regional.replicaZones"),
+				})),
+			))
+		})
+	})
+
+	Describe("#ValidateServiceAccountScopes", func() {
+		var (
+			fldPath = field.NewPath("serviceAccount")
+			sa      = &gcp.ServiceAccount{
+				Email:  "foo@bar.iam.gserviceaccount.com",
+				Scopes: []string{"https://www.googleapis.com/auth/compute", "https://www.googleapis.com/auth/cloud-platform"},
+			}
+		)
+
+		It("should forbid broad scopes", func() {
+			Expect(ValidateServiceAccountScopes(sa, nil, fldPath)).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeForbidden),
+					"Field": Equal("serviceAccount.scopes[1]"),
+				})),
+			))
+		})
+
+		It("should allow explicitly allowed broad scopes", func() {
+			Expect(ValidateServiceAccountScopes(sa, BroadServiceAccountScopes, fldPath)).To(BeEmpty())
+		})
+
+		It("should forbid broad scopes given as shorthand", func() {
+			Expect(ValidateServiceAccountScopes(&gcp.ServiceAccount{Scopes: []string{"compute", "cloud-platform"}}, nil, fldPath)).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeForbidden),
+					"Field": Equal("serviceAccount.scopes[1]"),
+				})),
+			))
+		})
+	})
+
 	Describe("#ValidateWorkersUpdate", func() {
 		It("should pass because workers are unchanged", func() {
 			newWorkers := copyWorkers(workers)
@@ -596,7 +1478,7 @@ func copyWorkers(workers []core.Worker) []core.Worker {
 func validateWorkerConfig(workers []core.Worker, workerConfig *gcp.WorkerConfig) field.ErrorList {
 	allErrs := field.ErrorList{}
 	for _, worker := range workers {
-		allErrs = append(allErrs, ValidateWorkerConfig(workerConfig, worker.DataVolumes)...)
+		allErrs = append(allErrs, ValidateWorkerConfig(workerConfig, worker.DataVolumes, worker.Machine.Type)...)
 	}
 
 	return allErrs
@@ -9,9 +9,12 @@ import (
 
 	healthcheckconfig "github.com/gardener/gardener/extensions/pkg/apis/config"
 	"github.com/spf13/pflag"
+	"k8s.io/utils/ptr"
 
 	"github.com/gardener/gardener-extension-provider-gcp/pkg/apis/config"
 	configloader "github.com/gardener/gardener-extension-provider-gcp/pkg/apis/config/loader"
+	configvalidation "github.com/gardener/gardener-extension-provider-gcp/pkg/apis/config/validation"
+	gcpclient "github.com/gardener/gardener-extension-provider-gcp/pkg/gcp/client"
 )
 
 // ConfigOptions are command line options that can be set for config.ControllerConfiguration.
@@ -41,6 +44,9 @@ func (c *ConfigOptions) Complete() error {
 	if err != nil {
 		return err
 	}
+	if errs := configvalidation.ValidateControllerConfiguration(config); len(errs) > 0 {
+		return fmt.Errorf("invalid controller configuration: %w", errs.ToAggregate())
+	}
 
 	c.config = &Config{config}
 	return nil
@@ -71,6 +77,108 @@ func (c *Config) ApplyETCDBackup(etcdBackup *config.ETCDBackup) {
 	*etcdBackup = c.Config.ETCD.Backup
 }
 
+// ApplyInfrastructure sets the given infrastructure controller configuration to that of this Config.
+func (c *Config) ApplyInfrastructure(infrastructure *config.Infrastructure) {
+	if c.Config.Infrastructure != nil {
+		*infrastructure = *c.Config.Infrastructure
+	}
+}
+
+// ApplyWorker sets the given worker controller configuration to that of this Config.
+func (c *Config) ApplyWorker(worker *config.Worker) {
+	if c.Config.Worker != nil {
+		*worker = *c.Config.Worker
+	}
+}
+
+// ApplyControlPlane sets the given controlplane controller configuration to that of this Config.
+func (c *Config) ApplyControlPlane(controlPlane *config.ControlPlane) {
+	if c.Config.ControlPlane != nil {
+		*controlPlane = *c.Config.ControlPlane
+	}
+}
+
+// ApplyBastion sets the given bastion controller configuration to that of this Config.
+func (c *Config) ApplyBastion(bastion *config.Bastion) {
+	if c.Config.Bastion != nil {
+		*bastion = *c.Config.Bastion
+	}
+}
+
+// ApplyNode sets the given node configuration to that of this Config.
+func (c *Config) ApplyNode(node *config.Node) {
+	if c.Config.Node != nil {
+		*node = *c.Config.Node
+	}
+}
+
+// ApplyStorageClasses sets the given storage class configuration to that of this Config.
+func (c *Config) ApplyStorageClasses(storageClasses *config.StorageClasses) {
+	if c.Config.StorageClasses != nil {
+		*storageClasses = *c.Config.StorageClasses
+	}
+}
+
+// ApplyAPIEndpoints sets the given GCP API endpoints to the ones of this Config.
+func (c *Config) ApplyAPIEndpoints(endpoints *gcpclient.Endpoints) {
+	if apiEndpoints := c.Config.APIEndpoints; apiEndpoints != nil {
+		*endpoints = gcpclient.Endpoints{
+			Compute:             ptr.Deref(apiEndpoints.Compute, ""),
+			IAM:                 ptr.Deref(apiEndpoints.IAM, ""),
+			DNS:                 ptr.Deref(apiEndpoints.DNS, ""),
+			Storage:             ptr.Deref(apiEndpoints.Storage, ""),
+			NetworkConnectivity: ptr.Deref(apiEndpoints.NetworkConnectivity, ""),
+			ResourceManager:     ptr.Deref(apiEndpoints.ResourceManager, ""),
+			ServiceNetworking:   ptr.Deref(apiEndpoints.ServiceNetworking, ""),
+		}
+	}
+}
+
+// ApplyComputeRetry sets the given retry options of the compute clients to the ones of this Config. Unset values keep
+// their defaults.
+func (c *Config) ApplyComputeRetry(opts *gcpclient.RetryOptions) {
+	if computeRetry := c.Config.ComputeRetry; computeRetry != nil {
+		if computeRetry.MaxAttempts != nil {
+			opts.MaxAttempts = *computeRetry.MaxAttempts
+		}
+		if computeRetry.InitialInterval != nil {
+			opts.InitialInterval = computeRetry.InitialInterval.Duration
+		}
+		if computeRetry.MaxInterval != nil {
+			opts.MaxInterval = computeRetry.MaxInterval.Duration
+		}
+	}
+}
+
+// ApplyComputeRateLimit sets the given rate limit options of the compute clients to the ones of this Config. Unset
+// values keep their defaults.
+func (c *Config) ApplyComputeRateLimit(opts *gcpclient.RateLimitOptions) {
+	if computeRateLimit := c.Config.ComputeRateLimit; computeRateLimit != nil {
+		if computeRateLimit.QPS != nil {
+			opts.QPS = float64(*computeRateLimit.QPS)
+		}
+		if computeRateLimit.Burst != nil {
+			opts.Burst = *computeRateLimit.Burst
+		}
+	}
+}
+
+// ApplyComputeOperationWait sets the given operation wait options of the compute clients to the ones of this Config.
+// Unset values keep their defaults.
+func (c *Config) ApplyComputeOperationWait(opts *gcpclient.OperationWaitOptions) {
+	if computeOperationWait := c.Config.ComputeOperationWait; computeOperationWait != nil {
+		if computeOperationWait.InitialInterval != nil {
+			opts.InitialInterval = computeOperationWait.InitialInterval.Duration
+		}
+		if computeOperationWait.MaxInterval != nil {
+			opts.MaxInterval = computeOperationWait.MaxInterval.Duration
+		}
+		if computeOperationWait.Timeout != nil {
+			opts.Timeout = computeOperationWait.Timeout.Duration
+		}
+	}
+}
+
 // Options initializes empty config.ControllerConfiguration, applies the set values and returns it.
 func (c *Config) Options() config.ControllerConfiguration {
 	var cfg config.ControllerConfiguration
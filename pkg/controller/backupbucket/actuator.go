@@ -7,6 +7,9 @@ package backupbucket
 import (
 	"context"
 	"errors"
+	"fmt"
+	"maps"
+	"net/http"
 	"reflect"
 
 	"cloud.google.com/go/storage"
@@ -15,15 +18,25 @@ import (
 	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
 	"github.com/go-logr/logr"
 	"k8s.io/apimachinery/pkg/runtime/serializer"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/utils/ptr"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/manager"
 
 	"github.com/gardener/gardener-extension-provider-gcp/pkg/admission"
 	apisgcp "github.com/gardener/gardener-extension-provider-gcp/pkg/apis/gcp"
 	"github.com/gardener/gardener-extension-provider-gcp/pkg/apis/gcp/helper"
+	"github.com/gardener/gardener-extension-provider-gcp/pkg/gcp"
 	gcpclient "github.com/gardener/gardener-extension-provider-gcp/pkg/gcp/client"
 )
 
+const (
+	// defaultStorageClass is the storage class of backup buckets if no storage class is configured.
+	defaultStorageClass = "STANDARD"
+
+	publicAccessPreventionEnforced = "enforced"
+)
+
 type actuator struct {
 	backupbucket.Actuator
 	client           client.Client
@@ -68,8 +81,8 @@ func (a *actuator) Reconcile(ctx context.Context, logger logr.Logger, bb *extens
 			return err
 		}
 
-	} else if isUpdateRequired(attrs, backupBucketConfig) {
-		attrs, err = updateBucket(ctx, storageClient, bb.Name, backupBucketConfig, logger)
+	} else if updateAttrs := getBucketAttrsToUpdate(bb, attrs, backupBucketConfig); updateAttrs != nil {
+		attrs, err = updateBucket(ctx, storageClient, bb.Name, *updateAttrs, logger)
 		if err != nil {
 			return err
 		}
@@ -108,33 +121,49 @@ func createBucket(ctx context.Context, storageClient gcpclient.StorageClient, bb
 		SoftDeletePolicy: &storage.SoftDeletePolicy{
 			RetentionDuration: 0,
 		},
+		StorageClass:           defaultStorageClass,
+		PublicAccessPrevention: storage.PublicAccessPreventionEnforced,
+		Labels:                 desiredLabels(bb, config),
 	}
 
-	if config != nil && config.Immutability != nil {
-		attrs.RetentionPolicy = &storage.RetentionPolicy{
-			RetentionPeriod: config.Immutability.RetentionPeriod.Duration,
+	if config != nil {
+		attrs.RetentionPolicy = desiredRetentionPolicy(config)
+		attrs.VersioningEnabled = ptr.Deref(config.Versioning, false)
+		if config.StorageClass != "" {
+			attrs.StorageClass = config.StorageClass
+		}
+		// The location of a bucket is immutable, hence it is only considered when the bucket is created.
+		if config.Location != "" {
+			attrs.Location = config.Location
+		}
+		if len(config.DualRegion) > 0 {
+			attrs.CustomPlacementConfig = &storage.CustomPlacementConfig{DataLocations: config.DualRegion}
+		}
+		attrs.Lifecycle = desiredLifecycle(config)
+		if config.Encryption != nil {
+			attrs.Encryption = &storage.BucketEncryption{DefaultKMSKeyName: config.Encryption.KmsKeyName}
+		}
+		attrs.UniformBucketLevelAccess.Enabled = ptr.Deref(config.UniformBucketLevelAccess, true)
+		attrs.PublicAccessPrevention = toPublicAccessPrevention(ptr.Deref(config.PublicAccessPrevention, publicAccessPreventionEnforced))
+		if ptr.Deref(config.TurboReplication, false) {
+			attrs.RPO = storage.RPOAsyncTurbo
 		}
 	}
 
 	if err := storageClient.CreateBucket(ctx, attrs); err != nil {
 		logger.Error(err, "Failed to create bucket", "name", bb.Name)
-		return nil, util.DetermineError(err, helper.KnownCodes)
+		return nil, util.DetermineError(wrapEncryptionError(err, attrs.Encryption), helper.KnownCodes)
 	}
 	logger.Info("Bucket created successfully", "name", bb.Name)
 	return attrs, nil
 }
 
-func updateBucket(ctx context.Context, storageClient gcpclient.StorageClient, bucketName string, config *apisgcp.BackupBucketConfig, logger logr.Logger) (*storage.BucketAttrs, error) {
+func updateBucket(ctx context.Context, storageClient gcpclient.StorageClient, bucketName string, updateAttrs storage.BucketAttrsToUpdate, logger logr.Logger) (*storage.BucketAttrs, error) {
 	logger.Info("Updating bucket attributes", "name", bucketName)
-	updateAttrs := storage.BucketAttrsToUpdate{
-		RetentionPolicy: &storage.RetentionPolicy{
-			RetentionPeriod: config.Immutability.RetentionPeriod.Duration,
-		},
-	}
 	attrs, err := storageClient.UpdateBucket(ctx, bucketName, updateAttrs)
 	if err != nil {
 		logger.Error(err, "Failed to update bucket", "name", bucketName)
-		return nil, util.DetermineError(err, helper.KnownCodes)
+		return nil, util.DetermineError(wrapEncryptionError(err, updateAttrs.Encryption), helper.KnownCodes)
 	}
 	logger.Info("Bucket updated successfully", "name", bucketName)
 	return attrs, nil
@@ -150,17 +179,137 @@ func lockBucket(ctx context.Context, storageClient gcpclient.StorageClient, buck
 	return nil
 }
 
-func isUpdateRequired(attrs *storage.BucketAttrs, config *apisgcp.BackupBucketConfig) bool {
+// getBucketAttrsToUpdate returns the attributes of the bucket which differ from the given configuration or nil if the
+// bucket is up-to-date.
+func getBucketAttrsToUpdate(bb *extensionsv1alpha1.BackupBucket, attrs *storage.BucketAttrs, config *apisgcp.BackupBucketConfig) *storage.BucketAttrsToUpdate {
 	if config == nil {
-		return false
+		return nil
+	}
+
+	var (
+		updateAttrs     storage.BucketAttrsToUpdate
+		updateRequired  bool
+		retentionPolicy = desiredRetentionPolicy(config)
+	)
+
+	if !reflect.DeepEqual(retentionPolicy, attrs.RetentionPolicy) {
+		updateAttrs.RetentionPolicy = retentionPolicy
+		if retentionPolicy == nil {
+			// a retention period of 0 removes the retention policy.
+			updateAttrs.RetentionPolicy = &storage.RetentionPolicy{}
+		}
+		updateRequired = true
+	}
+
+	if config.Versioning != nil && *config.Versioning != attrs.VersioningEnabled {
+		updateAttrs.VersioningEnabled = *config.Versioning
+		updateRequired = true
+	}
+
+	if lifecycle := desiredLifecycle(config); !reflect.DeepEqual(lifecycle.Rules, attrs.Lifecycle.Rules) {
+		updateAttrs.Lifecycle = &lifecycle
+		updateRequired = true
+	}
+
+	if config.Encryption != nil && (attrs.Encryption == nil || attrs.Encryption.DefaultKMSKeyName != config.Encryption.KmsKeyName) {
+		updateAttrs.Encryption = &storage.BucketEncryption{DefaultKMSKeyName: config.Encryption.KmsKeyName}
+		updateRequired = true
+	}
+
+	// The uniform bucket-level access can only be disabled within 90 days after enabling it, hence it is only updated if
+	// it differs to avoid needless failures.
+	if config.UniformBucketLevelAccess != nil && *config.UniformBucketLevelAccess != attrs.UniformBucketLevelAccess.Enabled {
+		updateAttrs.UniformBucketLevelAccess = &storage.UniformBucketLevelAccess{Enabled: *config.UniformBucketLevelAccess}
+		updateRequired = true
+	}
+
+	if config.PublicAccessPrevention != nil && *config.PublicAccessPrevention != attrs.PublicAccessPrevention.String() {
+		updateAttrs.PublicAccessPrevention = toPublicAccessPrevention(*config.PublicAccessPrevention)
+		updateRequired = true
 	}
 
-	var desiredRetentionPolicy *storage.RetentionPolicy
-	if config.Immutability != nil {
-		desiredRetentionPolicy = &storage.RetentionPolicy{
-			RetentionPeriod: config.Immutability.RetentionPeriod.Duration,
+	// Buckets which are not dual-region buckets do not report any RPO, hence only the turbo replication is compared.
+	if config.TurboReplication != nil && *config.TurboReplication != (attrs.RPO == storage.RPOAsyncTurbo) {
+		updateAttrs.RPO = storage.RPODefault
+		if *config.TurboReplication {
+			updateAttrs.RPO = storage.RPOAsyncTurbo
 		}
+		updateRequired = true
 	}
 
-	return !reflect.DeepEqual(desiredRetentionPolicy, attrs.RetentionPolicy)
+	// Only the configured labels are reconciled, labels which were removed from the configuration are kept as they
+	// cannot be distinguished from labels added by others.
+	if len(config.Labels) > 0 {
+		labels := desiredLabels(bb, config)
+		for _, key := range sets.List(sets.KeySet(labels)) {
+			if attrs.Labels[key] != labels[key] {
+				updateAttrs.SetLabel(key, labels[key])
+				updateRequired = true
+			}
+		}
+	}
+
+	if config.StorageClass != "" && config.StorageClass != attrs.StorageClass {
+		updateAttrs.StorageClass = config.StorageClass
+		updateRequired = true
+	}
+
+	if !updateRequired {
+		return nil
+	}
+	return &updateAttrs
+}
+
+func desiredRetentionPolicy(config *apisgcp.BackupBucketConfig) *storage.RetentionPolicy {
+	if config.Immutability == nil {
+		return nil
+	}
+	return &storage.RetentionPolicy{
+		RetentionPeriod: config.Immutability.RetentionPeriod.Duration,
+	}
+}
+
+// wrapEncryptionError adds a hint about the required permissions for the KMS key to errors which are caused by missing
+// permissions.
+func wrapEncryptionError(err error, encryption *storage.BucketEncryption) error {
+	if encryption == nil || !gcpclient.IsErrorCode(err, http.StatusForbidden) {
+		return err
+	}
+	return fmt.Errorf("the Cloud Storage service agent of the project must be granted the role roles/cloudkms.cryptoKeyEncrypterDecrypter for the KMS key %q: %w", encryption.DefaultKMSKeyName, err)
+}
+
+// desiredLabels returns the labels of the bucket, i.e. the configured labels merged with the labels managed by Gardener.
+func desiredLabels(bb *extensionsv1alpha1.BackupBucket, config *apisgcp.BackupBucketConfig) map[string]string {
+	labels := map[string]string{}
+	if config != nil {
+		maps.Copy(labels, config.Labels)
+	}
+	labels[gcp.BucketLabelKeyManagedBy] = gcp.BucketLabelValueManagedBy
+	labels[gcp.BucketLabelKeyBackupBucket] = gcp.SanitizeGcpLabelValue(bb.Name)
+	return labels
+}
+
+func toPublicAccessPrevention(publicAccessPrevention string) storage.PublicAccessPrevention {
+	if publicAccessPrevention == publicAccessPreventionEnforced {
+		return storage.PublicAccessPreventionEnforced
+	}
+	return storage.PublicAccessPreventionInherited
+}
+
+func desiredLifecycle(config *apisgcp.BackupBucketConfig) storage.Lifecycle {
+	var lifecycle storage.Lifecycle
+	for _, rule := range config.LifecycleRules {
+		lifecycle.Rules = append(lifecycle.Rules, storage.LifecycleRule{
+			Action: storage.LifecycleAction{
+				Type:         rule.Action,
+				StorageClass: rule.StorageClass,
+			},
+			Condition: storage.LifecycleCondition{
+				AgeInDays:             int64(ptr.Deref(rule.AgeDays, 0)),
+				MatchesStorageClasses: rule.MatchesStorageClass,
+				NumNewerVersions:      int64(ptr.Deref(rule.NumberOfNewerVersions, 0)),
+			},
+		})
+	}
+	return lifecycle
 }
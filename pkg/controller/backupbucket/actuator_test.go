@@ -7,6 +7,8 @@ package backupbucket_test
 import (
 	"context"
 	"fmt"
+	"net/http"
+	"reflect"
 	"time"
 
 	"cloud.google.com/go/storage"
@@ -18,6 +20,7 @@ import (
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 	"go.uber.org/mock/gomock"
+	"google.golang.org/api/googleapi"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
@@ -256,6 +259,448 @@ var _ = Describe("Actuator", func() {
 			})
 		})
 
+		Context("when versioning is configured", func() {
+			setVersioning := func(versioning bool) {
+				backupBucket.Spec.ProviderConfig = &runtime.RawExtension{
+					Raw: []byte(fmt.Sprintf(`{"apiVersion": "gcp.provider.extensions.gardener.cloud/v1alpha1","kind": "BackupBucketConfig","versioning":%t}`, versioning)),
+				}
+			}
+
+			BeforeEach(func() {
+				backupBucket = &extensionsv1alpha1.BackupBucket{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      bucketName,
+						Namespace: "garden",
+					},
+					Spec: extensionsv1alpha1.BackupBucketSpec{
+						SecretRef: secretRef,
+						Region:    region,
+					},
+				}
+			})
+
+			It("should create the bucket with versioning enabled", func() {
+				setVersioning(true)
+				gcpClientFactory.EXPECT().Storage(ctx, c, secretRef).Return(gcpStorageClient, nil)
+				gcpStorageClient.EXPECT().Attrs(ctx, bucketName).Return(nil, storage.ErrBucketNotExist)
+				gcpStorageClient.EXPECT().CreateBucket(ctx, gomock.Cond(func(attrs *storage.BucketAttrs) bool {
+					return attrs.VersioningEnabled
+				})).Return(nil)
+
+				Expect(a.Reconcile(ctx, logger, backupBucket)).To(Succeed())
+			})
+
+			It("should enable versioning on an existing bucket", func() {
+				setVersioning(true)
+				gcpClientFactory.EXPECT().Storage(ctx, c, secretRef).Return(gcpStorageClient, nil)
+				gcpStorageClient.EXPECT().Attrs(ctx, bucketName).Return(&storage.BucketAttrs{Location: region}, nil)
+				gcpStorageClient.EXPECT().UpdateBucket(ctx, bucketName, storage.BucketAttrsToUpdate{VersioningEnabled: true}).
+					Return(&storage.BucketAttrs{Location: region, VersioningEnabled: true}, nil)
+
+				Expect(a.Reconcile(ctx, logger, backupBucket)).To(Succeed())
+			})
+
+			It("should disable versioning on an existing bucket", func() {
+				setVersioning(false)
+				gcpClientFactory.EXPECT().Storage(ctx, c, secretRef).Return(gcpStorageClient, nil)
+				gcpStorageClient.EXPECT().Attrs(ctx, bucketName).Return(&storage.BucketAttrs{Location: region, VersioningEnabled: true}, nil)
+				gcpStorageClient.EXPECT().UpdateBucket(ctx, bucketName, storage.BucketAttrsToUpdate{VersioningEnabled: false}).
+					Return(&storage.BucketAttrs{Location: region}, nil)
+
+				Expect(a.Reconcile(ctx, logger, backupBucket)).To(Succeed())
+			})
+
+			It("should not update the bucket if versioning is already in the desired state", func() {
+				setVersioning(true)
+				gcpClientFactory.EXPECT().Storage(ctx, c, secretRef).Return(gcpStorageClient, nil)
+				gcpStorageClient.EXPECT().Attrs(ctx, bucketName).Return(&storage.BucketAttrs{Location: region, VersioningEnabled: true}, nil)
+
+				Expect(a.Reconcile(ctx, logger, backupBucket)).To(Succeed())
+			})
+		})
+
+		Context("when a storage class is configured", func() {
+			BeforeEach(func() {
+				backupBucket = &extensionsv1alpha1.BackupBucket{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      bucketName,
+						Namespace: "garden",
+					},
+					Spec: extensionsv1alpha1.BackupBucketSpec{
+						SecretRef: secretRef,
+						Region:    region,
+					},
+				}
+				backupBucket.Spec.ProviderConfig = &runtime.RawExtension{
+					Raw: []byte(`{"apiVersion": "gcp.provider.extensions.gardener.cloud/v1alpha1","kind": "BackupBucketConfig","storageClass":"NEARLINE"}`),
+				}
+			})
+
+			It("should create the bucket with the configured storage class", func() {
+				gcpClientFactory.EXPECT().Storage(ctx, c, secretRef).Return(gcpStorageClient, nil)
+				gcpStorageClient.EXPECT().Attrs(ctx, bucketName).Return(nil, storage.ErrBucketNotExist)
+				gcpStorageClient.EXPECT().CreateBucket(ctx, gomock.Cond(func(attrs *storage.BucketAttrs) bool {
+					return attrs.StorageClass == "NEARLINE"
+				})).Return(nil)
+
+				Expect(a.Reconcile(ctx, logger, backupBucket)).To(Succeed())
+			})
+
+			It("should create the bucket with the default storage class if none is configured", func() {
+				backupBucket.Spec.ProviderConfig = nil
+				gcpClientFactory.EXPECT().Storage(ctx, c, secretRef).Return(gcpStorageClient, nil)
+				gcpStorageClient.EXPECT().Attrs(ctx, bucketName).Return(nil, storage.ErrBucketNotExist)
+				gcpStorageClient.EXPECT().CreateBucket(ctx, gomock.Cond(func(attrs *storage.BucketAttrs) bool {
+					return attrs.StorageClass == "STANDARD"
+				})).Return(nil)
+
+				Expect(a.Reconcile(ctx, logger, backupBucket)).To(Succeed())
+			})
+
+			It("should update the storage class of an existing bucket", func() {
+				gcpClientFactory.EXPECT().Storage(ctx, c, secretRef).Return(gcpStorageClient, nil)
+				gcpStorageClient.EXPECT().Attrs(ctx, bucketName).Return(&storage.BucketAttrs{Location: region, StorageClass: "STANDARD"}, nil)
+				gcpStorageClient.EXPECT().UpdateBucket(ctx, bucketName, storage.BucketAttrsToUpdate{StorageClass: "NEARLINE"}).
+					Return(&storage.BucketAttrs{Location: region, StorageClass: "NEARLINE"}, nil)
+
+				Expect(a.Reconcile(ctx, logger, backupBucket)).To(Succeed())
+			})
+		})
+
+		Context("when a location is configured", func() {
+			BeforeEach(func() {
+				backupBucket = &extensionsv1alpha1.BackupBucket{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      bucketName,
+						Namespace: "garden",
+					},
+					Spec: extensionsv1alpha1.BackupBucketSpec{
+						SecretRef: secretRef,
+						Region:    region,
+					},
+				}
+				backupBucket.Spec.ProviderConfig = &runtime.RawExtension{
+					Raw: []byte(`{"apiVersion": "gcp.provider.extensions.gardener.cloud/v1alpha1","kind": "BackupBucketConfig","location":"EU","dualRegion":["europe-west1","europe-west4"]}`),
+				}
+			})
+
+			It("should create a dual-region bucket", func() {
+				gcpClientFactory.EXPECT().Storage(ctx, c, secretRef).Return(gcpStorageClient, nil)
+				gcpStorageClient.EXPECT().Attrs(ctx, bucketName).Return(nil, storage.ErrBucketNotExist)
+				gcpStorageClient.EXPECT().CreateBucket(ctx, gomock.Cond(func(attrs *storage.BucketAttrs) bool {
+					return attrs.Location == "EU" && attrs.CustomPlacementConfig != nil &&
+						reflect.DeepEqual(attrs.CustomPlacementConfig.DataLocations, []string{"europe-west1", "europe-west4"})
+				})).Return(nil)
+
+				Expect(a.Reconcile(ctx, logger, backupBucket)).To(Succeed())
+			})
+
+			It("should not update the location of an existing bucket", func() {
+				gcpClientFactory.EXPECT().Storage(ctx, c, secretRef).Return(gcpStorageClient, nil)
+				gcpStorageClient.EXPECT().Attrs(ctx, bucketName).Return(&storage.BucketAttrs{Location: "EUROPE-WEST1"}, nil)
+
+				Expect(a.Reconcile(ctx, logger, backupBucket)).To(Succeed())
+			})
+		})
+
+		Context("when lifecycle rules are configured", func() {
+			var lifecycle storage.Lifecycle
+
+			BeforeEach(func() {
+				backupBucket = &extensionsv1alpha1.BackupBucket{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      bucketName,
+						Namespace: "garden",
+					},
+					Spec: extensionsv1alpha1.BackupBucketSpec{
+						SecretRef: secretRef,
+						Region:    region,
+					},
+				}
+				backupBucket.Spec.ProviderConfig = &runtime.RawExtension{
+					Raw: []byte(`{"apiVersion": "gcp.provider.extensions.gardener.cloud/v1alpha1","kind": "BackupBucketConfig","lifecycleRules":[{"action":"Delete","ageDays":30},{"action":"SetStorageClass","storageClass":"COLDLINE","ageDays":7,"matchesStorageClass":["STANDARD"]}]}`),
+				}
+				lifecycle = storage.Lifecycle{Rules: []storage.LifecycleRule{
+					{
+						Action:    storage.LifecycleAction{Type: "Delete"},
+						Condition: storage.LifecycleCondition{AgeInDays: 30},
+					},
+					{
+						Action:    storage.LifecycleAction{Type: "SetStorageClass", StorageClass: "COLDLINE"},
+						Condition: storage.LifecycleCondition{AgeInDays: 7, MatchesStorageClasses: []string{"STANDARD"}},
+					},
+				}}
+			})
+
+			It("should create the bucket with the lifecycle rules", func() {
+				gcpClientFactory.EXPECT().Storage(ctx, c, secretRef).Return(gcpStorageClient, nil)
+				gcpStorageClient.EXPECT().Attrs(ctx, bucketName).Return(nil, storage.ErrBucketNotExist)
+				gcpStorageClient.EXPECT().CreateBucket(ctx, gomock.Cond(func(attrs *storage.BucketAttrs) bool {
+					return reflect.DeepEqual(attrs.Lifecycle, lifecycle)
+				})).Return(nil)
+
+				Expect(a.Reconcile(ctx, logger, backupBucket)).To(Succeed())
+			})
+
+			It("should update the lifecycle rules of an existing bucket", func() {
+				gcpClientFactory.EXPECT().Storage(ctx, c, secretRef).Return(gcpStorageClient, nil)
+				gcpStorageClient.EXPECT().Attrs(ctx, bucketName).Return(&storage.BucketAttrs{Location: region, Lifecycle: storage.Lifecycle{Rules: lifecycle.Rules[:1]}}, nil)
+				gcpStorageClient.EXPECT().UpdateBucket(ctx, bucketName, storage.BucketAttrsToUpdate{Lifecycle: &lifecycle}).
+					Return(&storage.BucketAttrs{Location: region, Lifecycle: lifecycle}, nil)
+
+				Expect(a.Reconcile(ctx, logger, backupBucket)).To(Succeed())
+			})
+
+			It("should not update the bucket if the lifecycle rules are up-to-date", func() {
+				gcpClientFactory.EXPECT().Storage(ctx, c, secretRef).Return(gcpStorageClient, nil)
+				gcpStorageClient.EXPECT().Attrs(ctx, bucketName).Return(&storage.BucketAttrs{Location: region, Lifecycle: lifecycle}, nil)
+
+				Expect(a.Reconcile(ctx, logger, backupBucket)).To(Succeed())
+			})
+
+			It("should remove lifecycle rules which are not configured", func() {
+				backupBucket.Spec.ProviderConfig.Raw = []byte(`{"apiVersion": "gcp.provider.extensions.gardener.cloud/v1alpha1","kind": "BackupBucketConfig"}`)
+				gcpClientFactory.EXPECT().Storage(ctx, c, secretRef).Return(gcpStorageClient, nil)
+				gcpStorageClient.EXPECT().Attrs(ctx, bucketName).Return(&storage.BucketAttrs{Location: region, Lifecycle: lifecycle}, nil)
+				gcpStorageClient.EXPECT().UpdateBucket(ctx, bucketName, storage.BucketAttrsToUpdate{Lifecycle: &storage.Lifecycle{}}).
+					Return(&storage.BucketAttrs{Location: region}, nil)
+
+				Expect(a.Reconcile(ctx, logger, backupBucket)).To(Succeed())
+			})
+		})
+
+		Context("when encryption is configured", func() {
+			const kmsKeyName = "projects/foo/locations/europe-west1/keyRings/bar/cryptoKeys/baz"
+
+			BeforeEach(func() {
+				backupBucket = &extensionsv1alpha1.BackupBucket{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      bucketName,
+						Namespace: "garden",
+					},
+					Spec: extensionsv1alpha1.BackupBucketSpec{
+						SecretRef: secretRef,
+						Region:    region,
+					},
+				}
+				backupBucket.Spec.ProviderConfig = &runtime.RawExtension{
+					Raw: []byte(`{"apiVersion": "gcp.provider.extensions.gardener.cloud/v1alpha1","kind": "BackupBucketConfig","encryption":{"kmsKeyName":"` + kmsKeyName + `"}}`),
+				}
+			})
+
+			It("should create the bucket with the default KMS key", func() {
+				gcpClientFactory.EXPECT().Storage(ctx, c, secretRef).Return(gcpStorageClient, nil)
+				gcpStorageClient.EXPECT().Attrs(ctx, bucketName).Return(nil, storage.ErrBucketNotExist)
+				gcpStorageClient.EXPECT().CreateBucket(ctx, gomock.Cond(func(attrs *storage.BucketAttrs) bool {
+					return attrs.Encryption != nil && attrs.Encryption.DefaultKMSKeyName == kmsKeyName
+				})).Return(nil)
+
+				Expect(a.Reconcile(ctx, logger, backupBucket)).To(Succeed())
+			})
+
+			It("should update the default KMS key of an existing bucket", func() {
+				gcpClientFactory.EXPECT().Storage(ctx, c, secretRef).Return(gcpStorageClient, nil)
+				gcpStorageClient.EXPECT().Attrs(ctx, bucketName).Return(&storage.BucketAttrs{Location: region}, nil)
+				gcpStorageClient.EXPECT().UpdateBucket(ctx, bucketName, storage.BucketAttrsToUpdate{Encryption: &storage.BucketEncryption{DefaultKMSKeyName: kmsKeyName}}).
+					Return(&storage.BucketAttrs{Location: region, Encryption: &storage.BucketEncryption{DefaultKMSKeyName: kmsKeyName}}, nil)
+
+				Expect(a.Reconcile(ctx, logger, backupBucket)).To(Succeed())
+			})
+
+			It("should point out the missing permissions for the KMS key", func() {
+				gcpClientFactory.EXPECT().Storage(ctx, c, secretRef).Return(gcpStorageClient, nil)
+				gcpStorageClient.EXPECT().Attrs(ctx, bucketName).Return(nil, storage.ErrBucketNotExist)
+				gcpStorageClient.EXPECT().CreateBucket(ctx, gomock.Any()).Return(&googleapi.Error{Code: http.StatusForbidden, Message: "Permission denied on Cloud KMS key."})
+
+				Expect(a.Reconcile(ctx, logger, backupBucket)).To(MatchError(ContainSubstring("must be granted the role roles/cloudkms.cryptoKeyEncrypterDecrypter")))
+			})
+		})
+
+		Context("when access settings are configured", func() {
+			setAccess := func(uniformBucketLevelAccess bool, publicAccessPrevention string) {
+				backupBucket.Spec.ProviderConfig = &runtime.RawExtension{
+					Raw: []byte(fmt.Sprintf(`{"apiVersion": "gcp.provider.extensions.gardener.cloud/v1alpha1","kind": "BackupBucketConfig","uniformBucketLevelAccess":%t,"publicAccessPrevention":%q}`, uniformBucketLevelAccess, publicAccessPrevention)),
+				}
+			}
+
+			BeforeEach(func() {
+				backupBucket = &extensionsv1alpha1.BackupBucket{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      bucketName,
+						Namespace: "garden",
+					},
+					Spec: extensionsv1alpha1.BackupBucketSpec{
+						SecretRef: secretRef,
+						Region:    region,
+					},
+				}
+			})
+
+			It("should create the bucket with uniform bucket-level access and enforced public access prevention by default", func() {
+				gcpClientFactory.EXPECT().Storage(ctx, c, secretRef).Return(gcpStorageClient, nil)
+				gcpStorageClient.EXPECT().Attrs(ctx, bucketName).Return(nil, storage.ErrBucketNotExist)
+				gcpStorageClient.EXPECT().CreateBucket(ctx, gomock.Cond(func(attrs *storage.BucketAttrs) bool {
+					return attrs.UniformBucketLevelAccess.Enabled && attrs.PublicAccessPrevention == storage.PublicAccessPreventionEnforced
+				})).Return(nil)
+
+				Expect(a.Reconcile(ctx, logger, backupBucket)).To(Succeed())
+			})
+
+			It("should create the bucket with the configured access settings", func() {
+				setAccess(false, "inherited")
+				gcpClientFactory.EXPECT().Storage(ctx, c, secretRef).Return(gcpStorageClient, nil)
+				gcpStorageClient.EXPECT().Attrs(ctx, bucketName).Return(nil, storage.ErrBucketNotExist)
+				gcpStorageClient.EXPECT().CreateBucket(ctx, gomock.Cond(func(attrs *storage.BucketAttrs) bool {
+					return !attrs.UniformBucketLevelAccess.Enabled && attrs.PublicAccessPrevention == storage.PublicAccessPreventionInherited
+				})).Return(nil)
+
+				Expect(a.Reconcile(ctx, logger, backupBucket)).To(Succeed())
+			})
+
+			It("should reconcile drifted access settings", func() {
+				setAccess(true, "enforced")
+				gcpClientFactory.EXPECT().Storage(ctx, c, secretRef).Return(gcpStorageClient, nil)
+				gcpStorageClient.EXPECT().Attrs(ctx, bucketName).Return(&storage.BucketAttrs{Location: region, PublicAccessPrevention: storage.PublicAccessPreventionInherited}, nil)
+				gcpStorageClient.EXPECT().UpdateBucket(ctx, bucketName, storage.BucketAttrsToUpdate{
+					UniformBucketLevelAccess: &storage.UniformBucketLevelAccess{Enabled: true},
+					PublicAccessPrevention:   storage.PublicAccessPreventionEnforced,
+				}).Return(&storage.BucketAttrs{Location: region}, nil)
+
+				Expect(a.Reconcile(ctx, logger, backupBucket)).To(Succeed())
+			})
+
+			It("should not update the bucket if the access settings are already in the desired state", func() {
+				setAccess(true, "enforced")
+				gcpClientFactory.EXPECT().Storage(ctx, c, secretRef).Return(gcpStorageClient, nil)
+				gcpStorageClient.EXPECT().Attrs(ctx, bucketName).Return(&storage.BucketAttrs{
+					Location:                 region,
+					UniformBucketLevelAccess: storage.UniformBucketLevelAccess{Enabled: true},
+					PublicAccessPrevention:   storage.PublicAccessPreventionEnforced,
+				}, nil)
+
+				Expect(a.Reconcile(ctx, logger, backupBucket)).To(Succeed())
+			})
+		})
+
+		Context("when turbo replication is configured", func() {
+			setTurboReplication := func(turboReplication bool) {
+				backupBucket.Spec.ProviderConfig = &runtime.RawExtension{
+					Raw: []byte(fmt.Sprintf(`{"apiVersion": "gcp.provider.extensions.gardener.cloud/v1alpha1","kind": "BackupBucketConfig","location":"EUR4","turboReplication":%t}`, turboReplication)),
+				}
+			}
+
+			BeforeEach(func() {
+				backupBucket = &extensionsv1alpha1.BackupBucket{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      bucketName,
+						Namespace: "garden",
+					},
+					Spec: extensionsv1alpha1.BackupBucketSpec{
+						SecretRef: secretRef,
+						Region:    region,
+					},
+				}
+			})
+
+			It("should create the bucket with turbo replication", func() {
+				setTurboReplication(true)
+				gcpClientFactory.EXPECT().Storage(ctx, c, secretRef).Return(gcpStorageClient, nil)
+				gcpStorageClient.EXPECT().Attrs(ctx, bucketName).Return(nil, storage.ErrBucketNotExist)
+				gcpStorageClient.EXPECT().CreateBucket(ctx, gomock.Cond(func(attrs *storage.BucketAttrs) bool {
+					return attrs.RPO == storage.RPOAsyncTurbo
+				})).Return(nil)
+
+				Expect(a.Reconcile(ctx, logger, backupBucket)).To(Succeed())
+			})
+
+			It("should enable turbo replication on an existing bucket", func() {
+				setTurboReplication(true)
+				gcpClientFactory.EXPECT().Storage(ctx, c, secretRef).Return(gcpStorageClient, nil)
+				gcpStorageClient.EXPECT().Attrs(ctx, bucketName).Return(&storage.BucketAttrs{Location: "EUR4", RPO: storage.RPODefault}, nil)
+				gcpStorageClient.EXPECT().UpdateBucket(ctx, bucketName, storage.BucketAttrsToUpdate{RPO: storage.RPOAsyncTurbo}).
+					Return(&storage.BucketAttrs{Location: "EUR4", RPO: storage.RPOAsyncTurbo}, nil)
+
+				Expect(a.Reconcile(ctx, logger, backupBucket)).To(Succeed())
+			})
+
+			It("should disable turbo replication on an existing bucket", func() {
+				setTurboReplication(false)
+				gcpClientFactory.EXPECT().Storage(ctx, c, secretRef).Return(gcpStorageClient, nil)
+				gcpStorageClient.EXPECT().Attrs(ctx, bucketName).Return(&storage.BucketAttrs{Location: "EUR4", RPO: storage.RPOAsyncTurbo}, nil)
+				gcpStorageClient.EXPECT().UpdateBucket(ctx, bucketName, storage.BucketAttrsToUpdate{RPO: storage.RPODefault}).
+					Return(&storage.BucketAttrs{Location: "EUR4", RPO: storage.RPODefault}, nil)
+
+				Expect(a.Reconcile(ctx, logger, backupBucket)).To(Succeed())
+			})
+
+			It("should not update the bucket if turbo replication is already in the desired state", func() {
+				setTurboReplication(true)
+				gcpClientFactory.EXPECT().Storage(ctx, c, secretRef).Return(gcpStorageClient, nil)
+				gcpStorageClient.EXPECT().Attrs(ctx, bucketName).Return(&storage.BucketAttrs{Location: "EUR4", RPO: storage.RPOAsyncTurbo}, nil)
+
+				Expect(a.Reconcile(ctx, logger, backupBucket)).To(Succeed())
+			})
+		})
+
+		Context("when labels are configured", func() {
+			BeforeEach(func() {
+				backupBucket = &extensionsv1alpha1.BackupBucket{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      bucketName,
+						Namespace: "garden",
+					},
+					Spec: extensionsv1alpha1.BackupBucketSpec{
+						SecretRef: secretRef,
+						Region:    region,
+					},
+				}
+				backupBucket.Spec.ProviderConfig = &runtime.RawExtension{
+					Raw: []byte(`{"apiVersion": "gcp.provider.extensions.gardener.cloud/v1alpha1","kind": "BackupBucketConfig","labels":{"cost-center":"1234"}}`),
+				}
+			})
+
+			It("should create the bucket with the configured and the Gardener labels", func() {
+				gcpClientFactory.EXPECT().Storage(ctx, c, secretRef).Return(gcpStorageClient, nil)
+				gcpStorageClient.EXPECT().Attrs(ctx, bucketName).Return(nil, storage.ErrBucketNotExist)
+				gcpStorageClient.EXPECT().CreateBucket(ctx, gomock.Cond(func(attrs *storage.BucketAttrs) bool {
+					return reflect.DeepEqual(attrs.Labels, map[string]string{
+						"cost-center":            "1234",
+						"managed-by":             "gardener",
+						"gardener-backup-bucket": bucketName,
+					})
+				})).Return(nil)
+
+				Expect(a.Reconcile(ctx, logger, backupBucket)).To(Succeed())
+			})
+
+			It("should reconcile drifted labels", func() {
+				gcpClientFactory.EXPECT().Storage(ctx, c, secretRef).Return(gcpStorageClient, nil)
+				gcpStorageClient.EXPECT().Attrs(ctx, bucketName).Return(&storage.BucketAttrs{Location: region, Labels: map[string]string{
+					"cost-center": "5678",
+					"managed-by":  "gardener",
+					"other":       "label",
+				}}, nil)
+
+				var updateAttrs storage.BucketAttrsToUpdate
+				updateAttrs.SetLabel("cost-center", "1234")
+				updateAttrs.SetLabel("gardener-backup-bucket", bucketName)
+				gcpStorageClient.EXPECT().UpdateBucket(ctx, bucketName, updateAttrs).Return(&storage.BucketAttrs{Location: region}, nil)
+
+				Expect(a.Reconcile(ctx, logger, backupBucket)).To(Succeed())
+			})
+
+			It("should not update the bucket if the labels are up-to-date", func() {
+				gcpClientFactory.EXPECT().Storage(ctx, c, secretRef).Return(gcpStorageClient, nil)
+				gcpStorageClient.EXPECT().Attrs(ctx, bucketName).Return(&storage.BucketAttrs{Location: region, Labels: map[string]string{
+					"cost-center":            "1234",
+					"managed-by":             "gardener",
+					"gardener-backup-bucket": bucketName,
+				}}, nil)
+
+				Expect(a.Reconcile(ctx, logger, backupBucket)).To(Succeed())
+			})
+		})
+
 		Context("when providerConfig cannot be decoded", func() {
 			BeforeEach(func() {
 				backupBucket = &extensionsv1alpha1.BackupBucket{
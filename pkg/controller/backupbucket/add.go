@@ -29,13 +29,15 @@ type AddOptions struct {
 	IgnoreOperationAnnotation bool
 	// ExtensionClass defines the extension class this extension is responsible for.
 	ExtensionClass extensionsv1alpha1.ExtensionClass
+	// GCPClientFactory is the factory for the GCP clients of the controller.
+	GCPClientFactory gcpclient.Factory
 }
 
 // AddToManagerWithOptions adds a controller with the given Options to the given manager.
 // The opts.Reconciler is being set with a newly instantiated actuator.
 func AddToManagerWithOptions(ctx context.Context, mgr manager.Manager, opts AddOptions) error {
 	return backupbucket.Add(ctx, mgr, backupbucket.AddArgs{
-		Actuator:          NewActuator(mgr, gcpclient.New()),
+		Actuator:          NewActuator(mgr, opts.GCPClientFactory),
 		ControllerOptions: opts.Controller,
 		Predicates:        backupbucket.DefaultPredicates(opts.IgnoreOperationAnnotation),
 		Type:              gcp.Type,
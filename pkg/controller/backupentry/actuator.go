@@ -22,12 +22,14 @@ import (
 )
 
 type actuator struct {
-	client client.Client
+	client           client.Client
+	gcpClientFactory gcpclient.Factory
 }
 
-func newActuator(mgr manager.Manager) genericactuator.BackupEntryDelegate {
+func newActuator(mgr manager.Manager, gcpClientFactory gcpclient.Factory) genericactuator.BackupEntryDelegate {
 	return &actuator{
-		client: mgr.GetClient(),
+		client:           mgr.GetClient(),
+		gcpClientFactory: gcpClientFactory,
 	}
 }
 
@@ -36,7 +38,7 @@ func (a *actuator) GetETCDSecretData(_ context.Context, _ logr.Logger, _ *extens
 }
 
 func (a *actuator) Delete(ctx context.Context, _ logr.Logger, be *extensionsv1alpha1.BackupEntry) error {
-	storageClient, err := gcpclient.NewStorageClientFromSecretRef(ctx, a.client, be.Spec.SecretRef)
+	storageClient, err := a.gcpClientFactory.Storage(ctx, a.client, be.Spec.SecretRef)
 	if err != nil {
 		return util.DetermineError(err, helper.KnownCodes)
 	}
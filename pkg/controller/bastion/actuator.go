@@ -18,9 +18,11 @@ import (
 	"github.com/go-logr/logr"
 	computev1 "google.golang.org/api/compute/v1"
 	corev1 "k8s.io/api/core/v1"
+	"k8s.io/utils/clock"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/manager"
 
+	"github.com/gardener/gardener-extension-provider-gcp/pkg/apis/config"
 	gcpapi "github.com/gardener/gardener-extension-provider-gcp/pkg/apis/gcp"
 	"github.com/gardener/gardener-extension-provider-gcp/pkg/gcp"
 	gcpclient "github.com/gardener/gardener-extension-provider-gcp/pkg/gcp/client"
@@ -29,15 +31,24 @@ import (
 const (
 	// SSHPort is the default SSH Port used for bastion ingress firewall rule
 	SSHPort = 22
+	// IAPSourceRange is the range from which the Identity-Aware Proxy forwards TCP connections to bastion instances in
+	// IAP mode, see https://cloud.google.com/iap/docs/using-tcp-forwarding.
+	IAPSourceRange = "35.235.240.0/20"
 )
 
 type actuator struct {
-	client client.Client
+	client           client.Client
+	config           config.Bastion
+	clock            clock.Clock
+	gcpClientFactory gcpclient.Factory
 }
 
-func newActuator(mgr manager.Manager) bastion.Actuator {
+func newActuator(mgr manager.Manager, config config.Bastion, gcpClientFactory gcpclient.Factory) bastion.Actuator {
 	return &actuator{
-		client: mgr.GetClient(),
+		client:           mgr.GetClient(),
+		config:           config,
+		clock:            clock.RealClock{},
+		gcpClientFactory: gcpClientFactory,
 	}
 }
 
@@ -33,17 +33,17 @@ func (a *actuator) Delete(ctx context.Context, log logr.Logger, bastion *extensi
 		Name:      v1beta1constants.SecretNameCloudProvider,
 	}
 
-	gcpClient, err := gcpclient.New().Compute(ctx, a.client, secretReference)
+	gcpClient, err := a.gcpClientFactory.Compute(ctx, a.client, secretReference)
 	if err != nil {
 		return util.DetermineError(fmt.Errorf("failed to create GCP client: %w", err), helper.KnownCodes)
 	}
 
-	infrastructureStatus, subnet, err := getInfrastructureStatus(ctx, a.client, cluster)
+	infrastructureStatus, subnet, err := getInfrastructureStatus(ctx, a.client, cluster, a.config)
 	if err != nil {
 		return err
 	}
 
-	opt, err := DetermineOptions(bastion, cluster, serviceAccount.ProjectID, infrastructureStatus.Networks.VPC.Name, subnet)
+	opt, err := DetermineOptions(bastion, cluster, serviceAccount.ProjectID, infrastructureStatus.Networks.VPC.Name, subnet, a.config)
 	if err != nil {
 		return fmt.Errorf("failed to determine Options: %w", err)
 	}
@@ -83,7 +83,7 @@ func (a *actuator) Delete(ctx context.Context, log logr.Logger, bastion *extensi
 }
 
 func removeFirewallRules(ctx context.Context, client gcpclient.ComputeClient, opt *Options) error {
-	firewallList := []string{FirewallIngressAllowSSHResourceName(opt.BastionInstanceName), FirewallEgressDenyAllResourceName(opt.BastionInstanceName), FirewallEgressAllowOnlyResourceName(opt.BastionInstanceName)}
+	firewallList := []string{FirewallIngressAllowSSHResourceName(opt.BastionInstanceName), FirewallIngressAllowSSHIPv6ResourceName(opt.BastionInstanceName), FirewallEgressDenyAllResourceName(opt.BastionInstanceName), FirewallEgressAllowOnlyResourceName(opt.BastionInstanceName)}
 	for _, firewall := range firewallList {
 		if err := client.DeleteFirewallRule(ctx, firewall); err != nil {
 			return err
@@ -9,6 +9,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"reflect"
+	"slices"
 	"time"
 
 	"github.com/gardener/gardener/extensions/pkg/controller"
@@ -23,6 +24,7 @@ import (
 	"k8s.io/utils/ptr"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
+	"github.com/gardener/gardener-extension-provider-gcp/pkg/apis/config"
 	"github.com/gardener/gardener-extension-provider-gcp/pkg/apis/gcp/helper"
 	gcpclient "github.com/gardener/gardener-extension-provider-gcp/pkg/gcp/client"
 )
@@ -53,17 +55,17 @@ func (a *actuator) Reconcile(ctx context.Context, log logr.Logger, bastion *exte
 		Name:      v1beta1constants.SecretNameCloudProvider,
 	}
 
-	gcpClient, err := gcpclient.New().Compute(ctx, a.client, secretReference)
+	gcpClient, err := a.gcpClientFactory.Compute(ctx, a.client, secretReference)
 	if err != nil {
 		return util.DetermineError(fmt.Errorf("failed to create GCP client: %w", err), helper.KnownCodes)
 	}
 
-	infrastructureStatus, subnet, err := getInfrastructureStatus(ctx, a.client, cluster)
+	infrastructureStatus, subnet, err := getInfrastructureStatus(ctx, a.client, cluster, a.config)
 	if err != nil {
 		return err
 	}
 
-	opt, err := DetermineOptions(bastion, cluster, serviceAccount.ProjectID, infrastructureStatus.Networks.VPC.Name, subnet)
+	opt, err := DetermineOptions(bastion, cluster, serviceAccount.ProjectID, infrastructureStatus.Networks.VPC.Name, subnet, a.config)
 	if err != nil {
 		return fmt.Errorf("failed to determine Options: %w", err)
 	}
@@ -75,7 +77,11 @@ func (a *actuator) Reconcile(ctx context.Context, log logr.Logger, bastion *exte
 		}
 	}
 
-	bytes, err := json.Marshal(&providerStatusRaw{Zone: opt.Zone})
+	bytes, err := json.Marshal(&providerStatusRaw{
+		Zone:                opt.Zone,
+		IAPTunnelCommand:    iapTunnelCommand(opt),
+		ExpirationTimestamp: expirationTimestamp(bastion, a.config.MaxLifetime),
+	})
 	if err != nil {
 		return err
 	}
@@ -86,7 +92,20 @@ func (a *actuator) Reconcile(ctx context.Context, log logr.Logger, bastion *exte
 		return fmt.Errorf("failed to store status.providerStatus for zone: %s", opt.Zone)
 	}
 
-	err = ensureFirewallRules(ctx, log, gcpClient, bastion, opt)
+	// The SSH ingress of an expired bastion must not be opened again, e.g. when the bastion is updated.
+	if isExpired(bastion, a.config.MaxLifetime, a.clock.Now()) {
+		if err := removeIngressFirewallRules(ctx, gcpClient, opt.BastionInstanceName); err != nil {
+			return util.DetermineError(fmt.Errorf("failed to remove ingress firewall rules: %w", err), helper.KnownCodes)
+		}
+		return fmt.Errorf("bastion exceeded its maximum lifetime of %s, its SSH ingress was revoked", a.config.MaxLifetime.Duration)
+	}
+
+	opt.IngressCIDRs, opt.IngressCIDRsIPv6, err = ingressCIDRs(bastion, opt)
+	if err != nil {
+		return err
+	}
+
+	err = ensureFirewallRules(ctx, log, gcpClient, opt)
 	if err != nil {
 		return util.DetermineError(fmt.Errorf("failed to ensure firewall rule: %w", err), helper.KnownCodes)
 	}
@@ -97,7 +116,7 @@ func (a *actuator) Reconcile(ctx context.Context, log logr.Logger, bastion *exte
 	}
 
 	// check if the instance already exists and has an IP
-	endpoints, err := getInstanceEndpoints(instance)
+	endpoints, err := getInstanceEndpoints(instance, opt)
 	if err != nil {
 		return err
 	}
@@ -118,30 +137,47 @@ func (a *actuator) Reconcile(ctx context.Context, log logr.Logger, bastion *exte
 	return a.client.Status().Patch(ctx, bastion, patch)
 }
 
-func ensureFirewallRules(ctx context.Context, log logr.Logger, client gcpclient.ComputeClient, bastion *extensionsv1alpha1.Bastion, opt *Options) error {
-	cidrs, err := ingressPermissions(bastion)
-	if err != nil {
-		return err
+func ensureFirewallRules(ctx context.Context, log logr.Logger, client gcpclient.ComputeClient, opt *Options) error {
+	// A firewall rule must not mix IPv4 and IPv6 source ranges, hence IPv6 clients are allowed by a separate rule.
+	var ingressFirewallList []*compute.Firewall
+	if len(opt.IngressCIDRs) > 0 || len(opt.IngressCIDRsIPv6) == 0 {
+		ingressFirewallList = append(ingressFirewallList, IngressAllowSSH(opt, opt.IngressCIDRs))
+	}
+	if len(opt.IngressCIDRsIPv6) > 0 {
+		ingressFirewallList = append(ingressFirewallList, IngressAllowSSHIPv6(opt, opt.IngressCIDRsIPv6))
 	}
 
-	firewallList := []*compute.Firewall{IngressAllowSSH(opt, cidrs), EgressDenyAll(opt), EgressAllowOnly(opt)}
-
-	for _, item := range firewallList {
+	for _, item := range append(ingressFirewallList, EgressDenyAll(opt), EgressAllowOnly(opt)) {
 		if err := createFirewallRuleIfNotExist(ctx, log, client, item); err != nil {
 			return err
 		}
 	}
 
-	firewall, err := client.GetFirewallRule(ctx, IngressAllowSSH(opt, cidrs).Name)
-	if err != nil || firewall == nil {
-		return fmt.Errorf("could not get firewall rule: %w", err)
+	// The ingress rule of an address family is deleted if it is not needed anymore, e.g. if the IPv6 CIDRs were removed
+	// or the bastion was switched to the IAP mode.
+	for _, name := range []string{FirewallIngressAllowSSHResourceName(opt.BastionInstanceName), FirewallIngressAllowSSHIPv6ResourceName(opt.BastionInstanceName)} {
+		if slices.ContainsFunc(ingressFirewallList, func(item *compute.Firewall) bool { return item.Name == name }) {
+			continue
+		}
+		if err := client.DeleteFirewallRule(ctx, name); err != nil {
+			return fmt.Errorf("could not delete firewall rule %s: %w", name, err)
+		}
 	}
 
-	currentCIDRs := firewall.SourceRanges
-	wantedCIDRs := cidrs
+	for _, item := range ingressFirewallList {
+		firewall, err := client.GetFirewallRule(ctx, item.Name)
+		if err != nil || firewall == nil {
+			return fmt.Errorf("could not get firewall rule: %w", err)
+		}
+
+		currentCIDRs := firewall.SourceRanges
+		wantedCIDRs := item.SourceRanges
 
-	if !reflect.DeepEqual(currentCIDRs, wantedCIDRs) {
-		return patchFirewallRule(ctx, client, IngressAllowSSH(opt, cidrs).Name, cidrs)
+		if !reflect.DeepEqual(currentCIDRs, wantedCIDRs) {
+			if err := patchFirewallRule(ctx, client, item.Name, wantedCIDRs); err != nil {
+				return err
+			}
+		}
 	}
 
 	return nil
@@ -168,7 +204,7 @@ func ensureComputeInstance(ctx context.Context, logger logr.Logger, bastion *ext
 	return nil, fmt.Errorf("failed to get (create) bastion compute instance: %w", err)
 }
 
-func getInstanceEndpoints(instance *compute.Instance) (*bastionEndpoints, error) {
+func getInstanceEndpoints(instance *compute.Instance, opt *Options) (*bastionEndpoints, error) {
 	if instance == nil {
 		return nil, fmt.Errorf("compute instance can't be nil")
 	}
@@ -187,14 +223,30 @@ func getInstanceEndpoints(instance *compute.Instance) (*bastionEndpoints, error)
 
 	internalIP := &networkInterfaces[0].NetworkIP
 
+	if ingress := addressToIngress(&instance.Name, internalIP); ingress != nil {
+		endpoints.private = ingress
+	}
+
+	// In IAP mode, the instance has no external IP and users connect to its internal IP through IAP TCP forwarding,
+	// hence the internal IP is published as the endpoint of the bastion.
+	if opt.Mode == config.BastionModeIAP {
+		endpoints.public = addressToIngress(nil, internalIP)
+		return endpoints, nil
+	}
+
 	if len(networkInterfaces[0].AccessConfigs) == 0 {
 		return nil, fmt.Errorf("no access config found for network interface: %s", instance.Name)
 	}
 
 	externalIP := &networkInterfaces[0].AccessConfigs[0].NatIP
 
-	if ingress := addressToIngress(&instance.Name, internalIP); ingress != nil {
-		endpoints.private = ingress
+	// If the clients only connect via IPv6, the external IPv6 address is published as the endpoint of the bastion.
+	if len(opt.IngressCIDRsIPv6) > 0 && len(opt.IngressCIDRs) == 0 {
+		if len(networkInterfaces[0].Ipv6AccessConfigs) == 0 {
+			return nil, fmt.Errorf("no IPv6 access config found for network interface: %s", instance.Name)
+		}
+		endpoints.public = addressToIngress(nil, &networkInterfaces[0].Ipv6AccessConfigs[0].ExternalIpv6)
+		return endpoints, nil
 	}
 
 	// GCP does not automatically assign a public dns name to the instance (in contrast to e.g. AWS).
@@ -258,18 +310,31 @@ func metadataItemsDefine(userData []byte) []*compute.MetadataItems {
 	}
 }
 
+func diskTypeDefine(opt *Options) string {
+	if opt.DiskType == "" {
+		return ""
+	}
+	return fmt.Sprintf("zones/%s/diskTypes/%s", opt.Zone, opt.DiskType)
+}
+
 func machineTypeDefine(opt *Options) string {
 	return fmt.Sprintf("zones/%s/machineTypes/%s", opt.Zone, opt.MachineName)
 }
 
 func networkInterfacesDefine(opt *Options) []*compute.NetworkInterface {
-	return []*compute.NetworkInterface{
-		{
-			Network:       opt.Network,
-			Subnetwork:    opt.Subnetwork,
-			AccessConfigs: []*compute.AccessConfig{{Name: "External NAT", Type: "ONE_TO_ONE_NAT"}},
-		},
+	networkInterface := &compute.NetworkInterface{
+		Network:    opt.Network,
+		Subnetwork: opt.Subnetwork,
+	}
+	if opt.Mode != config.BastionModeIAP {
+		networkInterface.AccessConfigs = []*compute.AccessConfig{{Name: "External NAT", Type: "ONE_TO_ONE_NAT"}}
+	}
+	// IPv6 clients connect to an external IPv6 address, which requires a subnet with an external IPv6 range.
+	if len(opt.IngressCIDRsIPv6) > 0 {
+		networkInterface.StackType = "IPV4_IPV6"
+		networkInterface.Ipv6AccessConfigs = []*compute.AccessConfig{{Name: "External IPv6", Type: "DIRECT_IPV6"}}
 	}
+	return []*compute.NetworkInterface{networkInterface}
 }
 
 func disksDefine(opt *Options) []*compute.AttachedDisk {
@@ -277,10 +342,11 @@ func disksDefine(opt *Options) []*compute.AttachedDisk {
 		{
 			AutoDelete: true,
 			Boot:       true,
-			DiskSizeGb: 10,
+			DiskSizeGb: opt.DiskSizeGB,
 			Mode:       "READ_WRITE",
 			InitializeParams: &compute.AttachedDiskInitializeParams{
 				DiskName:    opt.DiskName,
+				DiskType:    diskTypeDefine(opt),
 				Description: "Gardenctl Bastion disk",
 				SourceImage: opt.ImagePath,
 			},
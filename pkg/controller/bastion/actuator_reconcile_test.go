@@ -0,0 +1,80 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package bastion
+
+import (
+	"context"
+
+	"github.com/go-logr/logr"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.uber.org/mock/gomock"
+	"google.golang.org/api/compute/v1"
+
+	mockgcpclient "github.com/gardener/gardener-extension-provider-gcp/pkg/gcp/client/mock"
+)
+
+var _ = Describe("Reconcile", func() {
+	var (
+		ctx = context.TODO()
+
+		ctrl             *gomock.Controller
+		gcpComputeClient *mockgcpclient.MockComputeClient
+		opt              *Options
+	)
+
+	BeforeEach(func() {
+		ctrl = gomock.NewController(GinkgoT())
+		gcpComputeClient = mockgcpclient.NewMockComputeClient(ctrl)
+
+		opt = &Options{
+			BastionInstanceName: "cluster1-bastionName1-bastion-1cdc8",
+			Network:             "projects/project/global/networks/cluster1",
+			WorkersCIDR:         "10.250.0.0/16",
+		}
+	})
+
+	AfterEach(func() {
+		ctrl.Finish()
+	})
+
+	Describe("#ensureFirewallRules", func() {
+		expectFirewallRules := func(ingressRules ...*compute.Firewall) {
+			for _, rule := range append(ingressRules, EgressDenyAll(opt), EgressAllowOnly(opt)) {
+				gcpComputeClient.EXPECT().InsertFirewallRule(ctx, rule).Return(rule, nil)
+			}
+			for _, rule := range ingressRules {
+				gcpComputeClient.EXPECT().GetFirewallRule(ctx, rule.Name).Return(rule, nil)
+			}
+		}
+
+		It("should create the ingress rules of both address families for dual-stack ingress", func() {
+			opt.IngressCIDRs = []string{"1.2.3.4/32"}
+			opt.IngressCIDRsIPv6 = []string{"2001:db8::/64"}
+
+			expectFirewallRules(IngressAllowSSH(opt, opt.IngressCIDRs), IngressAllowSSHIPv6(opt, opt.IngressCIDRsIPv6))
+
+			Expect(ensureFirewallRules(ctx, logr.Discard(), gcpComputeClient, opt)).To(Succeed())
+		})
+
+		It("should delete the IPv6 ingress rule if the ingress was changed from dual-stack to IPv4 only", func() {
+			opt.IngressCIDRs = []string{"1.2.3.4/32"}
+
+			expectFirewallRules(IngressAllowSSH(opt, opt.IngressCIDRs))
+			gcpComputeClient.EXPECT().DeleteFirewallRule(ctx, "cluster1-bastionName1-bastion-1cdc8-allow-ssh-ipv6")
+
+			Expect(ensureFirewallRules(ctx, logr.Discard(), gcpComputeClient, opt)).To(Succeed())
+		})
+
+		It("should delete the IPv4 ingress rule if the ingress was changed from dual-stack to IPv6 only", func() {
+			opt.IngressCIDRsIPv6 = []string{"2001:db8::/64"}
+
+			expectFirewallRules(IngressAllowSSHIPv6(opt, opt.IngressCIDRsIPv6))
+			gcpComputeClient.EXPECT().DeleteFirewallRule(ctx, "cluster1-bastionName1-bastion-1cdc8-allow-ssh")
+
+			Expect(ensureFirewallRules(ctx, logr.Discard(), gcpComputeClient, opt)).To(Succeed())
+		})
+	})
+})
@@ -9,10 +9,12 @@ import (
 
 	"github.com/gardener/gardener/extensions/pkg/controller/bastion"
 	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	"k8s.io/utils/clock"
 	"sigs.k8s.io/controller-runtime/pkg/controller"
 	"sigs.k8s.io/controller-runtime/pkg/log"
 	"sigs.k8s.io/controller-runtime/pkg/manager"
 
+	"github.com/gardener/gardener-extension-provider-gcp/pkg/apis/config"
 	"github.com/gardener/gardener-extension-provider-gcp/pkg/gcp"
 	gcpclient "github.com/gardener/gardener-extension-provider-gcp/pkg/gcp/client"
 )
@@ -30,14 +32,24 @@ type AddOptions struct {
 	IgnoreOperationAnnotation bool
 	// ExtensionClass defines the extension class this extension is responsible for.
 	ExtensionClass extensionsv1alpha1.ExtensionClass
+	// Bastion is the configuration for the bastion controller.
+	Bastion config.Bastion
+	// GCPClientFactory is the factory for the GCP clients of the controller.
+	GCPClientFactory gcpclient.Factory
 }
 
 // AddToManagerWithOptions adds a controller with the given Options to the given manager.
 // The opts.Reconciler is being set with a newly instantiated actuator.
 func AddToManagerWithOptions(mgr manager.Manager, opts AddOptions) error {
+	if opts.Bastion.MaxLifetime != nil {
+		if err := mgr.Add(newLifetimeEnforcer(mgr.GetClient(), log.Log, opts.GCPClientFactory, clock.RealClock{}, opts.Bastion.MaxLifetime)); err != nil {
+			return err
+		}
+	}
+
 	return bastion.Add(mgr, bastion.AddArgs{
-		Actuator:          newActuator(mgr),
-		ConfigValidator:   NewConfigValidator(mgr, log.Log, gcpclient.New()),
+		Actuator:          newActuator(mgr, opts.Bastion, opts.GCPClientFactory),
+		ConfigValidator:   NewConfigValidator(mgr, log.Log, opts.GCPClientFactory, opts.Bastion),
 		ControllerOptions: opts.Controller,
 		Predicates:        bastion.DefaultPredicates(opts.IgnoreOperationAnnotation),
 		Type:              gcp.Type,
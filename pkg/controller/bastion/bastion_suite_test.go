@@ -17,6 +17,7 @@ import (
 	. "github.com/gardener/gardener/pkg/utils/test/matchers"
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/gstruct"
 	"go.uber.org/mock/gomock"
 	"google.golang.org/api/compute/v1"
 	networkingv1 "k8s.io/api/networking/v1"
@@ -25,6 +26,7 @@ import (
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/utils/ptr"
 
+	"github.com/gardener/gardener-extension-provider-gcp/pkg/apis/config"
 	gcpapi "github.com/gardener/gardener-extension-provider-gcp/pkg/apis/gcp"
 )
 
@@ -60,7 +62,7 @@ var _ = Describe("Bastion", func() {
 
 	Describe("Determine options", func() {
 		It("should return options", func() {
-			options, err := DetermineOptions(bastion, cluster, "projectID", "vNet", "subnet")
+			options, err := DetermineOptions(bastion, cluster, "projectID", "vNet", "subnet", config.Bastion{})
 			Expect(err).To(Not(HaveOccurred()))
 
 			Expect(options.BastionInstanceName).To(Equal("cluster1-bastionName1-bastion-1cdc8"))
@@ -70,6 +72,151 @@ var _ = Describe("Bastion", func() {
 			Expect(options.ProjectID).To(Equal("projectID"))
 			Expect(options.Network).To(Equal("projects/projectID/global/networks/vNet"))
 			Expect(options.WorkersCIDR).To(Equal("10.250.0.0/16"))
+			Expect(options.MachineName).To(Equal("machineName"))
+			Expect(options.ImagePath).To(Equal("/path/to/images"))
+			Expect(options.DiskSizeGB).To(Equal(int64(10)))
+			Expect(options.DiskType).To(BeEmpty())
+			Expect(options.Mode).To(Equal(config.BastionModePublic))
+		})
+
+		It("should return the instance shape of the controller configuration", func() {
+			cluster.CloudProfile.Spec.MachineImages = nil
+			cluster.CloudProfile.Spec.MachineTypes = nil
+
+			options, err := DetermineOptions(bastion, cluster, "projectID", "vNet", "subnet", config.Bastion{
+				MachineType: ptr.To("e2-micro"),
+				Image:       ptr.To("projects/debian-cloud/global/images/family/debian-12"),
+				DiskSizeGB:  ptr.To[int64](20),
+				DiskType:    ptr.To("pd-balanced"),
+			})
+			Expect(err).NotTo(HaveOccurred())
+
+			instance := computeInstanceDefine(options, nil)
+			Expect(instance.MachineType).To(Equal("zones/us-west1-a/machineTypes/e2-micro"))
+			Expect(instance.Disks).To(ConsistOf(gstruct.PointTo(gstruct.MatchFields(gstruct.IgnoreExtras, gstruct.Fields{
+				"DiskSizeGb": Equal(int64(20)),
+				"InitializeParams": gstruct.PointTo(gstruct.MatchFields(gstruct.IgnoreExtras, gstruct.Fields{
+					"DiskType":    Equal("zones/us-west1-a/diskTypes/pd-balanced"),
+					"SourceImage": Equal("projects/debian-cloud/global/images/family/debian-12"),
+				})),
+			}))))
+		})
+
+		It("should take the image from the cloud profile if only the machine type is configured", func() {
+			options, err := DetermineOptions(bastion, cluster, "projectID", "vNet", "subnet", config.Bastion{MachineType: ptr.To("e2-micro")})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(options.MachineName).To(Equal("e2-micro"))
+			Expect(options.ImagePath).To(Equal("/path/to/images"))
+
+			instance := computeInstanceDefine(options, nil)
+			Expect(instance.Disks[0].DiskSizeGb).To(Equal(int64(10)))
+			Expect(instance.Disks[0].InitializeParams.DiskType).To(BeEmpty())
+		})
+	})
+
+	Describe("connection modes", func() {
+		var instance *compute.Instance
+
+		BeforeEach(func() {
+			bastion.Spec.Ingress = []extensionsv1alpha1.BastionIngressPolicy{
+				{IPBlock: networkingv1.IPBlock{CIDR: "213.69.151.253/24"}},
+			}
+			instance = &compute.Instance{
+				Name:   "bastion",
+				Status: "RUNNING",
+				NetworkInterfaces: []*compute.NetworkInterface{{
+					NetworkIP:     "10.250.0.5",
+					AccessConfigs: []*compute.AccessConfig{{NatIP: "34.1.2.3"}},
+				}},
+			}
+		})
+
+		It("should expose the bastion via an external IP in public mode", func() {
+			options, err := DetermineOptions(bastion, cluster, "projectID", "vNet", "subnet", config.Bastion{Mode: ptr.To(config.BastionModePublic)})
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(computeInstanceDefine(options, nil).NetworkInterfaces[0].AccessConfigs).To(HaveLen(1))
+
+			options.IngressCIDRs, options.IngressCIDRsIPv6, err = ingressCIDRs(bastion, options)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(options.IngressCIDRs).To(ConsistOf("213.69.151.0/24"))
+			Expect(options.IngressCIDRsIPv6).To(BeEmpty())
+
+			endpoints, err := getInstanceEndpoints(instance, options)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(endpoints.Ready()).To(BeTrue())
+			Expect(endpoints.public.IP).To(Equal("34.1.2.3"))
+			Expect(endpoints.private.IP).To(Equal("10.250.0.5"))
+
+			Expect(iapTunnelCommand(options)).To(BeEmpty())
+		})
+
+		It("should expose the bastion only through IAP in IAP mode", func() {
+			options, err := DetermineOptions(bastion, cluster, "projectID", "vNet", "subnet", config.Bastion{Mode: ptr.To(config.BastionModeIAP)})
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(computeInstanceDefine(options, nil).NetworkInterfaces[0].AccessConfigs).To(BeEmpty())
+
+			bastion.Spec.Ingress = append(bastion.Spec.Ingress, extensionsv1alpha1.BastionIngressPolicy{IPBlock: networkingv1.IPBlock{CIDR: "2001:db8::1/64"}})
+			options.IngressCIDRs, options.IngressCIDRsIPv6, err = ingressCIDRs(bastion, options)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(options.IngressCIDRs).To(ConsistOf("35.235.240.0/20"))
+			Expect(options.IngressCIDRsIPv6).To(BeEmpty())
+			Expect(IngressAllowSSH(options, options.IngressCIDRs).Allowed).To(ConsistOf(&compute.FirewallAllowed{IPProtocol: "tcp", Ports: []string{"22"}}))
+
+			instance.NetworkInterfaces[0].AccessConfigs = nil
+			endpoints, err := getInstanceEndpoints(instance, options)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(endpoints.Ready()).To(BeTrue())
+			Expect(endpoints.public.IP).To(Equal("10.250.0.5"))
+			Expect(endpoints.public.Hostname).To(BeEmpty())
+
+			Expect(iapTunnelCommand(options)).To(Equal("gcloud compute start-iap-tunnel cluster1-bastionName1-bastion-1cdc8 22 --local-host-port=localhost:2222 --zone=us-west1-a --project=projectID"))
+		})
+
+		It("should expose the bastion via an external IPv6 address to IPv6 clients", func() {
+			bastion.Spec.Ingress = []extensionsv1alpha1.BastionIngressPolicy{
+				{IPBlock: networkingv1.IPBlock{CIDR: "2001:db8:0:1::42/64"}},
+			}
+			options, err := DetermineOptions(bastion, cluster, "projectID", "vNet", "subnet", config.Bastion{})
+			Expect(err).NotTo(HaveOccurred())
+
+			options.IngressCIDRs, options.IngressCIDRsIPv6, err = ingressCIDRs(bastion, options)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(options.IngressCIDRs).To(BeEmpty())
+			Expect(options.IngressCIDRsIPv6).To(ConsistOf("2001:db8:0:1::/64"))
+
+			firewall := IngressAllowSSHIPv6(options, options.IngressCIDRsIPv6)
+			Expect(firewall.Name).To(Equal("cluster1-bastionName1-bastion-1cdc8-allow-ssh-ipv6"))
+			Expect(firewall.SourceRanges).To(ConsistOf("2001:db8:0:1::/64"))
+			Expect(firewall.Allowed).To(ConsistOf(&compute.FirewallAllowed{IPProtocol: "tcp", Ports: []string{"22"}}))
+
+			networkInterface := computeInstanceDefine(options, nil).NetworkInterfaces[0]
+			Expect(networkInterface.StackType).To(Equal("IPV4_IPV6"))
+			Expect(networkInterface.Ipv6AccessConfigs).To(ConsistOf(&compute.AccessConfig{Name: "External IPv6", Type: "DIRECT_IPV6"}))
+
+			instance.NetworkInterfaces[0].Ipv6AccessConfigs = []*compute.AccessConfig{{ExternalIpv6: "2600:1900:4000::1"}}
+			endpoints, err := getInstanceEndpoints(instance, options)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(endpoints.Ready()).To(BeTrue())
+			Expect(endpoints.public.IP).To(Equal("2600:1900:4000::1"))
+			Expect(endpoints.private.IP).To(Equal("10.250.0.5"))
+		})
+
+		It("should keep exposing the external IPv4 address to dual-stack clients", func() {
+			bastion.Spec.Ingress = append(bastion.Spec.Ingress, extensionsv1alpha1.BastionIngressPolicy{IPBlock: networkingv1.IPBlock{CIDR: "2001:db8::/128"}})
+			options, err := DetermineOptions(bastion, cluster, "projectID", "vNet", "subnet", config.Bastion{})
+			Expect(err).NotTo(HaveOccurred())
+
+			options.IngressCIDRs, options.IngressCIDRsIPv6, err = ingressCIDRs(bastion, options)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(options.IngressCIDRs).To(ConsistOf("213.69.151.0/24"))
+			Expect(options.IngressCIDRsIPv6).To(ConsistOf("2001:db8::/128"))
+
+			instance.NetworkInterfaces[0].Ipv6AccessConfigs = []*compute.AccessConfig{{ExternalIpv6: "2600:1900:4000::1"}}
+			endpoints, err := getInstanceEndpoints(instance, options)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(endpoints.public.IP).To(Equal("34.1.2.3"))
 		})
 	})
 
@@ -106,6 +253,7 @@ var _ = Describe("Bastion", func() {
 			},
 			Entry("disk resource name", DiskResourceName(baseName), "clusterName-LetsExceed63LenLimit0-bastion-139c4-disk"),
 			Entry("firewall ingress ssh resource name", FirewallIngressAllowSSHResourceName(baseName), "clusterName-LetsExceed63LenLimit0-bastion-139c4-allow-ssh"),
+			Entry("firewall ingress ssh ipv6 resource name", FirewallIngressAllowSSHIPv6ResourceName(baseName), "clusterName-LetsExceed63LenLimit0-bastion-139c4-allow-ssh-ipv6"),
 			Entry("firewall egress allow resource name", FirewallEgressAllowOnlyResourceName(baseName), "clusterName-LetsExceed63LenLimit0-bastion-139c4-egress-worker"),
 			Entry("firewall egress deny resource name", FirewallEgressDenyAllResourceName(baseName), "clusterName-LetsExceed63LenLimit0-bastion-139c4-deny-all"),
 		)
@@ -114,13 +262,13 @@ var _ = Describe("Bastion", func() {
 	Describe("check getZone", func() {
 		var testProviderStatusRaw providerStatusRaw
 		It("should return an empty string", func() {
-			testProviderStatusRaw = providerStatusRaw{""}
+			testProviderStatusRaw = providerStatusRaw{Zone: ""}
 			res := getZone(cluster, "us-west", &testProviderStatusRaw)
 			Expect(res).To(BeEmpty())
 		})
 
 		It("should return a zone string", func() {
-			testProviderStatusRaw = providerStatusRaw{"us-west1-a"}
+			testProviderStatusRaw = providerStatusRaw{Zone: "us-west1-a"}
 			res := getZone(cluster, "us-west", &testProviderStatusRaw)
 			Expect(res).To(Equal("us-west1-a"))
 		})
@@ -170,9 +318,24 @@ var _ = Describe("Bastion", func() {
 					CIDR: "213.69.151.253/24",
 				}},
 			}
-			res, err := ingressPermissions(bastion)
+			res, resIPv6, err := ingressPermissions(bastion)
 			Expect(err).To(Not(HaveOccurred()))
 			Expect(res[0]).To(Equal("213.69.151.0/24"))
+			Expect(resIPv6).To(BeEmpty())
+		})
+		It("Should return a separate string array with ipV6 normalized addresses", func() {
+			bastion.Spec.Ingress = []extensionsv1alpha1.BastionIngressPolicy{
+				{IPBlock: networkingv1.IPBlock{
+					CIDR: "213.69.151.253/24",
+				}},
+				{IPBlock: networkingv1.IPBlock{
+					CIDR: "2001:db8:0:1:2:3:4:5/64",
+				}},
+			}
+			res, resIPv6, err := ingressPermissions(bastion)
+			Expect(err).To(Not(HaveOccurred()))
+			Expect(res).To(ConsistOf("213.69.151.0/24"))
+			Expect(resIPv6).To(ConsistOf("2001:db8:0:1::/64"))
 
 		})
 		It("Should throw an error with invalid CIDR entry", func() {
@@ -181,9 +344,10 @@ var _ = Describe("Bastion", func() {
 					CIDR: "1234",
 				}},
 			}
-			res, err := ingressPermissions(bastion)
+			res, resIPv6, err := ingressPermissions(bastion)
 			Expect(err).To(HaveOccurred())
 			Expect(res).To(BeEmpty())
+			Expect(resIPv6).To(BeEmpty())
 		})
 	})
 
@@ -8,6 +8,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"path"
 
 	"github.com/gardener/gardener/extensions/pkg/controller/bastion"
 	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
@@ -16,9 +17,11 @@ import (
 	"github.com/go-logr/logr"
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/util/validation/field"
+	"k8s.io/utils/ptr"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/manager"
 
+	"github.com/gardener/gardener-extension-provider-gcp/pkg/apis/config"
 	"github.com/gardener/gardener-extension-provider-gcp/pkg/apis/gcp"
 	"github.com/gardener/gardener-extension-provider-gcp/pkg/apis/gcp/helper"
 	gcpclient "github.com/gardener/gardener-extension-provider-gcp/pkg/gcp/client"
@@ -28,14 +31,16 @@ type configValidator struct {
 	client           client.Client
 	gcpClientFactory gcpclient.Factory
 	logger           logr.Logger
+	config           config.Bastion
 }
 
 // NewConfigValidator creates a new ConfigValidator.
-func NewConfigValidator(mgr manager.Manager, logger logr.Logger, gcpClientFactory gcpclient.Factory) bastion.ConfigValidator {
+func NewConfigValidator(mgr manager.Manager, logger logr.Logger, gcpClientFactory gcpclient.Factory, config config.Bastion) bastion.ConfigValidator {
 	return &configValidator{
 		client:           mgr.GetClient(),
 		gcpClientFactory: gcpClientFactory,
 		logger:           logger.WithName("gcp-bastion-config-validator"),
+		config:           config,
 	}
 }
 
@@ -45,7 +50,7 @@ func (c *configValidator) Validate(ctx context.Context, bastion *extensionsv1alp
 
 	logger := c.logger.WithValues("bastion", client.ObjectKeyFromObject(bastion))
 
-	infrastructureStatus, subnet, err := getInfrastructureStatus(ctx, c.client, cluster)
+	infrastructureStatus, subnet, err := getInfrastructureStatus(ctx, c.client, cluster, c.config)
 	if err != nil {
 		allErrs = append(allErrs, field.InternalError(nil, err))
 		return allErrs
@@ -65,12 +70,14 @@ func (c *configValidator) Validate(ctx context.Context, bastion *extensionsv1alp
 
 	// Validate bastion config
 	logger.Info("Validating bastion configuration")
-	allErrs = append(allErrs, c.validateInfrastructureStatus(ctx, computeClient, cluster.Shoot.Spec.Region, infrastructureStatus, subnet)...)
+	allErrs = append(allErrs, c.validateInfrastructureStatus(ctx, computeClient, cluster.Shoot.Spec.Region, infrastructureStatus, subnet, c.requiresExternalIPv6(bastion))...)
 
 	return allErrs
 }
 
-func getInfrastructureStatus(ctx context.Context, c client.Client, cluster *extensions.Cluster) (*gcp.InfrastructureStatus, string, error) {
+// getInfrastructureStatus returns the infrastructure status of the shoot and the name of the subnet of the bastion
+// instances, i.e. the subnet of the given controller configuration or the nodes subnet of the shoot.
+func getInfrastructureStatus(ctx context.Context, c client.Client, cluster *extensions.Cluster, bastionConfig config.Bastion) (*gcp.InfrastructureStatus, string, error) {
 	var infrastructureStatus *gcp.InfrastructureStatus
 	var nodeSubnet string
 
@@ -108,10 +115,20 @@ func getInfrastructureStatus(ctx context.Context, c client.Client, cluster *exte
 		return nil, "", errors.New("could not get subnet purpose node from infrastructure status")
 	}
 
-	return infrastructureStatus, nodeSubnet, nil
+	return infrastructureStatus, ptr.Deref(bastionConfig.Subnet, nodeSubnet), nil
 }
 
-func (c *configValidator) validateInfrastructureStatus(ctx context.Context, computeClient gcpclient.ComputeClient, region string, infrastructureStatus *gcp.InfrastructureStatus, nodeSubnet string) field.ErrorList {
+// requiresExternalIPv6 returns true if the given bastion allows IPv6 clients which connect to an external IPv6 address
+// of the bastion instance. Invalid ingress CIDRs are reported by the reconciliation.
+func (c *configValidator) requiresExternalIPv6(bastion *extensionsv1alpha1.Bastion) bool {
+	if ptr.Deref(c.config.Mode, config.BastionModePublic) == config.BastionModeIAP {
+		return false
+	}
+	_, cidrsIPv6, err := ingressPermissions(bastion)
+	return err == nil && len(cidrsIPv6) > 0
+}
+
+func (c *configValidator) validateInfrastructureStatus(ctx context.Context, computeClient gcpclient.ComputeClient, region string, infrastructureStatus *gcp.InfrastructureStatus, bastionSubnet string, requiresExternalIPv6 bool) field.ErrorList {
 	allErrs := field.ErrorList{}
 
 	vpc, err := computeClient.GetNetwork(ctx, infrastructureStatus.Networks.VPC.Name)
@@ -124,16 +141,25 @@ func (c *configValidator) validateInfrastructureStatus(ctx context.Context, comp
 		return allErrs
 	}
 
-	subnet, err := computeClient.GetSubnet(ctx, region, nodeSubnet)
+	subnet, err := computeClient.GetSubnet(ctx, region, bastionSubnet)
 	if err != nil {
-		allErrs = append(allErrs, field.InternalError(field.NewPath("subnet"), fmt.Errorf("could not get subnet %s from gcp provider: %w", nodeSubnet, err)))
+		allErrs = append(allErrs, field.InternalError(field.NewPath("subnet"), fmt.Errorf("could not get subnet %s from gcp provider: %w", bastionSubnet, err)))
 		return allErrs
 	}
 
 	if subnet == nil {
-		allErrs = append(allErrs, field.InternalError(field.NewPath("subnet"), fmt.Errorf("could not get subnet %s from gcp provider: Not Found", nodeSubnet)))
+		allErrs = append(allErrs, field.InternalError(field.NewPath("subnet"), fmt.Errorf("could not get subnet %s from gcp provider: Not Found", bastionSubnet)))
 		return allErrs
 	}
 
+	// The nodes subnet is managed by the infrastructure controller, but a configured subnet may belong to any VPC.
+	if c.config.Subnet != nil && path.Base(subnet.Network) != vpc.Name {
+		allErrs = append(allErrs, field.Invalid(field.NewPath("subnet"), bastionSubnet, fmt.Sprintf("subnet is not part of vpc %s", vpc.Name)))
+	}
+
+	if requiresExternalIPv6 && subnet.Ipv6AccessType != "EXTERNAL" {
+		allErrs = append(allErrs, field.Invalid(field.NewPath("subnet"), bastionSubnet, "subnet must have an external IPv6 range to allow IPv6 ingress"))
+	}
+
 	return allErrs
 }
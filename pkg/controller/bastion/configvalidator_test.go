@@ -19,12 +19,15 @@ import (
 	"go.uber.org/mock/gomock"
 	"google.golang.org/api/compute/v1"
 	corev1 "k8s.io/api/core/v1"
+	networkingv1 "k8s.io/api/networking/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/util/validation/field"
+	"k8s.io/utils/ptr"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/log"
 
+	"github.com/gardener/gardener-extension-provider-gcp/pkg/apis/config"
 	apisgcp "github.com/gardener/gardener-extension-provider-gcp/pkg/apis/gcp"
 	mockgcpclient "github.com/gardener/gardener-extension-provider-gcp/pkg/gcp/client/mock"
 )
@@ -63,7 +66,7 @@ var _ = Describe("ConfigValidator", func() {
 
 		mgr = mockmanager.NewMockManager(ctrl)
 		mgr.EXPECT().GetClient().Return(c)
-		cv = NewConfigValidator(mgr, logger, gcpClientFactory)
+		cv = NewConfigValidator(mgr, logger, gcpClientFactory, config.Bastion{})
 
 		bastion = &extensionsv1alpha1.Bastion{}
 		cluster = &extensions.Cluster{}
@@ -146,6 +149,75 @@ var _ = Describe("ConfigValidator", func() {
 					"Detail": Equal("could not get subnet bastion from gcp provider: Not Found"),
 				}))
 		})
+
+		Context("with a configured subnet", func() {
+			BeforeEach(func() {
+				mgr.EXPECT().GetClient().Return(c)
+				cv = NewConfigValidator(mgr, logger, gcpClientFactory, config.Bastion{Subnet: ptr.To("management")})
+				gcpComputeClient.EXPECT().GetNetwork(ctx, name).Return(&compute.Network{Name: name}, nil)
+			})
+
+			It("should succeed if the subnet is part of the vpc", func() {
+				gcpComputeClient.EXPECT().GetSubnet(ctx, region, "management").Return(&compute.Subnetwork{
+					Name:    "management",
+					Network: "https://www.googleapis.com/compute/v1/projects/project/global/networks/" + name,
+				}, nil)
+				errorList := cv.Validate(ctx, bastion, cluster)
+				Expect(errorList).To(BeEmpty())
+			})
+
+			It("should fail with InternalError if the subnet does not exist", func() {
+				gcpComputeClient.EXPECT().GetSubnet(ctx, region, "management").Return(nil, nil)
+				errorList := cv.Validate(ctx, bastion, cluster)
+				Expect(errorList).To(ConsistOfFields(
+					gstruct.Fields{
+						"Type":   Equal(field.ErrorTypeInternal),
+						"Field":  Equal("subnet"),
+						"Detail": Equal("could not get subnet management from gcp provider: Not Found"),
+					}))
+			})
+
+			It("should succeed for IPv6 ingress if the subnet has an external IPv6 range", func() {
+				bastion.Spec.Ingress = []extensionsv1alpha1.BastionIngressPolicy{{IPBlock: networkingv1.IPBlock{CIDR: "2001:db8::/64"}}}
+				gcpComputeClient.EXPECT().GetSubnet(ctx, region, "management").Return(&compute.Subnetwork{
+					Name:           "management",
+					Network:        "https://www.googleapis.com/compute/v1/projects/project/global/networks/" + name,
+					StackType:      "IPV4_IPV6",
+					Ipv6AccessType: "EXTERNAL",
+				}, nil)
+				errorList := cv.Validate(ctx, bastion, cluster)
+				Expect(errorList).To(BeEmpty())
+			})
+
+			It("should fail for IPv6 ingress if the subnet has no external IPv6 range", func() {
+				bastion.Spec.Ingress = []extensionsv1alpha1.BastionIngressPolicy{{IPBlock: networkingv1.IPBlock{CIDR: "2001:db8::/64"}}}
+				gcpComputeClient.EXPECT().GetSubnet(ctx, region, "management").Return(&compute.Subnetwork{
+					Name:    "management",
+					Network: "https://www.googleapis.com/compute/v1/projects/project/global/networks/" + name,
+				}, nil)
+				errorList := cv.Validate(ctx, bastion, cluster)
+				Expect(errorList).To(ConsistOfFields(
+					gstruct.Fields{
+						"Type":   Equal(field.ErrorTypeInvalid),
+						"Field":  Equal("subnet"),
+						"Detail": Equal("subnet must have an external IPv6 range to allow IPv6 ingress"),
+					}))
+			})
+
+			It("should fail if the subnet is part of another vpc", func() {
+				gcpComputeClient.EXPECT().GetSubnet(ctx, region, "management").Return(&compute.Subnetwork{
+					Name:    "management",
+					Network: "https://www.googleapis.com/compute/v1/projects/project/global/networks/other",
+				}, nil)
+				errorList := cv.Validate(ctx, bastion, cluster)
+				Expect(errorList).To(ConsistOfFields(
+					gstruct.Fields{
+						"Type":   Equal(field.ErrorTypeInvalid),
+						"Field":  Equal("subnet"),
+						"Detail": Equal("subnet is not part of vpc bastion"),
+					}))
+			})
+		})
 	})
 })
 
@@ -24,6 +24,14 @@ func IngressAllowSSH(opt *Options, cidr []string) *compute.Firewall {
 	}
 }
 
+// IngressAllowSSHIPv6 ingress rule to allow ssh access from IPv6 clients
+func IngressAllowSSHIPv6(opt *Options, cidr []string) *compute.Firewall {
+	firewall := IngressAllowSSH(opt, cidr)
+	firewall.Description = "SSH access for Bastion via IPv6"
+	firewall.Name = FirewallIngressAllowSSHIPv6ResourceName(opt.BastionInstanceName)
+	return firewall
+}
+
 // EgressDenyAll egress rule to deny all
 func EgressDenyAll(opt *Options) *compute.Firewall {
 	return &compute.Firewall{
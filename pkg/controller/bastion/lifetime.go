@@ -0,0 +1,131 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package bastion
+
+import (
+	"context"
+	"time"
+
+	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/utils/clock"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/gardener/gardener-extension-provider-gcp/pkg/gcp"
+	gcpclient "github.com/gardener/gardener-extension-provider-gcp/pkg/gcp/client"
+)
+
+// lifetimeCheckInterval is the interval in which the lifetime of the bastions is checked.
+const lifetimeCheckInterval = time.Minute
+
+// expirationTimestamp returns the time at which the given bastion exceeds the given maximum lifetime. It returns nil if
+// the lifetime of bastions is not limited.
+func expirationTimestamp(bastion *extensionsv1alpha1.Bastion, maxLifetime *metav1.Duration) *metav1.Time {
+	if maxLifetime == nil || maxLifetime.Duration <= 0 {
+		return nil
+	}
+	return &metav1.Time{Time: bastion.CreationTimestamp.Add(maxLifetime.Duration)}
+}
+
+// isExpired returns true if the given bastion exceeded the given maximum lifetime at the given time.
+func isExpired(bastion *extensionsv1alpha1.Bastion, maxLifetime *metav1.Duration, now time.Time) bool {
+	expiration := expirationTimestamp(bastion, maxLifetime)
+	return expiration != nil && !now.Before(expiration.Time)
+}
+
+// removeIngressFirewallRules removes the firewall rules which allow SSH connections to the bastion instance with the
+// given name. The egress firewall rules are kept, so that the instance stays isolated.
+func removeIngressFirewallRules(ctx context.Context, client gcpclient.ComputeClient, bastionInstanceName string) error {
+	for _, firewall := range []string{FirewallIngressAllowSSHResourceName(bastionInstanceName), FirewallIngressAllowSSHIPv6ResourceName(bastionInstanceName)} {
+		if err := client.DeleteFirewallRule(ctx, firewall); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// lifetimeEnforcer periodically revokes the SSH ingress of bastions which exceeded the maximum lifetime. Bastions are
+// only reconciled when they change, hence leaked bastions would never be noticed by the actuator alone.
+type lifetimeEnforcer struct {
+	client           client.Client
+	gcpClientFactory gcpclient.Factory
+	clock            clock.Clock
+	log              logr.Logger
+	maxLifetime      *metav1.Duration
+
+	// revoked contains the bastions whose SSH ingress was already revoked.
+	revoked sets.Set[types.UID]
+}
+
+func newLifetimeEnforcer(c client.Client, log logr.Logger, gcpClientFactory gcpclient.Factory, clock clock.Clock, maxLifetime *metav1.Duration) *lifetimeEnforcer {
+	return &lifetimeEnforcer{
+		client:           c,
+		gcpClientFactory: gcpClientFactory,
+		clock:            clock,
+		log:              log.WithName("gcp-bastion-lifetime-enforcer"),
+		maxLifetime:      maxLifetime,
+		revoked:          sets.New[types.UID](),
+	}
+}
+
+// Start checks the lifetime of the bastions until the given context is cancelled.
+func (e *lifetimeEnforcer) Start(ctx context.Context) error {
+	wait.UntilWithContext(ctx, e.enforce, lifetimeCheckInterval)
+	return nil
+}
+
+func (e *lifetimeEnforcer) enforce(ctx context.Context) {
+	bastionList := &extensionsv1alpha1.BastionList{}
+	if err := e.client.List(ctx, bastionList); err != nil {
+		e.log.Error(err, "Failed to list bastions")
+		return
+	}
+
+	existing := sets.New[types.UID]()
+	for i := range bastionList.Items {
+		bastion := &bastionList.Items[i]
+		existing.Insert(bastion.UID)
+
+		if bastion.Spec.Type != gcp.Type || bastion.DeletionTimestamp != nil || e.revoked.Has(bastion.UID) ||
+			!isExpired(bastion, e.maxLifetime, e.clock.Now()) {
+			continue
+		}
+
+		log := e.log.WithValues("bastion", client.ObjectKeyFromObject(bastion))
+		if err := e.revoke(ctx, bastion); err != nil {
+			log.Error(err, "Failed to revoke SSH ingress of bastion which exceeded its maximum lifetime")
+			continue
+		}
+		log.Info("Revoked SSH ingress of bastion which exceeded its maximum lifetime", "maxLifetime", e.maxLifetime.Duration)
+		e.revoked.Insert(bastion.UID)
+	}
+
+	// forget deleted bastions
+	e.revoked = e.revoked.Intersection(existing)
+}
+
+func (e *lifetimeEnforcer) revoke(ctx context.Context, bastion *extensionsv1alpha1.Bastion) error {
+	computeClient, err := e.gcpClientFactory.Compute(ctx, e.client, corev1.SecretReference{
+		Namespace: bastion.Namespace,
+		Name:      v1beta1constants.SecretNameCloudProvider,
+	})
+	if err != nil {
+		return err
+	}
+
+	// The name of the cluster equals the namespace of its bastions.
+	baseResourceName, err := generateBastionBaseResourceName(bastion.Namespace, bastion.Name)
+	if err != nil {
+		return err
+	}
+
+	return removeIngressFirewallRules(ctx, computeClient, baseResourceName)
+}
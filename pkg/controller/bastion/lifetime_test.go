@@ -0,0 +1,115 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package bastion
+
+import (
+	"context"
+	"time"
+
+	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.uber.org/mock/gomock"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	testclock "k8s.io/utils/clock/testing"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	"github.com/gardener/gardener-extension-provider-gcp/pkg/gcp"
+	mockgcpclient "github.com/gardener/gardener-extension-provider-gcp/pkg/gcp/client/mock"
+)
+
+var _ = Describe("Lifetime", func() {
+	var (
+		ctx          = context.TODO()
+		creationTime = time.Date(2024, 1, 1, 8, 0, 0, 0, time.UTC)
+		maxLifetime  = &metav1.Duration{Duration: 8 * time.Hour}
+
+		bastion *extensionsv1alpha1.Bastion
+	)
+
+	BeforeEach(func() {
+		bastion = &extensionsv1alpha1.Bastion{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:              "bastionName1",
+				Namespace:         "cluster1",
+				UID:               "uid",
+				CreationTimestamp: metav1.Time{Time: creationTime},
+			},
+			Spec: extensionsv1alpha1.BastionSpec{
+				DefaultSpec: extensionsv1alpha1.DefaultSpec{Type: gcp.Type},
+			},
+		}
+	})
+
+	Describe("#isExpired", func() {
+		It("should not expire bastions if the lifetime is not limited", func() {
+			Expect(expirationTimestamp(bastion, nil)).To(BeNil())
+			Expect(isExpired(bastion, nil, creationTime.Add(1000*time.Hour))).To(BeFalse())
+		})
+
+		It("should expire bastions once they exceed the maximum lifetime", func() {
+			Expect(expirationTimestamp(bastion, maxLifetime)).To(Equal(&metav1.Time{Time: creationTime.Add(8 * time.Hour)}))
+			Expect(isExpired(bastion, maxLifetime, creationTime.Add(8*time.Hour-time.Second))).To(BeFalse())
+			Expect(isExpired(bastion, maxLifetime, creationTime.Add(8*time.Hour))).To(BeTrue())
+		})
+	})
+
+	Describe("#enforce", func() {
+		var (
+			ctrl             *gomock.Controller
+			c                client.Client
+			fakeClock        *testclock.FakeClock
+			gcpClientFactory *mockgcpclient.MockFactory
+			gcpComputeClient *mockgcpclient.MockComputeClient
+			enforcer         *lifetimeEnforcer
+		)
+
+		BeforeEach(func() {
+			ctrl = gomock.NewController(GinkgoT())
+			gcpClientFactory = mockgcpclient.NewMockFactory(ctrl)
+			gcpComputeClient = mockgcpclient.NewMockComputeClient(ctrl)
+
+			c = fakeclient.NewClientBuilder().WithScheme(kubernetes.SeedScheme).Build()
+			Expect(c.Create(ctx, bastion)).To(Succeed())
+			// the fake client does not keep the creation timestamp
+			bastion.CreationTimestamp = metav1.Time{Time: creationTime}
+			Expect(c.Update(ctx, bastion)).To(Succeed())
+
+			fakeClock = testclock.NewFakeClock(creationTime)
+			enforcer = newLifetimeEnforcer(c, log.Log, gcpClientFactory, fakeClock, maxLifetime)
+		})
+
+		AfterEach(func() {
+			ctrl.Finish()
+		})
+
+		It("should revoke the SSH ingress of bastions once after they exceeded the maximum lifetime", func() {
+			fakeClock.Step(8*time.Hour - time.Minute)
+			enforcer.enforce(ctx)
+
+			fakeClock.Step(time.Minute)
+			gcpClientFactory.EXPECT().Compute(ctx, c, corev1.SecretReference{Namespace: "cluster1", Name: v1beta1constants.SecretNameCloudProvider}).Return(gcpComputeClient, nil)
+			gcpComputeClient.EXPECT().DeleteFirewallRule(ctx, "cluster1-bastionName1-bastion-1cdc8-allow-ssh")
+			gcpComputeClient.EXPECT().DeleteFirewallRule(ctx, "cluster1-bastionName1-bastion-1cdc8-allow-ssh-ipv6")
+			enforcer.enforce(ctx)
+
+			fakeClock.Step(time.Minute)
+			enforcer.enforce(ctx)
+		})
+
+		It("should ignore bastions of other providers", func() {
+			bastion.Spec.Type = "aws"
+			Expect(c.Update(ctx, bastion)).To(Succeed())
+
+			fakeClock.Step(8 * time.Hour)
+			enforcer.enforce(ctx)
+		})
+	})
+})
@@ -7,7 +7,6 @@ package bastion
 import (
 	"crypto/sha256"
 	"encoding/json"
-	"errors"
 	"fmt"
 	"net"
 	"slices"
@@ -17,7 +16,10 @@ import (
 	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
 	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
 	"github.com/gardener/gardener/pkg/extensions"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
 
+	"github.com/gardener/gardener-extension-provider-gcp/pkg/apis/config"
 	api "github.com/gardener/gardener-extension-provider-gcp/pkg/apis/gcp"
 	"github.com/gardener/gardener-extension-provider-gcp/pkg/apis/gcp/helper"
 )
@@ -27,6 +29,9 @@ import (
 const maxLengthForBaseName = 33
 const maxLengthForResource = 63
 
+// defaultDiskSizeGB is the size of the boot disk of bastion instances if the controller configuration does not set one.
+const defaultDiskSizeGB = 10
+
 // Options contains provider-related information required for setting up
 // a bastion instance. This struct combines precomputed values like the
 // bastion instance name with the IDs of pre-existing cloud provider
@@ -42,15 +47,26 @@ type Options struct {
 	WorkersCIDR         string
 	ImagePath           string
 	MachineName         string
+	DiskSizeGB          int64
+	DiskType            string
+	Mode                config.BastionMode
+	IngressCIDRs        []string
+	IngressCIDRsIPv6    []string
 }
 
 type providerStatusRaw struct {
 	Zone string `json:"zone"`
+	// IAPTunnelCommand is the command which opens a tunnel to the SSH port of a bastion instance in IAP mode.
+	IAPTunnelCommand string `json:"iapTunnelCommand,omitempty"`
+	// ExpirationTimestamp is the time at which the bastion exceeds the maximum lifetime and its SSH ingress is revoked.
+	ExpirationTimestamp *metav1.Time `json:"expirationTimestamp,omitempty"`
 }
 
 // DetermineOptions determines the required information that are required to reconcile a Bastion on GCP. This
-// function does not create any IaaS resources.
-func DetermineOptions(bastion *extensionsv1alpha1.Bastion, cluster *controller.Cluster, projectID, vNetworkName, subnetWork string) (*Options, error) {
+// function does not create any IaaS resources. The machine type, image and boot disk of the bastion instance are taken
+// from the given controller configuration, the machine type and image default to the bastion section of the cloud
+// profile.
+func DetermineOptions(bastion *extensionsv1alpha1.Bastion, cluster *controller.Cluster, projectID, vNetworkName, subnetWork string, bastionConfig config.Bastion) (*Options, error) {
 	providerStatus, err := getProviderStatus(bastion)
 	if err != nil {
 		return nil, err
@@ -71,19 +87,9 @@ func DetermineOptions(bastion *extensionsv1alpha1.Bastion, cluster *controller.C
 
 	region := cluster.Shoot.Spec.Region
 
-	bastionVmDetails, err := extensionsbastion.GetMachineSpecFromCloudProfile(cluster.CloudProfile)
-	if err != nil {
-		return nil, fmt.Errorf("failed to determine VM details for bastion host: %w", err)
-	}
-
-	cloudProfileConfig, err := helper.CloudProfileConfigFromCluster(cluster)
-	if err != nil {
-		return nil, fmt.Errorf("failed to extract cloud provider config from cluster: %w", err)
-	}
-
-	image, err := getProviderSpecificImage(cloudProfileConfig.MachineImages, bastionVmDetails)
+	machineType, imagePath, err := getMachineTypeAndImage(cluster, bastionConfig)
 	if err != nil {
-		return nil, fmt.Errorf("failed to extract image from provider config: %w", err)
+		return nil, err
 	}
 
 	return &Options{
@@ -95,11 +101,42 @@ func DetermineOptions(bastion *extensionsv1alpha1.Bastion, cluster *controller.C
 		ProjectID:           projectID,
 		Network:             fmt.Sprintf("projects/%s/global/networks/%s", projectID, vNetworkName),
 		WorkersCIDR:         workersCidr,
-		MachineName:         bastionVmDetails.MachineTypeName,
-		ImagePath:           image.Image,
+		MachineName:         machineType,
+		ImagePath:           imagePath,
+		DiskSizeGB:          ptr.Deref(bastionConfig.DiskSizeGB, defaultDiskSizeGB),
+		DiskType:            ptr.Deref(bastionConfig.DiskType, ""),
+		Mode:                ptr.Deref(bastionConfig.Mode, config.BastionModePublic),
 	}, nil
 }
 
+// getMachineTypeAndImage returns the machine type and the image of the bastion instance. Values which are not set in
+// the given controller configuration are determined from the bastion section of the cloud profile.
+func getMachineTypeAndImage(cluster *controller.Cluster, bastionConfig config.Bastion) (string, string, error) {
+	if bastionConfig.MachineType != nil && bastionConfig.Image != nil {
+		return *bastionConfig.MachineType, *bastionConfig.Image, nil
+	}
+
+	bastionVmDetails, err := extensionsbastion.GetMachineSpecFromCloudProfile(cluster.CloudProfile)
+	if err != nil {
+		return "", "", fmt.Errorf("failed to determine VM details for bastion host: %w", err)
+	}
+	machineType := ptr.Deref(bastionConfig.MachineType, bastionVmDetails.MachineTypeName)
+	if bastionConfig.Image != nil {
+		return machineType, *bastionConfig.Image, nil
+	}
+
+	cloudProfileConfig, err := helper.CloudProfileConfigFromCluster(cluster)
+	if err != nil {
+		return "", "", fmt.Errorf("failed to extract cloud provider config from cluster: %w", err)
+	}
+
+	image, err := getProviderSpecificImage(cloudProfileConfig.MachineImages, bastionVmDetails)
+	if err != nil {
+		return "", "", fmt.Errorf("failed to extract image from provider config: %w", err)
+	}
+	return machineType, image.Image, nil
+}
+
 func getZone(cluster *extensions.Cluster, region string, providerStatus *providerStatusRaw) string {
 	if providerStatus != nil {
 		return providerStatus.Zone
@@ -113,27 +150,45 @@ func getZone(cluster *extensions.Cluster, region string, providerStatus *provide
 	return ""
 }
 
-func ingressPermissions(bastion *extensionsv1alpha1.Bastion) ([]string, error) {
-	var cidrs []string
+// ingressCIDRs returns the IPv4 and IPv6 source ranges of the SSH ingress firewall rules. In IAP mode, only connections
+// forwarded by the Identity-Aware Proxy are allowed, regardless of the ingress CIDRs of the Bastion resource.
+func ingressCIDRs(bastion *extensionsv1alpha1.Bastion, opt *Options) ([]string, []string, error) {
+	if opt.Mode == config.BastionModeIAP {
+		return []string{IAPSourceRange}, nil, nil
+	}
+	return ingressPermissions(bastion)
+}
+
+// iapTunnelCommand returns the gcloud command which forwards a local port to the SSH port of the bastion instance
+// through the Identity-Aware Proxy. It returns an empty string if the bastion is not in IAP mode.
+func iapTunnelCommand(opt *Options) string {
+	if opt.Mode != config.BastionModeIAP {
+		return ""
+	}
+	return fmt.Sprintf("gcloud compute start-iap-tunnel %s %d --local-host-port=localhost:2222 --zone=%s --project=%s", opt.BastionInstanceName, SSHPort, opt.Zone, opt.ProjectID)
+}
+
+// ingressPermissions returns the normalised IPv4 and IPv6 ingress CIDRs of the given bastion. They are returned
+// separately because a firewall rule must not mix IPv4 and IPv6 source ranges.
+func ingressPermissions(bastion *extensionsv1alpha1.Bastion) ([]string, []string, error) {
+	var cidrs, cidrsIPv6 []string
 	for _, ingress := range bastion.Spec.Ingress {
 		cidr := ingress.IPBlock.CIDR
 		ip, ipNet, err := net.ParseCIDR(cidr)
 		if err != nil {
-			return nil, fmt.Errorf("invalid ingress CIDR %q: %w", cidr, err)
+			return nil, nil, fmt.Errorf("invalid ingress CIDR %q: %w", cidr, err)
 		}
 
 		normalisedCIDR := ipNet.String()
 
 		if ip.To4() != nil {
 			cidrs = append(cidrs, normalisedCIDR)
-		} else if ip.To16() != nil {
-			// Only IPv4 is supported in sourceRanges[].
-			// https://cloud.google.com/compute/docs/reference/rest/v1/firewalls/insert
-			return nil, errors.New("IPv6 is currently not fully supported")
+		} else {
+			cidrsIPv6 = append(cidrsIPv6, normalisedCIDR)
 		}
 	}
 
-	return cidrs, nil
+	return cidrs, cidrsIPv6, nil
 }
 
 func generateBastionBaseResourceName(clusterName string, bastionName string) (string, error) {
@@ -184,6 +239,11 @@ func FirewallIngressAllowSSHResourceName(baseName string) string {
 	return fmt.Sprintf("%s-allow-ssh", baseName)
 }
 
+// FirewallIngressAllowSSHIPv6ResourceName is Firewall ingress allow SSH via IPv6 rule resource name
+func FirewallIngressAllowSSHIPv6ResourceName(baseName string) string {
+	return fmt.Sprintf("%s-allow-ssh-ipv6", baseName)
+}
+
 // FirewallEgressAllowOnlyResourceName is Firewall egress allow only worker node rule resource name
 func FirewallEgressAllowOnlyResourceName(baseName string) string {
 	return fmt.Sprintf("%s-egress-worker", baseName)
@@ -9,6 +9,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"path/filepath"
+	"slices"
 	"strings"
 
 	"github.com/Masterminds/semver/v3"
@@ -20,6 +21,7 @@ import (
 	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
 	"github.com/gardener/gardener/pkg/utils/chart"
 	gutil "github.com/gardener/gardener/pkg/utils/gardener"
+	gardenerimagevector "github.com/gardener/gardener/pkg/utils/imagevector"
 	kutil "github.com/gardener/gardener/pkg/utils/kubernetes"
 	secretutils "github.com/gardener/gardener/pkg/utils/secrets"
 	secretsmanager "github.com/gardener/gardener/pkg/utils/secrets/manager"
@@ -33,12 +35,15 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/serializer"
+	"k8s.io/apimachinery/pkg/util/sets"
 	autoscalingv1 "k8s.io/autoscaler/vertical-pod-autoscaler/pkg/apis/autoscaling.k8s.io/v1"
 	"k8s.io/utils/ptr"
 	k8sclient "sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/manager"
 
 	"github.com/gardener/gardener-extension-provider-gcp/charts"
+	"github.com/gardener/gardener-extension-provider-gcp/imagevector"
+	"github.com/gardener/gardener-extension-provider-gcp/pkg/apis/config"
 	apisgcp "github.com/gardener/gardener-extension-provider-gcp/pkg/apis/gcp"
 	"github.com/gardener/gardener-extension-provider-gcp/pkg/gcp"
 	"github.com/gardener/gardener-extension-provider-gcp/pkg/internal"
@@ -49,6 +54,14 @@ const (
 	caNameControlPlane                   = "ca-" + gcp.Name + "-controlplane"
 	cloudControllerManagerDeploymentName = "cloud-controller-manager"
 	cloudControllerManagerServerName     = "cloud-controller-manager-server"
+	// ccmAPIErrorRateThreshold is the ratio of failed GCP API requests of the cloud-controller-manager above which an
+	// alert fires.
+	ccmAPIErrorRateThreshold = 0.05
+	// ccmRouteReconciliationLatencyThreshold is the 99th percentile latency of the GCP route requests of the
+	// cloud-controller-manager in seconds above which an alert fires.
+	ccmRouteReconciliationLatencyThreshold = 30
+	// maxControlPlaneReplicas is the maximum number of replicas which can be configured for a control plane component.
+	maxControlPlaneReplicas = 5
 )
 
 func secretConfigsFunc(namespace string) []extensionssecretsmanager.SecretConfigWithOptions {
@@ -160,7 +173,7 @@ var (
 				Objects: []*chart.Object{
 					// csi-driver
 					{Type: &appsv1.DaemonSet{}, Name: gcp.CSINodeName},
-					{Type: &storagev1.CSIDriver{}, Name: "pd.csi.storage.gke.io"},
+					{Type: &storagev1.CSIDriver{}, Name: gcp.CSIStorageProvisioner},
 					{Type: &corev1.ServiceAccount{}, Name: gcp.CSIDriverName},
 					{Type: &rbacv1.ClusterRole{}, Name: gcp.UsernamePrefix + gcp.CSIDriverName},
 					{Type: &rbacv1.ClusterRoleBinding{}, Name: gcp.UsernamePrefix + gcp.CSIDriverName},
@@ -218,18 +231,22 @@ var (
 )
 
 // NewValuesProvider creates a new ValuesProvider for the generic actuator.
-func NewValuesProvider(mgr manager.Manager) genericactuator.ValuesProvider {
+func NewValuesProvider(mgr manager.Manager, controlPlane config.ControlPlane) genericactuator.ValuesProvider {
 	return &valuesProvider{
-		client:  mgr.GetClient(),
-		decoder: serializer.NewCodecFactory(mgr.GetScheme(), serializer.EnableStrict).UniversalDecoder(),
+		client:       mgr.GetClient(),
+		decoder:      serializer.NewCodecFactory(mgr.GetScheme(), serializer.EnableStrict).UniversalDecoder(),
+		controlPlane: controlPlane,
+		imageVector:  imagevector.ImageVector(),
 	}
 }
 
 // valuesProvider is a ValuesProvider that provides GCP-specific values for the 2 charts applied by the generic actuator.
 type valuesProvider struct {
 	genericactuator.NoopValuesProvider
-	client  k8sclient.Client
-	decoder runtime.Decoder
+	client       k8sclient.Client
+	decoder      runtime.Decoder
+	controlPlane config.ControlPlane
+	imageVector  gardenerimagevector.ImageVector
 }
 
 // GetConfigChartValues returns the values for the config chart applied by the generic actuator.
@@ -306,7 +323,7 @@ func (vp *valuesProvider) GetControlPlaneChartValues(
 // GetControlPlaneShootChartValues returns the values for the control plane shoot chart applied by the generic actuator.
 func (vp *valuesProvider) GetControlPlaneShootChartValues(
 	_ context.Context,
-	_ *extensionsv1alpha1.ControlPlane,
+	cp *extensionsv1alpha1.ControlPlane,
 	cluster *extensionscontroller.Cluster,
 	_ secretsmanager.Reader,
 	_ map[string]string,
@@ -314,13 +331,71 @@ func (vp *valuesProvider) GetControlPlaneShootChartValues(
 	map[string]interface{},
 	error,
 ) {
-	return map[string]interface{}{
+	cpConfig := &apisgcp.ControlPlaneConfig{}
+	if cp.Spec.ProviderConfig != nil {
+		if _, _, err := vp.decoder.Decode(cp.Spec.ProviderConfig.Raw, nil, cpConfig); err != nil {
+			return nil, fmt.Errorf("could not decode providerConfig of controlplane '%s': %w", k8sclient.ObjectKeyFromObject(cp), err)
+		}
+	}
+
+	csiNode := map[string]interface{}{
+		"enabled":           true,
+		"kubernetesVersion": cluster.Shoot.Spec.Kubernetes.Version,
+	}
+	if cpConfig.Storage != nil && cpConfig.Storage.FSGroupPolicy != nil {
+		csiNode["fsGroupPolicy"] = *cpConfig.Storage.FSGroupPolicy
+	}
+	if cpConfig.Storage != nil && cpConfig.Storage.MaxVolumesPerNode != nil {
+		csiNode["maxVolumesPerNode"] = *cpConfig.Storage.MaxVolumesPerNode
+	}
+
+	values := map[string]interface{}{
 		gcp.CloudControllerManagerName: map[string]interface{}{"enabled": true},
-		gcp.CSINodeName: map[string]interface{}{
-			"enabled":           true,
-			"kubernetesVersion": cluster.Shoot.Spec.Kubernetes.Version,
-		},
-	}, nil
+		gcp.CSINodeName:                csiNode,
+	}
+
+	if err := vp.injectMirroredImages(values, controlPlaneShootChart, cluster.Shoot.Spec.Kubernetes.Version); err != nil {
+		return nil, err
+	}
+	return values, nil
+}
+
+// injectMirroredImages overrides the images of the sub-charts of the given chart with images from the configured image
+// registry, if any. The values returned by the values provider take precedence over the images injected by the generic
+// actuator.
+func (vp *valuesProvider) injectMirroredImages(values map[string]interface{}, c *chart.Chart, kubernetesVersion string) error {
+	registry := vp.controlPlane.ImageRegistry
+	if registry == nil {
+		return nil
+	}
+
+	for _, subChart := range c.SubCharts {
+		subChartValues, ok := values[subChart.Name].(map[string]interface{})
+		if !ok || len(subChart.Images) == 0 {
+			continue
+		}
+
+		images, err := gardenerimagevector.FindImages(vp.imageVector, subChart.Images, gardenerimagevector.TargetVersion(kubernetesVersion))
+		if err != nil {
+			return fmt.Errorf("could not find images of chart %q: %w", subChart.Name, err)
+		}
+		mirroredImages := make(map[string]interface{}, len(images))
+		for name, image := range images {
+			mirroredImages[name] = mirrorImage(*registry, image.String())
+		}
+		subChartValues["images"] = mirroredImages
+	}
+
+	return nil
+}
+
+// mirrorImage replaces the registry of the given image reference with the given registry. References without a registry,
+// i.e. whose first path component is no host, are prefixed with the registry.
+func mirrorImage(registry, image string) string {
+	if host, repository, found := strings.Cut(image, "/"); found && (strings.ContainsAny(host, ".:") || host == "localhost") {
+		image = repository
+	}
+	return registry + "/" + image
 }
 
 // getConfigChartValues collects and returns the configuration chart values.
@@ -333,6 +408,15 @@ func getConfigChartValues(
 	// Determine network names
 	networkName, subNetworkName := getNetworkNames(infraStatus, cp)
 
+	// The subnetwork of the cloud provider config is used by the CCM for internal load balancers.
+	if cpConfig.CloudControllerManager != nil && cpConfig.CloudControllerManager.InternalLoadBalancerSubnet != nil {
+		subnetName := *cpConfig.CloudControllerManager.InternalLoadBalancerSubnet
+		if !slices.ContainsFunc(infraStatus.Networks.Subnets, func(subnet apisgcp.Subnet) bool { return subnet.Name == subnetName }) {
+			return nil, fmt.Errorf("subnet %q configured for internal load balancers is not a subnet of the infrastructure", subnetName)
+		}
+		subNetworkName = subnetName
+	}
+
 	// Collect config chart values
 	return map[string]interface{}{
 		"projectID":      serviceAccount.ProjectID,
@@ -367,13 +451,18 @@ func (vp *valuesProvider) getControlPlaneChartValues(
 		return nil, err
 	}
 
-	return map[string]interface{}{
+	values := map[string]interface{}{
 		"global": map[string]interface{}{
 			"genericTokenKubeconfigSecretName": extensionscontroller.GenericTokenKubeconfigSecretNameFromCluster(cluster),
 		},
 		gcp.CloudControllerManagerName: ccm,
 		gcp.CSIControllerName:          csi,
-	}, nil
+	}
+
+	if err := vp.injectMirroredImages(values, controlPlaneChart, cluster.Shoot.Spec.Kubernetes.Version); err != nil {
+		return nil, err
+	}
+	return values, nil
 }
 
 // getCCMChartValues collects and returns the CCM chart values.
@@ -393,7 +482,7 @@ func (vp *valuesProvider) getCCMChartValues(
 
 	values := map[string]interface{}{
 		"enabled":           true,
-		"replicas":          extensionscontroller.GetControlPlaneReplicas(cluster, scaledDown, 1),
+		"replicas":          getReplicas(cluster, scaledDown, replicasOverrides(cpConfig).CloudControllerManager),
 		"clusterName":       cp.Namespace,
 		"kubernetesVersion": cluster.Shoot.Spec.Kubernetes.Version,
 		"podNetwork":        strings.Join(extensionscontroller.GetPodNetwork(cluster), ","),
@@ -409,10 +498,39 @@ func (vp *valuesProvider) getCCMChartValues(
 			"server": serverSecret.Name,
 		},
 		"gep19Monitoring": gep19Monitoring,
+		"alerting": map[string]interface{}{
+			"apiErrorRateThreshold":               ccmAPIErrorRateThreshold,
+			"routeReconciliationLatencyThreshold": ccmRouteReconciliationLatencyThreshold,
+		},
 	}
 
 	if cpConfig.CloudControllerManager != nil {
 		values["featureGates"] = cpConfig.CloudControllerManager.FeatureGates
+		if period := cpConfig.CloudControllerManager.RouteReconciliationPeriod; period != nil {
+			values["routeReconciliationPeriod"] = period.Duration.String()
+		}
+		if syncs := cpConfig.CloudControllerManager.ConcurrentRouteSyncs; syncs != nil {
+			values["concurrentRouteSyncs"] = *syncs
+		}
+		if len(cpConfig.CloudControllerManager.AdditionalFlags) > 0 {
+			values["additionalFlags"] = cpConfig.CloudControllerManager.AdditionalFlags
+		}
+		if frequency := cpConfig.CloudControllerManager.NodeStatusUpdateFrequency; frequency != nil {
+			values["nodeStatusUpdateFrequency"] = frequency.Duration.String()
+		}
+		if leaderElection := cpConfig.CloudControllerManager.LeaderElection; leaderElection != nil {
+			leaderElectionValues := map[string]interface{}{}
+			if leaderElection.LeaseDuration != nil {
+				leaderElectionValues["leaseDuration"] = leaderElection.LeaseDuration.Duration.String()
+			}
+			if leaderElection.RenewDeadline != nil {
+				leaderElectionValues["renewDeadline"] = leaderElection.RenewDeadline.Duration.String()
+			}
+			if leaderElection.RetryPeriod != nil {
+				leaderElectionValues["retryPeriod"] = leaderElection.RetryPeriod.Duration.String()
+			}
+			values["leaderElection"] = leaderElectionValues
+		}
 	}
 
 	ok, err := vp.isOverlayEnabled(cluster.Shoot.Spec.Networking)
@@ -443,14 +561,14 @@ func getCSIControllerChartValues(
 
 	values := map[string]interface{}{
 		"enabled":   true,
-		"replicas":  extensionscontroller.GetControlPlaneReplicas(cluster, scaledDown, 1),
+		"replicas":  getReplicas(cluster, scaledDown, replicasOverrides(cpConfig).CSIController),
 		"projectID": serviceAccount.ProjectID,
 		"zone":      cpConfig.Zone,
 		"podAnnotations": map[string]interface{}{
 			"checksum/secret-" + v1beta1constants.SecretNameCloudProvider: checksums[v1beta1constants.SecretNameCloudProvider],
 		},
 		"csiSnapshotController": map[string]interface{}{
-			"replicas": extensionscontroller.GetControlPlaneReplicas(cluster, scaledDown, 1),
+			"replicas": getReplicas(cluster, scaledDown, replicasOverrides(cpConfig).CSISnapshotController),
 		},
 	}
 
@@ -459,7 +577,7 @@ func getCSIControllerChartValues(
 		return nil, err
 	}
 	if versionutils.ConstraintK8sGreaterEqual131.Check(k8sVersion) {
-		if _, ok := cluster.Shoot.Annotations[gcp.AnnotationEnableVolumeAttributesClass]; ok {
+		if isVolumeAttributesClassEnabled(cpConfig, cluster) {
 			values["csiDriver"] = map[string]interface{}{
 				"storage": map[string]interface{}{
 					"supportsDynamicIopsProvisioning":       []string{"hyperdisk-balanced", "hyperdisk-extreme"},
@@ -482,11 +600,21 @@ func getCSIControllerChartValues(
 	return values, nil
 }
 
+// isVolumeAttributesClassEnabled returns whether the CSI driver shall support VolumeAttributesClasses. The field of the
+// ControlPlaneConfig takes precedence over the deprecated shoot annotation.
+func isVolumeAttributesClassEnabled(cpConfig *apisgcp.ControlPlaneConfig, cluster *extensionscontroller.Cluster) bool {
+	if cpConfig.Storage != nil && cpConfig.Storage.EnableVolumeAttributesClass != nil {
+		return *cpConfig.Storage.EnableVolumeAttributesClass
+	}
+	_, ok := cluster.Shoot.Annotations[gcp.AnnotationEnableVolumeAttributesClass]
+	return ok
+}
+
 // getStorageClassChartValues collects and returns the shoot storage-class chart values.
 func (vp *valuesProvider) GetStorageClassesChartValues(
 	_ context.Context,
 	cp *extensionsv1alpha1.ControlPlane,
-	_ *extensionscontroller.Cluster,
+	cluster *extensionscontroller.Cluster,
 ) (map[string]interface{}, error) {
 	managedDefaultStorageClass := true
 	managedDefaultVolumeSnapshotClass := true
@@ -504,24 +632,116 @@ func (vp *valuesProvider) GetStorageClassesChartValues(
 		managedDefaultVolumeSnapshotClass = ptr.Deref(cpConfig.Storage.ManagedDefaultVolumeSnapshotClass, true)
 	}
 
-	return map[string]interface{}{
+	values := map[string]interface{}{
 		"managedDefaultStorageClass":        managedDefaultStorageClass,
 		"managedDefaultVolumeSnapshotClass": managedDefaultVolumeSnapshotClass,
-	}, nil
+	}
+
+	if cpConfig.Storage != nil && len(cpConfig.Storage.AdditionalStorageClasses) > 0 {
+		values["additionalStorageClasses"] = getAdditionalStorageClassesValues(cpConfig.Storage.AdditionalStorageClasses)
+	}
+	if cpConfig.Storage != nil && cpConfig.Storage.SnapshotLocation != "" {
+		values["snapshotLocation"] = cpConfig.Storage.SnapshotLocation
+	}
+
+	replicaZones, err := vp.regionalDiskReplicaZones(cluster)
+	if err != nil {
+		return nil, err
+	}
+	if len(replicaZones) > 0 {
+		values["regionalReplicaZones"] = replicaZones
+	}
+
+	return values, nil
+}
+
+// getAdditionalStorageClassesValues returns the chart values of the given additional storage classes.
+func getAdditionalStorageClassesValues(classes []apisgcp.StorageClassConfig) []interface{} {
+	values := make([]interface{}, 0, len(classes))
+	for _, class := range classes {
+		parameters := make(map[string]interface{}, len(class.Parameters))
+		for key, value := range class.Parameters {
+			parameters[key] = value
+		}
+		values = append(values, map[string]interface{}{
+			"name":                 class.Name,
+			"type":                 class.Type,
+			"parameters":           parameters,
+			"default":              ptr.Deref(class.Default, false),
+			"allowVolumeExpansion": ptr.Deref(class.AllowVolumeExpansion, true),
+		})
+	}
+	return values
+}
+
+// regionalDiskReplicaZones returns the sorted replica zones of all regional disks of the worker pools. The regional
+// storage class is restricted to these zones to keep the topology of the volumes consistent with the one of the nodes.
+func (vp *valuesProvider) regionalDiskReplicaZones(cluster *extensionscontroller.Cluster) ([]string, error) {
+	zones := sets.New[string]()
+	if cluster == nil || cluster.Shoot == nil {
+		return nil, nil
+	}
+
+	for _, pool := range cluster.Shoot.Spec.Provider.Workers {
+		if pool.ProviderConfig == nil {
+			continue
+		}
+		workerConfig := &apisgcp.WorkerConfig{}
+		if _, _, err := vp.decoder.Decode(pool.ProviderConfig.Raw, nil, workerConfig); err != nil {
+			return nil, fmt.Errorf("could not decode providerConfig of worker pool %q: %w", pool.Name, err)
+		}
+		if workerConfig.Volume != nil && workerConfig.Volume.Regional != nil {
+			zones.Insert(workerConfig.Volume.Regional.ReplicaZones...)
+		}
+		for _, dataVolume := range workerConfig.DataVolumes {
+			if dataVolume.Regional != nil {
+				zones.Insert(dataVolume.Regional.ReplicaZones...)
+			}
+		}
+	}
+
+	return sets.List(zones), nil
+}
+
+// replicasOverrides returns the configured replicas overrides of the control plane components.
+func replicasOverrides(cpConfig *apisgcp.ControlPlaneConfig) apisgcp.ControlPlaneReplicas {
+	if cpConfig.Replicas == nil {
+		return apisgcp.ControlPlaneReplicas{}
+	}
+	return *cpConfig.Replicas
+}
+
+// getReplicas returns the replicas of a control plane component. The given override is capped at
+// maxControlPlaneReplicas and does not take effect if the control plane is scaled down.
+func getReplicas(cluster *extensionscontroller.Cluster, scaledDown bool, override *int32) int {
+	replicas := 1
+	if override != nil {
+		replicas = min(int(*override), maxControlPlaneReplicas)
+	}
+	return extensionscontroller.GetControlPlaneReplicas(cluster, scaledDown, replicas)
 }
 
 // getNetworkNames determines the network and subnetwork names from the given infrastructure status and controlplane.
+// The self-link of the VPC is preferred over its name as it also identifies networks of other projects (Shared VPC).
+// The subnetwork is used for internal load balancers. If the infrastructure has no internal subnet, the subnet of the
+// nodes is used, as the CCM would otherwise pick an arbitrary subnet of the VPC, e.g. the proxy-only subnet.
 func getNetworkNames(
 	infraStatus *apisgcp.InfrastructureStatus,
 	cp *extensionsv1alpha1.ControlPlane,
 ) (string, string) {
-	networkName := infraStatus.Networks.VPC.Name
+	networkName := infraStatus.Networks.VPC.SelfLink
+	if networkName == "" {
+		networkName = infraStatus.Networks.VPC.Name
+	}
 	if networkName == "" {
 		networkName = cp.Namespace
 	}
 
 	subNetworkName := ""
 	subnet, _ := apihelper.FindSubnetForPurpose(infraStatus.Networks.Subnets, apisgcp.PurposeInternal)
+	if subnet == nil {
+		subnet, _ = apihelper.FindSubnetForPurpose(infraStatus.Networks.Subnets, apisgcp.PurposeNodes)
+	}
 	if subnet != nil {
 		subNetworkName = subnet.Name
 	}
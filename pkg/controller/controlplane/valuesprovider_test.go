@@ -7,12 +7,15 @@ package controlplane
 import (
 	"context"
 	"encoding/json"
+	"path/filepath"
+	"time"
 
 	extensionscontroller "github.com/gardener/gardener/extensions/pkg/controller"
 	"github.com/gardener/gardener/extensions/pkg/controller/controlplane/genericactuator"
 	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
 	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
 	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	"github.com/gardener/gardener/pkg/chartrenderer"
 	"github.com/gardener/gardener/pkg/utils"
 	secretsmanager "github.com/gardener/gardener/pkg/utils/secrets/manager"
 	fakesecretsmanager "github.com/gardener/gardener/pkg/utils/secrets/manager/fake"
@@ -20,6 +23,7 @@ import (
 	mockmanager "github.com/gardener/gardener/third_party/mock/controller-runtime/manager"
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
+	monitoringv1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1"
 	"go.uber.org/mock/gomock"
 	appsv1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
@@ -27,10 +31,14 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/yaml"
+	"k8s.io/apimachinery/pkg/version"
 	"k8s.io/utils/ptr"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
 
+	"github.com/gardener/gardener-extension-provider-gcp/charts"
+	"github.com/gardener/gardener-extension-provider-gcp/pkg/apis/config"
 	apisgcp "github.com/gardener/gardener-extension-provider-gcp/pkg/apis/gcp"
 	"github.com/gardener/gardener-extension-provider-gcp/pkg/gcp"
 	"github.com/gardener/gardener-extension-provider-gcp/pkg/internal"
@@ -135,7 +143,7 @@ var _ = Describe("ValuesProvider", func() {
 		mgr = mockmanager.NewMockManager(ctrl)
 		mgr.EXPECT().GetClient().Return(c)
 		mgr.EXPECT().GetScheme().Return(scheme)
-		vp = NewValuesProvider(mgr)
+		vp = NewValuesProvider(mgr, config.ControlPlane{})
 
 		fakeClient = fakeclient.NewClientBuilder().Build()
 		fakeSecretsManager = fakesecretsmanager.New(fakeClient, namespace)
@@ -185,6 +193,109 @@ var _ = Describe("ValuesProvider", func() {
 				"nodeTags":       namespace,
 			}))
 		})
+
+		It("should prefer the self-link of the VPC over its name", func() {
+			selfLink := "https://www.googleapis.com/compute/v1/projects/host-project/global/networks/vpc-1234"
+			cpWithSelfLink := cp.DeepCopy()
+			cpWithSelfLink.Spec.InfrastructureProviderStatus.Raw = encode(&apisgcp.InfrastructureStatus{
+				Networks: apisgcp.NetworkStatus{
+					VPC: apisgcp.VPC{
+						Name:     "vpc-1234",
+						SelfLink: selfLink,
+					},
+				},
+			})
+			c.EXPECT().Get(context.TODO(), cpSecretKey, &corev1.Secret{}).DoAndReturn(clientGet(cpSecret))
+
+			values, err := vp.GetConfigChartValues(ctx, cpWithSelfLink, cluster)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(values).To(HaveKeyWithValue("networkName", selfLink))
+		})
+
+		Context("internal load balancer subnet", func() {
+			var cpWithSubnets *extensionsv1alpha1.ControlPlane
+
+			BeforeEach(func() {
+				cpWithSubnets = cp.DeepCopy()
+				cpWithSubnets.Spec.InfrastructureProviderStatus.Raw = encode(&apisgcp.InfrastructureStatus{
+					Networks: apisgcp.NetworkStatus{
+						VPC: apisgcp.VPC{
+							Name: "vpc-1234",
+						},
+						Subnets: []apisgcp.Subnet{
+							{
+								Name:    "subnet-nodes1234",
+								Purpose: apisgcp.PurposeNodes,
+							},
+							{
+								Name:    "subnet-acbd1234",
+								Purpose: apisgcp.PurposeInternal,
+							},
+						},
+					},
+				})
+			})
+
+			It("should use the internal subnet for internal load balancers", func() {
+				c.EXPECT().Get(context.TODO(), cpSecretKey, &corev1.Secret{}).DoAndReturn(clientGet(cpSecret))
+
+				values, err := vp.GetConfigChartValues(ctx, cpWithSubnets, cluster)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(values).To(HaveKeyWithValue("subNetworkName", "subnet-acbd1234"))
+			})
+
+			It("should use the nodes subnet for internal load balancers if the internal subnet is skipped", func() {
+				cpWithSubnets.Spec.InfrastructureProviderStatus.Raw = encode(&apisgcp.InfrastructureStatus{
+					Networks: apisgcp.NetworkStatus{
+						VPC: apisgcp.VPC{
+							Name: "vpc-1234",
+						},
+						Subnets: []apisgcp.Subnet{
+							{
+								Name:    "subnet-nodes1234",
+								Purpose: apisgcp.PurposeNodes,
+							},
+							{
+								Name:    "subnet-proxy1234",
+								Purpose: apisgcp.PurposeProxyOnly,
+							},
+						},
+					},
+				})
+				c.EXPECT().Get(context.TODO(), cpSecretKey, &corev1.Secret{}).DoAndReturn(clientGet(cpSecret))
+
+				values, err := vp.GetConfigChartValues(ctx, cpWithSubnets, cluster)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(values).To(HaveKeyWithValue("subNetworkName", "subnet-nodes1234"))
+			})
+
+			It("should use the configured subnet for internal load balancers", func() {
+				cpWithSubnets.Spec.ProviderConfig.Raw = encode(&apisgcp.ControlPlaneConfig{
+					Zone: "europe-west1a",
+					CloudControllerManager: &apisgcp.CloudControllerManagerConfig{
+						InternalLoadBalancerSubnet: ptr.To("subnet-nodes1234"),
+					},
+				})
+				c.EXPECT().Get(context.TODO(), cpSecretKey, &corev1.Secret{}).DoAndReturn(clientGet(cpSecret))
+
+				values, err := vp.GetConfigChartValues(ctx, cpWithSubnets, cluster)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(values).To(HaveKeyWithValue("subNetworkName", "subnet-nodes1234"))
+			})
+
+			It("should fail if the configured subnet is not part of the infrastructure", func() {
+				cpWithSubnets.Spec.ProviderConfig.Raw = encode(&apisgcp.ControlPlaneConfig{
+					Zone: "europe-west1a",
+					CloudControllerManager: &apisgcp.CloudControllerManagerConfig{
+						InternalLoadBalancerSubnet: ptr.To("unknown-subnet"),
+					},
+				})
+				c.EXPECT().Get(context.TODO(), cpSecretKey, &corev1.Secret{}).DoAndReturn(clientGet(cpSecret))
+
+				_, err := vp.GetConfigChartValues(ctx, cpWithSubnets, cluster)
+				Expect(err).To(MatchError(ContainSubstring(`subnet "unknown-subnet" configured for internal load balancers`)))
+			})
+		})
 	})
 
 	Describe("#GetControlPlaneChartValues", func() {
@@ -216,6 +327,10 @@ var _ = Describe("ValuesProvider", func() {
 			"secrets": map[string]interface{}{
 				"server": "cloud-controller-manager-server",
 			},
+			"alerting": map[string]interface{}{
+				"apiErrorRateThreshold":               0.05,
+				"routeReconciliationLatencyThreshold": 30,
+			},
 			"configureCloudRoutes": false,
 		})
 
@@ -255,6 +370,33 @@ var _ = Describe("ValuesProvider", func() {
 			}))
 		})
 
+		It("should render the alerting rules of the cloud-controller-manager with the configured thresholds", func() {
+			values, err := vp.GetControlPlaneChartValues(ctx, cp, cluster, fakeSecretsManager, checksums, false)
+			Expect(err).NotTo(HaveOccurred())
+
+			renderer := chartrenderer.NewWithServerVersion(&version.Info{GitVersion: "v1.30.0"})
+			release, err := renderer.RenderEmbeddedFS(charts.InternalChart, filepath.Join(charts.InternalChartsPath, "seed-controlplane", "charts", gcp.CloudControllerManagerName), gcp.CloudControllerManagerName, namespace, utils.MergeMaps(values[gcp.CloudControllerManagerName].(map[string]interface{}), map[string]interface{}{
+				"gep19Monitoring": true,
+				"global": map[string]interface{}{
+					"genericTokenKubeconfigSecretName": genericTokenKubeconfigSecretName,
+				},
+			}))
+			Expect(err).NotTo(HaveOccurred())
+
+			prometheusRule := &monitoringv1.PrometheusRule{}
+			Expect(yaml.Unmarshal([]byte(release.FileContent("prometheusrule.yaml")), prometheusRule)).To(Succeed())
+			Expect(prometheusRule.Name).To(Equal("shoot-cloud-controller-manager"))
+
+			expressions := map[string]string{}
+			for _, group := range prometheusRule.Spec.Groups {
+				for _, rule := range group.Rules {
+					expressions[rule.Alert] = rule.Expr.String()
+				}
+			}
+			Expect(expressions).To(HaveKeyWithValue("CloudControllerManagerGCPAPIErrorRateHigh", ContainSubstring("> 0.05")))
+			Expect(expressions).To(HaveKeyWithValue("CloudControllerManagerRouteReconciliationSlow", ContainSubstring("> 30")))
+		})
+
 		It("should return correct control plane chart values for clusters without overlay", func() {
 			shootWithoutOverlay := cluster.Shoot.DeepCopy()
 			shootWithoutOverlay.Spec.Networking.Type = ptr.To("calico")
@@ -289,6 +431,175 @@ var _ = Describe("ValuesProvider", func() {
 			})))
 		})
 
+		It("should return correct control plane chart values for clusters with route reconciliation settings", func() {
+			cpWithRouteSettings := cp.DeepCopy()
+			cpWithRouteSettings.Spec.ProviderConfig.Raw = encode(&apisgcp.ControlPlaneConfig{
+				Zone: "europe-west1a",
+				CloudControllerManager: &apisgcp.CloudControllerManagerConfig{
+					FeatureGates: map[string]bool{
+						"SomeKubernetesFeature": true,
+					},
+					RouteReconciliationPeriod: &metav1.Duration{Duration: 90 * time.Second},
+					ConcurrentRouteSyncs:      ptr.To[int32](20),
+				},
+			})
+
+			values, err := vp.GetControlPlaneChartValues(ctx, cpWithRouteSettings, cluster, fakeSecretsManager, checksums, false)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(values[gcp.CloudControllerManagerName]).To(Equal(utils.MergeMaps(ccmChartValues, map[string]interface{}{
+				"kubernetesVersion":         cluster.Shoot.Spec.Kubernetes.Version,
+				"gep19Monitoring":           false,
+				"routeReconciliationPeriod": "1m30s",
+				"concurrentRouteSyncs":      int32(20),
+			})))
+		})
+
+		It("should return correct control plane chart values for clusters with leader election and node status settings", func() {
+			cpWithTimings := cp.DeepCopy()
+			cpWithTimings.Spec.ProviderConfig.Raw = encode(&apisgcp.ControlPlaneConfig{
+				Zone: "europe-west1a",
+				CloudControllerManager: &apisgcp.CloudControllerManagerConfig{
+					FeatureGates: map[string]bool{
+						"SomeKubernetesFeature": true,
+					},
+					NodeStatusUpdateFrequency: &metav1.Duration{Duration: 10 * time.Minute},
+					LeaderElection: &apisgcp.LeaderElection{
+						LeaseDuration: &metav1.Duration{Duration: time.Minute},
+						RenewDeadline: &metav1.Duration{Duration: 40 * time.Second},
+					},
+				},
+			})
+
+			values, err := vp.GetControlPlaneChartValues(ctx, cpWithTimings, cluster, fakeSecretsManager, checksums, false)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(values[gcp.CloudControllerManagerName]).To(Equal(utils.MergeMaps(ccmChartValues, map[string]interface{}{
+				"kubernetesVersion":         cluster.Shoot.Spec.Kubernetes.Version,
+				"gep19Monitoring":           false,
+				"nodeStatusUpdateFrequency": "10m0s",
+				"leaderElection": map[string]interface{}{
+					"leaseDuration": "1m0s",
+					"renewDeadline": "40s",
+				},
+			})))
+		})
+
+		Describe("replicas overrides", func() {
+			var cpWithReplicas *extensionsv1alpha1.ControlPlane
+
+			BeforeEach(func() {
+				cpWithReplicas = cp.DeepCopy()
+				cpWithReplicas.Spec.ProviderConfig.Raw = encode(&apisgcp.ControlPlaneConfig{
+					Zone: "europe-west1a",
+					Replicas: &apisgcp.ControlPlaneReplicas{
+						CloudControllerManager: ptr.To[int32](2),
+						CSIController:          ptr.To[int32](3),
+						CSISnapshotController:  ptr.To[int32](10),
+					},
+				})
+			})
+
+			It("should use the overrides and cap them at the maximum", func() {
+				values, err := vp.GetControlPlaneChartValues(ctx, cpWithReplicas, cluster, fakeSecretsManager, checksums, false)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(values[gcp.CloudControllerManagerName]).To(HaveKeyWithValue("replicas", 2))
+				Expect(values[gcp.CSIControllerName]).To(HaveKeyWithValue("replicas", 3))
+				Expect(values[gcp.CSIControllerName]).To(HaveKeyWithValue("csiSnapshotController", HaveKeyWithValue("replicas", 5)))
+			})
+
+			It("should ignore the overrides if the control plane is scaled down", func() {
+				hibernatedCluster := *cluster
+				hibernatedCluster.Shoot = cluster.Shoot.DeepCopy()
+				hibernatedCluster.Shoot.Spec.Hibernation = &gardencorev1beta1.Hibernation{Enabled: ptr.To(true)}
+
+				values, err := vp.GetControlPlaneChartValues(ctx, cpWithReplicas, &hibernatedCluster, fakeSecretsManager, checksums, true)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(values[gcp.CloudControllerManagerName]).To(HaveKeyWithValue("replicas", 0))
+				Expect(values[gcp.CSIControllerName]).To(HaveKeyWithValue("replicas", 0))
+				Expect(values[gcp.CSIControllerName]).To(HaveKeyWithValue("csiSnapshotController", HaveKeyWithValue("replicas", 0)))
+			})
+		})
+
+		It("should return correct control plane chart values for clusters with additional flags", func() {
+			cpWithAdditionalFlags := cp.DeepCopy()
+			cpWithAdditionalFlags.Spec.ProviderConfig.Raw = encode(&apisgcp.ControlPlaneConfig{
+				Zone: "europe-west1a",
+				CloudControllerManager: &apisgcp.CloudControllerManagerConfig{
+					FeatureGates: map[string]bool{
+						"SomeKubernetesFeature": true,
+					},
+					AdditionalFlags: map[string]string{
+						"concurrent-service-syncs": "20",
+						"kube-api-qps":             "50",
+					},
+				},
+			})
+
+			values, err := vp.GetControlPlaneChartValues(ctx, cpWithAdditionalFlags, cluster, fakeSecretsManager, checksums, false)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(values[gcp.CloudControllerManagerName]).To(Equal(utils.MergeMaps(ccmChartValues, map[string]interface{}{
+				"kubernetesVersion": cluster.Shoot.Spec.Kubernetes.Version,
+				"gep19Monitoring":   false,
+				"additionalFlags": map[string]string{
+					"concurrent-service-syncs": "20",
+					"kube-api-qps":             "50",
+				},
+			})))
+		})
+
+		It("should use the configured image registry for the provider images", func() {
+			mgr.EXPECT().GetClient().Return(c)
+			mgr.EXPECT().GetScheme().Return(scheme)
+			vp = NewValuesProvider(mgr, config.ControlPlane{ImageRegistry: ptr.To("registry.example.com/mirror")})
+
+			values, err := vp.GetControlPlaneChartValues(ctx, cp, cluster, fakeSecretsManager, checksums, false)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(values[gcp.CloudControllerManagerName]).To(HaveKeyWithValue("images", HaveKeyWithValue(gcp.CloudControllerManagerImageName,
+				HavePrefix("registry.example.com/mirror/gardener-project/releases/kubernetes/cloud-provider-gcp:v1.28."))))
+			Expect(values[gcp.CSIControllerName]).To(HaveKeyWithValue("images", SatisfyAll(
+				HaveLen(7),
+				HaveKeyWithValue(gcp.CSIDriverImageName, HavePrefix("registry.example.com/mirror/")),
+				HaveKeyWithValue(gcp.CSIProvisionerImageName, HavePrefix("registry.example.com/mirror/")),
+				HaveKeyWithValue(gcp.CSISnapshotControllerImageName, HavePrefix("registry.example.com/mirror/")),
+			)))
+		})
+
+		DescribeTable("VolumeAttributesClass support",
+			func(version string, annotation bool, enabled *bool, expected bool) {
+				cluster.Shoot.Spec.Kubernetes.Version = version
+				if annotation {
+					cluster.Shoot.Annotations = map[string]string{gcp.AnnotationEnableVolumeAttributesClass: "true"}
+				}
+				cpWithStorage := cp.DeepCopy()
+				cpWithStorage.Spec.ProviderConfig.Raw = encode(&apisgcp.ControlPlaneConfig{
+					Zone:    "europe-west1a",
+					Storage: &apisgcp.Storage{EnableVolumeAttributesClass: enabled},
+				})
+
+				values, err := vp.GetControlPlaneChartValues(ctx, cpWithStorage, cluster, fakeSecretsManager, checksums, false)
+				Expect(err).NotTo(HaveOccurred())
+				csi := values[gcp.CSIControllerName].(map[string]interface{})
+				if !expected {
+					Expect(csi).NotTo(HaveKey("csiDriver"))
+					Expect(csi).NotTo(HaveKey("csiResizer"))
+					Expect(csi).NotTo(HaveKey("csiProvisioner"))
+					return
+				}
+				Expect(csi).To(HaveKeyWithValue("csiDriver", map[string]interface{}{
+					"storage": map[string]interface{}{
+						"supportsDynamicIopsProvisioning":       []string{"hyperdisk-balanced", "hyperdisk-extreme"},
+						"supportsDynamicThroughputProvisioning": []string{"hyperdisk-balanced", "hyperdisk-throughput", "hyperdisk-ml"},
+					},
+				}))
+				Expect(csi).To(HaveKeyWithValue("csiResizer", map[string]interface{}{"featureGates": map[string]string{"VolumeAttributesClass": "true"}}))
+				Expect(csi).To(HaveKeyWithValue("csiProvisioner", map[string]interface{}{"featureGates": map[string]string{"VolumeAttributesClass": "true"}}))
+			},
+			Entry("disabled by default", "1.31.0", false, nil, false),
+			Entry("enabled by the annotation", "1.31.0", true, nil, true),
+			Entry("enabled by the field", "1.31.0", false, ptr.To(true), true),
+			Entry("disabled by the field despite the annotation", "1.31.0", true, ptr.To(false), false),
+			Entry("not supported by the Kubernetes version", "1.30.5", false, ptr.To(true), false),
+		)
+
 		DescribeTable("topologyAwareRoutingEnabled value",
 			func(seedSettings *gardencorev1beta1.SeedSettings, shootControlPlane *gardencorev1beta1.ControlPlane) {
 				cluster.Seed = &gardencorev1beta1.Seed{
@@ -347,6 +658,61 @@ var _ = Describe("ValuesProvider", func() {
 				}),
 			}))
 		})
+
+		It("should return the configured fsGroupPolicy of the CSI driver", func() {
+			cpWithStorage := cp.DeepCopy()
+			cpWithStorage.Spec.ProviderConfig.Raw = encode(&apisgcp.ControlPlaneConfig{
+				Zone:    "europe-west1a",
+				Storage: &apisgcp.Storage{FSGroupPolicy: ptr.To("None")},
+			})
+
+			values, err := vp.GetControlPlaneShootChartValues(ctx, cpWithStorage, cluster, fakeSecretsManager, nil)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(values).To(Equal(map[string]interface{}{
+				gcp.CloudControllerManagerName: enabledTrue,
+				gcp.CSINodeName: utils.MergeMaps(enabledTrue, map[string]interface{}{
+					"kubernetesVersion": "1.28.2",
+					"fsGroupPolicy":     "None",
+				}),
+			}))
+		})
+
+		It("should render the configured max volumes per node into the CSI driver", func() {
+			cpWithStorage := cp.DeepCopy()
+			cpWithStorage.Spec.ProviderConfig.Raw = encode(&apisgcp.ControlPlaneConfig{
+				Zone:    "europe-west1a",
+				Storage: &apisgcp.Storage{MaxVolumesPerNode: ptr.To[int32](15)},
+			})
+
+			values, err := vp.GetControlPlaneShootChartValues(ctx, cpWithStorage, cluster, fakeSecretsManager, nil)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(values[gcp.CSINodeName]).To(HaveKeyWithValue("maxVolumesPerNode", int32(15)))
+
+			renderer := chartrenderer.NewWithServerVersion(&version.Info{GitVersion: "v1.30.0"})
+			release, err := renderer.RenderEmbeddedFS(charts.InternalChart, filepath.Join(charts.InternalChartsPath, "shoot-system-components", "charts", gcp.CSINodeName), gcp.CSINodeName, metav1.NamespaceSystem, values[gcp.CSINodeName].(map[string]interface{}))
+			Expect(err).NotTo(HaveOccurred())
+
+			daemonSet := &appsv1.DaemonSet{}
+			Expect(yaml.Unmarshal([]byte(release.FileContent("daemonset.yaml")), daemonSet)).To(Succeed())
+			Expect(daemonSet.Spec.Template.Spec.Containers[0].Name).To(Equal("csi-driver"))
+			Expect(daemonSet.Spec.Template.Spec.Containers[0].Args).To(ContainElement("--max-volumes-per-node=15"))
+		})
+
+		It("should use the configured image registry for the provider images", func() {
+			mgr.EXPECT().GetClient().Return(c)
+			mgr.EXPECT().GetScheme().Return(scheme)
+			vp = NewValuesProvider(mgr, config.ControlPlane{ImageRegistry: ptr.To("registry.example.com:5000")})
+
+			values, err := vp.GetControlPlaneShootChartValues(ctx, cp, cluster, fakeSecretsManager, nil)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(values[gcp.CloudControllerManagerName]).NotTo(HaveKey("images"))
+			Expect(values[gcp.CSINodeName]).To(HaveKeyWithValue("images", SatisfyAll(
+				HaveLen(3),
+				HaveKeyWithValue(gcp.CSIDriverImageName, HavePrefix("registry.example.com:5000/")),
+				HaveKeyWithValue(gcp.CSINodeDriverRegistrarImageName, HavePrefix("registry.example.com:5000/")),
+				HaveKeyWithValue(gcp.CSILivenessProbeImageName, HavePrefix("registry.example.com:5000/")),
+			)))
+		})
 	})
 	Describe("#GetStorageClassesChartValues()", func() {
 		It("should return correct storage class chart values when using managed classes", func() {
@@ -373,6 +739,73 @@ var _ = Describe("ValuesProvider", func() {
 				"managedDefaultVolumeSnapshotClass": false,
 			}))
 		})
+
+		It("should return correct storage class chart values for additional storage classes", func() {
+			cp.Spec.ProviderConfig.Raw = encode(&apisgcp.ControlPlaneConfig{
+				Storage: &apisgcp.Storage{
+					ManagedDefaultStorageClass: ptr.To(false),
+					AdditionalStorageClasses: []apisgcp.StorageClassConfig{
+						{
+							Name:       "hyperdisk",
+							Type:       "hyperdisk-balanced",
+							Parameters: map[string]string{"provisioned-iops-on-create": "5000"},
+							Default:    ptr.To(true),
+						},
+						{
+							Name:                 "throughput",
+							Type:                 "hyperdisk-throughput",
+							AllowVolumeExpansion: ptr.To(false),
+						},
+					},
+				},
+			})
+
+			values, err := vp.GetStorageClassesChartValues(ctx, cp, cluster)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(values).To(Equal(map[string]interface{}{
+				"managedDefaultStorageClass":        false,
+				"managedDefaultVolumeSnapshotClass": true,
+				"additionalStorageClasses": []interface{}{
+					map[string]interface{}{
+						"name":                 "hyperdisk",
+						"type":                 "hyperdisk-balanced",
+						"parameters":           map[string]interface{}{"provisioned-iops-on-create": "5000"},
+						"default":              true,
+						"allowVolumeExpansion": true,
+					},
+					map[string]interface{}{
+						"name":                 "throughput",
+						"type":                 "hyperdisk-throughput",
+						"parameters":           map[string]interface{}{},
+						"default":              false,
+						"allowVolumeExpansion": false,
+					},
+				},
+			}))
+		})
+
+		It("should return the snapshot location of the default volume snapshot class", func() {
+			cp.Spec.ProviderConfig.Raw = encode(&apisgcp.ControlPlaneConfig{
+				Storage: &apisgcp.Storage{SnapshotLocation: "europe-west1"},
+			})
+
+			values, err := vp.GetStorageClassesChartValues(ctx, cp, cluster)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(values).To(HaveKeyWithValue("snapshotLocation", "europe-west1"))
+		})
+
+		It("should restrict the regional storage class to the replica zones of the worker pools", func() {
+			cluster.Shoot.Spec.Provider.Workers[0].ProviderConfig = &runtime.RawExtension{
+				Raw: encode(&apisgcp.WorkerConfig{
+					Volume:      &apisgcp.Volume{Regional: &apisgcp.RegionalDisk{ReplicaZones: []string{"zone-b", "zone-c"}}},
+					DataVolumes: []apisgcp.DataVolume{{Name: "data", Regional: &apisgcp.RegionalDisk{ReplicaZones: []string{"zone-a", "zone-b"}}}},
+				}),
+			}
+
+			values, err := vp.GetStorageClassesChartValues(ctx, cp, cluster)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(values).To(HaveKeyWithValue("regionalReplicaZones", []string{"zone-a", "zone-b", "zone-c"}))
+		})
 	})
 })
 
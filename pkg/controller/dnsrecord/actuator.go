@@ -7,6 +7,9 @@ package dnsrecord
 import (
 	"context"
 	"fmt"
+	"maps"
+	"slices"
+	"strings"
 	"time"
 
 	extensionscontroller "github.com/gardener/gardener/extensions/pkg/controller"
@@ -16,10 +19,14 @@ import (
 	extensionsv1alpha1helper "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1/helper"
 	reconcilerutils "github.com/gardener/gardener/pkg/controllerutils/reconciler"
 	"github.com/go-logr/logr"
+	googledns "google.golang.org/api/dns/v1"
+	"k8s.io/apimachinery/pkg/util/validation/field"
 	k8sclient "sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/manager"
 
+	apisgcp "github.com/gardener/gardener-extension-provider-gcp/pkg/apis/gcp"
 	"github.com/gardener/gardener-extension-provider-gcp/pkg/apis/gcp/helper"
+	"github.com/gardener/gardener-extension-provider-gcp/pkg/apis/gcp/validation"
 	gcpclient "github.com/gardener/gardener-extension-provider-gcp/pkg/gcp/client"
 )
 
@@ -28,6 +35,9 @@ const (
 	// in order to prevent quick retries that could quickly exhaust the account rate limits in case of e.g.
 	// configuration issues.
 	requeueAfterOnProviderError = 30 * time.Second
+
+	// maxTTL is the maximum TTL of DNS records in seconds. Higher TTLs are capped to this value.
+	maxTTL int64 = 7 * 24 * 60 * 60
 )
 
 type actuator struct {
@@ -44,7 +54,19 @@ func NewActuator(mgr manager.Manager, gcpClientFactory gcpclient.Factory) dnsrec
 }
 
 // Reconcile reconciles the DNSRecord.
-func (a *actuator) Reconcile(ctx context.Context, log logr.Logger, dns *extensionsv1alpha1.DNSRecord, _ *extensionscontroller.Cluster) error {
+func (a *actuator) Reconcile(ctx context.Context, log logr.Logger, dns *extensionsv1alpha1.DNSRecord, cluster *extensionscontroller.Cluster) error {
+	config, err := helper.DNSRecordConfigFromDNSRecord(dns)
+	if err != nil {
+		return fmt.Errorf("could not decode provider config: %w", err)
+	}
+	if errs := validation.ValidateDNSRecordConfig(config, field.NewPath("spec", "providerConfig")); len(errs) > 0 {
+		return fmt.Errorf("invalid provider config: %w", errs.ToAggregate())
+	}
+	ttl, err := getTTL(log, dns, config)
+	if err != nil {
+		return err
+	}
+
 	// Create GCP DNS client
 	dnsClient, err := a.gcpClientFactory.DNS(ctx, a.client, dns.Spec.SecretRef)
 	if err != nil {
@@ -52,18 +74,35 @@ func (a *actuator) Reconcile(ctx context.Context, log logr.Logger, dns *extensio
 	}
 
 	// Determine DNS managed zone
-	managedZone, err := a.getManagedZone(ctx, log, dns, dnsClient)
+	managedZone, err := a.getManagedZone(ctx, log, dns, cluster, config, dnsClient)
 	if err != nil {
 		return util.DetermineError(err, helper.KnownCodes)
 	}
 
+	// Ensure that an explicitly specified private managed zone is bound to the VPC network of the shoot
+	if isPrivateZone(config) && dns.Spec.Zone != nil && *dns.Spec.Zone != "" {
+		if err := a.validatePrivateManagedZone(ctx, dns, cluster, config, dnsClient, managedZone); err != nil {
+			return util.DetermineError(err, helper.KnownCodes)
+		}
+	}
+
 	// Create or update DNS recordset
-	ttl := extensionsv1alpha1helper.GetDNSRecordTTL(dns.Spec.TTL)
-	log.Info("Creating or updating DNS recordset", "managedZone", managedZone, "name", dns.Spec.Name, "type", dns.Spec.RecordType, "rrdatas", dns.Spec.Values, "dnsrecord", k8sclient.ObjectKeyFromObject(dns))
-	if err := dnsClient.CreateOrUpdateRecordSet(ctx, managedZone, dns.Spec.Name, string(dns.Spec.RecordType), dns.Spec.Values, ttl); err != nil {
-		return &reconcilerutils.RequeueAfterError{
-			Cause:        fmt.Errorf("could not create or update DNS recordset in managed zone %s with name %s, type %s, and rrdatas %v: %+v", managedZone, dns.Spec.Name, dns.Spec.RecordType, dns.Spec.Values, err),
-			RequeueAfter: requeueAfterOnProviderError,
+	if config.RoutingPolicy != nil {
+		routingPolicy := toRoutingPolicy(config.RoutingPolicy)
+		log.Info("Creating or updating DNS recordset with routing policy", "managedZone", managedZone, "name", dns.Spec.Name, "type", dns.Spec.RecordType, "routingPolicy", config.RoutingPolicy, "dnsrecord", k8sclient.ObjectKeyFromObject(dns))
+		if err := dnsClient.CreateOrUpdateRoutingPolicyRecordSet(ctx, managedZone, dns.Spec.Name, string(dns.Spec.RecordType), routingPolicy, ttl); err != nil {
+			return &reconcilerutils.RequeueAfterError{
+				Cause:        fmt.Errorf("could not create or update DNS recordset in managed zone %s with name %s, type %s, and routing policy: %+v", managedZone, dns.Spec.Name, dns.Spec.RecordType, err),
+				RequeueAfter: requeueAfterOnProviderError,
+			}
+		}
+	} else {
+		log.Info("Creating or updating DNS recordset", "managedZone", managedZone, "name", dns.Spec.Name, "type", dns.Spec.RecordType, "rrdatas", dns.Spec.Values, "dnsrecord", k8sclient.ObjectKeyFromObject(dns))
+		if err := dnsClient.CreateOrUpdateRecordSet(ctx, managedZone, dns.Spec.Name, string(dns.Spec.RecordType), dns.Spec.Values, ttl); err != nil {
+			return &reconcilerutils.RequeueAfterError{
+				Cause:        fmt.Errorf("could not create or update DNS recordset in managed zone %s with name %s, type %s, and rrdatas %v: %+v", managedZone, dns.Spec.Name, dns.Spec.RecordType, dns.Spec.Values, err),
+				RequeueAfter: requeueAfterOnProviderError,
+			}
 		}
 	}
 
@@ -74,7 +113,12 @@ func (a *actuator) Reconcile(ctx context.Context, log logr.Logger, dns *extensio
 }
 
 // Delete deletes the DNSRecord.
-func (a *actuator) Delete(ctx context.Context, log logr.Logger, dns *extensionsv1alpha1.DNSRecord, _ *extensionscontroller.Cluster) error {
+func (a *actuator) Delete(ctx context.Context, log logr.Logger, dns *extensionsv1alpha1.DNSRecord, cluster *extensionscontroller.Cluster) error {
+	config, err := helper.DNSRecordConfigFromDNSRecord(dns)
+	if err != nil {
+		return fmt.Errorf("could not decode provider config: %w", err)
+	}
+
 	// Create GCP DNS client
 	dnsClient, err := a.gcpClientFactory.DNS(ctx, a.client, dns.Spec.SecretRef)
 	if err != nil {
@@ -82,7 +126,7 @@ func (a *actuator) Delete(ctx context.Context, log logr.Logger, dns *extensionsv
 	}
 
 	// Determine DNS managed zone
-	managedZone, err := a.getManagedZone(ctx, log, dns, dnsClient)
+	managedZone, err := a.getManagedZone(ctx, log, dns, cluster, config, dnsClient)
 	if err != nil {
 		return util.DetermineError(err, helper.KnownCodes)
 	}
@@ -113,7 +157,7 @@ func (a *actuator) Migrate(context.Context, logr.Logger, *extensionsv1alpha1.DNS
 	return nil
 }
 
-func (a *actuator) getManagedZone(ctx context.Context, log logr.Logger, dns *extensionsv1alpha1.DNSRecord, dnsClient gcpclient.DNSClient) (string, error) {
+func (a *actuator) getManagedZone(ctx context.Context, log logr.Logger, dns *extensionsv1alpha1.DNSRecord, cluster *extensionscontroller.Cluster, config *apisgcp.DNSRecordConfig, dnsClient gcpclient.DNSClient) (string, error) {
 	switch {
 	case dns.Spec.Zone != nil && *dns.Spec.Zone != "":
 		return *dns.Spec.Zone, nil
@@ -122,7 +166,11 @@ func (a *actuator) getManagedZone(ctx context.Context, log logr.Logger, dns *ext
 	default:
 		// The zone is not specified in the resource status or spec. Try to determine the zone by
 		// getting all managed zones of the account and searching for the longest zone name that is a suffix of dns.spec.Name
-		zones, err := dnsClient.GetManagedZones(ctx)
+		filter, err := a.getManagedZoneFilter(ctx, cluster, config)
+		if err != nil {
+			return "", err
+		}
+		zones, err := dnsClient.GetManagedZones(ctx, filter)
 		if err != nil {
 			return "", &reconcilerutils.RequeueAfterError{
 				Cause:        fmt.Errorf("could not get DNS managed zones: %+v", err),
@@ -137,3 +185,99 @@ func (a *actuator) getManagedZone(ctx context.Context, log logr.Logger, dns *ext
 		return zone, nil
 	}
 }
+
+func (a *actuator) validatePrivateManagedZone(ctx context.Context, dns *extensionsv1alpha1.DNSRecord, cluster *extensionscontroller.Cluster, config *apisgcp.DNSRecordConfig, dnsClient gcpclient.DNSClient, managedZone string) error {
+	filter, err := a.getManagedZoneFilter(ctx, cluster, config)
+	if err != nil {
+		return err
+	}
+	zones, err := dnsClient.GetManagedZones(ctx, filter)
+	if err != nil {
+		return &reconcilerutils.RequeueAfterError{
+			Cause:        fmt.Errorf("could not get DNS managed zones: %+v", err),
+			RequeueAfter: requeueAfterOnProviderError,
+		}
+	}
+	// Managed zones can be specified either by their name or by their ID, composed of the project ID and their name.
+	if !slices.ContainsFunc(slices.Collect(maps.Values(zones)), func(zoneID string) bool {
+		return zoneID == managedZone || strings.HasSuffix(zoneID, "/"+managedZone)
+	}) {
+		return fmt.Errorf("DNS managed zone %s of DNSRecord %s is not a private managed zone bound to VPC network %s", managedZone, k8sclient.ObjectKeyFromObject(dns), filter.Network)
+	}
+	return nil
+}
+
+// getManagedZoneFilter returns the filter for the managed zones which may host the DNS record. Private managed zones
+// must be bound to the VPC network of the shoot.
+func (a *actuator) getManagedZoneFilter(ctx context.Context, cluster *extensionscontroller.Cluster, config *apisgcp.DNSRecordConfig) (gcpclient.ManagedZoneFilter, error) {
+	if !isPrivateZone(config) {
+		return gcpclient.ManagedZoneFilter{Visibility: apisgcp.DNSZoneVisibilityPublic}, nil
+	}
+
+	if cluster == nil || cluster.Shoot == nil {
+		return gcpclient.ManagedZoneFilter{}, fmt.Errorf("private DNS managed zones are only supported for DNS records of shoots")
+	}
+
+	infra := &extensionsv1alpha1.Infrastructure{}
+	if err := a.client.Get(ctx, k8sclient.ObjectKey{Namespace: cluster.ObjectMeta.Name, Name: cluster.Shoot.Name}, infra); err != nil {
+		return gcpclient.ManagedZoneFilter{}, fmt.Errorf("could not get infrastructure of shoot: %w", err)
+	}
+	infraStatus, err := helper.InfrastructureStatusFromRaw(infra.Status.ProviderStatus)
+	if err != nil {
+		return gcpclient.ManagedZoneFilter{}, fmt.Errorf("could not decode infrastructure status: %w", err)
+	}
+	if infraStatus.Networks.VPC.Name == "" {
+		return gcpclient.ManagedZoneFilter{}, fmt.Errorf("VPC network of shoot is not yet known")
+	}
+
+	return gcpclient.ManagedZoneFilter{
+		Visibility: apisgcp.DNSZoneVisibilityPrivate,
+		Network:    infraStatus.Networks.VPC.Name,
+	}, nil
+}
+
+// getTTL returns the TTL of the DNS record, i.e. the TTL of the provider config, `spec.ttl` or the default TTL of
+// 120 seconds. TTLs exceeding maxTTL are capped.
+func getTTL(log logr.Logger, dns *extensionsv1alpha1.DNSRecord, config *apisgcp.DNSRecordConfig) (int64, error) {
+	ttl := extensionsv1alpha1helper.GetDNSRecordTTL(dns.Spec.TTL)
+	if config != nil && config.TTL != nil {
+		ttl = *config.TTL
+	}
+
+	if ttl < 0 {
+		return 0, fmt.Errorf("invalid TTL %d of DNSRecord %s: must not be negative", ttl, k8sclient.ObjectKeyFromObject(dns))
+	}
+	if ttl > maxTTL {
+		log.Info("Capping TTL of DNS record", "ttl", ttl, "maxTTL", maxTTL, "dnsrecord", k8sclient.ObjectKeyFromObject(dns))
+		return maxTTL, nil
+	}
+	return ttl, nil
+}
+
+// toRoutingPolicy converts the given routing policy of the provider config into a Cloud DNS routing policy.
+func toRoutingPolicy(policy *apisgcp.DNSRoutingPolicy) *googledns.RRSetRoutingPolicy {
+	routingPolicy := &googledns.RRSetRoutingPolicy{}
+	if len(policy.WeightedRoundRobin) > 0 {
+		routingPolicy.Wrr = &googledns.RRSetRoutingPolicyWrrPolicy{}
+		for _, target := range policy.WeightedRoundRobin {
+			routingPolicy.Wrr.Items = append(routingPolicy.Wrr.Items, &googledns.RRSetRoutingPolicyWrrPolicyWrrPolicyItem{
+				Weight:  float64(target.Weight),
+				Rrdatas: target.Values,
+			})
+		}
+	}
+	if len(policy.Geo) > 0 {
+		routingPolicy.Geo = &googledns.RRSetRoutingPolicyGeoPolicy{}
+		for _, target := range policy.Geo {
+			routingPolicy.Geo.Items = append(routingPolicy.Geo.Items, &googledns.RRSetRoutingPolicyGeoPolicyGeoPolicyItem{
+				Location: target.Location,
+				Rrdatas:  target.Values,
+			})
+		}
+	}
+	return routingPolicy
+}
+
+func isPrivateZone(config *apisgcp.DNSRecordConfig) bool {
+	return config != nil && config.ZoneVisibility != nil && *config.ZoneVisibility == apisgcp.DNSZoneVisibilityPrivate
+}
@@ -6,23 +6,30 @@ package dnsrecord_test
 
 import (
 	"context"
+	"fmt"
 
+	extensionscontroller "github.com/gardener/gardener/extensions/pkg/controller"
 	"github.com/gardener/gardener/extensions/pkg/controller/dnsrecord"
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
 	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
 	mockclient "github.com/gardener/gardener/third_party/mock/controller-runtime/client"
 	mockmanager "github.com/gardener/gardener/third_party/mock/controller-runtime/manager"
 	"github.com/go-logr/logr"
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gstruct"
 	"go.uber.org/mock/gomock"
+	googledns "google.golang.org/api/dns/v1"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/utils/ptr"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/log"
 
 	. "github.com/gardener/gardener-extension-provider-gcp/pkg/controller/dnsrecord"
 	"github.com/gardener/gardener-extension-provider-gcp/pkg/gcp"
+	gcpclient "github.com/gardener/gardener-extension-provider-gcp/pkg/gcp/client"
 	mockgcpclient "github.com/gardener/gardener-extension-provider-gcp/pkg/gcp/client/mock"
 )
 
@@ -102,7 +109,7 @@ var _ = Describe("Actuator", func() {
 	Describe("#Reconcile", func() {
 		It("should reconcile the DNSRecord", func() {
 			gcpClientFactory.EXPECT().DNS(ctx, c, dns.Spec.SecretRef).Return(gcpDNSClient, nil)
-			gcpDNSClient.EXPECT().GetManagedZones(ctx).Return(zones, nil)
+			gcpDNSClient.EXPECT().GetManagedZones(ctx, gcpclient.ManagedZoneFilter{Visibility: "public"}).Return(zones, nil)
 			gcpDNSClient.EXPECT().CreateOrUpdateRecordSet(ctx, zone, domainName, string(extensionsv1alpha1.DNSRecordTypeA), []string{address}, int64(120)).Return(nil)
 			sw.EXPECT().Patch(ctx, gomock.AssignableToTypeOf(&extensionsv1alpha1.DNSRecord{}), gomock.Any()).DoAndReturn(
 				func(_ context.Context, obj *extensionsv1alpha1.DNSRecord, _ client.Patch, _ ...client.PatchOption) error {
@@ -116,6 +123,192 @@ var _ = Describe("Actuator", func() {
 			err := a.Reconcile(ctx, logger, dns, nil)
 			Expect(err).NotTo(HaveOccurred())
 		})
+
+		DescribeTable("should reconcile the DNSRecord with the determined TTL",
+			func(specTTL *int64, providerConfig string, expectedTTL int64) {
+				dns.Spec.TTL = specTTL
+				if providerConfig != "" {
+					dns.Spec.ProviderConfig = &runtime.RawExtension{Raw: []byte(providerConfig)}
+				}
+				gcpClientFactory.EXPECT().DNS(ctx, c, dns.Spec.SecretRef).Return(gcpDNSClient, nil)
+				gcpDNSClient.EXPECT().GetManagedZones(ctx, gcpclient.ManagedZoneFilter{Visibility: "public"}).Return(zones, nil)
+				gcpDNSClient.EXPECT().CreateOrUpdateRecordSet(ctx, zone, domainName, string(extensionsv1alpha1.DNSRecordTypeA), []string{address}, expectedTTL).Return(nil)
+				sw.EXPECT().Patch(ctx, gomock.AssignableToTypeOf(&extensionsv1alpha1.DNSRecord{}), gomock.Any()).Return(nil)
+
+				Expect(a.Reconcile(ctx, logger, dns, nil)).To(Succeed())
+			},
+			Entry("default TTL", nil, "", int64(120)),
+			Entry("TTL of the spec", ptr.To[int64](300), "", int64(300)),
+			Entry("zero TTL", ptr.To[int64](0), "", int64(0)),
+			Entry("TTL of the spec exceeding the maximum", ptr.To[int64](1000000), "", int64(604800)),
+			Entry("TTL of the provider config", ptr.To[int64](300), `{"apiVersion":"gcp.provider.extensions.gardener.cloud/v1alpha1","kind":"DNSRecordConfig","ttl":60}`, int64(60)),
+			Entry("TTL of the provider config exceeding the maximum", nil, `{"apiVersion":"gcp.provider.extensions.gardener.cloud/v1alpha1","kind":"DNSRecordConfig","ttl":1000000}`, int64(604800)),
+		)
+
+		Context("weighted round robin routing policy", func() {
+			wrrConfig := func(blueWeight, greenWeight int) *runtime.RawExtension {
+				return &runtime.RawExtension{Raw: []byte(fmt.Sprintf(`{"apiVersion":"gcp.provider.extensions.gardener.cloud/v1alpha1","kind":"DNSRecordConfig","routingPolicy":{"weightedRoundRobin":[{"weight":%d,"values":["1.2.3.4"]},{"weight":%d,"values":["5.6.7.8"]}]}}`, blueWeight, greenWeight))}
+			}
+			wrrPolicy := func(blueWeight, greenWeight float64) *googledns.RRSetRoutingPolicy {
+				return &googledns.RRSetRoutingPolicy{Wrr: &googledns.RRSetRoutingPolicyWrrPolicy{Items: []*googledns.RRSetRoutingPolicyWrrPolicyWrrPolicyItem{
+					{Weight: blueWeight, Rrdatas: []string{"1.2.3.4"}},
+					{Weight: greenWeight, Rrdatas: []string{"5.6.7.8"}},
+				}}}
+			}
+
+			It("should create and update the recordset with the weighted targets", func() {
+				dns.Spec.ProviderConfig = wrrConfig(90, 10)
+				gcpClientFactory.EXPECT().DNS(ctx, c, dns.Spec.SecretRef).Return(gcpDNSClient, nil)
+				gcpDNSClient.EXPECT().GetManagedZones(ctx, gcpclient.ManagedZoneFilter{Visibility: "public"}).Return(zones, nil)
+				gcpDNSClient.EXPECT().CreateOrUpdateRoutingPolicyRecordSet(ctx, zone, domainName, string(extensionsv1alpha1.DNSRecordTypeA), wrrPolicy(90, 10), int64(120)).Return(nil)
+				sw.EXPECT().Patch(ctx, gomock.AssignableToTypeOf(&extensionsv1alpha1.DNSRecord{}), gomock.Any()).Return(nil)
+
+				Expect(a.Reconcile(ctx, logger, dns, nil)).To(Succeed())
+
+				dns.Status.Zone = ptr.To(zone)
+				dns.Spec.ProviderConfig = wrrConfig(50, 50)
+				gcpClientFactory.EXPECT().DNS(ctx, c, dns.Spec.SecretRef).Return(gcpDNSClient, nil)
+				gcpDNSClient.EXPECT().CreateOrUpdateRoutingPolicyRecordSet(ctx, zone, domainName, string(extensionsv1alpha1.DNSRecordTypeA), wrrPolicy(50, 50), int64(120)).Return(nil)
+				sw.EXPECT().Patch(ctx, gomock.AssignableToTypeOf(&extensionsv1alpha1.DNSRecord{}), gomock.Any()).Return(nil)
+
+				Expect(a.Reconcile(ctx, logger, dns, nil)).To(Succeed())
+			})
+
+			It("should fail if the weights are invalid", func() {
+				dns.Spec.ProviderConfig = wrrConfig(0, 0)
+
+				err := a.Reconcile(ctx, logger, dns, nil)
+				Expect(err).To(MatchError(ContainSubstring("the sum of the weights must be greater than 0")))
+			})
+
+			It("should delete the recordset with the routing policy", func() {
+				dns.Spec.ProviderConfig = wrrConfig(90, 10)
+				dns.Status.Zone = ptr.To(zone)
+				gcpClientFactory.EXPECT().DNS(ctx, c, dns.Spec.SecretRef).Return(gcpDNSClient, nil)
+				gcpDNSClient.EXPECT().DeleteRecordSet(ctx, zone, domainName, string(extensionsv1alpha1.DNSRecordTypeA)).Return(nil)
+
+				Expect(a.Delete(ctx, logger, dns, nil)).To(Succeed())
+			})
+		})
+
+		Context("geo routing policy", func() {
+			geoConfig := func(europeValue string) *runtime.RawExtension {
+				return &runtime.RawExtension{Raw: []byte(fmt.Sprintf(`{"apiVersion":"gcp.provider.extensions.gardener.cloud/v1alpha1","kind":"DNSRecordConfig","routingPolicy":{"geo":[{"location":"europe-west1","values":[%q]},{"location":"us-east1","values":["5.6.7.8"]}]}}`, europeValue))}
+			}
+			geoPolicy := func(europeValue string) *googledns.RRSetRoutingPolicy {
+				return &googledns.RRSetRoutingPolicy{Geo: &googledns.RRSetRoutingPolicyGeoPolicy{Items: []*googledns.RRSetRoutingPolicyGeoPolicyGeoPolicyItem{
+					{Location: "europe-west1", Rrdatas: []string{europeValue}},
+					{Location: "us-east1", Rrdatas: []string{"5.6.7.8"}},
+				}}}
+			}
+
+			It("should create, update and delete the recordset with the regional targets", func() {
+				dns.Spec.ProviderConfig = geoConfig("1.2.3.4")
+				gcpClientFactory.EXPECT().DNS(ctx, c, dns.Spec.SecretRef).Return(gcpDNSClient, nil)
+				gcpDNSClient.EXPECT().GetManagedZones(ctx, gcpclient.ManagedZoneFilter{Visibility: "public"}).Return(zones, nil)
+				gcpDNSClient.EXPECT().CreateOrUpdateRoutingPolicyRecordSet(ctx, zone, domainName, string(extensionsv1alpha1.DNSRecordTypeA), geoPolicy("1.2.3.4"), int64(120)).Return(nil)
+				sw.EXPECT().Patch(ctx, gomock.AssignableToTypeOf(&extensionsv1alpha1.DNSRecord{}), gomock.Any()).Return(nil)
+
+				Expect(a.Reconcile(ctx, logger, dns, nil)).To(Succeed())
+
+				dns.Status.Zone = ptr.To(zone)
+				dns.Spec.ProviderConfig = geoConfig("1.2.3.5")
+				gcpClientFactory.EXPECT().DNS(ctx, c, dns.Spec.SecretRef).Return(gcpDNSClient, nil)
+				gcpDNSClient.EXPECT().CreateOrUpdateRoutingPolicyRecordSet(ctx, zone, domainName, string(extensionsv1alpha1.DNSRecordTypeA), geoPolicy("1.2.3.5"), int64(120)).Return(nil)
+				sw.EXPECT().Patch(ctx, gomock.AssignableToTypeOf(&extensionsv1alpha1.DNSRecord{}), gomock.Any()).Return(nil)
+
+				Expect(a.Reconcile(ctx, logger, dns, nil)).To(Succeed())
+
+				gcpClientFactory.EXPECT().DNS(ctx, c, dns.Spec.SecretRef).Return(gcpDNSClient, nil)
+				gcpDNSClient.EXPECT().DeleteRecordSet(ctx, zone, domainName, string(extensionsv1alpha1.DNSRecordTypeA)).Return(nil)
+
+				Expect(a.Delete(ctx, logger, dns, nil)).To(Succeed())
+			})
+
+			It("should fail if a location is unknown", func() {
+				dns.Spec.ProviderConfig = &runtime.RawExtension{Raw: []byte(`{"apiVersion":"gcp.provider.extensions.gardener.cloud/v1alpha1","kind":"DNSRecordConfig","routingPolicy":{"geo":[{"location":"europe","values":["1.2.3.4"]}]}}`)}
+
+				err := a.Reconcile(ctx, logger, dns, nil)
+				Expect(err).To(MatchError(ContainSubstring(`spec.providerConfig.routingPolicy.geo[0].location: Unsupported value: "europe"`)))
+			})
+		})
+
+		It("should fail if the TTL is negative", func() {
+			dns.Spec.TTL = ptr.To[int64](-1)
+
+			err := a.Reconcile(ctx, logger, dns, nil)
+			Expect(err).To(MatchError("invalid TTL -1 of DNSRecord shoot--foobar--gcp/dnsrecord-external: must not be negative"))
+		})
+
+		It("should fail if the provider config is invalid", func() {
+			dns.Spec.ProviderConfig = &runtime.RawExtension{
+				Raw: []byte(`{"apiVersion":"gcp.provider.extensions.gardener.cloud/v1alpha1","kind":"DNSRecordConfig","zoneVisibility":"internal"}`),
+			}
+
+			err := a.Reconcile(ctx, logger, dns, nil)
+			Expect(err).To(MatchError(ContainSubstring("spec.providerConfig.zoneVisibility: Unsupported value")))
+		})
+
+		Context("private managed zones", func() {
+			var (
+				cluster       *extensionscontroller.Cluster
+				privateFilter gcpclient.ManagedZoneFilter
+			)
+
+			BeforeEach(func() {
+				dns.Spec.ProviderConfig = &runtime.RawExtension{
+					Raw: []byte(`{"apiVersion":"gcp.provider.extensions.gardener.cloud/v1alpha1","kind":"DNSRecordConfig","zoneVisibility":"private"}`),
+				}
+				cluster = &extensionscontroller.Cluster{
+					ObjectMeta: metav1.ObjectMeta{Name: namespace},
+					Shoot:      &gardencorev1beta1.Shoot{ObjectMeta: metav1.ObjectMeta{Name: "foobar"}},
+				}
+				privateFilter = gcpclient.ManagedZoneFilter{Visibility: "private", Network: "shoot-vpc"}
+
+				c.EXPECT().Get(ctx, client.ObjectKey{Namespace: namespace, Name: "foobar"}, gomock.AssignableToTypeOf(&extensionsv1alpha1.Infrastructure{})).DoAndReturn(
+					func(_ context.Context, _ client.ObjectKey, obj *extensionsv1alpha1.Infrastructure, _ ...client.GetOption) error {
+						obj.Status.ProviderStatus = &runtime.RawExtension{
+							Raw: []byte(`{"apiVersion":"gcp.provider.extensions.gardener.cloud/v1alpha1","kind":"InfrastructureStatus","networks":{"vpc":{"name":"shoot-vpc"}}}`),
+						}
+						return nil
+					},
+				)
+			})
+
+			It("should select the private managed zone bound to the VPC network if a public zone has the same DNS name", func() {
+				gcpClientFactory.EXPECT().DNS(ctx, c, dns.Spec.SecretRef).Return(gcpDNSClient, nil)
+				// Only the private zone matches the filter, the public zone "zone" for the same DNS name is not returned.
+				gcpDNSClient.EXPECT().GetManagedZones(ctx, privateFilter).Return(map[string]string{shootDomain: "project/private-zone"}, nil)
+				gcpDNSClient.EXPECT().CreateOrUpdateRecordSet(ctx, "project/private-zone", domainName, string(extensionsv1alpha1.DNSRecordTypeA), []string{address}, int64(120)).Return(nil)
+				sw.EXPECT().Patch(ctx, gomock.AssignableToTypeOf(&extensionsv1alpha1.DNSRecord{}), gomock.Any()).DoAndReturn(
+					func(_ context.Context, obj *extensionsv1alpha1.DNSRecord, _ client.Patch, _ ...client.PatchOption) error {
+						Expect(obj.Status.Zone).To(PointTo(Equal("project/private-zone")))
+						return nil
+					},
+				)
+
+				Expect(a.Reconcile(ctx, logger, dns, cluster)).To(Succeed())
+			})
+
+			It("should accept a specified private managed zone bound to the VPC network", func() {
+				dns.Spec.Zone = ptr.To("private-zone")
+				gcpClientFactory.EXPECT().DNS(ctx, c, dns.Spec.SecretRef).Return(gcpDNSClient, nil)
+				gcpDNSClient.EXPECT().GetManagedZones(ctx, privateFilter).Return(map[string]string{shootDomain: "project/private-zone"}, nil)
+				gcpDNSClient.EXPECT().CreateOrUpdateRecordSet(ctx, "private-zone", domainName, string(extensionsv1alpha1.DNSRecordTypeA), []string{address}, int64(120)).Return(nil)
+				sw.EXPECT().Patch(ctx, gomock.AssignableToTypeOf(&extensionsv1alpha1.DNSRecord{}), gomock.Any()).Return(nil)
+
+				Expect(a.Reconcile(ctx, logger, dns, cluster)).To(Succeed())
+			})
+
+			It("should reject a specified managed zone which is not bound to the VPC network", func() {
+				dns.Spec.Zone = ptr.To(zone)
+				gcpClientFactory.EXPECT().DNS(ctx, c, dns.Spec.SecretRef).Return(gcpDNSClient, nil)
+				gcpDNSClient.EXPECT().GetManagedZones(ctx, privateFilter).Return(map[string]string{shootDomain: "project/private-zone"}, nil)
+
+				err := a.Reconcile(ctx, logger, dns, cluster)
+				Expect(err).To(MatchError(ContainSubstring("is not a private managed zone bound to VPC network shoot-vpc")))
+			})
+		})
 	})
 
 	Describe("#Delete", func() {
@@ -0,0 +1,87 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package healthcheck
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/gardener/gardener/extensions/pkg/controller/healthcheck"
+	"github.com/gardener/gardener/extensions/pkg/controller/healthcheck/general"
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	"github.com/go-logr/logr"
+	appsv1 "k8s.io/api/apps/v1"
+	storagev1 "k8s.io/api/storage/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	"github.com/gardener/gardener-extension-provider-gcp/pkg/gcp"
+)
+
+// CSINodeHealthChecker checks that the CSI driver is available on the nodes of the shoot, i.e. that the csi-driver-node
+// DaemonSet is healthy and that the CSIDriver is registered.
+type CSINodeHealthChecker struct {
+	logger      logr.Logger
+	shootClient client.Client
+}
+
+// NewCSINodeHealthChecker returns a health check for the CSI driver on the nodes of the shoot.
+func NewCSINodeHealthChecker() healthcheck.HealthCheck {
+	return &CSINodeHealthChecker{}
+}
+
+// InjectShootClient injects the shoot client.
+func (c *CSINodeHealthChecker) InjectShootClient(shootClient client.Client) {
+	c.shootClient = shootClient
+}
+
+// SetLoggerSuffix injects the logger.
+func (c *CSINodeHealthChecker) SetLoggerSuffix(provider, extension string) {
+	c.logger = log.Log.WithName(fmt.Sprintf("%s-%s-healthcheck-csi-node", provider, extension))
+}
+
+// DeepCopy clones the health check.
+func (c *CSINodeHealthChecker) DeepCopy() healthcheck.HealthCheck {
+	shallowCopy := *c
+	return &shallowCopy
+}
+
+// Check executes the health check.
+func (c *CSINodeHealthChecker) Check(ctx context.Context, _ types.NamespacedName) (*healthcheck.SingleCheckResult, error) {
+	daemonSet := &appsv1.DaemonSet{}
+	if err := c.shootClient.Get(ctx, client.ObjectKey{Namespace: metav1.NamespaceSystem, Name: gcp.CSINodeName}, daemonSet); err != nil {
+		if apierrors.IsNotFound(err) {
+			return &healthcheck.SingleCheckResult{
+				Status: gardencorev1beta1.ConditionFalse,
+				Detail: fmt.Sprintf("DaemonSet %q in namespace %q not found", gcp.CSINodeName, metav1.NamespaceSystem),
+			}, nil
+		}
+		return nil, fmt.Errorf("failed to retrieve DaemonSet %q in namespace %q: %w", gcp.CSINodeName, metav1.NamespaceSystem, err)
+	}
+	if isHealthy, err := general.DaemonSetIsHealthy(daemonSet); !isHealthy {
+		c.logger.Error(err, "Health check failed")
+		return &healthcheck.SingleCheckResult{
+			Status: gardencorev1beta1.ConditionFalse,
+			Detail: err.Error(),
+		}, nil
+	}
+
+	if err := c.shootClient.Get(ctx, client.ObjectKey{Name: gcp.CSIStorageProvisioner}, &storagev1.CSIDriver{}); err != nil {
+		if apierrors.IsNotFound(err) {
+			return &healthcheck.SingleCheckResult{
+				Status: gardencorev1beta1.ConditionFalse,
+				Detail: fmt.Sprintf("CSIDriver %q is not registered", gcp.CSIStorageProvisioner),
+			}, nil
+		}
+		return nil, fmt.Errorf("failed to retrieve CSIDriver %q: %w", gcp.CSIStorageProvisioner, err)
+	}
+
+	return &healthcheck.SingleCheckResult{
+		Status: gardencorev1beta1.ConditionTrue,
+	}, nil
+}
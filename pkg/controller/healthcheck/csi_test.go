@@ -0,0 +1,94 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package healthcheck_test
+
+import (
+	"context"
+
+	"github.com/gardener/gardener/extensions/pkg/controller/healthcheck"
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	appsv1 "k8s.io/api/apps/v1"
+	storagev1 "k8s.io/api/storage/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	. "github.com/gardener/gardener-extension-provider-gcp/pkg/controller/healthcheck"
+	"github.com/gardener/gardener-extension-provider-gcp/pkg/gcp"
+)
+
+var _ = Describe("CSINodeHealthChecker", func() {
+	var (
+		ctx         = context.Background()
+		request     = types.NamespacedName{Namespace: "shoot--foo--bar", Name: "control-plane"}
+		shootClient client.Client
+		checker     healthcheck.HealthCheck
+
+		daemonSet *appsv1.DaemonSet
+		csiDriver *storagev1.CSIDriver
+	)
+
+	BeforeEach(func() {
+		shootClient = fakeclient.NewClientBuilder().WithScheme(kubernetes.ShootScheme).Build()
+		checker = NewCSINodeHealthChecker()
+		healthcheck.ShootClientInto(shootClient, checker)
+		checker.SetLoggerSuffix("provider-gcp", "controlplane")
+
+		daemonSet = &appsv1.DaemonSet{
+			ObjectMeta: metav1.ObjectMeta{Name: gcp.CSINodeName, Namespace: metav1.NamespaceSystem, Generation: 1},
+			Status: appsv1.DaemonSetStatus{
+				ObservedGeneration:     1,
+				DesiredNumberScheduled: 3,
+				CurrentNumberScheduled: 3,
+				UpdatedNumberScheduled: 3,
+				NumberAvailable:        3,
+			},
+		}
+		csiDriver = &storagev1.CSIDriver{ObjectMeta: metav1.ObjectMeta{Name: gcp.CSIStorageProvisioner}}
+	})
+
+	It("should succeed if the DaemonSet is ready and the CSIDriver is registered", func() {
+		Expect(shootClient.Create(ctx, daemonSet)).To(Succeed())
+		Expect(shootClient.Create(ctx, csiDriver)).To(Succeed())
+
+		result, err := checker.Check(ctx, request)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(result.Status).To(Equal(gardencorev1beta1.ConditionTrue))
+	})
+
+	It("should fail if the DaemonSet does not exist", func() {
+		Expect(shootClient.Create(ctx, csiDriver)).To(Succeed())
+
+		result, err := checker.Check(ctx, request)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(result.Status).To(Equal(gardencorev1beta1.ConditionFalse))
+		Expect(result.Detail).To(ContainSubstring("not found"))
+	})
+
+	It("should fail if the DaemonSet is not ready", func() {
+		daemonSet.Status.NumberAvailable = 2
+		daemonSet.Status.NumberUnavailable = 1
+		Expect(shootClient.Create(ctx, daemonSet)).To(Succeed())
+		Expect(shootClient.Create(ctx, csiDriver)).To(Succeed())
+
+		result, err := checker.Check(ctx, request)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(result.Status).To(Equal(gardencorev1beta1.ConditionFalse))
+		Expect(result.Detail).To(ContainSubstring(gcp.CSINodeName))
+	})
+
+	It("should fail if the CSIDriver is not registered", func() {
+		Expect(shootClient.Create(ctx, daemonSet)).To(Succeed())
+
+		result, err := checker.Check(ctx, request)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(result.Status).To(Equal(gardencorev1beta1.ConditionFalse))
+		Expect(result.Detail).To(Equal(`CSIDriver "pd.csi.storage.gke.io" is not registered`))
+	})
+})
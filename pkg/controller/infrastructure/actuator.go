@@ -16,21 +16,30 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/manager"
 
+	controllerconfig "github.com/gardener/gardener-extension-provider-gcp/pkg/apis/config"
 	"github.com/gardener/gardener-extension-provider-gcp/pkg/apis/gcp/v1alpha1"
+	"github.com/gardener/gardener-extension-provider-gcp/pkg/controller/infrastructure/infraflow/shared"
+	gcpclient "github.com/gardener/gardener-extension-provider-gcp/pkg/gcp/client"
 )
 
 type actuator struct {
 	client                     client.Client
 	restConfig                 *rest.Config
 	disableProjectedTokenMount bool
+	concurrencyLimiter         *shared.ConcurrencyLimiter
+	cloudNATDefaults           *controllerconfig.CloudNAT
+	gcpClientFactory           gcpclient.Factory
 }
 
 // NewActuator creates a new infrastructure.Actuator.
-func NewActuator(mgr manager.Manager, disableProjectedTokenMount bool) infrastructure.Actuator {
+func NewActuator(mgr manager.Manager, disableProjectedTokenMount bool, concurrencyLimiter *shared.ConcurrencyLimiter, cloudNATDefaults *controllerconfig.CloudNAT, gcpClientFactory gcpclient.Factory) infrastructure.Actuator {
 	return &actuator{
 		client:                     mgr.GetClient(),
 		restConfig:                 mgr.GetConfig(),
 		disableProjectedTokenMount: disableProjectedTokenMount,
+		concurrencyLimiter:         concurrencyLimiter,
+		cloudNATDefaults:           cloudNATDefaults,
+		gcpClientFactory:           gcpClientFactory,
 	}
 }
 
@@ -13,7 +13,9 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/log"
 	"sigs.k8s.io/controller-runtime/pkg/manager"
 
+	"github.com/gardener/gardener-extension-provider-gcp/pkg/apis/config"
 	"github.com/gardener/gardener-extension-provider-gcp/pkg/apis/gcp/helper"
+	"github.com/gardener/gardener-extension-provider-gcp/pkg/controller/infrastructure/infraflow/shared"
 	"github.com/gardener/gardener-extension-provider-gcp/pkg/gcp"
 	gcpclient "github.com/gardener/gardener-extension-provider-gcp/pkg/gcp/client"
 )
@@ -34,14 +36,18 @@ type AddOptions struct {
 	DisableProjectedTokenMount bool
 	// ExtensionClass defines the extension class this extension is responsible for.
 	ExtensionClass extensionsv1alpha1.ExtensionClass
+	// Infrastructure is the configuration for the infrastructure controller.
+	Infrastructure config.Infrastructure
+	// GCPClientFactory is the factory for the GCP clients of the controller.
+	GCPClientFactory gcpclient.Factory
 }
 
 // AddToManagerWithOptions adds a controller with the given AddOptions to the given manager.
 // The opts.Reconciler is being set with a newly instantiated actuator.
 func AddToManagerWithOptions(ctx context.Context, mgr manager.Manager, opts AddOptions) error {
 	return infrastructure.Add(ctx, mgr, infrastructure.AddArgs{
-		Actuator:          NewActuator(mgr, opts.DisableProjectedTokenMount),
-		ConfigValidator:   NewConfigValidator(mgr, log.Log, gcpclient.New()),
+		Actuator:          NewActuator(mgr, opts.DisableProjectedTokenMount, shared.NewConcurrencyLimiter(opts.Infrastructure.ConcurrencyLimits), opts.Infrastructure.CloudNAT, opts.GCPClientFactory),
+		ConfigValidator:   NewConfigValidator(mgr, log.Log, opts.GCPClientFactory),
 		ControllerOptions: opts.Controller,
 		Predicates:        infrastructure.DefaultPredicates(ctx, mgr, opts.IgnoreOperationAnnotation),
 		Type:              gcp.Type,
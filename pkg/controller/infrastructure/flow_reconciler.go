@@ -8,16 +8,22 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"strings"
 
 	"github.com/gardener/gardener/extensions/pkg/controller"
 	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
 	"github.com/go-logr/logr"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/client-go/rest"
+	"k8s.io/utils/ptr"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
+	controllerconfig "github.com/gardener/gardener-extension-provider-gcp/pkg/apis/config"
 	"github.com/gardener/gardener-extension-provider-gcp/pkg/apis/gcp"
+	"github.com/gardener/gardener-extension-provider-gcp/pkg/apis/gcp/helper"
 	"github.com/gardener/gardener-extension-provider-gcp/pkg/controller/infrastructure/infraflow"
+	"github.com/gardener/gardener-extension-provider-gcp/pkg/controller/infrastructure/infraflow/shared"
+	"github.com/gardener/gardener-extension-provider-gcp/pkg/features"
 	gcpinternal "github.com/gardener/gardener-extension-provider-gcp/pkg/gcp"
 	gcpclient "github.com/gardener/gardener-extension-provider-gcp/pkg/gcp/client"
 	"github.com/gardener/gardener-extension-provider-gcp/pkg/internal"
@@ -30,15 +36,21 @@ type FlowReconciler struct {
 	restConfig                 *rest.Config
 	log                        logr.Logger
 	disableProjectedTokenMount bool
+	concurrencyLimiter         *shared.ConcurrencyLimiter
+	cloudNATDefaults           *controllerconfig.CloudNAT
+	gcpClientFactory           gcpclient.Factory
 }
 
 // NewFlowReconciler creates a new flow reconciler.
-func NewFlowReconciler(client client.Client, restConfig *rest.Config, log logr.Logger, projToken bool) (Reconciler, error) {
+func NewFlowReconciler(client client.Client, restConfig *rest.Config, log logr.Logger, projToken bool, concurrencyLimiter *shared.ConcurrencyLimiter, cloudNATDefaults *controllerconfig.CloudNAT, gcpClientFactory gcpclient.Factory) (Reconciler, error) {
 	return &FlowReconciler{
 		client:                     client,
 		restConfig:                 restConfig,
 		log:                        log,
 		disableProjectedTokenMount: projToken,
+		concurrencyLimiter:         concurrencyLimiter,
+		cloudNATDefaults:           cloudNATDefaults,
+		gcpClientFactory:           gcpClientFactory,
 	}, nil
 }
 
@@ -62,6 +74,12 @@ func (f *FlowReconciler) Reconcile(ctx context.Context, infra *extensionsv1alpha
 		if err != nil {
 			return err
 		}
+	} else if ptr.Deref(hasBoolAnnotation(infra, gcpinternal.AnnotationKeyMigrateVerify), false) {
+		// in the migrate-verify mode, the migration only happens if the live resources match the terraform state.
+		infraState, err = f.verifyMigrationFromTerraform(ctx, infra, cluster)
+		if err != nil {
+			return err
+		}
 	} else {
 		// otherwise migrate it from the terraform state if needed.
 		infraState, err = f.migrateFromTerraform(ctx, infra)
@@ -81,11 +99,13 @@ func (f *FlowReconciler) Reconcile(ctx context.Context, infra *extensionsv1alpha
 		State:          infraState,
 		Cluster:        cluster,
 		ServiceAccount: serviceAccount,
-		Factory:        gcpclient.New(),
+		Factory:        f.gcpClientFactory,
 		Client:         f.client,
 		PersistFunc: func(ctx context.Context, state *runtime.RawExtension) error {
 			return patchProviderStatusAndState(ctx, f.client, infra, nil, state)
 		},
+		ConcurrencyLimiter: f.concurrencyLimiter,
+		CloudNATDefaults:   f.cloudNATDefaults,
 	})
 	if err != nil {
 		return fmt.Errorf("failed to create flow context: %v", err)
@@ -114,15 +134,19 @@ func (f *FlowReconciler) Delete(ctx context.Context, infra *extensionsv1alpha1.I
 	if err != nil {
 		return err
 	}
-	gc := gcpclient.New()
 	fctx, err := infraflow.NewFlowContext(ctx, infraflow.Opts{
 		Log:            f.log,
 		Infra:          infra,
 		Cluster:        cluster,
 		ServiceAccount: serviceAccount,
-		Factory:        gc,
+		Factory:        f.gcpClientFactory,
 		Client:         f.client,
 		State:          infraState,
+		PersistFunc: func(ctx context.Context, state *runtime.RawExtension) error {
+			return patchProviderStatusAndState(ctx, f.client, infra, nil, state)
+		},
+		ConcurrencyLimiter: f.concurrencyLimiter,
+		CloudNATDefaults:   f.cloudNATDefaults,
 	})
 	if err != nil {
 		return fmt.Errorf("failed to create flow context: %v", err)
@@ -197,3 +221,77 @@ func (f *FlowReconciler) migrateFromTerraform(ctx context.Context, infra *extens
 	}
 	return state, nil
 }
+
+// stateFromTerraform translates the terraform state of the infrastructure into the equivalent flow state. In addition,
+// it returns the GCP resources which are tracked by the terraform state. It is only used by the verified migration.
+func (f *FlowReconciler) stateFromTerraform(ctx context.Context, infra *extensionsv1alpha1.Infrastructure) (*gcp.InfrastructureState, []infraflow.Resource, error) {
+	tf, err := internal.NewTerraformer(f.log, f.restConfig, infrainternal.TerraformerPurpose, infra, f.disableProjectedTokenMount)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// nothing to do if state is empty
+	if tf.IsStateEmpty(ctx) {
+		return &gcp.InfrastructureState{Data: map[string]string{}}, nil, nil
+	}
+
+	rawState, err := tf.GetRawState(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+	tfState, err := shared.UnmarshalTerraformStateFromTerraformer(rawState)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// we want to prevent allowing the deletion of infrastructure if there may be still resources in the cloudprovider,
+	// hence the state marks that infra resources were created if terraform had created any resources. In addition, it
+	// keeps track of a created service account.
+	state, resources := infraflow.StateFromTerraform(tfState)
+	return state, resources, nil
+}
+
+// verifyMigrationFromTerraform computes the flow state which is equivalent to the terraform state of the infrastructure
+// and diffs the live GCP resources against the resources tracked by the terraform state and the resources managed by
+// the flow. The computed state is only returned if there are no discrepancies.
+func (f *FlowReconciler) verifyMigrationFromTerraform(ctx context.Context, infra *extensionsv1alpha1.Infrastructure, cluster *controller.Cluster) (*gcp.InfrastructureState, error) {
+	state, tfResources, err := f.stateFromTerraform(ctx, infra)
+	if err != nil {
+		return nil, err
+	}
+	if len(state.Data) == 0 {
+		return state, nil
+	}
+
+	config, err := helper.InfrastructureConfigFromInfrastructure(infra)
+	if err != nil {
+		return nil, err
+	}
+	withServiceAccount := !features.ExtensionFeatureGate.Enabled(features.DisableGardenerServiceAccountCreation) ||
+		state.Data[infraflow.CreatedServiceAccountKey] != ""
+	flowResources := infraflow.ExpectedResources(cluster.ObjectMeta.Name, config, withServiceAccount)
+
+	computeClient, err := f.gcpClientFactory.Compute(ctx, f.client, infra.Spec.SecretRef)
+	if err != nil {
+		return nil, err
+	}
+	iamClient, err := f.gcpClientFactory.IAM(ctx, f.client, infra.Spec.SecretRef)
+	if err != nil {
+		return nil, err
+	}
+
+	discrepancies, err := infraflow.VerifyMigration(ctx, computeClient, iamClient, infra.Spec.Region, tfResources, flowResources)
+	if err != nil {
+		return nil, err
+	}
+	if len(discrepancies) > 0 {
+		var messages []string
+		for _, d := range discrepancies {
+			messages = append(messages, d.String())
+		}
+		return nil, fmt.Errorf("refusing to migrate infrastructure from terraform, found %d discrepancies: %s", len(discrepancies), strings.Join(messages, "; "))
+	}
+
+	f.log.Info("Verified migration of infrastructure from terraform", "resources", len(tfResources))
+	return state, nil
+}
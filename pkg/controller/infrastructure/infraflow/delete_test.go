@@ -0,0 +1,256 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package infraflow
+
+import (
+	"context"
+	"errors"
+	"net/http"
+
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	"github.com/go-logr/logr"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gstruct"
+	"go.uber.org/mock/gomock"
+	"google.golang.org/api/cloudresourcemanager/v1"
+	"google.golang.org/api/compute/v1"
+	"google.golang.org/api/googleapi"
+	"google.golang.org/api/iam/v1"
+
+	"github.com/gardener/gardener-extension-provider-gcp/pkg/apis/gcp"
+	"github.com/gardener/gardener-extension-provider-gcp/pkg/controller/infrastructure/infraflow/shared"
+	gcpclient "github.com/gardener/gardener-extension-provider-gcp/pkg/gcp/client"
+	mockgcpclient "github.com/gardener/gardener-extension-provider-gcp/pkg/gcp/client/mock"
+)
+
+var _ = Describe("Delete", func() {
+	const (
+		clusterName = "shoot--foo--bar"
+		region      = "europe-west1"
+		network     = "https://www.googleapis.com/compute/v1/projects/project/global/networks/" + clusterName
+		otherNet    = "https://www.googleapis.com/compute/v1/projects/project/global/networks/" + clusterName + "-2"
+	)
+
+	var (
+		ctx           context.Context
+		ctrl          *gomock.Controller
+		computeClient *mockgcpclient.MockComputeClient
+		fctx          *FlowContext
+	)
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		ctrl = gomock.NewController(GinkgoT())
+		computeClient = mockgcpclient.NewMockComputeClient(ctrl)
+
+		fctx = &FlowContext{
+			infra: &extensionsv1alpha1.Infrastructure{
+				Spec: extensionsv1alpha1.InfrastructureSpec{Region: region},
+			},
+			config:        &gcp.InfrastructureConfig{},
+			state:         &gcp.InfrastructureState{Data: map[string]string{CreatedResourcesExistKey: "true"}},
+			clusterName:   clusterName,
+			whiteboard:    shared.NewWhiteboard(),
+			log:           logr.Discard(),
+			computeClient: computeClient,
+		}
+	})
+
+	AfterEach(func() {
+		ctrl.Finish()
+	})
+
+	It("should delete legacy and current resources of a Gardener-managed VPC", func() {
+		firewalls := []*compute.Firewall{
+			{Name: clusterName + "-allow-internal-access", Network: network},
+			{Name: "legacy-allow-ssh", Network: network},
+			{Name: "other-allow-ssh", Network: otherNet},
+		}
+		routers := []*compute.Router{
+			{Name: clusterName + "-cloud-router", Network: network},
+			{Name: clusterName + "-router", Network: network},
+			{Name: "other-router", Network: otherNet},
+		}
+		subnets := []*compute.Subnetwork{
+			{Name: clusterName + "-nodes", Network: network},
+			{Name: clusterName + "-internal", Network: network},
+			{Name: clusterName + "-proxy-only", Network: network},
+			{Name: clusterName + "-workers", Network: network},
+			{Name: "other-nodes", Network: otherNet},
+		}
+
+		computeClient.EXPECT().ListRoutes(gomock.Any(), gomock.Any()).Return(nil, nil)
+		computeClient.EXPECT().ListFirewallRules(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, opts gcpclient.FirewallListOpts) ([]*compute.Firewall, error) {
+			return filter(firewalls, opts.ClientFilter), nil
+		})
+		computeClient.EXPECT().ListRouters(gomock.Any(), region, gomock.Any()).DoAndReturn(func(_ context.Context, _ string, opts gcpclient.RouterListOpts) ([]*compute.Router, error) {
+			return filter(routers, opts.ClientFilter), nil
+		})
+		computeClient.EXPECT().ListSubnets(gomock.Any(), region, gomock.Any()).DoAndReturn(func(_ context.Context, _ string, opts gcpclient.SubnetListOpts) ([]*compute.Subnetwork, error) {
+			return filter(subnets, opts.ClientFilter), nil
+		})
+
+		computeClient.EXPECT().DeleteFirewallRule(gomock.Any(), clusterName+"-allow-internal-access")
+		computeClient.EXPECT().DeleteFirewallRule(gomock.Any(), "legacy-allow-ssh")
+		legacyRouterDeleted := computeClient.EXPECT().DeleteRouter(gomock.Any(), region, clusterName+"-router")
+		computeClient.EXPECT().DeleteRouter(gomock.Any(), region, clusterName+"-cloud-router")
+		computeClient.EXPECT().DeleteSubnet(gomock.Any(), region, clusterName+"-workers").After(legacyRouterDeleted)
+		computeClient.EXPECT().DeleteSubnet(gomock.Any(), region, clusterName+"-nodes")
+		computeClient.EXPECT().DeleteSubnet(gomock.Any(), region, clusterName+"-internal")
+		computeClient.EXPECT().DeleteSubnet(gomock.Any(), region, clusterName+"-proxy-only")
+		computeClient.EXPECT().DeleteNetwork(gomock.Any(), clusterName)
+
+		Expect(fctx.Delete(ctx)).To(Succeed())
+	})
+
+	It("should not sweep legacy resources of a user-managed VPC", func() {
+		fctx.config.Networks.VPC = &gcp.VPC{Name: "user-vpc"}
+
+		computeClient.EXPECT().ListRoutes(gomock.Any(), gomock.Any()).Return(nil, nil)
+		computeClient.EXPECT().ListFirewallRules(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, opts gcpclient.FirewallListOpts) ([]*compute.Firewall, error) {
+			return filter([]*compute.Firewall{
+				{Name: clusterName + "-allow-internal-access", Network: "user-vpc"},
+				{Name: "foreign-allow-ssh", Network: "user-vpc"},
+			}, opts.ClientFilter), nil
+		})
+		computeClient.EXPECT().DeleteFirewallRule(gomock.Any(), clusterName+"-allow-internal-access")
+		computeClient.EXPECT().DeleteRouter(gomock.Any(), region, clusterName+"-cloud-router")
+		computeClient.EXPECT().DeleteSubnet(gomock.Any(), region, clusterName+"-nodes")
+		computeClient.EXPECT().DeleteSubnet(gomock.Any(), region, clusterName+"-internal")
+		computeClient.EXPECT().DeleteSubnet(gomock.Any(), region, clusterName+"-proxy-only")
+
+		Expect(fctx.Delete(ctx)).To(Succeed())
+	})
+
+	It("should only delete the routes of the shoot's pod CIDRs", func() {
+		const instance = "https://www.googleapis.com/compute/v1/projects/project/zones/europe-west1-b/instances/"
+		routes := []*compute.Route{
+			{Name: clusterName + "-1", Network: network, NextHopInstance: instance + clusterName + "-worker-z1-abc"},
+			{Name: clusterName + "-2", Network: otherNet, NextHopInstance: instance + clusterName + "-worker-z1-abc"},
+			{Name: "shoot--foo--bar2-1", Network: network, NextHopInstance: instance + "shoot--foo--bar2-worker-z1-abc"},
+			{Name: "user-route", Network: network, NextHopInstance: instance + clusterName + "-worker-z1-abc"},
+			{Name: "default-route", Network: network, NextHopGateway: "global/gateways/default-internet-gateway"},
+		}
+		// the route was tracked by a previous attempt but is not listed anymore.
+		fctx.whiteboard.GetChild(ChildKeyRoutes).Set(clusterName+"-0", network)
+
+		computeClient.EXPECT().ListRoutes(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, opts gcpclient.RouteListOpts) ([]*compute.Route, error) {
+			return filter(routes, opts.ClientFilter), nil
+		})
+		computeClient.EXPECT().DeleteRoute(gomock.Any(), clusterName+"-0")
+		computeClient.EXPECT().DeleteRoute(gomock.Any(), clusterName+"-1")
+
+		Expect(fctx.ensureKubernetesRoutesDeleted(ctx)).To(Succeed())
+		Expect(fctx.whiteboard.GetChild(ChildKeyRoutes).AsMap()).To(BeEmpty())
+	})
+
+	It("should keep tracking the routes if their deletion fails", func() {
+		computeClient.EXPECT().ListRoutes(gomock.Any(), gomock.Any()).Return([]*compute.Route{{Name: clusterName + "-1", Network: network}}, nil)
+		computeClient.EXPECT().DeleteRoute(gomock.Any(), clusterName+"-1").Return(errors.New("fake"))
+
+		Expect(fctx.ensureKubernetesRoutesDeleted(ctx)).To(MatchError("fake"))
+		Expect(fctx.whiteboard.GetChild(ChildKeyRoutes).AsMap()).To(Equal(map[string]string{clusterName + "-1": network}))
+	})
+
+	Describe("service account bindings", func() {
+		const (
+			email  = clusterName + "@project.iam.gserviceaccount.com"
+			member = "serviceAccount:" + email
+		)
+
+		var (
+			iamClient *mockgcpclient.MockIAMClient
+			crmClient *mockgcpclient.MockResourceManagerClient
+		)
+
+		BeforeEach(func() {
+			iamClient = mockgcpclient.NewMockIAMClient(ctrl)
+			crmClient = mockgcpclient.NewMockResourceManagerClient(ctrl)
+			fctx.iamClient = iamClient
+			fctx.crmClient = crmClient
+			fctx.whiteboard.GetChild(ChildKeyIDs).Set(KeyServiceAccountEmail, email)
+		})
+
+		It("should remove the service account from the bindings of the project", func() {
+			crmClient.EXPECT().GetProjectIAMPolicy(gomock.Any()).Return(&cloudresourcemanager.Policy{
+				Etag: "etag",
+				Bindings: []*cloudresourcemanager.Binding{
+					{Role: "roles/compute.viewer", Members: []string{member, "user:foo@example.com"}},
+					{Role: "roles/storage.admin", Members: []string{"deleted:" + member + "?uid=123"}},
+					{Role: "roles/owner", Members: []string{"serviceAccount:other@project.iam.gserviceaccount.com"}},
+				},
+			}, nil)
+			crmClient.EXPECT().SetProjectIAMPolicy(gomock.Any(), &cloudresourcemanager.Policy{
+				Etag: "etag",
+				Bindings: []*cloudresourcemanager.Binding{
+					{Role: "roles/compute.viewer", Members: []string{"user:foo@example.com"}},
+					{Role: "roles/owner", Members: []string{"serviceAccount:other@project.iam.gserviceaccount.com"}},
+				},
+			})
+
+			Expect(fctx.ensureServiceAccountBindingsDeleted(ctx)).To(Succeed())
+			Expect(fctx.whiteboard.Get(DeletedServiceAccountBindingsKey)).To(PointTo(Equal("true")))
+		})
+
+		It("should not update the policy if the service account has no bindings", func() {
+			crmClient.EXPECT().GetProjectIAMPolicy(gomock.Any()).Return(&cloudresourcemanager.Policy{
+				Bindings: []*cloudresourcemanager.Binding{
+					{Role: "roles/owner", Members: []string{"serviceAccount:other@project.iam.gserviceaccount.com"}},
+				},
+			}, nil)
+
+			Expect(fctx.ensureServiceAccountBindingsDeleted(ctx)).To(Succeed())
+			Expect(fctx.whiteboard.Get(DeletedServiceAccountBindingsKey)).To(PointTo(Equal("true")))
+		})
+
+		It("should look up the email of the service account if it is not tracked", func() {
+			fctx.whiteboard.GetChild(ChildKeyIDs).Delete(KeyServiceAccountEmail)
+
+			iamClient.EXPECT().GetServiceAccount(gomock.Any(), clusterName).Return(&iam.ServiceAccount{Email: email}, nil)
+			crmClient.EXPECT().GetProjectIAMPolicy(gomock.Any()).Return(&cloudresourcemanager.Policy{
+				Bindings: []*cloudresourcemanager.Binding{{Role: "roles/compute.viewer", Members: []string{member}}},
+			}, nil)
+			crmClient.EXPECT().SetProjectIAMPolicy(gomock.Any(), &cloudresourcemanager.Policy{})
+
+			Expect(fctx.ensureServiceAccountBindingsDeleted(ctx)).To(Succeed())
+		})
+
+		It("should do nothing if the service account does not exist anymore", func() {
+			fctx.whiteboard.GetChild(ChildKeyIDs).Delete(KeyServiceAccountEmail)
+
+			iamClient.EXPECT().GetServiceAccount(gomock.Any(), clusterName).Return(nil, nil)
+
+			Expect(fctx.ensureServiceAccountBindingsDeleted(ctx)).To(Succeed())
+		})
+
+		It("should skip the cleanup if the IAM policy of the project is not accessible", func() {
+			crmClient.EXPECT().GetProjectIAMPolicy(gomock.Any()).Return(nil, &googleapi.Error{Code: http.StatusForbidden})
+
+			Expect(fctx.ensureServiceAccountBindingsDeleted(ctx)).To(Succeed())
+			Expect(fctx.whiteboard.Get(DeletedServiceAccountBindingsKey)).To(BeNil())
+		})
+
+		It("should return the error if the policy cannot be updated", func() {
+			crmClient.EXPECT().GetProjectIAMPolicy(gomock.Any()).Return(&cloudresourcemanager.Policy{
+				Bindings: []*cloudresourcemanager.Binding{{Role: "roles/compute.viewer", Members: []string{member}}},
+			}, nil)
+			crmClient.EXPECT().SetProjectIAMPolicy(gomock.Any(), gomock.Any()).Return(nil, &googleapi.Error{Code: http.StatusConflict})
+
+			Expect(fctx.ensureServiceAccountBindingsDeleted(ctx)).To(HaveOccurred())
+			Expect(fctx.whiteboard.Get(DeletedServiceAccountBindingsKey)).To(BeNil())
+		})
+	})
+})
+
+func filter[T any](items []T, f func(T) bool) []T {
+	var res []T
+	for _, item := range items {
+		if f(item) {
+			res = append(res, item)
+		}
+	}
+	return res
+}
@@ -3,12 +3,18 @@ package infraflow
 import (
 	"context"
 	"fmt"
+	"net/http"
+	"path"
+	"slices"
 	"strings"
+	"time"
 
 	"google.golang.org/api/compute/v1"
+	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/utils/ptr"
 
 	"github.com/gardener/gardener-extension-provider-gcp/pkg/controller/infrastructure/infraflow/shared"
+	"github.com/gardener/gardener-extension-provider-gcp/pkg/features"
 	"github.com/gardener/gardener-extension-provider-gcp/pkg/gcp/client"
 	"github.com/gardener/gardener-extension-provider-gcp/pkg/internal/infrastructure"
 )
@@ -182,6 +188,43 @@ func (fctx *FlowContext) ensureInternalSubnet(ctx context.Context) error {
 	return nil
 }
 
+func (fctx *FlowContext) ensureProxyOnlySubnet(ctx context.Context) error {
+	var (
+		region = fctx.infra.Spec.Region
+	)
+
+	if fctx.config.Networks.ProxyOnly == nil {
+		return fctx.ensureProxyOnlySubnetDeleted(ctx)
+	}
+
+	if err := fctx.ensureObjectKeys(ObjectKeyVPC); err != nil {
+		return err
+	}
+	vpc := GetObject[*compute.Network](fctx.whiteboard, ObjectKeyVPC)
+
+	subnetName := fctx.proxyOnlySubnetNameFromConfig()
+
+	subnet, err := fctx.computeClient.GetSubnet(ctx, region, subnetName)
+	if err != nil {
+		return err
+	}
+
+	// the proxy-only subnet is not updated as neither its CIDR nor its purpose can be changed in place.
+	if subnet == nil {
+		desired := targetProxyOnlySubnetState(subnetName, *fctx.config.Networks.ProxyOnly, vpc.SelfLink)
+		subnet, err = fctx.computeClient.InsertSubnet(ctx, region, desired)
+		if err != nil {
+			return err
+		}
+	} else if subnet.IpCidrRange != *fctx.config.Networks.ProxyOnly {
+		return fmt.Errorf("proxy-only subnet %s has CIDR %s instead of %s, it must be removed from the infrastructure config before it can be recreated", subnetName, subnet.IpCidrRange, *fctx.config.Networks.ProxyOnly)
+	}
+
+	fctx.whiteboard.Set(CreatedResourcesExistKey, "true")
+	fctx.whiteboard.SetObject(ObjectKeyProxyOnlySubnet, subnet)
+	return nil
+}
+
 func (fctx *FlowContext) ensureCloudRouter(ctx context.Context) error {
 	if fctx.config.Networks.VPC != nil && fctx.config.Networks.VPC.CloudRouter != nil {
 		return fctx.ensureUserManagedCloudRouter(ctx)
@@ -266,6 +309,15 @@ func (fctx *FlowContext) ensureCloudNAT(ctx context.Context) error {
 		return err
 	}
 
+	// a NAT which was created before the CloudNAT was disabled is removed from the router.
+	if ptr.Deref(fctx.config.Networks.DisableCloudNAT, false) {
+		if err := fctx.ensureCloudNATDeleted(ctx); err != nil {
+			return err
+		}
+		fctx.whiteboard.DeleteObject(ObjectKeyNAT)
+		return nil
+	}
+
 	subnet := GetObject[*compute.Subnetwork](fctx.whiteboard, ObjectKeyNodeSubnet)
 	router := GetObject[*compute.Router](fctx.whiteboard, ObjectKeyRouter)
 
@@ -279,7 +331,9 @@ func (fctx *FlowContext) ensureCloudNAT(ctx context.Context) error {
 		addresses = a.([]*compute.Address)
 	}
 
-	targetNat := targetNATState(natName, subnet.SelfLink, fctx.config.Networks.CloudNAT, addresses)
+	natConfig := infrastructure.CloudNATWithDefaults(fctx.config.Networks.CloudNAT, fctx.natDefaults)
+	targetNat := targetNATState(natName, subnet.SelfLink, natConfig, addresses)
+	targetNat.DrainNatIps = fctx.natIPsToDrain(router, targetNat)
 	router, nat, err = fctx.updater.NAT(ctx, fctx.infra.Spec.Region, *router, *targetNat)
 	if err != nil {
 		return err
@@ -291,18 +345,70 @@ func (fctx *FlowContext) ensureCloudNAT(ctx context.Context) error {
 	return nil
 }
 
+// natIPsToDrain returns the IP addresses which were removed from the NAT, but whose existing connections are still
+// drained. The start of the drain period is tracked in the state, so that an address is released by the first
+// reconciliation after natIPDrainPeriod has passed.
+func (fctx *FlowContext) natIPsToDrain(router *compute.Router, desired *compute.RouterNat) []string {
+	tracked := fctx.whiteboard.GetChild(ChildKeyDrainingNatIPs)
+
+	removed := sets.New[string]()
+	index := slices.IndexFunc(router.Nats, func(nat *compute.RouterNat) bool {
+		return nat.Name == desired.Name
+	})
+	// connections can only be drained if the NAT keeps using manually allocated IP addresses.
+	if index >= 0 && desired.NatIpAllocateOption == "MANUAL_ONLY" {
+		removed.Insert(router.Nats[index].NatIps...)
+		removed.Insert(router.Nats[index].DrainNatIps...)
+		removed.Delete(desired.NatIps...)
+	}
+
+	var (
+		now   = shared.DefaultTimer.Now()
+		drain []string
+		names = sets.New[string]()
+	)
+	for _, selfLink := range sets.List(removed) {
+		name := path.Base(selfLink)
+		names.Insert(name)
+		since, err := time.Parse(time.RFC3339, ptr.Deref(tracked.Get(name), ""))
+		if err != nil {
+			since = now
+			tracked.Set(name, since.Format(time.RFC3339))
+		}
+		if now.Sub(since) < natIPDrainPeriod {
+			drain = append(drain, selfLink)
+		}
+	}
+
+	// addresses which are in use again or not attached to the NAT anymore are not tracked any longer.
+	for name := range tracked.AsMap() {
+		if !names.Has(name) {
+			tracked.Delete(name)
+		}
+	}
+
+	return drain
+}
+
 func (fctx *FlowContext) ensureFirewallRules(ctx context.Context) error {
 	if err := fctx.ensureObjectKeys(ObjectKeyVPC); err != nil {
 		return err
 	}
 	vpc := GetObject[*compute.Network](fctx.whiteboard, ObjectKeyVPC)
 
-	cidrs := []*string{fctx.podCIDR, fctx.config.Networks.Internal, ptr.To(fctx.config.Networks.Workers), ptr.To(fctx.config.Networks.Worker)}
+	// the proxies of regional internal Application Load Balancers connect to the backends from the proxy-only subnet.
+	cidrs := []*string{fctx.podCIDR, fctx.config.Networks.Internal, fctx.config.Networks.ProxyOnly, ptr.To(fctx.config.Networks.Workers), ptr.To(fctx.config.Networks.Worker)}
+	var healthCheckPorts []string
+	if fctx.config.Networks.HealthChecks != nil {
+		healthCheckPorts = fctx.config.Networks.HealthChecks.AdditionalPorts
+	}
 	rules := []*compute.Firewall{
 		firewallRuleAllowInternal(firewallRuleAllowInternalName(fctx.clusterName), vpc.SelfLink, cidrs),
-		firewallRuleAllowHealthChecks(firewallRuleAllowHealthChecksName(fctx.clusterName), vpc.SelfLink),
+		firewallRuleAllowHealthChecks(firewallRuleAllowHealthChecksName(fctx.clusterName), vpc.SelfLink, healthCheckPorts),
 	}
+	desired := sets.New[string]()
 	for _, rule := range rules {
+		desired.Insert(rule.Name)
 		gcprule, err := fctx.computeClient.GetFirewallRule(ctx, rule.Name)
 		if err != nil {
 			return fmt.Errorf("failed to ensure firewall rule [name=%s]: %v", rule.Name, err)
@@ -319,10 +425,183 @@ func (fctx *FlowContext) ensureFirewallRules(ctx context.Context) error {
 		}
 	}
 
+	if features.ExtensionFeatureGate.Enabled(features.PruneOrphanedFirewallRules) {
+		if err := fctx.ensureOrphanedFirewallRulesDeleted(ctx, vpc.Name, desired); err != nil {
+			return err
+		}
+	}
+
 	// delete unnecessary firewall rule.
 	return fctx.computeClient.DeleteFirewallRule(ctx, firewallRuleAllowExternalName(fctx.clusterName))
 }
 
+// ensureOrphanedFirewallRulesDeleted deletes the firewall rules of the VPC which follow the naming scheme of the
+// infrastructure firewall rules but are not part of the given desired rules anymore.
+func (fctx *FlowContext) ensureOrphanedFirewallRulesDeleted(ctx context.Context, vpcName string, desired sets.Set[string]) error {
+	log := shared.LogFromContext(ctx)
+
+	fws, err := fctx.computeClient.ListFirewallRules(ctx, client.FirewallListOpts{
+		Filter: fmt.Sprintf(`network eq ".*(%s).*"`, vpcName),
+		ClientFilter: func(f *compute.Firewall) bool {
+			return isOrphanedFirewallRule(f, vpcName, fctx.clusterName, desired)
+		},
+	})
+	if err != nil {
+		return err
+	}
+
+	for _, fw := range fws {
+		log.Info(fmt.Sprintf("destroying orphaned firewall rule [name=%s]", fw.Name))
+		if err := fctx.computeClient.DeleteFirewallRule(ctx, fw.Name); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// ensureNCCSpoke registers the VPC as a spoke of the configured Network Connectivity Center hub. The hub itself is
+// never modified. If the configuration was removed, the previously created spoke is deleted.
+func (fctx *FlowContext) ensureNCCSpoke(ctx context.Context) error {
+	log := shared.LogFromContext(ctx)
+
+	ncc := fctx.config.Networks.NetworkConnectivityCenter
+	if ncc == nil {
+		return fctx.ensureNCCSpokeDeleted(ctx)
+	}
+
+	if err := fctx.ensureObjectKeys(ObjectKeyVPC); err != nil {
+		return err
+	}
+	vpc := GetObject[*compute.Network](fctx.whiteboard, ObjectKeyVPC)
+	spokeName := fctx.nccSpokeNameFromConfig()
+
+	current, err := fctx.nccClient.GetSpoke(ctx, spokeName)
+	if err != nil {
+		return err
+	}
+
+	if current != nil && !isNCCSpokeUpToDate(current, ncc.Hub, vpc.Name) {
+		log.Info("recreating outdated NCC spoke", "name", spokeName, "hub", ncc.Hub)
+		if err := fctx.nccClient.DeleteSpoke(ctx, spokeName); err != nil {
+			return err
+		}
+		current = nil
+	}
+
+	if current == nil {
+		if _, err := fctx.nccClient.CreateSpoke(ctx, spokeName, targetNCCSpokeState(ncc.Hub, fctx.clusterName, vpc.SelfLink)); err != nil {
+			log.Error(err, "failed to create NCC spoke", "name", spokeName, "hub", ncc.Hub)
+			return err
+		}
+	}
+
+	fctx.whiteboard.GetChild(ChildKeyIDs).Set(KeyNCCSpoke, spokeName)
+	fctx.whiteboard.Set(CreatedResourcesExistKey, "true")
+	return nil
+}
+
+func (fctx *FlowContext) ensureNCCSpokeDeleted(ctx context.Context) error {
+	if err := fctx.nccClient.DeleteSpoke(ctx, fctx.nccSpokeNameFromConfig()); err != nil {
+		return err
+	}
+
+	fctx.whiteboard.GetChild(ChildKeyIDs).Delete(KeyNCCSpoke)
+	return nil
+}
+
+// ensurePrivateServiceAccess reserves the configured address range in the VPC and allocates it to the Private Service
+// Access connection of the VPC, which is created if it does not exist yet. The range is tracked in the state, so that
+// only ranges reserved by Gardener are released again. If the configuration was removed, the range is released.
+func (fctx *FlowContext) ensurePrivateServiceAccess(ctx context.Context) error {
+	log := shared.LogFromContext(ctx)
+
+	psa := fctx.config.Networks.PrivateServiceAccess
+	if psa == nil {
+		return fctx.ensurePrivateServiceAccessDeleted(ctx)
+	}
+
+	if err := fctx.ensureObjectKeys(ObjectKeyVPC); err != nil {
+		return err
+	}
+	vpc := GetObject[*compute.Network](fctx.whiteboard, ObjectKeyVPC)
+	description := fctx.psaRangeDescription()
+
+	address, err := fctx.computeClient.GetGlobalAddress(ctx, psa.Name)
+	if err != nil {
+		return err
+	}
+	if address == nil {
+		log.Info("reserving private service access range", "name", psa.Name)
+		if _, err := fctx.computeClient.InsertGlobalAddress(ctx, targetPSARangeState(psa, description, vpc.SelfLink)); err != nil {
+			return err
+		}
+	} else if fctx.whiteboard.GetChild(ChildKeyIDs).Get(KeyPSARange) == nil && address.Description != description {
+		return fmt.Errorf("address %s already exists and is not managed by Gardener", psa.Name)
+	}
+	fctx.whiteboard.GetChild(ChildKeyIDs).Set(KeyPSARange, psa.Name)
+	fctx.whiteboard.Set(CreatedResourcesExistKey, "true")
+
+	network, err := fctx.psaNetwork(ctx, vpc.Name)
+	if err != nil {
+		return err
+	}
+	connection, err := fctx.snClient.GetConnection(ctx, network)
+	if err != nil {
+		return err
+	}
+	if connection == nil {
+		log.Info("creating private service access connection", "network", network)
+		return fctx.snClient.CreateConnection(ctx, network, []string{psa.Name})
+	}
+	if slices.Contains(connection.ReservedPeeringRanges, psa.Name) {
+		return nil
+	}
+	log.Info("allocating private service access range to existing connection", "name", psa.Name, "network", network)
+	return fctx.snClient.UpdateConnection(ctx, network, append(slices.Clone(connection.ReservedPeeringRanges), psa.Name))
+}
+
+// ensurePrivateServiceAccessDeleted releases the address range which was reserved for Private Service Access. The
+// Private Service Access connection is deleted if no other ranges are allocated to it.
+func (fctx *FlowContext) ensurePrivateServiceAccessDeleted(ctx context.Context) error {
+	log := shared.LogFromContext(ctx)
+
+	name := fctx.whiteboard.GetChild(ChildKeyIDs).Get(KeyPSARange)
+	if name == nil {
+		return nil
+	}
+
+	network, err := fctx.psaNetwork(ctx, fctx.vpcNameFromConfig())
+	if err != nil {
+		return err
+	}
+	connection, err := fctx.snClient.GetConnection(ctx, network)
+	if err != nil {
+		return err
+	}
+	if connection != nil && slices.Contains(connection.ReservedPeeringRanges, *name) {
+		ranges := slices.DeleteFunc(slices.Clone(connection.ReservedPeeringRanges), func(r string) bool { return r == *name })
+		if len(ranges) == 0 {
+			log.Info("deleting private service access connection", "network", network)
+			err = fctx.snClient.DeleteConnection(ctx, network)
+		} else {
+			log.Info("releasing private service access range from connection", "name", *name, "network", network)
+			err = fctx.snClient.UpdateConnection(ctx, network, ranges)
+		}
+		if err != nil {
+			return err
+		}
+	}
+
+	log.Info("deleting private service access range", "name", *name)
+	if err := fctx.computeClient.DeleteGlobalAddress(ctx, *name); err != nil {
+		return err
+	}
+
+	fctx.whiteboard.GetChild(ChildKeyIDs).Delete(KeyPSARange)
+	return nil
+}
+
 func (fctx *FlowContext) ensureVPCDeleted(ctx context.Context) error {
 	networkName := fctx.vpcNameFromConfig()
 	err := fctx.computeClient.DeleteNetwork(ctx, networkName)
@@ -360,6 +639,63 @@ func (fctx *FlowContext) ensureInternalSubnetDeleted(ctx context.Context) error
 	return nil
 }
 
+func (fctx *FlowContext) ensureProxyOnlySubnetDeleted(ctx context.Context) error {
+	log := shared.LogFromContext(ctx)
+
+	subnetName := fctx.proxyOnlySubnetNameFromConfig()
+	log.Info("deleting proxy-only subnet")
+	err := fctx.computeClient.DeleteSubnet(ctx, fctx.infra.Spec.Region, subnetName)
+	if err != nil {
+		return err
+	}
+
+	fctx.whiteboard.DeleteObject(ObjectKeyProxyOnlySubnet)
+	return nil
+}
+
+// ensureServiceAccountBindingsDeleted removes the service account of the shoot from all role bindings of the IAM policy
+// of the project, so that no bindings of the deleted service account are left behind. The cleanup is skipped if the
+// credentials are not permitted to manage the IAM policy of the project.
+func (fctx *FlowContext) ensureServiceAccountBindingsDeleted(ctx context.Context) error {
+	log := shared.LogFromContext(ctx)
+
+	email := ptr.Deref(fctx.whiteboard.GetChild(ChildKeyIDs).Get(KeyServiceAccountEmail), "")
+	if email == "" {
+		sa, err := fctx.iamClient.GetServiceAccount(ctx, fctx.serviceAccountNameFromConfig())
+		if err != nil {
+			return err
+		}
+		if sa == nil {
+			return nil
+		}
+		email = sa.Email
+	}
+
+	policy, err := fctx.crmClient.GetProjectIAMPolicy(ctx)
+	if client.IsErrorCode(err, http.StatusForbidden) {
+		log.Info("skipping deletion of service account bindings, the IAM policy of the project is not accessible", "error", err.Error())
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	if roles := removeIAMMember(policy, email); len(roles) > 0 {
+		log.Info("deleting service account bindings", "roles", roles)
+		_, err := fctx.crmClient.SetProjectIAMPolicy(ctx, policy)
+		if client.IsErrorCode(err, http.StatusForbidden) {
+			log.Info("skipping deletion of service account bindings, the IAM policy of the project cannot be updated", "error", err.Error())
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+	}
+
+	fctx.whiteboard.Set(DeletedServiceAccountBindingsKey, "true")
+	return fctx.persistState(ctx)
+}
+
 func (fctx *FlowContext) ensureServiceAccountDeleted(ctx context.Context) error {
 	log := shared.LogFromContext(ctx)
 
@@ -427,6 +763,9 @@ func (fctx *FlowContext) ensureFirewallRulesDeleted(ctx context.Context) error {
 				}
 			} else if strings.HasPrefix(f.Name, fctx.clusterName) {
 				return true
+			} else if !isUserVPC(fctx.config) && isInNetwork(f.Network, vpcName) {
+				// all firewall rules of a Gardener-managed VPC belong to the shoot, including the ones with legacy names.
+				return true
 			}
 
 			return false
@@ -447,20 +786,67 @@ func (fctx *FlowContext) ensureFirewallRulesDeleted(ctx context.Context) error {
 	return nil
 }
 
+// ensureLegacyResourcesDeleted deletes the routers and subnets of a Gardener-managed VPC which are not covered by the
+// other deletion tasks, e.g. because they were created with the naming scheme of a prior version of the extension.
+// Otherwise, they would be orphaned and block the deletion of the VPC.
+func (fctx *FlowContext) ensureLegacyResourcesDeleted(ctx context.Context) error {
+	log := shared.LogFromContext(ctx)
+
+	var (
+		region       = fctx.infra.Spec.Region
+		vpcName      = fctx.vpcNameFromConfig()
+		filter       = fmt.Sprintf(`network eq ".*(%s).*"`, vpcName)
+		currentNames = sets.New(fctx.subnetNameFromConfig(), fctx.internalSubnetNameFromConfig(), fctx.proxyOnlySubnetNameFromConfig(), fctx.cloudRouterNameFromConfig())
+	)
+
+	// routers are deleted first as their NATs may still reference the subnets.
+	routers, err := fctx.computeClient.ListRouters(ctx, region, client.RouterListOpts{
+		Filter: filter,
+		ClientFilter: func(r *compute.Router) bool {
+			return isInNetwork(r.Network, vpcName) && !currentNames.Has(r.Name)
+		},
+	})
+	if err != nil {
+		return err
+	}
+	for _, router := range routers {
+		log.Info(fmt.Sprintf("destroying legacy router [name=%s]", router.Name))
+		if err := fctx.computeClient.DeleteRouter(ctx, region, router.Name); err != nil {
+			return err
+		}
+	}
+
+	subnets, err := fctx.computeClient.ListSubnets(ctx, region, client.SubnetListOpts{
+		Filter: filter,
+		ClientFilter: func(s *compute.Subnetwork) bool {
+			return isInNetwork(s.Network, vpcName) && !currentNames.Has(s.Name)
+		},
+	})
+	if err != nil {
+		return err
+	}
+	for _, subnet := range subnets {
+		log.Info(fmt.Sprintf("destroying legacy subnet [name=%s]", subnet.Name))
+		if err := fctx.computeClient.DeleteSubnet(ctx, region, subnet.Name); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// ensureKubernetesRoutesDeleted deletes the routes for the pod CIDRs which are programmed by the cloud-controller-manager
+// if the overlay network is disabled. They would be left behind if the shoot is force-deleted. The routes are tracked
+// in the state before they are deleted, so that they are deleted by the next attempt even if they are not listed anymore.
 func (fctx *FlowContext) ensureKubernetesRoutesDeleted(ctx context.Context) error {
 	log := shared.LogFromContext(ctx)
 	vpcName := fctx.vpcNameFromConfig()
+	tracked := fctx.whiteboard.GetChild(ChildKeyRoutes)
 
 	routes, err := fctx.computeClient.ListRoutes(ctx, client.RouteListOpts{
 		Filter: fmt.Sprintf(`network eq ".*(%s).*"`, vpcName),
 		ClientFilter: func(route *compute.Route) bool {
-			if strings.HasPrefix(route.Name, infrastructure.ShootPrefix) && strings.HasSuffix(route.Network, vpcName) {
-				urlParts := strings.Split(route.NextHopInstance, "/")
-				if strings.HasPrefix(urlParts[len(urlParts)-1], fctx.clusterName) {
-					return true
-				}
-			}
-			return false
+			return isKubernetesRoute(route, vpcName, fctx.clusterName)
 		},
 	})
 	if err != nil {
@@ -468,11 +854,20 @@ func (fctx *FlowContext) ensureKubernetesRoutesDeleted(ctx context.Context) erro
 	}
 
 	for _, route := range routes {
-		log.Info(fmt.Sprintf("destroying route[name=%s]", route.Name))
-		err := fctx.computeClient.DeleteRoute(ctx, route.Name)
-		if err != nil {
+		tracked.Set(route.Name, route.Network)
+	}
+	if len(routes) > 0 {
+		if err := fctx.persistState(ctx); err != nil {
+			return err
+		}
+	}
+
+	for name := range tracked.AsMap() {
+		log.Info(fmt.Sprintf("destroying route[name=%s]", name))
+		if err := fctx.computeClient.DeleteRoute(ctx, name); err != nil {
 			return err
 		}
+		tracked.Delete(name)
 	}
 
 	return nil
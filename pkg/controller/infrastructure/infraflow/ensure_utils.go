@@ -1,12 +1,22 @@
 package infraflow
 
 import (
+	"context"
 	"fmt"
+	"path"
+	"regexp"
+	"slices"
+	"strings"
 
+	"google.golang.org/api/cloudresourcemanager/v1"
 	"google.golang.org/api/compute/v1"
+	"google.golang.org/api/networkconnectivity/v1"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/utils/ptr"
 
 	"github.com/gardener/gardener-extension-provider-gcp/pkg/apis/gcp"
 	"github.com/gardener/gardener-extension-provider-gcp/pkg/controller/infrastructure/infraflow/shared"
+	"github.com/gardener/gardener-extension-provider-gcp/pkg/internal/infrastructure"
 )
 
 const (
@@ -18,6 +28,11 @@ const (
 	DefaultFlowSampling = 0.5
 	// DefaultMetadata is the default value for the Flow Logs metadata.
 	DefaultMetadata = "EXCLUDE_ALL_METADATA"
+	// SubnetPurposeRegionalManagedProxy is the purpose of subnets which are reserved for the proxies of regional
+	// internal Application Load Balancers.
+	SubnetPurposeRegionalManagedProxy = "REGIONAL_MANAGED_PROXY"
+	// SubnetRoleActive is the role of the proxy-only subnet which is currently used in a region.
+	SubnetRoleActive = "ACTIVE"
 )
 
 // GetObject returns the object and attempts to cast it to the specified type.
@@ -50,6 +65,64 @@ func (fctx *FlowContext) vpcNameFromConfig() string {
 	return vpcName
 }
 
+func (fctx *FlowContext) nccSpokeNameFromConfig() string {
+	return fctx.clusterName
+}
+
+// hasNCCSpoke returns true if a Network Connectivity Center spoke is configured or has been created before.
+func (fctx *FlowContext) hasNCCSpoke() bool {
+	return fctx.config.Networks.NetworkConnectivityCenter != nil || fctx.whiteboard.GetChild(ChildKeyIDs).Get(KeyNCCSpoke) != nil
+}
+
+// hasPrivateServiceAccess returns true if Private Service Access is configured or its address range has been reserved
+// before.
+func (fctx *FlowContext) hasPrivateServiceAccess() bool {
+	return fctx.config.Networks.PrivateServiceAccess != nil || fctx.whiteboard.GetChild(ChildKeyIDs).Get(KeyPSARange) != nil
+}
+
+func (fctx *FlowContext) psaRangeDescription() string {
+	return fmt.Sprintf("gardener-managed private service access range of %s", fctx.clusterName)
+}
+
+// psaNetwork returns the given VPC in the form which is expected by the Service Networking API. It requires the
+// project number instead of the project ID.
+func (fctx *FlowContext) psaNetwork(ctx context.Context, vpcName string) (string, error) {
+	projectNumber, err := fctx.crmClient.GetProjectNumber(ctx)
+	if err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("projects/%d/global/networks/%s", projectNumber, vpcName), nil
+}
+
+func targetPSARangeState(psa *gcp.PSAConfig, description, networkURL string) *compute.Address {
+	return &compute.Address{
+		Name:         psa.Name,
+		Description:  description,
+		AddressType:  "INTERNAL",
+		Purpose:      "VPC_PEERING",
+		Address:      ptr.Deref(psa.Address, ""),
+		PrefixLength: int64(psa.PrefixLength),
+		Network:      networkURL,
+	}
+}
+
+func targetNCCSpokeState(hub, description, networkURL string) *networkconnectivity.Spoke {
+	return &networkconnectivity.Spoke{
+		Description: description,
+		Hub:         hub,
+		LinkedVpcNetwork: &networkconnectivity.LinkedVpcNetwork{
+			Uri: networkURL,
+		},
+	}
+}
+
+// isNCCSpokeUpToDate returns true if the spoke is attached to the given hub and links the given network. Both are
+// immutable, hence an outdated spoke needs to be recreated. The API may return the hub with the project number
+// instead of the project ID, hence only the hub names are compared.
+func isNCCSpokeUpToDate(spoke *networkconnectivity.Spoke, hub, networkName string) bool {
+	return path.Base(spoke.Hub) == path.Base(hub) && spoke.LinkedVpcNetwork != nil && isInNetwork(spoke.LinkedVpcNetwork.Uri, networkName)
+}
+
 func (fctx *FlowContext) subnetNameFromConfig() string {
 	return fmt.Sprintf("%s-nodes", fctx.clusterName)
 }
@@ -58,6 +131,10 @@ func (fctx *FlowContext) internalSubnetNameFromConfig() string {
 	return fmt.Sprintf("%s-internal", fctx.clusterName)
 }
 
+func (fctx *FlowContext) proxyOnlySubnetNameFromConfig() string {
+	return fmt.Sprintf("%s-proxy-only", fctx.clusterName)
+}
+
 func (fctx *FlowContext) cloudRouterNameFromConfig() string {
 	routerName := fmt.Sprintf("%s-cloud-router", fctx.clusterName)
 	if fctx.config.Networks.VPC != nil && fctx.config.Networks.VPC.CloudRouter != nil {
@@ -93,6 +170,15 @@ func targetNetwork(name string) *compute.Network {
 	}
 }
 
+// targetProxyOnlySubnetState returns the desired state of the proxy-only subnet which is used by the Envoy proxies of
+// regional internal Application Load Balancers. Flow logs and private Google access are not supported for it.
+func targetProxyOnlySubnetState(name, cidr, networkName string) *compute.Subnetwork {
+	subnet := targetSubnetState(name, "gardener-managed proxy-only subnet", cidr, networkName, nil)
+	subnet.Purpose = SubnetPurposeRegionalManagedProxy
+	subnet.Role = SubnetRoleActive
+	return subnet
+}
+
 func targetSubnetState(name, description, cidr, networkName string, flowLogs *gcp.FlowLogs) *compute.Subnetwork {
 	subnet := &compute.Subnetwork{
 		Description:           description,
@@ -263,7 +349,7 @@ func firewallRuleAllowExternal(name, network string) *compute.Firewall {
 	}
 }
 
-func firewallRuleAllowHealthChecks(name, network string) *compute.Firewall {
+func firewallRuleAllowHealthChecks(name, network string, additionalPorts []string) *compute.Firewall {
 	return &compute.Firewall{
 		Name:      name,
 		Network:   network,
@@ -282,7 +368,7 @@ func firewallRuleAllowHealthChecks(name, network string) *compute.Firewall {
 			},
 			{
 				IPProtocol: "tcp",
-				Ports:      []string{"30000-32767"},
+				Ports:      append([]string{"30000-32767"}, additionalPorts...),
 			},
 		},
 		ForceSendFields: []string{"Disabled"},
@@ -290,6 +376,35 @@ func firewallRuleAllowHealthChecks(name, network string) *compute.Firewall {
 	}
 }
 
+// removeIAMMember removes the given service account from the role bindings of the given IAM policy, including the
+// members which GCP renames once the service account is deleted, i.e. `deleted:serviceAccount:<email>?uid=<uid>`.
+// Bindings without members are dropped. It returns the roles of the bindings the service account was removed from.
+func removeIAMMember(policy *cloudresourcemanager.Policy, email string) []string {
+	var (
+		member        = "serviceAccount:" + email
+		deletedMember = "deleted:" + member + "?uid="
+		roles         []string
+		bindings      []*cloudresourcemanager.Binding
+	)
+
+	for _, binding := range policy.Bindings {
+		members := slices.DeleteFunc(slices.Clone(binding.Members), func(m string) bool {
+			return m == member || strings.HasPrefix(m, deletedMember)
+		})
+		if len(members) < len(binding.Members) {
+			roles = append(roles, binding.Role)
+		}
+		if len(members) == 0 {
+			continue
+		}
+		binding.Members = members
+		bindings = append(bindings, binding)
+	}
+
+	policy.Bindings = bindings
+	return roles
+}
+
 func isUserRouter(config *gcp.InfrastructureConfig) bool {
 	return config.Networks.VPC != nil &&
 		config.Networks.VPC.CloudRouter != nil &&
@@ -299,3 +414,49 @@ func isUserRouter(config *gcp.InfrastructureConfig) bool {
 func isUserVPC(config *gcp.InfrastructureConfig) bool {
 	return config.Networks.VPC != nil && len(config.Networks.VPC.Name) > 0
 }
+
+// isKubernetesRoute returns true if the given route was programmed by the cloud-controller-manager of the given cluster.
+// Such routes are part of the VPC and forward a pod CIDR to one of the nodes of the cluster. Their names are prefixed
+// with the cluster name, which is truncated by the cloud-controller-manager, hence only the shoot prefix is checked.
+func isKubernetesRoute(route *compute.Route, vpcName, clusterName string) bool {
+	return strings.HasPrefix(route.Name, infrastructure.ShootPrefix) &&
+		isInNetwork(route.Network, vpcName) &&
+		strings.HasPrefix(path.Base(route.NextHopInstance), clusterName+"-")
+}
+
+// bastionFirewallRuleRegex matches the names of the firewall rules of bastions, which are prefixed with the cluster name.
+var bastionFirewallRuleRegex = regexp.MustCompile(`-bastion-[0-9a-f]{5}-`)
+
+// isOrphanedFirewallRule returns true if the given firewall rule is an infrastructure firewall rule of the given cluster
+// which is not desired anymore. It is deliberately conservative: the rule must be part of the VPC, its name must be
+// prefixed with the cluster name and it must either be one of the rules which were created for the cluster in the past
+// or only target the tag of the cluster. The latter prevents deleting the rules of other shoots in the same VPC whose
+// names share the prefix, e.g. the rules of a shoot named "<cluster>-foo". The firewall rules of the
+// cloud-controller-manager and of bastions are never orphaned.
+func isOrphanedFirewallRule(firewall *compute.Firewall, vpcName, clusterName string, desired sets.Set[string]) bool {
+	if !isInNetwork(firewall.Network, vpcName) ||
+		!strings.HasPrefix(firewall.Name, clusterName+"-") ||
+		desired.Has(firewall.Name) ||
+		bastionFirewallRuleRegex.MatchString(firewall.Name) {
+		return false
+	}
+
+	if legacyFirewallRuleNames(clusterName).Has(firewall.Name) {
+		return true
+	}
+	return len(firewall.TargetTags) == 1 && firewall.TargetTags[0] == clusterName
+}
+
+// legacyFirewallRuleNames returns the names of all infrastructure firewall rules which were created for the given cluster.
+func legacyFirewallRuleNames(clusterName string) sets.Set[string] {
+	return sets.New(
+		firewallRuleAllowInternalName(clusterName),
+		firewallRuleAllowExternalName(clusterName),
+		firewallRuleAllowHealthChecksName(clusterName),
+	)
+}
+
+// isInNetwork returns true if the given network URL references the network with the given name.
+func isInNetwork(networkURL, networkName string) bool {
+	return networkURL == networkName || strings.HasSuffix(networkURL, "/networks/"+networkName)
+}
@@ -0,0 +1,110 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package infraflow
+
+import (
+	"context"
+
+	"github.com/go-logr/logr"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.uber.org/mock/gomock"
+	"google.golang.org/api/compute/v1"
+	"k8s.io/apimachinery/pkg/util/sets"
+
+	"github.com/gardener/gardener-extension-provider-gcp/pkg/apis/gcp"
+	"github.com/gardener/gardener-extension-provider-gcp/pkg/controller/infrastructure/infraflow/shared"
+	gcpclient "github.com/gardener/gardener-extension-provider-gcp/pkg/gcp/client"
+	mockgcpclient "github.com/gardener/gardener-extension-provider-gcp/pkg/gcp/client/mock"
+)
+
+var _ = Describe("Orphaned firewall rules", func() {
+	const (
+		clusterName = "shoot--foo--bar"
+		network     = "https://www.googleapis.com/compute/v1/projects/project/global/networks/" + clusterName
+		otherNet    = "https://www.googleapis.com/compute/v1/projects/project/global/networks/" + clusterName + "-2"
+	)
+
+	var desired = sets.New(clusterName+"-allow-internal-access", clusterName+"-allow-health-checks")
+
+	DescribeTable("#isOrphanedFirewallRule",
+		func(firewall *compute.Firewall, orphaned bool) {
+			Expect(isOrphanedFirewallRule(firewall, clusterName, clusterName, desired)).To(Equal(orphaned))
+		},
+		Entry("legacy rule of the shoot", &compute.Firewall{Name: clusterName + "-allow-external-access", Network: network}, true),
+		Entry("rule targeting the shoot", &compute.Firewall{Name: clusterName + "-allow-ssh", Network: network, TargetTags: []string{clusterName}}, true),
+		Entry("desired rule", &compute.Firewall{Name: clusterName + "-allow-internal-access", Network: network}, false),
+		Entry("rule of another network", &compute.Firewall{Name: clusterName + "-allow-ssh", Network: otherNet, TargetTags: []string{clusterName}}, false),
+		Entry("rule of another shoot", &compute.Firewall{Name: "shoot--foo--bar2-allow-ssh", Network: network}, false),
+		Entry("rule of another shoot with the cluster name as prefix", &compute.Firewall{Name: clusterName + "-baz-allow-internal-access", Network: network, TargetTags: []string{clusterName + "-baz"}}, false),
+		Entry("rule of another shoot with the cluster name as prefix without target tags", &compute.Firewall{Name: clusterName + "-baz-allow-health-checks", Network: network}, false),
+		Entry("rule of the cloud-controller-manager", &compute.Firewall{Name: "k8s-fw-a1b2c3", Network: network, TargetTags: []string{clusterName}}, false),
+		Entry("rule of a bastion", &compute.Firewall{Name: clusterName + "-cli-xyz-bastion-1cdc8-allow-ssh", Network: network}, false),
+		Entry("user rule without the prefix", &compute.Firewall{Name: "allow-ssh", Network: network}, false),
+		Entry("user rule targeting other tags", &compute.Firewall{Name: clusterName + "-custom", Network: network, TargetTags: []string{"custom"}}, false),
+		Entry("user rule targeting the shoot and other tags", &compute.Firewall{Name: clusterName + "-custom", Network: network, TargetTags: []string{clusterName, "custom"}}, false),
+		Entry("user rule without target tags", &compute.Firewall{Name: clusterName + "-custom", Network: network}, false),
+	)
+
+	DescribeTable("#firewallRuleAllowHealthChecks",
+		func(additionalPorts []string, expectedTCPPorts []string) {
+			rule := firewallRuleAllowHealthChecks(clusterName+"-allow-health-checks", network, additionalPorts)
+			Expect(rule.Allowed).To(ConsistOf(
+				&compute.FirewallAllowed{IPProtocol: "udp", Ports: []string{"30000-32767"}},
+				&compute.FirewallAllowed{IPProtocol: "tcp", Ports: expectedTCPPorts},
+			))
+			Expect(rule.DestinationRanges).To(BeEmpty())
+		},
+		Entry("without additional ports", nil, []string{"30000-32767"}),
+		Entry("with additional ports", []string{"8080", "9000-9100"}, []string{"30000-32767", "8080", "9000-9100"}),
+	)
+
+	Describe("#ensureOrphanedFirewallRulesDeleted", func() {
+		var (
+			ctx           context.Context
+			ctrl          *gomock.Controller
+			computeClient *mockgcpclient.MockComputeClient
+			fctx          *FlowContext
+		)
+
+		BeforeEach(func() {
+			ctx = context.Background()
+			ctrl = gomock.NewController(GinkgoT())
+			computeClient = mockgcpclient.NewMockComputeClient(ctrl)
+
+			fctx = &FlowContext{
+				config:        &gcp.InfrastructureConfig{},
+				clusterName:   clusterName,
+				whiteboard:    shared.NewWhiteboard(),
+				log:           logr.Discard(),
+				computeClient: computeClient,
+			}
+		})
+
+		AfterEach(func() {
+			ctrl.Finish()
+		})
+
+		It("should only delete the orphaned firewall rules of the shoot", func() {
+			firewalls := []*compute.Firewall{
+				{Name: clusterName + "-allow-internal-access", Network: network},
+				{Name: clusterName + "-allow-external-access", Network: network},
+				{Name: clusterName + "-allow-ssh", Network: network, TargetTags: []string{clusterName}},
+				{Name: clusterName + "-baz-allow-internal-access", Network: network, TargetTags: []string{clusterName + "-baz"}},
+				{Name: clusterName + "-baz-allow-external-access", Network: network},
+				{Name: "k8s-fw-a1b2c3", Network: network, TargetTags: []string{clusterName}},
+				{Name: "allow-ssh", Network: network},
+			}
+
+			computeClient.EXPECT().ListFirewallRules(ctx, gomock.Any()).DoAndReturn(func(_ context.Context, opts gcpclient.FirewallListOpts) ([]*compute.Firewall, error) {
+				return filter(firewalls, opts.ClientFilter), nil
+			})
+			computeClient.EXPECT().DeleteFirewallRule(ctx, clusterName+"-allow-external-access")
+			computeClient.EXPECT().DeleteFirewallRule(ctx, clusterName+"-allow-ssh")
+
+			Expect(fctx.ensureOrphanedFirewallRulesDeleted(ctx, clusterName, desired)).To(Succeed())
+		})
+	})
+})
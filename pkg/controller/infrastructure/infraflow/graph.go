@@ -5,6 +5,7 @@ import (
 
 	"github.com/gardener/gardener/pkg/utils/flow"
 
+	"github.com/gardener/gardener-extension-provider-gcp/pkg/apis/config"
 	"github.com/gardener/gardener-extension-provider-gcp/pkg/controller/infrastructure/infraflow/shared"
 	"github.com/gardener/gardener-extension-provider-gcp/pkg/features"
 )
@@ -14,77 +15,161 @@ const (
 	defaultDeleteTimeout time.Duration = 5 * time.Minute
 )
 
+const (
+	// ResourceTypeServiceAccount is the resource type of the service account tasks.
+	ResourceTypeServiceAccount = config.ResourceTypeServiceAccount
+	// ResourceTypeVPC is the resource type of the VPC tasks.
+	ResourceTypeVPC = config.ResourceTypeVPC
+	// ResourceTypeSubnet is the resource type of the subnet tasks.
+	ResourceTypeSubnet = config.ResourceTypeSubnet
+	// ResourceTypeRouter is the resource type of the CloudRouter tasks.
+	ResourceTypeRouter = config.ResourceTypeRouter
+	// ResourceTypeAddress is the resource type of the IP address tasks.
+	ResourceTypeAddress = config.ResourceTypeAddress
+	// ResourceTypeNAT is the resource type of the CloudNAT tasks.
+	ResourceTypeNAT = config.ResourceTypeNAT
+	// ResourceTypeFirewall is the resource type of the firewall rule tasks.
+	ResourceTypeFirewall = config.ResourceTypeFirewall
+	// ResourceTypeRoute is the resource type of the route tasks.
+	ResourceTypeRoute = config.ResourceTypeRoute
+	// ResourceTypeNCCSpoke is the resource type of the Network Connectivity Center spoke tasks.
+	ResourceTypeNCCSpoke = config.ResourceTypeNCCSpoke
+)
+
 func (fctx *FlowContext) buildReconcileGraph() *flow.Graph {
-	fctx.BasicFlowContext = shared.NewBasicFlowContext().WithSpan().WithLogger(fctx.log).WithPersist(fctx.persistState)
+	fctx.BasicFlowContext = shared.NewBasicFlowContext().WithSpan().WithLogger(fctx.log).WithPersist(fctx.persistState).WithConcurrencyLimiter(fctx.limiter)
 	g := flow.NewGraph("infrastructure reconciliation")
 
 	fctx.AddTask(g, "ensure service account", fctx.ensureServiceAccount,
 		shared.Timeout(defaultCreateTimeout),
+		shared.ResourceType(ResourceTypeServiceAccount),
 		shared.DoIf(
 			!features.ExtensionFeatureGate.Enabled(features.DisableGardenerServiceAccountCreation) || fctx.whiteboard.Get(CreatedServiceAccountKey) != nil,
 		),
 	)
 	ensureVPC := fctx.AddTask(g, "ensure VPC", fctx.ensureVPC,
 		shared.Timeout(defaultCreateTimeout),
+		shared.ResourceType(ResourceTypeVPC),
 	)
 	ensureSubnet := fctx.AddTask(g, "ensure worker subnet", fctx.ensureSubnet,
 		shared.Timeout(defaultCreateTimeout),
+		shared.ResourceType(ResourceTypeSubnet),
 		shared.Dependencies(ensureVPC),
 	)
 	ensureInternalSubnet := fctx.AddTask(g, "ensure internal subnet", fctx.ensureInternalSubnet,
 		shared.Timeout(defaultCreateTimeout),
+		shared.ResourceType(ResourceTypeSubnet),
+		shared.Dependencies(ensureVPC),
+	)
+	ensureProxyOnlySubnet := fctx.AddTask(g, "ensure proxy-only subnet", fctx.ensureProxyOnlySubnet,
+		shared.Timeout(defaultCreateTimeout),
+		shared.ResourceType(ResourceTypeSubnet),
 		shared.Dependencies(ensureVPC),
 	)
 	ensureRouter := fctx.AddTask(g, "ensure router", fctx.ensureCloudRouter,
 		shared.Timeout(defaultCreateTimeout),
+		shared.ResourceType(ResourceTypeRouter),
 		shared.Dependencies(ensureVPC),
 	)
 	ensureIpAddresses := fctx.AddTask(g, "ensure IP addresses", fctx.ensureAddresses,
 		shared.Timeout(defaultCreateTimeout),
+		shared.ResourceType(ResourceTypeAddress),
 		shared.DoIf(fctx.config.Networks.CloudNAT != nil && len(fctx.config.Networks.CloudNAT.NatIPNames) > 0),
 	)
 	fctx.AddTask(g, "ensure nats", fctx.ensureCloudNAT,
 		shared.Timeout(defaultCreateTimeout),
+		shared.ResourceType(ResourceTypeNAT),
 		shared.Dependencies(ensureRouter, ensureSubnet, ensureIpAddresses))
 
+	fctx.AddTask(g, "ensure NCC spoke", fctx.ensureNCCSpoke,
+		shared.Timeout(defaultCreateTimeout),
+		shared.ResourceType(ResourceTypeNCCSpoke),
+		shared.Dependencies(ensureVPC),
+		shared.DoIf(fctx.hasNCCSpoke()),
+	)
+
+	fctx.AddTask(g, "ensure private service access", fctx.ensurePrivateServiceAccess,
+		shared.Timeout(defaultCreateTimeout),
+		shared.ResourceType(ResourceTypeAddress),
+		shared.Dependencies(ensureVPC),
+		shared.DoIf(fctx.hasPrivateServiceAccess()),
+	)
+
 	fctx.AddTask(g, "ensure firewall", fctx.ensureFirewallRules,
 		shared.Timeout(defaultCreateTimeout),
-		shared.Dependencies(ensureVPC, ensureSubnet, ensureInternalSubnet),
+		shared.ResourceType(ResourceTypeFirewall),
+		shared.Dependencies(ensureVPC, ensureSubnet, ensureInternalSubnet, ensureProxyOnlySubnet),
 	)
 
 	return g
 }
 
 func (fctx *FlowContext) buildDeleteGraph() *flow.Graph {
-	fctx.BasicFlowContext = shared.NewBasicFlowContext().WithLogger(fctx.log).WithSpan()
+	fctx.BasicFlowContext = shared.NewBasicFlowContext().WithLogger(fctx.log).WithSpan().WithConcurrencyLimiter(fctx.limiter)
 	g := flow.NewGraph("infrastructure deletion")
 
+	ensureServiceAccountBindingsDeleted := fctx.AddTask(g, "destroy service account bindings", fctx.ensureServiceAccountBindingsDeleted,
+		shared.Timeout(defaultDeleteTimeout),
+		shared.ResourceType(ResourceTypeServiceAccount),
+		shared.DoIf(fctx.whiteboard.Get(CreatedServiceAccountKey) != nil && fctx.whiteboard.Get(DeletedServiceAccountBindingsKey) == nil),
+	)
 	fctx.AddTask(g, "destroy service account", fctx.ensureServiceAccountDeleted,
-		shared.Timeout(defaultDeleteTimeout), shared.DoIf(fctx.whiteboard.Get(CreatedServiceAccountKey) != nil),
+		shared.Timeout(defaultDeleteTimeout),
+		shared.ResourceType(ResourceTypeServiceAccount),
+		shared.Dependencies(ensureServiceAccountBindingsDeleted),
+		shared.DoIf(fctx.whiteboard.Get(CreatedServiceAccountKey) != nil),
 	)
-	fctx.AddTask(g, "destroy kubernetes routes", fctx.ensureKubernetesRoutesDeleted, shared.Timeout(defaultDeleteTimeout))
-	ensureFirewallDeleted := fctx.AddTask(g, "destroy infrastructure firewall", fctx.ensureFirewallRulesDeleted, shared.Timeout(20*time.Minute))
+	fctx.AddTask(g, "destroy kubernetes routes", fctx.ensureKubernetesRoutesDeleted, shared.Timeout(defaultDeleteTimeout), shared.ResourceType(ResourceTypeRoute))
+	ensureFirewallDeleted := fctx.AddTask(g, "destroy infrastructure firewall", fctx.ensureFirewallRulesDeleted, shared.Timeout(20*time.Minute), shared.ResourceType(ResourceTypeFirewall))
 	ensureNatDeleted := fctx.AddTask(g, "destroy nats", fctx.ensureCloudNATDeleted,
 		shared.Timeout(defaultDeleteTimeout),
+		shared.ResourceType(ResourceTypeNAT),
 		// we do not need to clean up CloudNAT for managed CloudRouters because it will be deleted with the router deletion.
 		shared.DoIf(isUserRouter(fctx.config)),
 	)
+	// legacy resources only need to be swept in Gardener-managed VPCs, user-managed VPCs may contain foreign resources.
+	ensureLegacyResourcesDeleted := fctx.AddTask(g, "destroy legacy resources", fctx.ensureLegacyResourcesDeleted,
+		shared.Timeout(defaultDeleteTimeout),
+		shared.DoIf(!isUserVPC(fctx.config)),
+	)
 	ensureInternalSubnetDeleted := fctx.AddTask(g, "destroy internal subnet", fctx.ensureInternalSubnetDeleted,
 		shared.Timeout(defaultDeleteTimeout),
+		shared.ResourceType(ResourceTypeSubnet),
+		shared.Dependencies(ensureLegacyResourcesDeleted),
+	)
+	ensureProxyOnlySubnetDeleted := fctx.AddTask(g, "destroy proxy-only subnet", fctx.ensureProxyOnlySubnetDeleted,
+		shared.Timeout(defaultDeleteTimeout),
+		shared.ResourceType(ResourceTypeSubnet),
+		shared.Dependencies(ensureLegacyResourcesDeleted),
 	)
 	ensureCloudRouterDeleted := fctx.AddTask(g, "ensure router deleted", fctx.ensureCloudRouterDeleted,
 		shared.Timeout(defaultDeleteTimeout),
+		shared.ResourceType(ResourceTypeRouter),
 		shared.Dependencies(ensureNatDeleted),
 		// for user-managed CloudRouters, skip deletion.
 		shared.DoIf(!isUserRouter(fctx.config)),
 	)
 	ensureSubnetDeleted := fctx.AddTask(g, "destroy worker subnet", fctx.ensureSubnetDeleted,
 		shared.Timeout(defaultDeleteTimeout),
-		shared.Dependencies(ensureCloudRouterDeleted),
+		shared.ResourceType(ResourceTypeSubnet),
+		shared.Dependencies(ensureCloudRouterDeleted, ensureLegacyResourcesDeleted),
+	)
+	// the spoke is created by Gardener even for user-managed VPCs, the hub is never touched.
+	ensureNCCSpokeDeleted := fctx.AddTask(g, "destroy NCC spoke", fctx.ensureNCCSpokeDeleted,
+		shared.Timeout(defaultDeleteTimeout),
+		shared.ResourceType(ResourceTypeNCCSpoke),
+		shared.DoIf(fctx.hasNCCSpoke()),
+	)
+	// the peering of the Private Service Access blocks the deletion of the VPC.
+	ensurePrivateServiceAccessDeleted := fctx.AddTask(g, "destroy private service access", fctx.ensurePrivateServiceAccessDeleted,
+		shared.Timeout(defaultDeleteTimeout),
+		shared.ResourceType(ResourceTypeAddress),
+		shared.DoIf(fctx.whiteboard.GetChild(ChildKeyIDs).Get(KeyPSARange) != nil),
 	)
 	fctx.AddTask(g, "destroy vpc", fctx.ensureVPCDeleted,
 		shared.Timeout(defaultDeleteTimeout),
-		shared.Dependencies(ensureSubnetDeleted, ensureInternalSubnetDeleted, ensureCloudRouterDeleted, ensureFirewallDeleted),
+		shared.ResourceType(ResourceTypeVPC),
+		shared.Dependencies(ensureSubnetDeleted, ensureInternalSubnetDeleted, ensureProxyOnlySubnetDeleted, ensureCloudRouterDeleted, ensureFirewallDeleted, ensureNCCSpokeDeleted, ensurePrivateServiceAccessDeleted),
 		shared.DoIf(!isUserVPC(fctx.config)),
 	)
 
@@ -0,0 +1,246 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package infraflow
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/gardener/gardener-extension-provider-gcp/pkg/apis/gcp"
+	"github.com/gardener/gardener-extension-provider-gcp/pkg/controller/infrastructure/infraflow/shared"
+	gcpclient "github.com/gardener/gardener-extension-provider-gcp/pkg/gcp/client"
+)
+
+// ResourceKind is the kind of GCP resource which is managed for an infrastructure.
+type ResourceKind string
+
+const (
+	// ResourceKindServiceAccount is the kind of service accounts.
+	ResourceKindServiceAccount ResourceKind = "ServiceAccount"
+	// ResourceKindNetwork is the kind of VPC networks.
+	ResourceKindNetwork ResourceKind = "Network"
+	// ResourceKindSubnet is the kind of subnets.
+	ResourceKindSubnet ResourceKind = "Subnet"
+	// ResourceKindRouter is the kind of CloudRouters.
+	ResourceKindRouter ResourceKind = "Router"
+	// ResourceKindNAT is the kind of CloudNATs.
+	ResourceKindNAT ResourceKind = "NAT"
+	// ResourceKindFirewall is the kind of firewall rules.
+	ResourceKindFirewall ResourceKind = "Firewall"
+)
+
+// terraformResourceKinds maps the types of the Terraform resources to the kinds of the GCP resources.
+var terraformResourceKinds = map[string]ResourceKind{
+	"google_service_account":    ResourceKindServiceAccount,
+	"google_compute_network":    ResourceKindNetwork,
+	"google_compute_subnetwork": ResourceKindSubnet,
+	"google_compute_router":     ResourceKindRouter,
+	"google_compute_router_nat": ResourceKindNAT,
+	"google_compute_firewall":   ResourceKindFirewall,
+}
+
+// Resource identifies a GCP resource which is managed for an infrastructure.
+type Resource struct {
+	// Kind is the kind of the resource.
+	Kind ResourceKind
+	// Name is the name of the resource.
+	Name string
+	// Region is the region of regional resources. If it is empty, the region of the infrastructure is used.
+	Region string
+	// Router is the name of the CloudRouter which contains a CloudNAT.
+	Router string
+}
+
+// String returns a human-readable representation of the resource.
+func (r Resource) String() string {
+	if r.Kind == ResourceKindNAT {
+		return fmt.Sprintf("%s %s (router %s)", r.Kind, r.Name, r.Router)
+	}
+	return fmt.Sprintf("%s %s", r.Kind, r.Name)
+}
+
+// Discrepancy is a difference between the live GCP resources, the resources tracked by the Terraform state and the
+// resources managed by the flow.
+type Discrepancy struct {
+	// Resource is the affected resource.
+	Resource Resource
+	// Message describes the discrepancy.
+	Message string
+}
+
+// String returns a human-readable representation of the discrepancy.
+func (d Discrepancy) String() string {
+	return fmt.Sprintf("%s %s", d.Resource, d.Message)
+}
+
+// StateFromTerraform computes the flow state which is equivalent to the given Terraform state. In addition, it returns
+// the GCP resources which are tracked by the Terraform state. It is used by the verified migration.
+func StateFromTerraform(tfState *shared.TerraformState) (*gcp.InfrastructureState, []Resource) {
+	var (
+		wb        = shared.NewWhiteboard()
+		resources []Resource
+	)
+
+	for _, tfResource := range tfState.Resources {
+		if tfResource.Mode != shared.ModeManaged || len(tfResource.Instances) == 0 {
+			continue
+		}
+		// any resource created by terraform prevents the deletion of the infrastructure without cleaning up.
+		wb.Set(CreatedResourcesExistKey, "true")
+
+		kind, ok := terraformResourceKinds[tfResource.Type]
+		if !ok {
+			continue
+		}
+
+		for _, instance := range tfResource.Instances {
+			resource := Resource{Kind: kind}
+			resource.Name, _ = shared.AttributeAsString(instance.Attributes, shared.AttributeKeyName)
+			resource.Region, _ = shared.AttributeAsString(instance.Attributes, "region")
+
+			switch kind {
+			case ResourceKindServiceAccount:
+				// the name attribute of service accounts is the fully qualified name.
+				resource.Name, _ = shared.AttributeAsString(instance.Attributes, "account_id")
+				if email, ok := shared.AttributeAsString(instance.Attributes, "email"); ok {
+					wb.GetChild(ChildKeyIDs).Set(KeyServiceAccountEmail, email)
+				}
+				wb.Set(CreatedServiceAccountKey, "true")
+			case ResourceKindNAT:
+				resource.Router, _ = shared.AttributeAsString(instance.Attributes, "router")
+			}
+
+			resources = append(resources, resource)
+		}
+	}
+
+	return &gcp.InfrastructureState{Data: wb.ExportAsFlatMap()}, resources
+}
+
+// ExpectedResources returns the GCP resources which are managed by the flow for the infrastructure of the given
+// cluster. User-managed resources, i.e. the VPC and the CloudRouter configured in the infrastructure config, are not
+// included.
+func ExpectedResources(clusterName string, config *gcp.InfrastructureConfig, withServiceAccount bool) []Resource {
+	var (
+		fctx      = &FlowContext{clusterName: clusterName, config: config}
+		resources []Resource
+	)
+
+	if withServiceAccount {
+		resources = append(resources, Resource{Kind: ResourceKindServiceAccount, Name: fctx.serviceAccountNameFromConfig()})
+	}
+	if !isUserVPC(config) {
+		resources = append(resources, Resource{Kind: ResourceKindNetwork, Name: fctx.vpcNameFromConfig()})
+	}
+	resources = append(resources, Resource{Kind: ResourceKindSubnet, Name: fctx.subnetNameFromConfig()})
+	if config.Networks.Internal != nil {
+		resources = append(resources, Resource{Kind: ResourceKindSubnet, Name: fctx.internalSubnetNameFromConfig()})
+	}
+	if !isUserRouter(config) {
+		resources = append(resources, Resource{Kind: ResourceKindRouter, Name: fctx.cloudRouterNameFromConfig()})
+	}
+
+	return append(resources,
+		Resource{Kind: ResourceKindNAT, Name: fctx.cloudNatNameFromConfig(), Router: fctx.cloudRouterNameFromConfig()},
+		Resource{Kind: ResourceKindFirewall, Name: firewallRuleAllowInternalName(clusterName)},
+		Resource{Kind: ResourceKindFirewall, Name: firewallRuleAllowHealthChecksName(clusterName)},
+	)
+}
+
+// VerifyMigration diffs the live GCP resources against the resources tracked by the Terraform state and the resources
+// managed by the flow. It returns the discrepancies which need to be resolved before the infrastructure is migrated
+// from Terraform to the flow.
+func VerifyMigration(
+	ctx context.Context,
+	computeClient gcpclient.ComputeClient,
+	iamClient gcpclient.IAMClient,
+	region string,
+	tfResources, flowResources []Resource,
+) ([]Discrepancy, error) {
+	var (
+		inTerraform   = map[string]bool{}
+		inFlow        = map[string]bool{}
+		resources     []Resource
+		discrepancies []Discrepancy
+	)
+
+	for _, r := range tfResources {
+		if !inTerraform[r.key()] {
+			resources = append(resources, r)
+		}
+		inTerraform[r.key()] = true
+	}
+	for _, r := range flowResources {
+		if !inTerraform[r.key()] && !inFlow[r.key()] {
+			resources = append(resources, r)
+		}
+		inFlow[r.key()] = true
+	}
+
+	for _, r := range resources {
+		exists, err := resourceExists(ctx, computeClient, iamClient, region, r)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get %s: %w", r, err)
+		}
+
+		var message string
+		switch {
+		case inTerraform[r.key()] && !exists:
+			message = "is tracked by the Terraform state, but does not exist"
+		case inTerraform[r.key()] && !inFlow[r.key()]:
+			message = "is tracked by the Terraform state, but is not managed by the flow"
+		case !inTerraform[r.key()] && exists:
+			message = "exists and is managed by the flow, but is not tracked by the Terraform state"
+		case !inTerraform[r.key()]:
+			message = "is managed by the flow, but is neither tracked by the Terraform state nor exists"
+		default:
+			continue
+		}
+		discrepancies = append(discrepancies, Discrepancy{Resource: r, Message: message})
+	}
+
+	return discrepancies, nil
+}
+
+// key identifies the resource independent of its region. The region is not always tracked by the Terraform state.
+func (r Resource) key() string {
+	return fmt.Sprintf("%s/%s/%s", r.Kind, r.Router, r.Name)
+}
+
+func resourceExists(ctx context.Context, computeClient gcpclient.ComputeClient, iamClient gcpclient.IAMClient, region string, r Resource) (bool, error) {
+	if r.Region != "" {
+		region = r.Region
+	}
+
+	switch r.Kind {
+	case ResourceKindServiceAccount:
+		sa, err := iamClient.GetServiceAccount(ctx, r.Name)
+		return sa != nil, err
+	case ResourceKindNetwork:
+		network, err := computeClient.GetNetwork(ctx, r.Name)
+		return network != nil, err
+	case ResourceKindSubnet:
+		subnet, err := computeClient.GetSubnet(ctx, region, r.Name)
+		return subnet != nil, err
+	case ResourceKindRouter:
+		router, err := computeClient.GetRouter(ctx, region, r.Name)
+		return router != nil, err
+	case ResourceKindNAT:
+		router, err := computeClient.GetRouter(ctx, region, r.Router)
+		if err != nil || router == nil {
+			return false, err
+		}
+		for _, nat := range router.Nats {
+			if nat.Name == r.Name {
+				return true, nil
+			}
+		}
+		return false, nil
+	case ResourceKindFirewall:
+		firewall, err := computeClient.GetFirewallRule(ctx, r.Name)
+		return firewall != nil, err
+	}
+	return false, fmt.Errorf("unknown resource kind %q", r.Kind)
+}
@@ -0,0 +1,212 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package infraflow
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.uber.org/mock/gomock"
+	"google.golang.org/api/compute/v1"
+	"google.golang.org/api/iam/v1"
+	"k8s.io/utils/ptr"
+
+	"github.com/gardener/gardener-extension-provider-gcp/pkg/apis/gcp"
+	"github.com/gardener/gardener-extension-provider-gcp/pkg/controller/infrastructure/infraflow/shared"
+	mockgcpclient "github.com/gardener/gardener-extension-provider-gcp/pkg/gcp/client/mock"
+)
+
+var _ = Describe("Migration from Terraform", func() {
+	const (
+		clusterName = "shoot--foo--bar"
+		region      = "europe-west1"
+		email       = clusterName + "@project.iam.gserviceaccount.com"
+	)
+
+	var (
+		tfState *shared.TerraformState
+		config  *gcp.InfrastructureConfig
+	)
+
+	managed := func(tfType, name string, attributes map[string]interface{}) shared.TFResource {
+		return shared.TFResource{
+			Mode:      shared.ModeManaged,
+			Type:      tfType,
+			Name:      name,
+			Instances: []shared.TFInstance{{Attributes: attributes}},
+		}
+	}
+
+	BeforeEach(func() {
+		tfState = &shared.TerraformState{
+			Version: 4,
+			Resources: []shared.TFResource{
+				managed("google_service_account", "serviceaccount", map[string]interface{}{
+					"account_id": clusterName,
+					"email":      email,
+					"name":       "projects/project/serviceAccounts/" + email,
+				}),
+				managed("google_compute_network", "network", map[string]interface{}{"name": clusterName}),
+				managed("google_compute_subnetwork", "subnetwork-nodes", map[string]interface{}{"name": clusterName + "-nodes", "region": region}),
+				managed("google_compute_subnetwork", "subnetwork-internal", map[string]interface{}{"name": clusterName + "-internal", "region": region}),
+				managed("google_compute_router", "router", map[string]interface{}{"name": clusterName + "-cloud-router", "region": region}),
+				managed("google_compute_router_nat", "nat", map[string]interface{}{"name": clusterName + "-cloud-nat", "router": clusterName + "-cloud-router", "region": region}),
+				managed("google_compute_firewall", "rule-allow-internal-access", map[string]interface{}{"name": clusterName + "-allow-internal-access"}),
+				managed("google_compute_firewall", "rule-allow-health-checks", map[string]interface{}{"name": clusterName + "-allow-health-checks"}),
+				{Mode: "data", Type: "google_compute_address", Name: "ip", Instances: []shared.TFInstance{{Attributes: map[string]interface{}{"name": "ip"}}}},
+			},
+		}
+		config = &gcp.InfrastructureConfig{
+			Networks: gcp.NetworkConfig{
+				Internal: ptr.To("10.251.0.0/16"),
+			},
+		}
+	})
+
+	Describe("#StateFromTerraform", func() {
+		It("should translate the VPC, subnet, router, NAT and firewall resources", func() {
+			state, resources := StateFromTerraform(tfState)
+
+			Expect(state.Data).To(Equal(map[string]string{
+				CreatedResourcesExistKey:                                "true",
+				CreatedServiceAccountKey:                                "true",
+				ChildKeyIDs + shared.Separator + KeyServiceAccountEmail: email,
+			}))
+			Expect(resources).To(Equal([]Resource{
+				{Kind: ResourceKindServiceAccount, Name: clusterName},
+				{Kind: ResourceKindNetwork, Name: clusterName},
+				{Kind: ResourceKindSubnet, Name: clusterName + "-nodes", Region: region},
+				{Kind: ResourceKindSubnet, Name: clusterName + "-internal", Region: region},
+				{Kind: ResourceKindRouter, Name: clusterName + "-cloud-router", Region: region},
+				{Kind: ResourceKindNAT, Name: clusterName + "-cloud-nat", Region: region, Router: clusterName + "-cloud-router"},
+				{Kind: ResourceKindFirewall, Name: clusterName + "-allow-internal-access"},
+				{Kind: ResourceKindFirewall, Name: clusterName + "-allow-health-checks"},
+			}))
+		})
+
+		It("should mark that resources exist for managed resources which are not translated", func() {
+			state, resources := StateFromTerraform(&shared.TerraformState{
+				Version: 4,
+				Resources: []shared.TFResource{
+					managed("google_compute_route", "route", map[string]interface{}{"name": clusterName + "-route"}),
+				},
+			})
+
+			Expect(state.Data).To(Equal(map[string]string{CreatedResourcesExistKey: "true"}))
+			Expect(resources).To(BeEmpty())
+		})
+
+		It("should not mark that resources exist for data sources", func() {
+			state, resources := StateFromTerraform(&shared.TerraformState{
+				Version: 4,
+				Resources: []shared.TFResource{
+					{Mode: "data", Type: "google_compute_network", Name: "network", Instances: []shared.TFInstance{{Attributes: map[string]interface{}{"name": "vpc"}}}},
+				},
+			})
+
+			Expect(state.Data).To(BeEmpty())
+			Expect(resources).To(BeEmpty())
+		})
+
+		It("should return an empty state for an empty terraform state", func() {
+			state, resources := StateFromTerraform(&shared.TerraformState{Version: 4})
+
+			Expect(state.Data).To(BeEmpty())
+			Expect(resources).To(BeEmpty())
+		})
+	})
+
+	Describe("#ExpectedResources", func() {
+		It("should return the resources managed by the flow", func() {
+			_, tfResources := StateFromTerraform(tfState)
+			flowResources := ExpectedResources(clusterName, config, true)
+
+			Expect(flowResources).To(HaveLen(len(tfResources)))
+			for i := range flowResources {
+				Expect(flowResources[i].key()).To(Equal(tfResources[i].key()))
+			}
+		})
+
+		It("should not return user-managed resources", func() {
+			config.Networks.VPC = &gcp.VPC{Name: "vpc", CloudRouter: &gcp.CloudRouter{Name: "router"}}
+
+			Expect(ExpectedResources(clusterName, config, false)).To(Equal([]Resource{
+				{Kind: ResourceKindSubnet, Name: clusterName + "-nodes"},
+				{Kind: ResourceKindSubnet, Name: clusterName + "-internal"},
+				{Kind: ResourceKindNAT, Name: clusterName + "-cloud-nat", Router: "router"},
+				{Kind: ResourceKindFirewall, Name: clusterName + "-allow-internal-access"},
+				{Kind: ResourceKindFirewall, Name: clusterName + "-allow-health-checks"},
+			}))
+		})
+	})
+
+	Describe("#VerifyMigration", func() {
+		var (
+			ctx           = context.Background()
+			ctrl          *gomock.Controller
+			computeClient *mockgcpclient.MockComputeClient
+			iamClient     *mockgcpclient.MockIAMClient
+		)
+
+		BeforeEach(func() {
+			ctrl = gomock.NewController(GinkgoT())
+			computeClient = mockgcpclient.NewMockComputeClient(ctrl)
+			iamClient = mockgcpclient.NewMockIAMClient(ctrl)
+		})
+
+		AfterEach(func() {
+			ctrl.Finish()
+		})
+
+		It("should report discrepancies between the live resources, the terraform state and the flow", func() {
+			tfResources := []Resource{
+				{Kind: ResourceKindServiceAccount, Name: clusterName},
+				{Kind: ResourceKindNetwork, Name: clusterName},
+				{Kind: ResourceKindSubnet, Name: clusterName + "-nodes", Region: region},
+				{Kind: ResourceKindNAT, Name: clusterName + "-cloud-nat", Router: clusterName + "-cloud-router"},
+				{Kind: ResourceKindFirewall, Name: clusterName + "-allow-external-access"},
+			}
+			flowResources := []Resource{
+				{Kind: ResourceKindServiceAccount, Name: clusterName},
+				{Kind: ResourceKindNetwork, Name: clusterName},
+				{Kind: ResourceKindSubnet, Name: clusterName + "-nodes"},
+				{Kind: ResourceKindRouter, Name: clusterName + "-cloud-router"},
+				{Kind: ResourceKindNAT, Name: clusterName + "-cloud-nat", Router: clusterName + "-cloud-router"},
+				{Kind: ResourceKindFirewall, Name: clusterName + "-allow-internal-access"},
+			}
+
+			iamClient.EXPECT().GetServiceAccount(ctx, clusterName).Return(&iam.ServiceAccount{}, nil)
+			computeClient.EXPECT().GetNetwork(ctx, clusterName).Return(nil, nil)
+			computeClient.EXPECT().GetSubnet(ctx, region, clusterName+"-nodes").Return(&compute.Subnetwork{}, nil)
+			computeClient.EXPECT().GetRouter(ctx, region, clusterName+"-cloud-router").Return(&compute.Router{
+				Nats: []*compute.RouterNat{{Name: clusterName + "-cloud-nat"}},
+			}, nil).Times(2)
+			computeClient.EXPECT().GetFirewallRule(ctx, clusterName+"-allow-external-access").Return(&compute.Firewall{}, nil)
+			computeClient.EXPECT().GetFirewallRule(ctx, clusterName+"-allow-internal-access").Return(nil, nil)
+
+			discrepancies, err := VerifyMigration(ctx, computeClient, iamClient, region, tfResources, flowResources)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(discrepancies).To(Equal([]Discrepancy{
+				{
+					Resource: Resource{Kind: ResourceKindNetwork, Name: clusterName},
+					Message:  "is tracked by the Terraform state, but does not exist",
+				},
+				{
+					Resource: Resource{Kind: ResourceKindFirewall, Name: clusterName + "-allow-external-access"},
+					Message:  "is tracked by the Terraform state, but is not managed by the flow",
+				},
+				{
+					Resource: Resource{Kind: ResourceKindRouter, Name: clusterName + "-cloud-router"},
+					Message:  "exists and is managed by the flow, but is not tracked by the Terraform state",
+				},
+				{
+					Resource: Resource{Kind: ResourceKindFirewall, Name: clusterName + "-allow-internal-access"},
+					Message:  "is managed by the flow, but is neither tracked by the Terraform state nor exists",
+				},
+			}))
+		})
+	})
+})
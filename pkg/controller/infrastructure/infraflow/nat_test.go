@@ -0,0 +1,237 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package infraflow
+
+import (
+	"context"
+	"time"
+
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	"github.com/go-logr/logr"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gstruct"
+	"go.uber.org/mock/gomock"
+	"google.golang.org/api/compute/v1"
+	"k8s.io/utils/ptr"
+
+	"github.com/gardener/gardener-extension-provider-gcp/pkg/apis/gcp"
+	"github.com/gardener/gardener-extension-provider-gcp/pkg/controller/infrastructure/infraflow/shared"
+	gcpclient "github.com/gardener/gardener-extension-provider-gcp/pkg/gcp/client"
+	mockgcpclient "github.com/gardener/gardener-extension-provider-gcp/pkg/gcp/client/mock"
+)
+
+var _ = Describe("CloudNAT", func() {
+	const (
+		clusterName = "shoot--foo--bar"
+		region      = "europe-west1"
+		routerName  = clusterName + "-cloud-router"
+		natName     = clusterName + "-cloud-nat"
+	)
+
+	var (
+		ctx           context.Context
+		ctrl          *gomock.Controller
+		computeClient *mockgcpclient.MockComputeClient
+		fctx          *FlowContext
+	)
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		ctrl = gomock.NewController(GinkgoT())
+		computeClient = mockgcpclient.NewMockComputeClient(ctrl)
+
+		fctx = &FlowContext{
+			infra: &extensionsv1alpha1.Infrastructure{
+				Spec: extensionsv1alpha1.InfrastructureSpec{Region: region},
+			},
+			config: &gcp.InfrastructureConfig{
+				Networks: gcp.NetworkConfig{DisableCloudNAT: ptr.To(true)},
+			},
+			clusterName:   clusterName,
+			whiteboard:    shared.NewWhiteboard(),
+			log:           logr.Discard(),
+			computeClient: computeClient,
+			updater:       gcpclient.NewUpdater(logr.Discard(), computeClient),
+		}
+		fctx.whiteboard.SetObject(ObjectKeyRouter, &compute.Router{Name: routerName})
+		fctx.whiteboard.SetObject(ObjectKeyNodeSubnet, &compute.Subnetwork{Name: clusterName + "-nodes"})
+	})
+
+	AfterEach(func() {
+		ctrl.Finish()
+	})
+
+	Describe("#ensureCloudNAT", func() {
+		It("should not create a NAT if the CloudNAT is disabled", func() {
+			computeClient.EXPECT().GetRouter(ctx, region, routerName).Return(&compute.Router{Name: routerName}, nil)
+
+			Expect(fctx.ensureCloudNAT(ctx)).To(Succeed())
+			Expect(fctx.whiteboard.GetObject(ObjectKeyNAT)).To(BeNil())
+		})
+
+		It("should remove an existing NAT if the CloudNAT is disabled", func() {
+			fctx.whiteboard.SetObject(ObjectKeyNAT, &compute.RouterNat{Name: natName})
+			computeClient.EXPECT().GetRouter(ctx, region, routerName).Return(&compute.Router{
+				Name: routerName,
+				Nats: []*compute.RouterNat{{Name: natName}},
+			}, nil)
+			computeClient.EXPECT().PatchRouter(ctx, region, routerName, gomock.Any()).DoAndReturn(func(_ context.Context, _, _ string, router *compute.Router) (*compute.Router, error) {
+				Expect(router.Nats).To(BeEmpty())
+				return router, nil
+			})
+
+			Expect(fctx.ensureCloudNAT(ctx)).To(Succeed())
+			Expect(fctx.whiteboard.GetObject(ObjectKeyNAT)).To(BeNil())
+		})
+
+		It("should enable the endpoint independent mapping of an existing NAT", func() {
+			fctx.config.Networks.DisableCloudNAT = nil
+			fctx.config.Networks.CloudNAT = &gcp.CloudNAT{
+				EndpointIndependentMapping: &gcp.EndpointIndependentMapping{Enabled: true},
+			}
+			fctx.whiteboard.SetObject(ObjectKeyRouter, &compute.Router{
+				Name: routerName,
+				Nats: []*compute.RouterNat{{Name: natName}},
+			})
+			computeClient.EXPECT().PatchRouter(ctx, region, routerName, gomock.Any()).DoAndReturn(func(_ context.Context, _, _ string, router *compute.Router) (*compute.Router, error) {
+				Expect(router.Nats).To(HaveLen(1))
+				Expect(router.Nats[0].EnableEndpointIndependentMapping).To(BeTrue())
+				return router, nil
+			})
+
+			Expect(fctx.ensureCloudNAT(ctx)).To(Succeed())
+			Expect(GetObject[*compute.RouterNat](fctx.whiteboard, ObjectKeyNAT).EnableEndpointIndependentMapping).To(BeTrue())
+		})
+
+		It("should update the timeouts of an existing NAT", func() {
+			fctx.config.Networks.DisableCloudNAT = nil
+			fctx.config.Networks.CloudNAT = &gcp.CloudNAT{
+				IcmpIdleTimeoutSec:           ptr.To[int32](60),
+				TcpEstablishedIdleTimeoutSec: ptr.To[int32](3600),
+				TcpTimeWaitTimeoutSec:        ptr.To[int32](90),
+				TcpTransitoryIdleTimeoutSec:  ptr.To[int32](45),
+				UdpIdleTimeoutSec:            ptr.To[int32](120),
+			}
+			fctx.whiteboard.SetObject(ObjectKeyRouter, &compute.Router{
+				Name: routerName,
+				Nats: []*compute.RouterNat{{
+					Name:                         natName,
+					IcmpIdleTimeoutSec:           30,
+					TcpEstablishedIdleTimeoutSec: 1200,
+					TcpTimeWaitTimeoutSec:        120,
+					TcpTransitoryIdleTimeoutSec:  30,
+					UdpIdleTimeoutSec:            30,
+				}},
+			})
+			computeClient.EXPECT().PatchRouter(ctx, region, routerName, gomock.Any()).DoAndReturn(func(_ context.Context, _, _ string, router *compute.Router) (*compute.Router, error) {
+				Expect(router.Nats).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+					"IcmpIdleTimeoutSec":           Equal(int64(60)),
+					"TcpEstablishedIdleTimeoutSec": Equal(int64(3600)),
+					"TcpTimeWaitTimeoutSec":        Equal(int64(90)),
+					"TcpTransitoryIdleTimeoutSec":  Equal(int64(45)),
+					"UdpIdleTimeoutSec":            Equal(int64(120)),
+				}))))
+				return router, nil
+			})
+
+			Expect(fctx.ensureCloudNAT(ctx)).To(Succeed())
+		})
+
+		It("should explicitly disable the endpoint independent mapping of an existing NAT", func() {
+			fctx.config.Networks.DisableCloudNAT = nil
+			fctx.whiteboard.SetObject(ObjectKeyRouter, &compute.Router{
+				Name: routerName,
+				Nats: []*compute.RouterNat{{Name: natName, EnableEndpointIndependentMapping: true}},
+			})
+			computeClient.EXPECT().PatchRouter(ctx, region, routerName, gomock.Any()).DoAndReturn(func(_ context.Context, _, _ string, router *compute.Router) (*compute.Router, error) {
+				Expect(router.Nats).To(HaveLen(1))
+				Expect(router.Nats[0].EnableEndpointIndependentMapping).To(BeFalse())
+				Expect(router.Nats[0].ForceSendFields).To(ContainElement("EnableEndpointIndependentMapping"))
+				return router, nil
+			})
+
+			Expect(fctx.ensureCloudNAT(ctx)).To(Succeed())
+		})
+	})
+
+	Describe("#ensureCloudNAT with user-managed IP addresses", func() {
+		const (
+			addressA = "https://www.googleapis.com/compute/v1/projects/foo/regions/europe-west1/addresses/ip-a"
+			addressB = "https://www.googleapis.com/compute/v1/projects/foo/regions/europe-west1/addresses/ip-b"
+		)
+
+		BeforeEach(func() {
+			fctx.config.Networks.DisableCloudNAT = nil
+			fctx.config.Networks.CloudNAT = &gcp.CloudNAT{
+				NatIPNames: []gcp.NatIPName{{Name: "ip-a"}},
+			}
+			fctx.whiteboard.SetObject(ObjectKeyIPAddresses, []*compute.Address{{Name: "ip-a", SelfLink: addressA}})
+		})
+
+		It("should drain the connections of a removed IP address", func() {
+			fctx.whiteboard.SetObject(ObjectKeyRouter, &compute.Router{
+				Name: routerName,
+				Nats: []*compute.RouterNat{{Name: natName, NatIps: []string{addressA, addressB}}},
+			})
+			computeClient.EXPECT().PatchRouter(ctx, region, routerName, gomock.Any()).DoAndReturn(func(_ context.Context, _, _ string, router *compute.Router) (*compute.Router, error) {
+				Expect(router.Nats).To(HaveLen(1))
+				Expect(router.Nats[0].NatIps).To(ConsistOf(addressA))
+				Expect(router.Nats[0].DrainNatIps).To(ConsistOf(addressB))
+				return router, nil
+			})
+
+			Expect(fctx.ensureCloudNAT(ctx)).To(Succeed())
+			Expect(fctx.whiteboard.GetChild(ChildKeyDrainingNatIPs).Get("ip-b")).NotTo(BeNil())
+		})
+
+		It("should keep draining a removed IP address within the drain period", func() {
+			fctx.whiteboard.GetChild(ChildKeyDrainingNatIPs).Set("ip-b", time.Now().Add(-10*time.Minute).Format(time.RFC3339))
+			fctx.whiteboard.SetObject(ObjectKeyRouter, &compute.Router{
+				Name: routerName,
+				Nats: []*compute.RouterNat{{Name: natName, NatIps: []string{addressA}, DrainNatIps: []string{addressB}}},
+			})
+			computeClient.EXPECT().PatchRouter(ctx, region, routerName, gomock.Any()).DoAndReturn(func(_ context.Context, _, _ string, router *compute.Router) (*compute.Router, error) {
+				Expect(router.Nats[0].DrainNatIps).To(ConsistOf(addressB))
+				return router, nil
+			})
+
+			Expect(fctx.ensureCloudNAT(ctx)).To(Succeed())
+		})
+
+		It("should release a drained IP address after the drain period", func() {
+			fctx.whiteboard.GetChild(ChildKeyDrainingNatIPs).Set("ip-b", time.Now().Add(-2*time.Hour).Format(time.RFC3339))
+			fctx.whiteboard.SetObject(ObjectKeyRouter, &compute.Router{
+				Name: routerName,
+				Nats: []*compute.RouterNat{{Name: natName, NatIps: []string{addressA}, DrainNatIps: []string{addressB}}},
+			})
+			computeClient.EXPECT().PatchRouter(ctx, region, routerName, gomock.Any()).DoAndReturn(func(_ context.Context, _, _ string, router *compute.Router) (*compute.Router, error) {
+				Expect(router.Nats[0].DrainNatIps).To(BeEmpty())
+				Expect(router.Nats[0].NullFields).To(ContainElement("DrainNatIps"))
+				return router, nil
+			})
+
+			Expect(fctx.ensureCloudNAT(ctx)).To(Succeed())
+		})
+
+		It("should stop draining an IP address which is used again", func() {
+			fctx.config.Networks.CloudNAT.NatIPNames = append(fctx.config.Networks.CloudNAT.NatIPNames, gcp.NatIPName{Name: "ip-b"})
+			fctx.whiteboard.SetObject(ObjectKeyIPAddresses, []*compute.Address{{Name: "ip-a", SelfLink: addressA}, {Name: "ip-b", SelfLink: addressB}})
+			fctx.whiteboard.GetChild(ChildKeyDrainingNatIPs).Set("ip-b", time.Now().Add(-10*time.Minute).Format(time.RFC3339))
+			fctx.whiteboard.SetObject(ObjectKeyRouter, &compute.Router{
+				Name: routerName,
+				Nats: []*compute.RouterNat{{Name: natName, NatIps: []string{addressA}, DrainNatIps: []string{addressB}}},
+			})
+			computeClient.EXPECT().PatchRouter(ctx, region, routerName, gomock.Any()).DoAndReturn(func(_ context.Context, _, _ string, router *compute.Router) (*compute.Router, error) {
+				Expect(router.Nats[0].NatIps).To(ConsistOf(addressA, addressB))
+				Expect(router.Nats[0].DrainNatIps).To(BeEmpty())
+				return router, nil
+			})
+
+			Expect(fctx.ensureCloudNAT(ctx)).To(Succeed())
+			Expect(fctx.whiteboard.GetChild(ChildKeyDrainingNatIPs).AsMap()).To(BeEmpty())
+		})
+	})
+})
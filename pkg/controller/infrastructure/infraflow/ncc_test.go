@@ -0,0 +1,117 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package infraflow
+
+import (
+	"context"
+
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	"github.com/go-logr/logr"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gstruct"
+	"go.uber.org/mock/gomock"
+	"google.golang.org/api/compute/v1"
+	"google.golang.org/api/networkconnectivity/v1"
+
+	"github.com/gardener/gardener-extension-provider-gcp/pkg/apis/gcp"
+	"github.com/gardener/gardener-extension-provider-gcp/pkg/controller/infrastructure/infraflow/shared"
+	mockgcpclient "github.com/gardener/gardener-extension-provider-gcp/pkg/gcp/client/mock"
+)
+
+var _ = Describe("NCC spoke", func() {
+	const (
+		clusterName = "shoot--foo--bar"
+		hub         = "projects/hub-project/locations/global/hubs/hub"
+		network     = "https://www.googleapis.com/compute/v1/projects/project/global/networks/" + clusterName
+	)
+
+	var (
+		ctx       context.Context
+		ctrl      *gomock.Controller
+		nccClient *mockgcpclient.MockNetworkConnectivityClient
+		fctx      *FlowContext
+	)
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		ctrl = gomock.NewController(GinkgoT())
+		nccClient = mockgcpclient.NewMockNetworkConnectivityClient(ctrl)
+
+		fctx = &FlowContext{
+			infra: &extensionsv1alpha1.Infrastructure{},
+			config: &gcp.InfrastructureConfig{
+				Networks: gcp.NetworkConfig{
+					NetworkConnectivityCenter: &gcp.NetworkConnectivityCenter{Hub: hub},
+				},
+			},
+			clusterName: clusterName,
+			whiteboard:  shared.NewWhiteboard(),
+			log:         logr.Discard(),
+			nccClient:   nccClient,
+		}
+		fctx.whiteboard.SetObject(ObjectKeyVPC, &compute.Network{Name: clusterName, SelfLink: network})
+	})
+
+	AfterEach(func() {
+		ctrl.Finish()
+	})
+
+	It("should create the spoke", func() {
+		nccClient.EXPECT().GetSpoke(ctx, clusterName).Return(nil, nil)
+		nccClient.EXPECT().CreateSpoke(ctx, clusterName, &networkconnectivity.Spoke{
+			Description:      clusterName,
+			Hub:              hub,
+			LinkedVpcNetwork: &networkconnectivity.LinkedVpcNetwork{Uri: network},
+		}).Return(&networkconnectivity.Spoke{}, nil)
+
+		Expect(fctx.ensureNCCSpoke(ctx)).To(Succeed())
+		Expect(fctx.whiteboard.GetChild(ChildKeyIDs).Get(KeyNCCSpoke)).To(PointTo(Equal(clusterName)))
+	})
+
+	It("should not touch an up-to-date spoke", func() {
+		nccClient.EXPECT().GetSpoke(ctx, clusterName).Return(&networkconnectivity.Spoke{
+			Hub:              "projects/123456789/locations/global/hubs/hub",
+			LinkedVpcNetwork: &networkconnectivity.LinkedVpcNetwork{Uri: network},
+		}, nil)
+
+		Expect(fctx.ensureNCCSpoke(ctx)).To(Succeed())
+		Expect(fctx.whiteboard.GetChild(ChildKeyIDs).Get(KeyNCCSpoke)).To(PointTo(Equal(clusterName)))
+	})
+
+	It("should recreate a spoke attached to another hub", func() {
+		nccClient.EXPECT().GetSpoke(ctx, clusterName).Return(&networkconnectivity.Spoke{
+			Hub:              "projects/hub-project/locations/global/hubs/other",
+			LinkedVpcNetwork: &networkconnectivity.LinkedVpcNetwork{Uri: network},
+		}, nil)
+		gomock.InOrder(
+			nccClient.EXPECT().DeleteSpoke(ctx, clusterName),
+			nccClient.EXPECT().CreateSpoke(ctx, clusterName, gomock.Any()).Return(&networkconnectivity.Spoke{}, nil),
+		)
+
+		Expect(fctx.ensureNCCSpoke(ctx)).To(Succeed())
+	})
+
+	It("should delete the spoke if the configuration was removed", func() {
+		fctx.config.Networks.NetworkConnectivityCenter = nil
+		fctx.whiteboard.GetChild(ChildKeyIDs).Set(KeyNCCSpoke, clusterName)
+		Expect(fctx.hasNCCSpoke()).To(BeTrue())
+
+		nccClient.EXPECT().DeleteSpoke(ctx, clusterName)
+
+		Expect(fctx.ensureNCCSpoke(ctx)).To(Succeed())
+		Expect(fctx.whiteboard.GetChild(ChildKeyIDs).Get(KeyNCCSpoke)).To(BeNil())
+		Expect(fctx.hasNCCSpoke()).To(BeFalse())
+	})
+
+	It("should delete the spoke on infrastructure deletion", func() {
+		fctx.whiteboard.GetChild(ChildKeyIDs).Set(KeyNCCSpoke, clusterName)
+
+		nccClient.EXPECT().DeleteSpoke(ctx, clusterName)
+
+		Expect(fctx.ensureNCCSpokeDeleted(ctx)).To(Succeed())
+		Expect(fctx.whiteboard.GetChild(ChildKeyIDs).Get(KeyNCCSpoke)).To(BeNil())
+	})
+})
@@ -0,0 +1,144 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package infraflow
+
+import (
+	"context"
+
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	"github.com/go-logr/logr"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gstruct"
+	"go.uber.org/mock/gomock"
+	"google.golang.org/api/compute/v1"
+	"google.golang.org/api/servicenetworking/v1"
+	"k8s.io/utils/ptr"
+
+	"github.com/gardener/gardener-extension-provider-gcp/pkg/apis/gcp"
+	"github.com/gardener/gardener-extension-provider-gcp/pkg/controller/infrastructure/infraflow/shared"
+	mockgcpclient "github.com/gardener/gardener-extension-provider-gcp/pkg/gcp/client/mock"
+)
+
+var _ = Describe("Private Service Access", func() {
+	const (
+		clusterName   = "shoot--foo--bar"
+		rangeName     = "psa-range"
+		network       = "https://www.googleapis.com/compute/v1/projects/project/global/networks/" + clusterName
+		psaNetwork    = "projects/123456789/global/networks/" + clusterName
+		description   = "gardener-managed private service access range of " + clusterName
+		projectNumber = int64(123456789)
+	)
+
+	var (
+		ctx           context.Context
+		ctrl          *gomock.Controller
+		computeClient *mockgcpclient.MockComputeClient
+		crmClient     *mockgcpclient.MockResourceManagerClient
+		snClient      *mockgcpclient.MockServiceNetworkingClient
+		fctx          *FlowContext
+	)
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		ctrl = gomock.NewController(GinkgoT())
+		computeClient = mockgcpclient.NewMockComputeClient(ctrl)
+		crmClient = mockgcpclient.NewMockResourceManagerClient(ctrl)
+		snClient = mockgcpclient.NewMockServiceNetworkingClient(ctrl)
+
+		fctx = &FlowContext{
+			infra: &extensionsv1alpha1.Infrastructure{},
+			config: &gcp.InfrastructureConfig{
+				Networks: gcp.NetworkConfig{
+					PrivateServiceAccess: &gcp.PSAConfig{Name: rangeName, PrefixLength: 16, Address: ptr.To("10.252.0.0")},
+				},
+			},
+			clusterName:   clusterName,
+			whiteboard:    shared.NewWhiteboard(),
+			log:           logr.Discard(),
+			computeClient: computeClient,
+			crmClient:     crmClient,
+			snClient:      snClient,
+		}
+		fctx.whiteboard.SetObject(ObjectKeyVPC, &compute.Network{Name: clusterName, SelfLink: network})
+		crmClient.EXPECT().GetProjectNumber(gomock.Any()).Return(projectNumber, nil).AnyTimes()
+	})
+
+	AfterEach(func() {
+		ctrl.Finish()
+	})
+
+	It("should reserve the range and create the connection", func() {
+		computeClient.EXPECT().GetGlobalAddress(ctx, rangeName).Return(nil, nil)
+		computeClient.EXPECT().InsertGlobalAddress(ctx, &compute.Address{
+			Name:         rangeName,
+			Description:  description,
+			AddressType:  "INTERNAL",
+			Purpose:      "VPC_PEERING",
+			Address:      "10.252.0.0",
+			PrefixLength: 16,
+			Network:      network,
+		}).Return(&compute.Address{Name: rangeName}, nil)
+		snClient.EXPECT().GetConnection(ctx, psaNetwork).Return(nil, nil)
+		snClient.EXPECT().CreateConnection(ctx, psaNetwork, []string{rangeName})
+
+		Expect(fctx.ensurePrivateServiceAccess(ctx)).To(Succeed())
+		Expect(fctx.whiteboard.GetChild(ChildKeyIDs).Get(KeyPSARange)).To(PointTo(Equal(rangeName)))
+	})
+
+	It("should add the range to an existing connection", func() {
+		computeClient.EXPECT().GetGlobalAddress(ctx, rangeName).Return(&compute.Address{Name: rangeName, Description: description}, nil)
+		snClient.EXPECT().GetConnection(ctx, psaNetwork).Return(&servicenetworking.Connection{ReservedPeeringRanges: []string{"other"}}, nil)
+		snClient.EXPECT().UpdateConnection(ctx, psaNetwork, []string{"other", rangeName})
+
+		Expect(fctx.ensurePrivateServiceAccess(ctx)).To(Succeed())
+	})
+
+	It("should not touch an up-to-date connection", func() {
+		fctx.whiteboard.GetChild(ChildKeyIDs).Set(KeyPSARange, rangeName)
+		computeClient.EXPECT().GetGlobalAddress(ctx, rangeName).Return(&compute.Address{Name: rangeName, Description: description}, nil)
+		snClient.EXPECT().GetConnection(ctx, psaNetwork).Return(&servicenetworking.Connection{ReservedPeeringRanges: []string{rangeName}}, nil)
+
+		Expect(fctx.ensurePrivateServiceAccess(ctx)).To(Succeed())
+	})
+
+	It("should refuse to adopt an address which is not managed by Gardener", func() {
+		computeClient.EXPECT().GetGlobalAddress(ctx, rangeName).Return(&compute.Address{Name: rangeName, Description: "foreign"}, nil)
+
+		Expect(fctx.ensurePrivateServiceAccess(ctx)).To(MatchError(ContainSubstring("not managed by Gardener")))
+		Expect(fctx.whiteboard.GetChild(ChildKeyIDs).Get(KeyPSARange)).To(BeNil())
+	})
+
+	Context("deletion", func() {
+		BeforeEach(func() {
+			fctx.config.Networks.PrivateServiceAccess = nil
+			fctx.whiteboard.GetChild(ChildKeyIDs).Set(KeyPSARange, rangeName)
+		})
+
+		It("should delete the connection and the range if no other range is allocated", func() {
+			snClient.EXPECT().GetConnection(ctx, psaNetwork).Return(&servicenetworking.Connection{ReservedPeeringRanges: []string{rangeName}}, nil)
+			connectionDeleted := snClient.EXPECT().DeleteConnection(ctx, psaNetwork)
+			computeClient.EXPECT().DeleteGlobalAddress(ctx, rangeName).After(connectionDeleted)
+
+			Expect(fctx.ensurePrivateServiceAccess(ctx)).To(Succeed())
+			Expect(fctx.whiteboard.GetChild(ChildKeyIDs).Get(KeyPSARange)).To(BeNil())
+		})
+
+		It("should only release the range if other ranges are allocated", func() {
+			snClient.EXPECT().GetConnection(ctx, psaNetwork).Return(&servicenetworking.Connection{ReservedPeeringRanges: []string{"other", rangeName}}, nil)
+			rangeReleased := snClient.EXPECT().UpdateConnection(ctx, psaNetwork, []string{"other"})
+			computeClient.EXPECT().DeleteGlobalAddress(ctx, rangeName).After(rangeReleased)
+
+			Expect(fctx.ensurePrivateServiceAccessDeleted(ctx)).To(Succeed())
+			Expect(fctx.whiteboard.GetChild(ChildKeyIDs).Get(KeyPSARange)).To(BeNil())
+		})
+
+		It("should do nothing if no range was reserved", func() {
+			fctx.whiteboard.GetChild(ChildKeyIDs).Delete(KeyPSARange)
+
+			Expect(fctx.ensurePrivateServiceAccessDeleted(ctx)).To(Succeed())
+		})
+	})
+})
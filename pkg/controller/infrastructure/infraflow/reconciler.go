@@ -2,7 +2,9 @@ package infraflow
 
 import (
 	"context"
+	"strconv"
 	"strings"
+	"time"
 
 	"github.com/gardener/gardener/extensions/pkg/controller"
 	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
@@ -14,6 +16,7 @@ import (
 	"k8s.io/utils/ptr"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
+	controllerconfig "github.com/gardener/gardener-extension-provider-gcp/pkg/apis/config"
 	"github.com/gardener/gardener-extension-provider-gcp/pkg/apis/gcp"
 	"github.com/gardener/gardener-extension-provider-gcp/pkg/apis/gcp/helper"
 	"github.com/gardener/gardener-extension-provider-gcp/pkg/apis/gcp/v1alpha1"
@@ -27,6 +30,9 @@ const (
 
 	// CreatedServiceAccountKey marks whether we have created a service account for the shoot. If not we will skip reconciling the service accounts
 	CreatedServiceAccountKey = "service_account_exist"
+	// DeletedServiceAccountBindingsKey marks whether the IAM bindings of the service account were removed from the IAM
+	// policy of the project during the deletion, so that the policy is not read again if the deletion is retried.
+	DeletedServiceAccountBindingsKey = "service_account_bindings_deleted"
 	// CreatedResourcesExistKey is a marker for the Terraform migration case. If the TF state is not empty
 	// we inject this marker into the state to block the deletion without having first a successful reconciliation.
 	CreatedResourcesExistKey = "resources_exist"
@@ -34,12 +40,23 @@ const (
 	ChildKeyIDs = "ids"
 	// KeyServiceAccountEmail is the key to store the service account object.
 	KeyServiceAccountEmail = "service-account-email"
+	// ChildKeyRoutes is the prefix key for the routes of the pod CIDRs which are deleted with the infrastructure.
+	ChildKeyRoutes = "routes"
+	// ChildKeyDrainingNatIPs is the prefix key for the IP addresses which were removed from the CloudNAT and whose
+	// connections are drained. The values are the times the draining started.
+	ChildKeyDrainingNatIPs = "draining-nat-ips"
+	// KeyNCCSpoke is the key to store the ID of the Network Connectivity Center spoke.
+	KeyNCCSpoke = "ncc-spoke"
+	// KeyPSARange is the key to store the name of the address range which was reserved for Private Service Access.
+	KeyPSARange = "psa-range"
 	// ObjectKeyVPC is the key to store the VPC object.
 	ObjectKeyVPC = "vpc"
 	// ObjectKeyNodeSubnet is the key to store the nodes subnet object.
 	ObjectKeyNodeSubnet = "subnet-nodes"
 	// ObjectKeyInternalSubnet is the key to store the internal subnet object.
 	ObjectKeyInternalSubnet = "subnet-internal"
+	// ObjectKeyProxyOnlySubnet is the key to store the proxy-only subnet object.
+	ObjectKeyProxyOnlySubnet = "subnet-proxy-only"
 	// ObjectKeyRouter router is the key for the CloudRouter.
 	ObjectKeyRouter = "router"
 	// ObjectKeyNAT is the key for the .CloudNAT object.
@@ -48,6 +65,12 @@ const (
 	ObjectKeyIPAddresses = "addresses/ip"
 )
 
+const (
+	// natIPDrainPeriod is the period in which the connections of an IP address removed from the CloudNAT are drained
+	// before the address is released.
+	natIPDrainPeriod = time.Hour
+)
+
 var (
 	// DefaultUpdaterFunc is the default constructor used for an Updated used in the package.
 	DefaultUpdaterFunc = gcpclient.NewUpdater
@@ -70,9 +93,14 @@ type FlowContext struct {
 	podCIDR        *string
 	persistFn      PersistStateFunc
 	log            logr.Logger
+	limiter        *shared.ConcurrencyLimiter
+	natDefaults    *controllerconfig.CloudNAT
 
 	computeClient gcpclient.ComputeClient
 	iamClient     gcpclient.IAMClient
+	nccClient     gcpclient.NetworkConnectivityClient
+	crmClient     gcpclient.ResourceManagerClient
+	snClient      gcpclient.ServiceNetworkingClient
 	*shared.BasicFlowContext
 }
 
@@ -88,6 +116,10 @@ type Opts struct {
 	Factory        gcpclient.Factory
 	Client         client.Client
 	PersistFunc    PersistStateFunc
+	// ConcurrencyLimiter limits the number of concurrent tasks per resource type. It is optional.
+	ConcurrencyLimiter *shared.ConcurrencyLimiter
+	// CloudNATDefaults are the controller-wide defaults of the CloudNAT. They are optional.
+	CloudNATDefaults *controllerconfig.CloudNAT
 }
 
 // NewFlowContext returns a new FlowContext.
@@ -107,6 +139,18 @@ func NewFlowContext(ctx context.Context, opts Opts) (*FlowContext, error) {
 	if err != nil {
 		return nil, err
 	}
+	ncc, err := opts.Factory.NetworkConnectivity(ctx, opts.Client, opts.Infra.Spec.SecretRef)
+	if err != nil {
+		return nil, err
+	}
+	crm, err := opts.Factory.ResourceManager(ctx, opts.Client, opts.Infra.Spec.SecretRef)
+	if err != nil {
+		return nil, err
+	}
+	sn, err := opts.Factory.ServiceNetworking(ctx, opts.Client, opts.Infra.Spec.SecretRef)
+	if err != nil {
+		return nil, err
+	}
 
 	fr := &FlowContext{
 		whiteboard:     wb,
@@ -119,9 +163,14 @@ func NewFlowContext(ctx context.Context, opts Opts) (*FlowContext, error) {
 		podCIDR:        opts.Cluster.Shoot.Spec.Networking.Pods,
 		persistFn:      opts.PersistFunc,
 		log:            opts.Log,
+		limiter:        opts.ConcurrencyLimiter,
+		natDefaults:    opts.CloudNATDefaults,
 
 		computeClient: com,
 		iamClient:     iam,
+		nccClient:     ncc,
+		crmClient:     crm,
+		snClient:      sn,
 	}
 
 	return fr, nil
@@ -169,6 +218,10 @@ func (fctx *FlowContext) getStatus() *v1alpha1.InfrastructureStatus {
 
 	if n := GetObject[*compute.Network](fctx.whiteboard, ObjectKeyVPC); n != nil {
 		status.Networks.VPC.Name = n.Name
+		status.Networks.VPC.SelfLink = n.SelfLink
+		if n.Id != 0 {
+			status.Networks.VPC.ID = strconv.FormatUint(n.Id, 10)
+		}
 	}
 
 	if s := GetObject[*compute.Subnetwork](fctx.whiteboard, ObjectKeyNodeSubnet); s != nil {
@@ -185,6 +238,13 @@ func (fctx *FlowContext) getStatus() *v1alpha1.InfrastructureStatus {
 		})
 	}
 
+	if s := GetObject[*compute.Subnetwork](fctx.whiteboard, ObjectKeyProxyOnlySubnet); s != nil {
+		status.Networks.Subnets = append(status.Networks.Subnets, v1alpha1.Subnet{
+			Name:    s.Name,
+			Purpose: v1alpha1.PurposeProxyOnly,
+		})
+	}
+
 	if router := GetObject[*compute.Router](fctx.whiteboard, ObjectKeyRouter); router != nil {
 		status.Networks.VPC.CloudRouter = &v1alpha1.CloudRouter{
 			Name: router.Name,
@@ -41,6 +41,7 @@ type TaskOption struct {
 	Dependencies []flow.TaskIDer
 	Timeout      time.Duration
 	DoIf         *bool
+	ResourceType string
 }
 
 // Dependencies creates a TaskOption for dependencies
@@ -58,6 +59,12 @@ func DoIf(condition bool) TaskOption {
 	return TaskOption{DoIf: ptr.To(condition)}
 }
 
+// ResourceType creates a TaskOption for the resource type the task operates on. It is used to limit the number of
+// concurrent tasks per resource type, see WithConcurrencyLimiter.
+func ResourceType(resourceType string) TaskOption {
+	return TaskOption{ResourceType: resourceType}
+}
+
 // BasicFlowContext provides logic for persisting the state and add tasks to the flow graph.
 type BasicFlowContext struct {
 	log           logr.Logger
@@ -66,6 +73,7 @@ type BasicFlowContext struct {
 
 	span      bool
 	persistFn flow.TaskFn
+	limiter   *ConcurrencyLimiter
 
 	lastPersistedGeneration int64
 	lastPersistedAt         time.Time
@@ -100,6 +108,12 @@ func (c *BasicFlowContext) WithPersist(task flow.TaskFn) *BasicFlowContext {
 	return c
 }
 
+// WithConcurrencyLimiter limits the number of concurrently running tasks per resource type.
+func (c *BasicFlowContext) WithConcurrencyLimiter(limiter *ConcurrencyLimiter) *BasicFlowContext {
+	c.limiter = limiter
+	return c
+}
+
 // PersistState persists the internal state to the provider status.
 func (c *BasicFlowContext) PersistState(ctx context.Context) error {
 	c.persistorLock.Lock()
@@ -126,12 +140,19 @@ func (c *BasicFlowContext) AddTask(g *flow.Graph, name string, fn flow.TaskFn, o
 			condition = condition && *opt.DoIf
 			allOptions.DoIf = ptr.To(condition)
 		}
+		if opt.ResourceType != "" {
+			allOptions.ResourceType = opt.ResourceType
+		}
 	}
 
 	tunedFn := fn
 	if allOptions.Timeout > 0 {
 		tunedFn = tunedFn.Timeout(allOptions.Timeout)
 	}
+	// the timeout only applies once a slot was acquired, otherwise tasks could time out while waiting for other tasks.
+	if allOptions.ResourceType != "" {
+		tunedFn = c.limitConcurrency(allOptions.ResourceType, tunedFn)
+	}
 	task := flow.Task{
 		Name:   name,
 		Fn:     c.wrapTaskFn(g.Name(), name, tunedFn),
@@ -145,6 +166,19 @@ func (c *BasicFlowContext) AddTask(g *flow.Graph, name string, fn flow.TaskFn, o
 	return g.Add(task)
 }
 
+// limitConcurrency wraps the task function fn so that it only runs once the limiter grants a slot for the resource type.
+func (c *BasicFlowContext) limitConcurrency(resourceType string, fn flow.TaskFn) flow.TaskFn {
+	return func(ctx context.Context) error {
+		release, err := c.limiter.Acquire(ctx, resourceType)
+		if err != nil {
+			return fmt.Errorf("failed waiting for concurrency limit of resource type %q: %w", resourceType, err)
+		}
+		defer release()
+
+		return fn(ctx)
+	}
+}
+
 // wrapTaskFn sets up the task function fn. It wraps it with the hooks
 func (c *BasicFlowContext) wrapTaskFn(flowName, taskName string, fn flow.TaskFn) flow.TaskFn {
 	return func(ctx context.Context) error {
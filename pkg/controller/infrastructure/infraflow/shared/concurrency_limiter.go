@@ -0,0 +1,50 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package shared
+
+import (
+	"context"
+)
+
+// ConcurrencyLimiter limits the number of tasks operating on the same resource type concurrently. It is meant to be
+// shared across all flows of a controller, so that the limits apply to all reconciliations running in parallel.
+// A nil ConcurrencyLimiter does not limit anything.
+type ConcurrencyLimiter struct {
+	semaphores map[string]chan struct{}
+}
+
+// NewConcurrencyLimiter creates a new ConcurrencyLimiter with the given limits per resource type. Resource types without
+// a positive limit are not limited.
+func NewConcurrencyLimiter(limits map[string]int32) *ConcurrencyLimiter {
+	l := &ConcurrencyLimiter{
+		semaphores: make(map[string]chan struct{}, len(limits)),
+	}
+	for resourceType, limit := range limits {
+		if limit > 0 {
+			l.semaphores[resourceType] = make(chan struct{}, limit)
+		}
+	}
+	return l
+}
+
+// Acquire blocks until a slot for the given resource type is available or the context is done. On success, the returned
+// function must be called to release the slot again.
+func (l *ConcurrencyLimiter) Acquire(ctx context.Context, resourceType string) (func(), error) {
+	if l == nil {
+		return func() {}, nil
+	}
+
+	semaphore, ok := l.semaphores[resourceType]
+	if !ok {
+		return func() {}, nil
+	}
+
+	select {
+	case semaphore <- struct{}{}:
+		return func() { <-semaphore }, nil
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+}
@@ -0,0 +1,121 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package shared_test
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/gardener/gardener/pkg/utils/flow"
+	"github.com/go-logr/logr"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/gardener/gardener-extension-provider-gcp/pkg/controller/infrastructure/infraflow/shared"
+)
+
+var _ = Describe("ConcurrencyLimiter", func() {
+	var ctx context.Context
+
+	BeforeEach(func() {
+		ctx = context.Background()
+	})
+
+	It("should not limit if the limiter is nil", func() {
+		var limiter *shared.ConcurrencyLimiter
+		release, err := limiter.Acquire(ctx, "firewall")
+		Expect(err).NotTo(HaveOccurred())
+		release()
+	})
+
+	It("should not limit resource types without a positive limit", func() {
+		limiter := shared.NewConcurrencyLimiter(map[string]int32{"subnet": 0})
+		for range 3 {
+			_, err := limiter.Acquire(ctx, "subnet")
+			Expect(err).NotTo(HaveOccurred())
+			_, err = limiter.Acquire(ctx, "firewall")
+			Expect(err).NotTo(HaveOccurred())
+		}
+	})
+
+	It("should block until a slot is released", func() {
+		limiter := shared.NewConcurrencyLimiter(map[string]int32{"firewall": 1})
+
+		release, err := limiter.Acquire(ctx, "firewall")
+		Expect(err).NotTo(HaveOccurred())
+
+		timeoutCtx, cancel := context.WithTimeout(ctx, 50*time.Millisecond)
+		defer cancel()
+		_, err = limiter.Acquire(timeoutCtx, "firewall")
+		Expect(err).To(MatchError(context.DeadlineExceeded))
+
+		release()
+		release, err = limiter.Acquire(ctx, "firewall")
+		Expect(err).NotTo(HaveOccurred())
+		release()
+	})
+
+	It("should limit the concurrency of flow tasks with the same resource type", func() {
+		var (
+			limiter = shared.NewConcurrencyLimiter(map[string]int32{"firewall": 2})
+			c       = shared.NewBasicFlowContext().WithLogger(logr.Discard()).WithConcurrencyLimiter(limiter)
+			g       = flow.NewGraph("test")
+
+			mu          sync.Mutex
+			running     int
+			maxRunning  int
+			otherRunner atomic.Int32
+		)
+
+		task := func(_ context.Context) error {
+			mu.Lock()
+			running++
+			maxRunning = max(maxRunning, running)
+			mu.Unlock()
+
+			time.Sleep(20 * time.Millisecond)
+
+			mu.Lock()
+			running--
+			mu.Unlock()
+			return nil
+		}
+
+		for _, name := range []string{"a", "b", "c", "d", "e"} {
+			c.AddTask(g, "firewall-"+name, task, shared.ResourceType("firewall"))
+		}
+		c.AddTask(g, "subnet", func(_ context.Context) error {
+			otherRunner.Add(1)
+			return nil
+		}, shared.ResourceType("subnet"))
+
+		Expect(g.Compile().Run(ctx, flow.Opts{Log: logr.Discard()})).To(Succeed())
+		Expect(maxRunning).To(Equal(2))
+		Expect(otherRunner.Load()).To(Equal(int32(1)))
+	})
+
+	It("should not count the time waiting for a slot against the task timeout", func() {
+		var (
+			limiter = shared.NewConcurrencyLimiter(map[string]int32{"firewall": 1})
+			c       = shared.NewBasicFlowContext().WithLogger(logr.Discard()).WithConcurrencyLimiter(limiter)
+			g       = flow.NewGraph("test")
+		)
+
+		for _, name := range []string{"a", "b", "c"} {
+			c.AddTask(g, "firewall-"+name, func(ctx context.Context) error {
+				select {
+				case <-time.After(30 * time.Millisecond):
+					return nil
+				case <-ctx.Done():
+					return ctx.Err()
+				}
+			}, shared.ResourceType("firewall"), shared.Timeout(50*time.Millisecond))
+		}
+
+		Expect(g.Compile().Run(ctx, flow.Opts{Log: logr.Discard()})).To(Succeed())
+	})
+})
@@ -0,0 +1,63 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package infraflow
+
+import (
+	"context"
+
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	"github.com/go-logr/logr"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.uber.org/mock/gomock"
+	"google.golang.org/api/compute/v1"
+
+	"github.com/gardener/gardener-extension-provider-gcp/pkg/apis/gcp"
+	"github.com/gardener/gardener-extension-provider-gcp/pkg/controller/infrastructure/infraflow/shared"
+	mockgcpclient "github.com/gardener/gardener-extension-provider-gcp/pkg/gcp/client/mock"
+)
+
+var _ = Describe("Internal subnet", func() {
+	const (
+		clusterName = "shoot--foo--bar"
+		region      = "europe-west1"
+	)
+
+	var (
+		ctx           context.Context
+		ctrl          *gomock.Controller
+		computeClient *mockgcpclient.MockComputeClient
+		fctx          *FlowContext
+	)
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		ctrl = gomock.NewController(GinkgoT())
+		computeClient = mockgcpclient.NewMockComputeClient(ctrl)
+
+		fctx = &FlowContext{
+			infra: &extensionsv1alpha1.Infrastructure{
+				Spec: extensionsv1alpha1.InfrastructureSpec{Region: region},
+			},
+			config:        &gcp.InfrastructureConfig{},
+			clusterName:   clusterName,
+			whiteboard:    shared.NewWhiteboard(),
+			log:           logr.Discard(),
+			computeClient: computeClient,
+		}
+	})
+
+	AfterEach(func() {
+		ctrl.Finish()
+	})
+
+	It("should skip the internal subnet and delete an existing one if no CIDR is configured", func() {
+		fctx.whiteboard.SetObject(ObjectKeyInternalSubnet, &compute.Subnetwork{Name: clusterName + "-internal"})
+		computeClient.EXPECT().DeleteSubnet(gomock.Any(), region, clusterName+"-internal")
+
+		Expect(fctx.ensureInternalSubnet(ctx)).To(Succeed())
+		Expect(fctx.whiteboard.HasObject(ObjectKeyInternalSubnet)).To(BeFalse())
+	})
+})
@@ -19,8 +19,10 @@ import (
 	"github.com/go-logr/logr"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/client-go/rest"
+	"k8s.io/utils/ptr"
 	k8sClient "sigs.k8s.io/controller-runtime/pkg/client"
 
+	controllerconfig "github.com/gardener/gardener-extension-provider-gcp/pkg/apis/config"
 	api "github.com/gardener/gardener-extension-provider-gcp/pkg/apis/gcp"
 	"github.com/gardener/gardener-extension-provider-gcp/pkg/apis/gcp/helper"
 	"github.com/gardener/gardener-extension-provider-gcp/pkg/apis/gcp/v1alpha1"
@@ -37,15 +39,19 @@ type TerraformReconciler struct {
 	restConfig                 *rest.Config
 	log                        logr.Logger
 	disableProjectedTokenMount bool
+	cloudNATDefaults           *controllerconfig.CloudNAT
+	gcpClientFactory           gcpclient.Factory
 }
 
 // NewTerraformReconciler returns a new instance of TerraformReconciler.
-func NewTerraformReconciler(client k8sClient.Client, restConfig *rest.Config, log logr.Logger, disableProjectedTokenMount bool) *TerraformReconciler {
+func NewTerraformReconciler(client k8sClient.Client, restConfig *rest.Config, log logr.Logger, disableProjectedTokenMount bool, cloudNATDefaults *controllerconfig.CloudNAT, gcpClientFactory gcpclient.Factory) *TerraformReconciler {
 	return &TerraformReconciler{
 		client:                     client,
 		restConfig:                 restConfig,
 		log:                        log,
 		disableProjectedTokenMount: disableProjectedTokenMount,
+		cloudNATDefaults:           cloudNATDefaults,
+		gcpClientFactory:           gcpClientFactory,
 	}
 }
 
@@ -88,6 +94,18 @@ func (t *TerraformReconciler) reconcile(ctx context.Context, infra *extensionsv1
 		return err
 	}
 
+	switch {
+	case ptr.Deref(config.Networks.DisableCloudNAT, false):
+		return fmt.Errorf("disabling the CloudNAT is only supported by the flow reconciler")
+	case config.Networks.ProxyOnly != nil:
+		return fmt.Errorf("proxy-only subnets are only supported by the flow reconciler")
+	case config.Networks.PrivateServiceAccess != nil:
+		return fmt.Errorf("private service access is only supported by the flow reconciler")
+	case config.Networks.NetworkConnectivityCenter != nil:
+		return fmt.Errorf("network connectivity center spokes are only supported by the flow reconciler")
+	}
+	config.Networks.CloudNAT = infrastructure.CloudNATWithDefaults(config.Networks.CloudNAT, t.cloudNATDefaults)
+
 	createSA, err := shouldCreateServiceAccount(infra)
 	if err != nil {
 		return err
@@ -143,7 +161,7 @@ func (t *TerraformReconciler) delete(ctx context.Context, infra *extensionsv1alp
 		return err
 	}
 
-	gcpClient, err := gcpclient.New().Compute(ctx, t.client, infra.Spec.SecretRef)
+	gcpClient, err := t.gcpClientFactory.Compute(ctx, t.client, infra.Spec.SecretRef)
 	if err != nil {
 		return util.DetermineError(err, helper.KnownCodes)
 	}
@@ -22,24 +22,30 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/cluster"
 	"sigs.k8s.io/controller-runtime/pkg/manager"
 
+	"github.com/gardener/gardener-extension-provider-gcp/pkg/apis/config"
 	api "github.com/gardener/gardener-extension-provider-gcp/pkg/apis/gcp"
 	"github.com/gardener/gardener-extension-provider-gcp/pkg/apis/gcp/helper"
+	gcpclient "github.com/gardener/gardener-extension-provider-gcp/pkg/gcp/client"
 )
 
 type delegateFactory struct {
-	gardenReader client.Reader
-	seedClient   client.Client
-	restConfig   *rest.Config
-	scheme       *runtime.Scheme
+	gardenReader     client.Reader
+	seedClient       client.Client
+	restConfig       *rest.Config
+	scheme           *runtime.Scheme
+	controllerConfig config.Worker
+	gcpClientFactory gcpclient.Factory
 }
 
 // NewActuator creates a new Actuator that updates the status of the handled WorkerPoolConfigs.
-func NewActuator(mgr manager.Manager, gardenCluster cluster.Cluster) worker.Actuator {
+func NewActuator(mgr manager.Manager, gardenCluster cluster.Cluster, controllerConfig config.Worker, gcpClientFactory gcpclient.Factory) worker.Actuator {
 	WorkerDelegate := &delegateFactory{
-		gardenReader: gardenCluster.GetAPIReader(),
-		seedClient:   mgr.GetClient(),
-		restConfig:   mgr.GetConfig(),
-		scheme:       mgr.GetScheme(),
+		gardenReader:     gardenCluster.GetAPIReader(),
+		seedClient:       mgr.GetClient(),
+		restConfig:       mgr.GetConfig(),
+		scheme:           mgr.GetScheme(),
+		controllerConfig: controllerConfig,
+		gcpClientFactory: gcpClientFactory,
 	}
 
 	return genericactuator.NewActuator(
@@ -77,6 +83,8 @@ func (d *delegateFactory) WorkerDelegate(_ context.Context, worker *extensionsv1
 
 		worker,
 		cluster,
+		d.controllerConfig,
+		d.gcpClientFactory,
 	)
 }
 
@@ -92,10 +100,16 @@ type WorkerDelegate struct {
 	cloudProfileConfig *api.CloudProfileConfig
 	cluster            *extensionscontroller.Cluster
 	worker             *extensionsv1alpha1.Worker
+	controllerConfig   config.Worker
 
 	machineClasses     []map[string]interface{}
 	machineDeployments worker.MachineDeployments
 	machineImages      []api.MachineImage
+
+	gcpClientFactory gcpclient.Factory
+
+	// resolvedImageFamilies maps the references to image families to the images they were resolved to.
+	resolvedImageFamilies map[string]string
 }
 
 // NewWorkerDelegate creates a new context for a worker reconciliation.
@@ -108,8 +122,10 @@ func NewWorkerDelegate(
 
 	worker *extensionsv1alpha1.Worker,
 	cluster *extensionscontroller.Cluster,
+	controllerConfig config.Worker,
+	gcpClientFactory gcpclient.Factory,
 ) (genericactuator.WorkerDelegate, error) {
-	config, err := helper.CloudProfileConfigFromCluster(cluster)
+	cloudProfileConfig, err := helper.CloudProfileConfigFromCluster(cluster)
 	if err != nil {
 		return nil, err
 	}
@@ -121,12 +137,21 @@ func NewWorkerDelegate(
 		seedChartApplier: seedChartApplier,
 		serverVersion:    serverVersion,
 
-		cloudProfileConfig: config,
+		cloudProfileConfig: cloudProfileConfig,
 		cluster:            cluster,
 		worker:             worker,
+		controllerConfig:   controllerConfig,
+
+		gcpClientFactory: gcpClientFactory,
 	}, nil
 }
 
+// SetGCPClientFactory sets the factory used to create the GCP clients of the worker delegate.
+// Introduced for Unit-testing.
+func (w *WorkerDelegate) SetGCPClientFactory(factory gcpclient.Factory) {
+	w.gcpClientFactory = factory
+}
+
 // GetMachineClasses returns the slice of machine classes contained inside the worker delegate.
 // Introduced for Unit-testing.
 func (w *WorkerDelegate) GetMachineClasses() []map[string]any {
@@ -6,6 +6,13 @@ package worker
 
 import (
 	"context"
+	"fmt"
+	"slices"
+
+	"google.golang.org/api/compute/v1"
+	"k8s.io/apimachinery/pkg/util/sets"
+
+	gcpclient "github.com/gardener/gardener-extension-provider-gcp/pkg/gcp/client"
 )
 
 // DeployMachineDependencies implements genericactuator.WorkerDelegate.
@@ -28,8 +35,42 @@ func (w *WorkerDelegate) PostReconcileHook(_ context.Context) error {
 	return nil
 }
 
-// PreDeleteHook implements genericactuator.WorkerDelegate.
-func (w *WorkerDelegate) PreDeleteHook(_ context.Context) error {
+// PreDeleteHook implements genericactuator.WorkerDelegate. It lifts the deletion protection of the VMs of the shoot,
+// as GCP refuses to delete protected VMs and the machine-controller-manager could not delete the machines otherwise.
+// All zones of the worker pools are checked, as the protection may have been disabled in the WorkerConfig after the
+// VMs were created.
+func (w *WorkerDelegate) PreDeleteHook(ctx context.Context) error {
+	zones := sets.New[string]()
+	for _, pool := range w.worker.Spec.Pools {
+		zones.Insert(pool.Zones...)
+	}
+	if zones.Len() == 0 {
+		return nil
+	}
+
+	computeClient, err := w.gcpClientFactory.Compute(ctx, w.client, w.worker.Spec.SecretRef)
+	if err != nil {
+		return err
+	}
+
+	for _, zone := range sets.List(zones) {
+		instances, err := computeClient.ListInstances(ctx, zone, gcpclient.InstanceListOpts{
+			Filter: "deletionProtection = true",
+			ClientFilter: func(instance *compute.Instance) bool {
+				return instance.Tags != nil && slices.Contains(instance.Tags.Items, w.worker.Namespace)
+			},
+		})
+		if err != nil {
+			return fmt.Errorf("could not list the protected VMs in zone %q: %w", zone, err)
+		}
+
+		for _, instance := range instances {
+			if err := computeClient.SetInstanceDeletionProtection(ctx, zone, instance.Name, false); err != nil {
+				return fmt.Errorf("could not lift the deletion protection of VM %q: %w", instance.Name, err)
+			}
+		}
+	}
+
 	return nil
 }
 
@@ -13,6 +13,7 @@ import (
 
 	api "github.com/gardener/gardener-extension-provider-gcp/pkg/apis/gcp"
 	"github.com/gardener/gardener-extension-provider-gcp/pkg/apis/gcp/helper"
+	"github.com/gardener/gardener-extension-provider-gcp/pkg/gcp"
 )
 
 // UpdateMachineImagesStatus updates the machine image status
@@ -62,6 +63,42 @@ func (w *WorkerDelegate) findMachineImage(name, version string, architecture *st
 	return "", worker.ErrorMachineImageNotFound(name, version, *architecture)
 }
 
+// resolveImageFamily returns the newest usable image of the image family the given image refers to, see
+// helper.ImageFamily, or the image itself if it does not refer to an image family. Families without a project are
+// looked up in the project of the shoot. The images are resolved once per reconciliation.
+func (w *WorkerDelegate) resolveImageFamily(ctx context.Context, image string) (string, error) {
+	project, family, ok := helper.ImageFamily(image)
+	if !ok {
+		return image, nil
+	}
+	if resolved, ok := w.resolvedImageFamilies[image]; ok {
+		return resolved, nil
+	}
+
+	if project == "" {
+		serviceAccount, err := gcp.GetServiceAccountFromSecretReference(ctx, w.client, w.worker.Spec.SecretRef)
+		if err != nil {
+			return "", err
+		}
+		project = serviceAccount.ProjectID
+	}
+	computeClient, err := w.gcpClientFactory.Compute(ctx, w.client, w.worker.Spec.SecretRef)
+	if err != nil {
+		return "", err
+	}
+	latest, err := computeClient.ResolveLatestImage(ctx, project, family)
+	if err != nil {
+		return "", fmt.Errorf("could not resolve image family %q: %w", image, err)
+	}
+
+	resolved := fmt.Sprintf("projects/%s/global/images/%s", project, latest.Name)
+	if w.resolvedImageFamilies == nil {
+		w.resolvedImageFamilies = make(map[string]string)
+	}
+	w.resolvedImageFamilies[image] = resolved
+	return resolved, nil
+}
+
 func appendMachineImage(machineImages []api.MachineImage, machineImage api.MachineImage) []api.MachineImage {
 	if _, err := helper.FindMachineImage(machineImages, machineImage.Name, machineImage.Version, machineImage.Architecture); err != nil {
 		return append(machineImages, machineImage)
@@ -9,11 +9,10 @@ import (
 	"fmt"
 	"maps"
 	"path/filepath"
-	"regexp"
 	"slices"
-	"sort"
 	"strconv"
 	"strings"
+	"text/template"
 
 	"github.com/gardener/gardener/extensions/pkg/controller/worker"
 	genericworkeractuator "github.com/gardener/gardener/extensions/pkg/controller/worker/genericactuator"
@@ -25,7 +24,9 @@ import (
 	machinev1alpha1 "github.com/gardener/machine-controller-manager/pkg/apis/machine/v1alpha1"
 	computev1 "google.golang.org/api/compute/v1"
 	v1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/api/resource"
+	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/utils/ptr"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
@@ -35,21 +36,59 @@ import (
 	"github.com/gardener/gardener-extension-provider-gcp/pkg/gcp"
 )
 
-var labelRegex = regexp.MustCompile(`[^a-z0-9_-]`)
-
 // InitializeCapacity is a handle to make the function accessible to the tests.
 var InitializeCapacity = initializeCapacity
 
 const (
-	persistentDiskExtreme     = "pd-extreme"
-	hyperDiskBalanced         = "hyperdisk-balanced"
-	hyperDiskExtreme          = "hyperdisk-extreme"
-	hyperDiskThroughput       = "hyperdisk-throughput"
-	maxGcpLabelCharactersSize = 63
+	persistentDiskExtreme = "pd-extreme"
+	hyperDiskBalanced     = "hyperdisk-balanced"
+	hyperDiskExtreme      = "hyperdisk-extreme"
+	hyperDiskThroughput   = "hyperdisk-throughput"
+	labelName             = "name"
+	labelClusterName      = "k8s-cluster-name"
 	// ResourceGPU is the GPU resource. It should be a non-negative integer.
 	ResourceGPU v1.ResourceName = "gpu"
 	// VolumeTypeScratch is the gcp SCRATCH volume type
 	VolumeTypeScratch = "SCRATCH"
+	// LocalSSDSize is the fixed size of a local SSD.
+	LocalSSDSize = "375Gi"
+	// CustomMachineDefaultFamily is the machine family of custom machine types without an explicit family.
+	CustomMachineDefaultFamily = "n1"
+	// MetadataKeyBlockProjectSSHKeys is the instance metadata key which blocks project-wide SSH keys on the VMs.
+	MetadataKeyBlockProjectSSHKeys = "block-project-ssh-keys"
+	// MetadataKeyUserData is the instance metadata key which contains the user data of the VMs.
+	MetadataKeyUserData = "user-data"
+	// MetadataKeyStartupScript is the instance metadata key which contains the script executed by the guest agent on
+	// every boot of the VMs.
+	MetadataKeyStartupScript = "startup-script"
+	// LabelGPUDriverVersion is the node label which contains the requested GPU driver version.
+	LabelGPUDriverVersion = "cloud.google.com/gke-gpu-driver-version"
+	// LabelGPUSharingStrategy is the node label which contains the GPU sharing strategy.
+	LabelGPUSharingStrategy = "cloud.google.com/gke-gpu-sharing-strategy"
+	// LabelGPUMaxSharedClientsPerGPU is the node label which contains the maximum number of containers sharing a GPU.
+	LabelGPUMaxSharedClientsPerGPU = "cloud.google.com/gke-max-shared-clients-per-gpu"
+	// MaxDNSSearchDomains is the maximum number of DNS search domains which can be configured for the VMs.
+	MaxDNSSearchDomains = 6
+	// MaxNetworkTags is the maximum number of additional network tags which can be configured for the VMs. GCP allows
+	// 64 network tags per VM, three of them are managed by Gardener.
+	MaxNetworkTags = 61
+	// MaxInstanceLabels is the maximum number of additional labels which can be configured for the VMs. GCP allows 64
+	// labels per VM, two of them are managed by Gardener.
+	MaxInstanceLabels = 62
+	// MaxInstanceDescriptionLength is the maximum length of the description of a VM.
+	MaxInstanceDescriptionLength = 2048
+	// GuestOSFeatureGVNIC is the guest OS feature for the Google Virtual NIC.
+	GuestOSFeatureGVNIC = "GVNIC"
+
+	// dnsSearchDomainsStartupScript configures the DNS search domains via a systemd-resolved drop-in.
+	dnsSearchDomainsStartupScript = `#!/bin/bash
+mkdir -p /etc/systemd/resolved.conf.d
+cat <<EOF > /etc/systemd/resolved.conf.d/99-gardener-search-domains.conf
+[Resolve]
+Domains=%s
+EOF
+systemctl try-restart systemd-resolved.service
+`
 )
 
 var (
@@ -57,8 +96,30 @@ var (
 	AllowedTypesIops = []string{persistentDiskExtreme, hyperDiskExtreme, hyperDiskBalanced}
 	// AllowedTypesThroughput are the volume types for which throughput can be configured
 	AllowedTypesThroughput = []string{hyperDiskThroughput, hyperDiskBalanced}
+	// ReservedInstanceMetadataKeys are the instance metadata keys which are managed by Gardener and cannot be
+	// overwritten by the instance metadata of the WorkerConfig.
+	ReservedInstanceMetadataKeys = sets.New(MetadataKeyBlockProjectSSHKeys, MetadataKeyUserData)
+	// ReservedInstanceLabels are the labels of the VMs which are managed by Gardener and cannot be overwritten by the
+	// instance labels of the WorkerConfig.
+	ReservedInstanceLabels = sets.New(labelName, labelClusterName)
+	// AcceleratorOptimizedMachineFamilies maps the accelerator-optimized machine families to the guest OS features
+	// required by their boot disks. Their VMs always have GPUs attached, hence they support neither live migration nor
+	// Confidential VMs.
+	AcceleratorOptimizedMachineFamilies = map[string][]string{
+		"a2": {GuestOSFeatureGVNIC},
+		"a3": {GuestOSFeatureGVNIC},
+		"g2": {GuestOSFeatureGVNIC},
+	}
 )
 
+// defaultServiceAccountScopes returns the scopes of the service account created by the infrastructure controller.
+func (w *WorkerDelegate) defaultServiceAccountScopes() []string {
+	if w.controllerConfig.DefaultServiceAccountScopes == nil {
+		return []string{computev1.ComputeScope}
+	}
+	return w.controllerConfig.DefaultServiceAccountScopes
+}
+
 // MachineClassKind yields the name of the machine class kind used by GCP provider.
 func (w *WorkerDelegate) MachineClassKind() string {
 	return "MachineClass"
@@ -112,6 +173,11 @@ func (w *WorkerDelegate) generateMachineConfig(ctx context.Context) error {
 		return err
 	}
 
+	userDataByPool, err := w.fetchUserData(ctx)
+	if err != nil {
+		return err
+	}
+
 	for _, pool := range w.worker.Spec.Pools {
 		zoneLen := int32(len(pool.Zones)) // #nosec: G115 - We check if pool zones exceeds max_int32.
 
@@ -121,19 +187,38 @@ func (w *WorkerDelegate) generateMachineConfig(ctx context.Context) error {
 				return fmt.Errorf("could not decode provider config: %+v", err)
 			}
 		}
+		if !ptr.Deref(workerConfig.BlockProjectSSHKeys, true) && !w.controllerConfig.AllowProjectSSHKeys {
+			return fmt.Errorf("worker pool %q must not allow project-wide SSH keys as this is not permitted by the controller configuration", pool.Name)
+		}
+
+		subnet := nodesSubnet
+		if workerConfig.SubnetName != "" {
+			if subnet, err = gcpapihelper.FindSubnetByName(infrastructureStatus.Networks.Subnets, workerConfig.SubnetName); err != nil {
+				return fmt.Errorf("worker pool %q references a subnet which is not part of the infrastructure: %w", pool.Name, err)
+			}
+		}
+
+		enableExternalIP := ptr.Deref(workerConfig.EnableExternalIP, false)
+		if enableExternalIP && len(infrastructureStatus.Networks.NatIPs) > 0 {
+			return fmt.Errorf("worker pool %q must not enable external IPs as the egress traffic of the shoot is restricted to the user-provided Cloud NAT IPs", pool.Name)
+		}
 
 		workerPoolHash, err := w.generateWorkerPoolHash(pool, *workerConfig)
 		if err != nil {
 			return err
 		}
 
-		poolLabels := getGcePoolLabels(w.worker, pool)
+		poolLabels := addInstanceLabels(getGcePoolLabels(w.worker, pool), workerConfig.InstanceLabels)
 
 		arch := ptr.Deref(pool.Architecture, v1beta1constants.ArchitectureAMD64)
 		machineImage, err := w.findMachineImage(pool.MachineImage.Name, pool.MachineImage.Version, &arch)
 		if err != nil {
 			return err
 		}
+		machineImage, err = w.resolveImageFamily(ctx, machineImage)
+		if err != nil {
+			return err
+		}
 
 		machineImages = appendMachineImage(machineImages, apisgcp.MachineImage{
 			Name:         pool.MachineImage.Name,
@@ -149,6 +234,9 @@ func (w *WorkerDelegate) generateMachineConfig(ctx context.Context) error {
 			if err != nil {
 				return err
 			}
+			if workerConfig.Volume != nil {
+				addRegionalDiskDetails(disk, workerConfig.Volume.Regional, w.worker.Spec.Region)
+			}
 			disks = append(disks, disk)
 		}
 
@@ -158,51 +246,86 @@ func (w *WorkerDelegate) generateMachineConfig(ctx context.Context) error {
 			if err != nil {
 				return err
 			}
+			addRegionalDiskDetails(disk, getDataVolumeWorkerConf(volume.Name, workerConfig.DataVolumes).Regional, w.worker.Spec.Region)
 			disks = append(disks, disk)
 		}
 
+		if err := checkRegionalDiskZones(pool, workerConfig); err != nil {
+			return err
+		}
+
+		// local SSDs
+		localSSDs, err := createDiskSpecsForLocalSSDs(workerConfig, poolLabels)
+		if err != nil {
+			return err
+		}
+		disks = append(disks, localSSDs...)
+
 		serviceAccounts := make([]map[string]interface{}, 0)
 		if workerConfig.ServiceAccount != nil {
 			serviceAccounts = append(serviceAccounts, map[string]interface{}{
 				"email":  workerConfig.ServiceAccount.Email,
-				"scopes": workerConfig.ServiceAccount.Scopes,
+				"scopes": gcpapihelper.NormalizeServiceAccountScopes(workerConfig.ServiceAccount.Scopes),
 			})
 		} else if len(infrastructureStatus.ServiceAccountEmail) != 0 {
 			serviceAccounts = append(serviceAccounts, map[string]interface{}{
 				"email":  infrastructureStatus.ServiceAccountEmail,
-				"scopes": []string{computev1.ComputeScope},
+				"scopes": w.defaultServiceAccountScopes(),
 			})
 		}
 
-		isLiveMigrationAllowed := true
+		userData := userDataByPool[pool.Name]
 
-		userData, err := worker.FetchUserData(ctx, w.client, w.worker.Namespace, pool)
-		if err != nil {
-			return err
+		machineType := pool.MachineType
+		if workerConfig.CustomMachine != nil {
+			machineType = CustomMachineType(workerConfig.CustomMachine)
+		}
+
+		// accelerator-optimized machines need specific settings, explicit settings of the WorkerConfig take precedence.
+		guestOSFeatures, confidentialCompute := workerConfig.GuestOSFeatures, workerConfig.ConfidentialCompute
+		acceleratorGuestOSFeatures, isAcceleratorOptimized := AcceleratorOptimizedMachineFamilies[MachineFamily(machineType)]
+		if isAcceleratorOptimized {
+			if guestOSFeatures == nil {
+				guestOSFeatures = acceleratorGuestOSFeatures
+			}
+			if confidentialCompute == nil {
+				confidentialCompute = ptr.To(false)
+			}
+		}
+		if pool.Volume != nil && len(guestOSFeatures) > 0 {
+			// the boot disk is always the first disk
+			disks[0]["guestOsFeatures"] = createGuestOSFeatures(guestOSFeatures)
 		}
 
 		for zoneIndex, zone := range pool.Zones {
 			zoneIdx := int32(zoneIndex) // #nosec: G115 - We check if pool zones exceeds max_int32.
+			description := fmt.Sprintf("Machine of Shoot %s created by machine-controller-manager.", w.worker.Name)
+			if workerConfig.DescriptionTemplate != "" {
+				description, err = RenderInstanceDescription(workerConfig.DescriptionTemplate, InstanceDescriptionData{
+					Shoot:     w.worker.Name,
+					Namespace: w.worker.Namespace,
+					Pool:      pool.Name,
+					Zone:      zone,
+				})
+				if err != nil {
+					return fmt.Errorf("could not render the description of the VMs of worker pool %q: %w", pool.Name, err)
+				}
+			}
+
 			machineClassSpec := map[string]interface{}{
 				"region":             w.worker.Spec.Region,
 				"zone":               zone,
-				"canIpForward":       true,
-				"deletionProtection": false,
-				"description":        fmt.Sprintf("Machine of Shoot %s created by machine-controller-manager.", w.worker.Name),
+				"canIpForward":       ptr.Deref(workerConfig.CanIPForward, true),
+				"deletionProtection": ptr.Deref(workerConfig.DeletionProtection, false),
+				"description":        description,
 				"disks":              disks,
 				"labels":             poolLabels,
-				// TODO: make this configurable for the user
-				"metadata": []map[string]string{
-					{
-						"key":   "block-project-ssh-keys",
-						"value": "TRUE",
-					},
-				},
-				"machineType": pool.MachineType,
+				"metadata":           createInstanceMetadata(workerConfig),
+				"machineType":        machineType,
 				"networkInterfaces": []map[string]interface{}{
 					{
-						"subnetwork":        nodesSubnet.Name,
-						"disableExternalIP": true,
+						"subnetwork":        subnet.Name,
+						"disableExternalIP": !enableExternalIP,
 					},
 				},
 				"secret": map[string]interface{}{
@@ -213,11 +336,7 @@ func (w *WorkerDelegate) generateMachineConfig(ctx context.Context) error {
 					"namespace": w.worker.Spec.SecretRef.Namespace,
 				},
 				"serviceAccounts": serviceAccounts,
-				"tags": []string{
-					w.worker.Namespace,
-					fmt.Sprintf("kubernetes-io-cluster-%s", w.worker.Namespace),
-					"kubernetes-io-role-node",
-				},
+				"tags":            networkTags(w.worker.Namespace, workerConfig.NetworkTags),
 			}
 
 			var (
@@ -234,7 +353,7 @@ func (w *WorkerDelegate) generateMachineConfig(ctx context.Context) error {
 				Maximum:                      worker.DistributeOverZones(zoneIdx, pool.Maximum, zoneLen),
 				MaxSurge:                     worker.DistributePositiveIntOrPercent(zoneIdx, pool.MaxSurge, zoneLen, pool.Maximum),
 				MaxUnavailable:               worker.DistributePositiveIntOrPercent(zoneIdx, pool.MaxUnavailable, zoneLen, pool.Minimum),
-				Labels:                       addTopologyLabel(pool.Labels, zone),
+				Labels:                       utils.MergeStringMaps(addTopologyLabel(pool.Labels, zone), gpuNodeLabels(workerConfig.GPU)),
 				Annotations:                  pool.Annotations,
 				Taints:                       pool.Taints,
 				MachineConfiguration:         genericworkeractuator.ReadMachineConfiguration(pool),
@@ -254,10 +373,20 @@ func (w *WorkerDelegate) generateMachineConfig(ctx context.Context) error {
 			}
 
 			if workerConfig.GPU != nil {
-				machineClassSpec["gpu"] = map[string]interface{}{
+				gpu := map[string]interface{}{
 					"acceleratorType": workerConfig.GPU.AcceleratorType,
 					"count":           workerConfig.GPU.Count,
 				}
+				if workerConfig.GPU.DriverVersion != "" {
+					gpu["driverVersion"] = workerConfig.GPU.DriverVersion
+				}
+				if sharing := workerConfig.GPU.GpuSharingConfig; sharing != nil {
+					gpu["gpuSharingConfig"] = map[string]interface{}{
+						"strategy":               string(sharing.Strategy),
+						"maxSharedClientsPerGpu": sharing.MaxSharedClientsPerGpu,
+					}
+				}
+				machineClassSpec["gpu"] = gpu
 				// using this gpu count for scale-from-zero cases
 				gpuCount = workerConfig.GPU.Count
 			}
@@ -266,16 +395,45 @@ func (w *WorkerDelegate) generateMachineConfig(ctx context.Context) error {
 				machineClassSpec["minCpuPlatform"] = *workerConfig.MinCpuPlatform
 			}
 
+			if len(workerConfig.ResourceManagerTags) > 0 {
+				machineClassSpec["resourceManagerTags"] = workerConfig.ResourceManagerTags
+			}
+
+			if confidentialCompute != nil {
+				machineClassSpec["confidentialInstanceConfig"] = map[string]interface{}{
+					"enableConfidentialCompute": *confidentialCompute,
+				}
+			}
+
+			// neither GPUs nor Confidential VMs support live migration
+			isLiveMigrationAllowed := !isAcceleratorOptimized && workerConfig.GPU == nil && !ptr.Deref(confidentialCompute, false)
+
 			nodeTemplate := pool.NodeTemplate.DeepCopy()
-			if workerConfig.NodeTemplate != nil {
-				// Support extended resources by copying into nodeTemplate.Capacity overriding if needed
-				maps.Copy(nodeTemplate.Capacity, workerConfig.NodeTemplate.Capacity)
+			if customMachine := workerConfig.CustomMachine; customMachine != nil {
+				// custom machine types are not part of the cloud profile, hence the capacity is derived from the explicit shape.
+				if nodeTemplate == nil {
+					nodeTemplate = &v1alpha1.NodeTemplate{}
+				}
+				if nodeTemplate.Capacity == nil {
+					nodeTemplate.Capacity = v1.ResourceList{}
+				}
+				nodeTemplate.Capacity[v1.ResourceCPU] = *resource.NewQuantity(int64(customMachine.VCPUs), resource.DecimalSI)
+				nodeTemplate.Capacity[v1.ResourceMemory] = *resource.NewQuantity(int64(customMachine.MemoryMiB)*1024*1024, resource.BinarySI)
 			}
 			if nodeTemplate != nil {
+				var capacityOverrides v1.ResourceList
+				if workerConfig.NodeTemplate != nil {
+					// Support extended resources by copying into nodeTemplate.Capacity overriding if needed
+					capacityOverrides = workerConfig.NodeTemplate.Capacity
+				}
+				var localSSDCount int32
+				if workerConfig.Volume != nil {
+					localSSDCount = ptr.Deref(workerConfig.Volume.LocalSSDCount, 0)
+				}
+
 				template := machinev1alpha1.NodeTemplate{
-					// always overwrite the GPU count if it was provided in the WorkerConfig.
-					Capacity:     initializeCapacity(nodeTemplate.Capacity, gpuCount),
-					InstanceType: pool.MachineType,
+					Capacity:     initializeCapacity(nodeTemplate.Capacity, capacityOverrides, gpuCount, localSSDCount),
+					InstanceType: machineType,
 					Region:       w.worker.Spec.Region,
 					Zone:         zone,
 					Architecture: ptr.To(arch),
@@ -287,7 +445,7 @@ func (w *WorkerDelegate) generateMachineConfig(ctx context.Context) error {
 				}
 			}
 
-			setSchedulingPolicy(machineClassSpec, isLiveMigrationAllowed)
+			setSchedulingPolicy(machineClassSpec, isLiveMigrationAllowed, workerConfig)
 			machineClasses = append(machineClasses, machineClassSpec)
 		}
 	}
@@ -299,6 +457,23 @@ func (w *WorkerDelegate) generateMachineConfig(ctx context.Context) error {
 	return nil
 }
 
+// fetchUserData reads the user data of all worker pools upfront, so that a missing user data secret or key is reported
+// before any machine configuration is generated.
+func (w *WorkerDelegate) fetchUserData(ctx context.Context) (map[string][]byte, error) {
+	userDataByPool := make(map[string][]byte, len(w.worker.Spec.Pools))
+	for _, pool := range w.worker.Spec.Pools {
+		userData, err := worker.FetchUserData(ctx, w.client, w.worker.Namespace, pool)
+		if err != nil {
+			if apierrors.IsNotFound(err) {
+				return nil, fmt.Errorf("user data secret %s/%s referenced in worker pool %s does not exist", w.worker.Namespace, pool.UserDataSecretRef.Name, pool.Name)
+			}
+			return nil, err
+		}
+		userDataByPool[pool.Name] = userData
+	}
+	return userDataByPool, nil
+}
+
 func (w *WorkerDelegate) generateWorkerPoolHash(pool v1alpha1.WorkerPool, workerConfig apisgcp.WorkerConfig) (string, error) {
 	var additionalData []string
 
@@ -328,6 +503,9 @@ func (w *WorkerDelegate) generateWorkerPoolHash(pool v1alpha1.WorkerPool, worker
 		if throughput := volume.ProvisionedThroughput; throughput != nil {
 			additionalData = append(additionalData, strconv.Itoa(int(*throughput)))
 		}
+		if regional := volume.Regional; regional != nil {
+			additionalData = append(additionalData, "regionalReplicaZones="+strings.Join(regional.ReplicaZones, ","))
+		}
 	}
 
 	// see https://cloud.google.com/compute/docs/instances/update-instance-properties?hl=de#updatable-properties
@@ -339,15 +517,87 @@ func (w *WorkerDelegate) generateWorkerPoolHash(pool v1alpha1.WorkerPool, worker
 
 	if gpu := workerConfig.GPU; gpu != nil {
 		additionalData = append(additionalData, gpu.AcceleratorType, strconv.Itoa(int(gpu.Count)))
+		if gpu.DriverVersion != "" {
+			additionalData = append(additionalData, "gpuDriverVersion="+gpu.DriverVersion)
+		}
+		if sharing := gpu.GpuSharingConfig; sharing != nil {
+			additionalData = append(additionalData, "gpuSharingStrategy="+string(sharing.Strategy), "gpuMaxSharedClients="+strconv.Itoa(int(sharing.MaxSharedClientsPerGpu)))
+		}
+	}
+
+	if customMachine := workerConfig.CustomMachine; customMachine != nil {
+		additionalData = append(additionalData, "customMachine="+CustomMachineType(customMachine))
+	}
+
+	for _, key := range slices.Sorted(maps.Keys(workerConfig.ResourceManagerTags)) {
+		additionalData = append(additionalData, key+"="+workerConfig.ResourceManagerTags[key])
+	}
+
+	for _, key := range slices.Sorted(maps.Keys(workerConfig.InstanceMetadata)) {
+		additionalData = append(additionalData, key+"="+workerConfig.InstanceMetadata[key])
+	}
+
+	if len(workerConfig.DNSSearchDomains) > 0 {
+		additionalData = append(additionalData, "dnsSearchDomains="+strings.Join(workerConfig.DNSSearchDomains, ","))
+	}
+
+	if !ptr.Deref(workerConfig.BlockProjectSSHKeys, true) {
+		additionalData = append(additionalData, MetadataKeyBlockProjectSSHKeys+"=FALSE")
+	}
+
+	if workerConfig.GuestOSFeatures != nil {
+		additionalData = append(additionalData, "guestOSFeatures="+strings.Join(workerConfig.GuestOSFeatures, ","))
+	}
+
+	if confidentialCompute := workerConfig.ConfidentialCompute; confidentialCompute != nil {
+		additionalData = append(additionalData, "confidentialCompute="+strconv.FormatBool(*confidentialCompute))
+	}
+
+	if len(workerConfig.NetworkTags) > 0 {
+		additionalData = append(additionalData, "networkTags="+strings.Join(slices.Sorted(slices.Values(workerConfig.NetworkTags)), ","))
+	}
+
+	if workerConfig.OnHostMaintenance != "" {
+		additionalData = append(additionalData, "onHostMaintenance="+string(workerConfig.OnHostMaintenance))
+	}
+
+	if !ptr.Deref(workerConfig.CanIPForward, true) {
+		additionalData = append(additionalData, "canIPForward=false")
+	}
+
+	if !ptr.Deref(workerConfig.AutomaticRestart, true) {
+		additionalData = append(additionalData, "automaticRestart=false")
+	}
+
+	if workerConfig.SubnetName != "" {
+		additionalData = append(additionalData, "subnetName="+workerConfig.SubnetName)
+	}
+
+	if ptr.Deref(workerConfig.EnableExternalIP, false) {
+		additionalData = append(additionalData, "enableExternalIP=true")
+	}
+
+	if len(workerConfig.InstanceLabels) > 0 {
+		instanceLabels := make([]string, 0, len(workerConfig.InstanceLabels))
+		for _, key := range slices.Sorted(maps.Keys(workerConfig.InstanceLabels)) {
+			instanceLabels = append(instanceLabels, key+"="+workerConfig.InstanceLabels[key])
+		}
+		additionalData = append(additionalData, "instanceLabels="+strings.Join(instanceLabels, ","))
+	}
+
+	if workerConfig.DescriptionTemplate != "" {
+		additionalData = append(additionalData, "descriptionTemplate="+workerConfig.DescriptionTemplate)
 	}
 
 	if serviceaccount := workerConfig.ServiceAccount; serviceaccount != nil {
 		additionalData = append(additionalData, serviceaccount.Email)
-		sort.Strings(serviceaccount.Scopes)
-		additionalData = append(additionalData, serviceaccount.Scopes...)
+		additionalData = append(additionalData, gcpapihelper.NormalizeServiceAccountScopes(serviceaccount.Scopes)...)
+	} else if scopes := w.controllerConfig.DefaultServiceAccountScopes; scopes != nil {
+		additionalData = append(additionalData, "defaultServiceAccountScopes="+strings.Join(scopes, ","))
 	}
 
 	if volume := workerConfig.Volume; volume != nil {
+		// the encryption key of existing disks cannot be changed, hence a key rotation rolls the nodes.
 		if encryption := volume.Encryption; encryption != nil {
 			if kmsKeyName := encryption.KmsKeyName; kmsKeyName != nil {
 				additionalData = append(additionalData, *kmsKeyName)
@@ -359,11 +609,93 @@ func (w *WorkerDelegate) generateWorkerPoolHash(pool v1alpha1.WorkerPool, worker
 		if localSSDInterface := volume.LocalSSDInterface; localSSDInterface != nil {
 			additionalData = append(additionalData, *localSSDInterface)
 		}
+		if regional := volume.Regional; regional != nil {
+			additionalData = append(additionalData, "regionalReplicaZones="+strings.Join(regional.ReplicaZones, ","))
+		}
+		if iops := volume.ProvisionedIops; iops != nil {
+			additionalData = append(additionalData, "provisionedIops="+strconv.FormatInt(*iops, 10))
+		}
+		if throughput := volume.ProvisionedThroughput; throughput != nil {
+			additionalData = append(additionalData, "provisionedThroughput="+strconv.FormatInt(*throughput, 10))
+		}
+		if localSSDCount := volume.LocalSSDCount; localSSDCount != nil {
+			additionalData = append(additionalData, "localSSDCount="+strconv.Itoa(int(*localSSDCount)))
+		}
 	}
 
 	return worker.WorkerPoolHash(pool, w.cluster, []string{}, additionalData)
 }
 
+// CustomMachineType returns the GCP machine type for the given custom machine shape, e.g. `custom-4-16384` for the
+// default n1 family or `n2-custom-4-16384` for other families.
+func CustomMachineType(customMachine *apisgcp.CustomMachine) string {
+	machineType := fmt.Sprintf("custom-%d-%d", customMachine.VCPUs, customMachine.MemoryMiB)
+	if family := customMachine.Family; family != "" && family != CustomMachineDefaultFamily {
+		machineType = family + "-" + machineType
+	}
+	return machineType
+}
+
+// MachineFamily returns the machine family of the given machine type, e.g. `n2` for `n2-standard-4`.
+func MachineFamily(machineType string) string {
+	family, _, _ := strings.Cut(machineType, "-")
+	return strings.ToLower(family)
+}
+
+func createGuestOSFeatures(features []string) []map[string]interface{} {
+	guestOSFeatures := make([]map[string]interface{}, 0, len(features))
+	for _, feature := range features {
+		guestOSFeatures = append(guestOSFeatures, map[string]interface{}{"type": feature})
+	}
+	return guestOSFeatures
+}
+
+// networkTags returns the Gardener-managed network tags of the VMs of the given shoot namespace merged with the given
+// additional network tags. Duplicates are omitted.
+func networkTags(namespace string, additionalTags []string) []string {
+	tags := []string{
+		namespace,
+		fmt.Sprintf("kubernetes-io-cluster-%s", namespace),
+		"kubernetes-io-role-node",
+	}
+	for _, tag := range additionalTags {
+		if !slices.Contains(tags, tag) {
+			tags = append(tags, tag)
+		}
+	}
+	return tags
+}
+
+// createInstanceMetadata returns the Gardener-managed instance metadata merged with the instance metadata of the
+// WorkerConfig. Reserved keys of the WorkerConfig are ignored - checked by worker validation.
+func createInstanceMetadata(workerConfig *apisgcp.WorkerConfig) []map[string]string {
+	metadata := []map[string]string{
+		{
+			"key":   MetadataKeyBlockProjectSSHKeys,
+			"value": strings.ToUpper(strconv.FormatBool(ptr.Deref(workerConfig.BlockProjectSSHKeys, true))),
+		},
+	}
+
+	if len(workerConfig.DNSSearchDomains) > 0 {
+		metadata = append(metadata, map[string]string{
+			"key":   MetadataKeyStartupScript,
+			"value": fmt.Sprintf(dnsSearchDomainsStartupScript, strings.Join(workerConfig.DNSSearchDomains, " ")),
+		})
+	}
+
+	for _, key := range slices.Sorted(maps.Keys(workerConfig.InstanceMetadata)) {
+		if ReservedInstanceMetadataKeys.Has(key) || (key == MetadataKeyStartupScript && len(workerConfig.DNSSearchDomains) > 0) {
+			continue
+		}
+		metadata = append(metadata, map[string]string{
+			"key":   key,
+			"value": workerConfig.InstanceMetadata[key],
+		})
+	}
+
+	return metadata
+}
+
 func createDiskSpecForVolume(volume *v1alpha1.Volume, image string, workerConfig *apisgcp.WorkerConfig, labels map[string]interface{}) (map[string]interface{}, error) {
 	return createDiskSpec(volume.Size, true, &image, volume.Type, workerConfig.Volume, nil, labels)
 }
@@ -373,6 +705,24 @@ func createDiskSpecForDataVolume(volume v1alpha1.DataVolume, workerConfig *apisg
 	return createDiskSpec(volume.Size, false, dataVolumeConf.SourceImage, volume.Type, workerConfig.Volume, &dataVolumeConf, labels)
 }
 
+func createDiskSpecsForLocalSSDs(workerConfig *apisgcp.WorkerConfig, labels map[string]interface{}) ([]map[string]interface{}, error) {
+	if workerConfig.Volume == nil || workerConfig.Volume.LocalSSDCount == nil {
+		return nil, nil
+	}
+
+	// encryption is not supported for local SSDs, hence only the interface is passed on - checked by worker validation
+	volumeConf := &apisgcp.Volume{LocalSSDInterface: workerConfig.Volume.LocalSSDInterface}
+	disks := make([]map[string]interface{}, 0, *workerConfig.Volume.LocalSSDCount)
+	for range *workerConfig.Volume.LocalSSDCount {
+		disk, err := createDiskSpec(LocalSSDSize, false, nil, ptr.To(VolumeTypeScratch), volumeConf, nil, labels)
+		if err != nil {
+			return nil, err
+		}
+		disks = append(disks, disk)
+	}
+	return disks, nil
+}
+
 func createDiskSpec(size string, boot bool, image, volumeType *string, volumeConf *apisgcp.Volume, dataVolumeConf *apisgcp.DataVolume, labels map[string]interface{}) (map[string]interface{}, error) {
 	volumeSize, err := worker.DiskSize(size)
 	if err != nil {
@@ -406,20 +756,30 @@ func createDiskSpec(size string, boot bool, image, volumeType *string, volumeCon
 		if volumeConf.LocalSSDInterface != nil && *volumeType == VolumeTypeScratch {
 			disk["interface"] = *volumeConf.LocalSSDInterface
 		}
+		if boot {
+			addProvisionedPerformance(disk, volumeType, volumeConf.ProvisionedIops, volumeConf.ProvisionedThroughput)
+		}
 	}
 
 	if dataVolumeConf != nil {
-		if dataVolumeConf.ProvisionedIops != nil && slices.Contains(AllowedTypesIops, *volumeType) {
-			disk["provisionedIops"] = *dataVolumeConf.ProvisionedIops
-		}
-		if dataVolumeConf.ProvisionedThroughput != nil && slices.Contains(AllowedTypesThroughput, *volumeType) {
-			disk["provisionedThroughput"] = *dataVolumeConf.ProvisionedThroughput
-		}
+		addProvisionedPerformance(disk, volumeType, dataVolumeConf.ProvisionedIops, dataVolumeConf.ProvisionedThroughput)
 	}
 
 	return disk, nil
 }
 
+func addProvisionedPerformance(disk map[string]interface{}, volumeType *string, iops, throughput *int64) {
+	if volumeType == nil {
+		return
+	}
+	if iops != nil && slices.Contains(AllowedTypesIops, *volumeType) {
+		disk["provisionedIops"] = *iops
+	}
+	if throughput != nil && slices.Contains(AllowedTypesThroughput, *volumeType) {
+		disk["provisionedThroughput"] = *throughput
+	}
+}
+
 func addDiskEncryptionDetails(disk map[string]interface{}, encryption *apisgcp.DiskEncryption) {
 	if encryption == nil {
 		return
@@ -434,6 +794,38 @@ func addDiskEncryptionDetails(disk map[string]interface{}, encryption *apisgcp.D
 	disk["encryption"] = encryptionMap
 }
 
+// addRegionalDiskDetails turns the given disk into a regional persistent disk replicated to the given zones.
+func addRegionalDiskDetails(disk map[string]interface{}, regional *apisgcp.RegionalDisk, region string) {
+	if regional == nil {
+		return
+	}
+	disk["region"] = region
+	disk["replicaZones"] = regional.ReplicaZones
+}
+
+// checkRegionalDiskZones checks that the machines of all zones of the pool can attach the regional disks of the pool,
+// i.e. that the zones of the pool are replica zones of all regional disks.
+func checkRegionalDiskZones(pool v1alpha1.WorkerPool, workerConfig *apisgcp.WorkerConfig) error {
+	var regionalDisks []*apisgcp.RegionalDisk
+	if workerConfig.Volume != nil && workerConfig.Volume.Regional != nil {
+		regionalDisks = append(regionalDisks, workerConfig.Volume.Regional)
+	}
+	for _, volume := range pool.DataVolumes {
+		if regional := getDataVolumeWorkerConf(volume.Name, workerConfig.DataVolumes).Regional; regional != nil {
+			regionalDisks = append(regionalDisks, regional)
+		}
+	}
+
+	for _, regional := range regionalDisks {
+		for _, zone := range pool.Zones {
+			if !slices.Contains(regional.ReplicaZones, zone) {
+				return fmt.Errorf("zone %q of worker pool %q is not a replica zone %v of its regional disks", zone, pool.Name, regional.ReplicaZones)
+			}
+		}
+	}
+	return nil
+}
+
 func getDataVolumeWorkerConf(volumeName string, dataVolumes []apisgcp.DataVolume) apisgcp.DataVolume {
 	for _, dv := range dataVolumes {
 		if dv.Name == volumeName {
@@ -445,20 +837,82 @@ func getDataVolumeWorkerConf(volumeName string, dataVolumes []apisgcp.DataVolume
 
 func getGcePoolLabels(worker *v1alpha1.Worker, pool v1alpha1.WorkerPool) map[string]interface{} {
 	gceInstanceLabels := map[string]interface{}{
-		"name": SanitizeGcpLabelValue(worker.Name),
+		labelName: gcp.SanitizeGcpLabelValue(worker.Name),
 		// Add shoot id to keep consistency with the label added to all disks by the csi-driver
-		"k8s-cluster-name": SanitizeGcpLabelValue(worker.Namespace),
+		labelClusterName: gcp.SanitizeGcpLabelValue(worker.Namespace),
 	}
 	for k, v := range pool.Labels {
-		if label := SanitizeGcpLabel(k); label != "" {
-			gceInstanceLabels[label] = SanitizeGcpLabelValue(v)
+		if label := gcp.SanitizeGcpLabel(k); label != "" {
+			gceInstanceLabels[label] = gcp.SanitizeGcpLabelValue(v)
 		}
 	}
 	return gceInstanceLabels
 }
 
-func initializeCapacity(capacityList v1.ResourceList, gpuCount int32) v1.ResourceList {
+// addInstanceLabels returns the given labels of a worker pool together with the sanitized instance labels of the
+// WorkerConfig. The labels of the worker pool take precedence.
+func addInstanceLabels(poolLabels map[string]interface{}, instanceLabels map[string]string) map[string]interface{} {
+	if len(instanceLabels) == 0 {
+		return poolLabels
+	}
+
+	labels := maps.Clone(poolLabels)
+	for _, key := range slices.Sorted(maps.Keys(instanceLabels)) {
+		label := gcp.SanitizeGcpLabel(key)
+		if _, ok := labels[label]; ok || label == "" {
+			continue
+		}
+		labels[label] = gcp.SanitizeGcpLabelValue(instanceLabels[key])
+	}
+	return labels
+}
+
+// InstanceDescriptionData is the data the description template of a worker pool is rendered with.
+type InstanceDescriptionData struct {
+	// Shoot is the name of the shoot.
+	Shoot string
+	// Namespace is the namespace of the shoot in the seed.
+	Namespace string
+	// Pool is the name of the worker pool.
+	Pool string
+	// Zone is the zone of the VM.
+	Zone string
+}
+
+// RenderInstanceDescription renders the given description template of a worker pool.
+func RenderInstanceDescription(descriptionTemplate string, data InstanceDescriptionData) (string, error) {
+	tmpl, err := template.New("description").Parse(descriptionTemplate)
+	if err != nil {
+		return "", err
+	}
+
+	var description strings.Builder
+	if err := tmpl.Execute(&description, data); err != nil {
+		return "", err
+	}
+	if description.Len() > MaxInstanceDescriptionLength {
+		return "", fmt.Errorf("description must not be longer than %d characters", MaxInstanceDescriptionLength)
+	}
+	return description.String(), nil
+}
+
+// initializeCapacity returns the capacity of the node template of a machine class. The local SSDs of the VMs add to the
+// ephemeral storage of the given capacity, afterwards the explicit overrides of the WorkerConfig are applied. The GPU
+// count is always overwritten if it was provided in the WorkerConfig.
+func initializeCapacity(capacityList, overrides v1.ResourceList, gpuCount, localSSDCount int32) v1.ResourceList {
 	resultCapacity := capacityList.DeepCopy()
+	if resultCapacity == nil {
+		resultCapacity = v1.ResourceList{}
+	}
+	if localSSDCount > 0 {
+		ephemeralStorage := resultCapacity[v1.ResourceEphemeralStorage]
+		localSSDSize := resource.MustParse(LocalSSDSize)
+		for range localSSDCount {
+			ephemeralStorage.Add(localSSDSize)
+		}
+		resultCapacity[v1.ResourceEphemeralStorage] = ephemeralStorage
+	}
+	maps.Copy(resultCapacity, overrides)
 	if gpuCount != 0 {
 		resultCapacity[ResourceGPU] = *resource.NewQuantity(int64(gpuCount), resource.DecimalSI)
 	}
@@ -466,47 +920,36 @@ func initializeCapacity(capacityList v1.ResourceList, gpuCount int32) v1.Resourc
 	return resultCapacity
 }
 
-func setSchedulingPolicy(machineClassSpec map[string]interface{}, isLiveMigrationAllowed bool) {
+func setSchedulingPolicy(machineClassSpec map[string]interface{}, isLiveMigrationAllowed bool, workerConfig *apisgcp.WorkerConfig) {
+	onHostMaintenance := apisgcp.OnHostMaintenanceTerminate
 	if isLiveMigrationAllowed {
-		machineClassSpec["scheduling"] = map[string]interface{}{
-			"automaticRestart":  true,
-			"onHostMaintenance": "MIGRATE",
-			"preemptible":       false,
-		}
-	} else {
-		machineClassSpec["scheduling"] = map[string]interface{}{
-			"automaticRestart":  true,
-			"onHostMaintenance": "TERMINATE",
-			"preemptible":       false,
+		onHostMaintenance = apisgcp.OnHostMaintenanceMigrate
+		if workerConfig.OnHostMaintenance != "" {
+			onHostMaintenance = workerConfig.OnHostMaintenance
 		}
 	}
-}
 
-// SanitizeGcpLabel will sanitize the label base on the gcp label Restrictions
-func SanitizeGcpLabel(label string) string {
-	return sanitizeGcpLabelOrValue(label, true)
+	machineClassSpec["scheduling"] = map[string]interface{}{
+		"automaticRestart":  ptr.Deref(workerConfig.AutomaticRestart, true),
+		"onHostMaintenance": string(onHostMaintenance),
+		"preemptible":       false,
+	}
 }
 
-// SanitizeGcpLabelValue will sanitize the value base on the gcp label Restrictions
-func SanitizeGcpLabelValue(value string) string {
-	return sanitizeGcpLabelOrValue(value, false)
-}
+func gpuNodeLabels(gpu *apisgcp.GPU) map[string]string {
+	if gpu == nil {
+		return nil
+	}
 
-// sanitizeGcpLabelOrValue will sanitize the label/value base on the gcp label Restrictions
-func sanitizeGcpLabelOrValue(label string, startWithCharacter bool) string {
-	v := labelRegex.ReplaceAllString(strings.ToLower(label), "_")
-	if startWithCharacter {
-		v = strings.TrimLeftFunc(v, func(r rune) bool {
-			if ('0' <= r && r <= '9') || r == '_' {
-				return true
-			}
-			return false
-		})
+	labels := map[string]string{}
+	if gpu.DriverVersion != "" {
+		labels[LabelGPUDriverVersion] = gpu.DriverVersion
 	}
-	if len(v) > maxGcpLabelCharactersSize {
-		return v[0:maxGcpLabelCharactersSize]
+	if sharing := gpu.GpuSharingConfig; sharing != nil {
+		labels[LabelGPUSharingStrategy] = string(sharing.Strategy)
+		labels[LabelGPUMaxSharedClientsPerGPU] = strconv.Itoa(int(sharing.MaxSharedClientsPerGpu))
 	}
-	return v
+	return labels
 }
 
 func addTopologyLabel(labels map[string]string, zone string) map[string]string {
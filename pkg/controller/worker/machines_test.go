@@ -28,7 +28,9 @@ import (
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 	"go.uber.org/mock/gomock"
+	compute "google.golang.org/api/compute/v1"
 	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
@@ -37,11 +39,14 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	"github.com/gardener/gardener-extension-provider-gcp/charts"
+	"github.com/gardener/gardener-extension-provider-gcp/pkg/apis/config"
 	api "github.com/gardener/gardener-extension-provider-gcp/pkg/apis/gcp"
 	apiv1alpha1 "github.com/gardener/gardener-extension-provider-gcp/pkg/apis/gcp/v1alpha1"
 	. "github.com/gardener/gardener-extension-provider-gcp/pkg/controller/worker"
 	gcpWorker "github.com/gardener/gardener-extension-provider-gcp/pkg/controller/worker"
 	"github.com/gardener/gardener-extension-provider-gcp/pkg/gcp"
+	gcpclient "github.com/gardener/gardener-extension-provider-gcp/pkg/gcp/client"
+	mockgcpclient "github.com/gardener/gardener-extension-provider-gcp/pkg/gcp/client/mock"
 )
 
 var _ = Describe("Machines", func() {
@@ -75,7 +80,7 @@ var _ = Describe("Machines", func() {
 
 	Context("WorkerDelegate", func() {
 		BeforeEach(func() {
-			workerDelegate, _ = NewWorkerDelegate(nil, scheme, nil, "", nil, nil)
+			workerDelegate, _ = NewWorkerDelegate(nil, scheme, nil, "", nil, nil, config.Worker{}, nil)
 		})
 
 		Describe("#GenerateMachineDeployments, #DeployMachineClasses", func() {
@@ -202,7 +207,7 @@ var _ = Describe("Machines", func() {
 					"memory": resource.MustParse("128Gi"),
 				}
 				nodeTemplatePool1Zone1 = machinev1alpha1.NodeTemplate{
-					Capacity:     gcpWorker.InitializeCapacity(nodeCapacity, acceleratorCount),
+					Capacity:     gcpWorker.InitializeCapacity(nodeCapacity, nil, acceleratorCount, 0),
 					InstanceType: machineType,
 					Region:       region,
 					Zone:         zone1,
@@ -210,7 +215,7 @@ var _ = Describe("Machines", func() {
 				}
 
 				nodeTemplatePool1Zone2 = machinev1alpha1.NodeTemplate{
-					Capacity:     gcpWorker.InitializeCapacity(nodeCapacity, acceleratorCount),
+					Capacity:     gcpWorker.InitializeCapacity(nodeCapacity, nil, acceleratorCount, 0),
 					InstanceType: machineType,
 					Region:       region,
 					Zone:         zone2,
@@ -385,7 +390,7 @@ var _ = Describe("Machines", func() {
 										},
 										ServiceAccount: &api.ServiceAccount{
 											Email:  "foo",
-											Scopes: []string{"bar"},
+											Scopes: []string{"https://www.googleapis.com/auth/devstorage.read_only"},
 										},
 										MinCpuPlatform: &minCpuPlatform,
 									}),
@@ -415,11 +420,11 @@ var _ = Describe("Machines", func() {
 				}
 
 				additionalData1 := []string{fmt.Sprintf("%dGi", volumeSize), minCpuPlatform, acceleratorTypeName, strconv.Itoa(int(acceleratorCount)), localVolumeInterface}
-				additionalData2 := []string{fmt.Sprintf("%dGi", volumeSize), minCpuPlatform, "foo", "bar", localVolumeInterface}
+				additionalData2 := []string{fmt.Sprintf("%dGi", volumeSize), minCpuPlatform, "foo", "https://www.googleapis.com/auth/devstorage.read_only", localVolumeInterface}
 				workerPoolHash1, _ = worker.WorkerPoolHash(w.Spec.Pools[0], cluster, []string{}, additionalData1)
 				workerPoolHash2, _ = worker.WorkerPoolHash(w.Spec.Pools[1], cluster, []string{}, additionalData2)
 
-				workerDelegate, _ = NewWorkerDelegate(c, scheme, chartApplier, "", w, clusterWithoutImages)
+				workerDelegate, _ = NewWorkerDelegate(c, scheme, chartApplier, "", w, clusterWithoutImages, config.Worker{}, nil)
 			})
 
 			expectedUserDataSecretRefRead := func() {
@@ -444,7 +449,7 @@ var _ = Describe("Machines", func() {
 						"k8s-cluster-name": namespace,
 					}
 					for k, v := range poolLabels {
-						instanceLabels[SanitizeGcpLabel(k)] = SanitizeGcpLabelValue(v)
+						instanceLabels[gcp.SanitizeGcpLabel(k)] = gcp.SanitizeGcpLabelValue(v)
 					}
 					defaultMachineClass = map[string]interface{}{
 						"region":             region,
@@ -519,7 +524,7 @@ var _ = Describe("Machines", func() {
 					machineClassPool2 := useDefaultMachineClass(
 						defaultMachineClass,
 						"serviceAccounts",
-						[]map[string]interface{}{{"email": "foo", "scopes": []string{"bar"}}},
+						[]map[string]interface{}{{"email": "foo", "scopes": []string{"https://www.googleapis.com/auth/devstorage.read_only"}}},
 					)
 					machineClassPool2["scheduling"] = map[string]interface{}{"automaticRestart": true, "onHostMaintenance": "MIGRATE", "preemptible": false}
 					delete(machineClassPool2, "gpu")
@@ -629,7 +634,7 @@ var _ = Describe("Machines", func() {
 							},
 						}),
 					}
-					workerDelegateCloudRouter, _ := NewWorkerDelegate(c, scheme, chartApplier, "", workerCloudRouter, cluster)
+					workerDelegateCloudRouter, _ := NewWorkerDelegate(c, scheme, chartApplier, "", workerCloudRouter, cluster, config.Worker{}, nil)
 
 					expectedUserDataSecretRefRead()
 
@@ -685,7 +690,8 @@ var _ = Describe("Machines", func() {
 
 			It("should fail because the version is invalid", func() {
 				clusterWithoutImages.Shoot.Spec.Kubernetes.Version = "invalid"
-				workerDelegate, _ = NewWorkerDelegate(c, scheme, chartApplier, "", w, cluster)
+				workerDelegate, _ = NewWorkerDelegate(c, scheme, chartApplier, "", w, cluster, config.Worker{}, nil)
+				expectedUserDataSecretRefRead()
 
 				result, err := workerDelegate.GenerateMachineDeployments(ctx)
 				Expect(err).To(HaveOccurred())
@@ -695,7 +701,7 @@ var _ = Describe("Machines", func() {
 			It("should fail because the infrastructure status cannot be decoded", func() {
 				w.Spec.InfrastructureProviderStatus = &runtime.RawExtension{}
 
-				workerDelegate, _ = NewWorkerDelegate(c, scheme, chartApplier, "", w, cluster)
+				workerDelegate, _ = NewWorkerDelegate(c, scheme, chartApplier, "", w, cluster, config.Worker{}, nil)
 
 				result, err := workerDelegate.GenerateMachineDeployments(ctx)
 				Expect(err).To(HaveOccurred())
@@ -707,7 +713,7 @@ var _ = Describe("Machines", func() {
 					Raw: encode(&api.InfrastructureStatus{}),
 				}
 
-				workerDelegate, _ = NewWorkerDelegate(c, scheme, chartApplier, "", w, cluster)
+				workerDelegate, _ = NewWorkerDelegate(c, scheme, chartApplier, "", w, cluster, config.Worker{}, nil)
 
 				result, err := workerDelegate.GenerateMachineDeployments(ctx)
 				Expect(err).To(HaveOccurred())
@@ -717,7 +723,8 @@ var _ = Describe("Machines", func() {
 			It("should fail because the machine image for given architecture cannot be found", func() {
 				w.Spec.Pools[0].Architecture = ptr.To(archFAKE)
 
-				workerDelegate, _ = NewWorkerDelegate(c, scheme, chartApplier, "", w, cluster)
+				workerDelegate, _ = NewWorkerDelegate(c, scheme, chartApplier, "", w, cluster, config.Worker{}, nil)
+				expectedUserDataSecretRefRead()
 
 				result, err := workerDelegate.GenerateMachineDeployments(ctx)
 				Expect(err).To(HaveOccurred())
@@ -725,7 +732,8 @@ var _ = Describe("Machines", func() {
 			})
 
 			It("should fail because the machine image cannot be found", func() {
-				workerDelegate, _ = NewWorkerDelegate(c, scheme, chartApplier, "", w, clusterWithoutImages)
+				workerDelegate, _ = NewWorkerDelegate(c, scheme, chartApplier, "", w, clusterWithoutImages, config.Worker{}, nil)
+				expectedUserDataSecretRefRead()
 
 				result, err := workerDelegate.GenerateMachineDeployments(ctx)
 				Expect(err).To(HaveOccurred())
@@ -735,13 +743,40 @@ var _ = Describe("Machines", func() {
 			It("should fail because the volume size cannot be decoded", func() {
 				w.Spec.Pools[0].Volume.Size = "not-decodeable"
 
-				workerDelegate, _ = NewWorkerDelegate(c, scheme, chartApplier, "", w, cluster)
+				workerDelegate, _ = NewWorkerDelegate(c, scheme, chartApplier, "", w, cluster, config.Worker{}, nil)
+				expectedUserDataSecretRefRead()
 
 				result, err := workerDelegate.GenerateMachineDeployments(ctx)
 				Expect(err).To(HaveOccurred())
 				Expect(result).To(BeNil())
 			})
 
+			It("should fail because the user data secret does not exist", func() {
+				c.EXPECT().Get(ctx, client.ObjectKey{Namespace: namespace, Name: userDataSecretName}, gomock.AssignableToTypeOf(&corev1.Secret{})).
+					Return(apierrors.NewNotFound(corev1.Resource("secrets"), userDataSecretName))
+
+				workerDelegate, _ = NewWorkerDelegate(c, scheme, chartApplier, "", w, cluster, config.Worker{}, nil)
+
+				result, err := workerDelegate.GenerateMachineDeployments(ctx)
+				Expect(err).To(MatchError(fmt.Sprintf("user data secret %s/%s referenced in worker pool %s does not exist", namespace, userDataSecretName, namePool1)))
+				Expect(result).To(BeNil())
+			})
+
+			It("should fail because the user data secret does not contain the referenced key", func() {
+				c.EXPECT().Get(ctx, client.ObjectKey{Namespace: namespace, Name: userDataSecretName}, gomock.AssignableToTypeOf(&corev1.Secret{})).DoAndReturn(
+					func(_ context.Context, _ client.ObjectKey, secret *corev1.Secret, _ ...client.GetOption) error {
+						secret.Data = map[string][]byte{"other-key": userData}
+						return nil
+					},
+				)
+
+				workerDelegate, _ = NewWorkerDelegate(c, scheme, chartApplier, "", w, cluster, config.Worker{}, nil)
+
+				result, err := workerDelegate.GenerateMachineDeployments(ctx)
+				Expect(err).To(MatchError(ContainSubstring(fmt.Sprintf("user data secret %s for worker pool %s has no %s field", userDataSecretName, namePool1, userDataSecretDataKey))))
+				Expect(result).To(BeNil())
+			})
+
 			It("should set expected machineControllerManager settings on machine deployment", func() {
 				testDrainTimeout := metav1.Duration{Duration: 10 * time.Minute}
 				testHealthTimeout := metav1.Duration{Duration: 20 * time.Minute}
@@ -756,7 +791,7 @@ var _ = Describe("Machines", func() {
 					NodeConditions:         testNodeConditions,
 				}
 
-				workerDelegate, _ = NewWorkerDelegate(c, scheme, chartApplier, "", w, cluster)
+				workerDelegate, _ = NewWorkerDelegate(c, scheme, chartApplier, "", w, cluster, config.Worker{}, nil)
 
 				expectedUserDataSecretRefRead()
 
@@ -791,7 +826,7 @@ var _ = Describe("Machines", func() {
 				expectedCapacity := w.Spec.Pools[0].NodeTemplate.Capacity.DeepCopy()
 				maps.Copy(expectedCapacity, customResources)
 
-				wd, err := NewWorkerDelegate(c, scheme, chartApplier, "", w, cluster)
+				wd, err := NewWorkerDelegate(c, scheme, chartApplier, "", w, cluster, config.Worker{}, nil)
 				Expect(err).NotTo(HaveOccurred())
 				expectedUserDataSecretRefRead()
 				_, err = wd.GenerateMachineDeployments(ctx)
@@ -807,56 +842,1151 @@ var _ = Describe("Machines", func() {
 				}
 			})
 
-			It("should set expected cluster-autoscaler annotations on the machine deployment", func() {
-				w.Spec.Pools[0].ClusterAutoscaler = &extensionsv1alpha1.ClusterAutoscalerOptions{
-					MaxNodeProvisionTime:             ptr.To(metav1.Duration{Duration: time.Minute}),
-					ScaleDownGpuUtilizationThreshold: ptr.To("0.4"),
-					ScaleDownUnneededTime:            ptr.To(metav1.Duration{Duration: 2 * time.Minute}),
-					ScaleDownUnreadyTime:             ptr.To(metav1.Duration{Duration: 3 * time.Minute}),
-					ScaleDownUtilizationThreshold:    ptr.To("0.5"),
+			It("should account for local SSDs and GPUs in the capacity of the nodeTemplate", func() {
+				w.Spec.Pools[0].ProviderConfig = &runtime.RawExtension{
+					Raw: encode(&api.WorkerConfig{
+						Volume: &api.Volume{
+							LocalSSDInterface: ptr.To("NVME"),
+							LocalSSDCount:     ptr.To[int32](2),
+						},
+						GPU: &api.GPU{
+							AcceleratorType: acceleratorTypeName,
+							Count:           2,
+						},
+					}),
 				}
-				w.Spec.Pools[1].ClusterAutoscaler = nil
-				workerDelegate, _ = NewWorkerDelegate(c, scheme, chartApplier, "", w, cluster)
 
+				wd, err := NewWorkerDelegate(c, scheme, chartApplier, "", w, cluster, config.Worker{}, nil)
+				Expect(err).NotTo(HaveOccurred())
 				expectedUserDataSecretRefRead()
+				_, err = wd.GenerateMachineDeployments(ctx)
+				Expect(err).NotTo(HaveOccurred())
 
-				result, err := workerDelegate.GenerateMachineDeployments(ctx)
+				workerDelegate := wd.(*WorkerDelegate)
+				for _, mClz := range workerDelegate.GetMachineClasses() {
+					if strings.Contains(mClz["name"].(string), namePool1) {
+						nt := mClz["nodeTemplate"].(machinev1alpha1.NodeTemplate)
+						Expect(nt.Capacity).To(HaveLen(4))
+						Expect(nt.Capacity.Cpu().Cmp(nodeCapacity["cpu"])).To(BeZero())
+						Expect(nt.Capacity.Memory().Cmp(nodeCapacity["memory"])).To(BeZero())
+						Expect(nt.Capacity.StorageEphemeral().Cmp(resource.MustParse("750Gi"))).To(BeZero())
+						gpus := nt.Capacity[gcpWorker.ResourceGPU]
+						Expect(gpus.Value()).To(Equal(int64(2)))
+					}
+				}
+			})
+
+			It("should attach the configured number of local SSDs", func() {
+				w.Spec.Pools[0].ProviderConfig = &runtime.RawExtension{
+					Raw: encode(&api.WorkerConfig{
+						Volume: &api.Volume{
+							LocalSSDInterface: ptr.To("NVME"),
+							LocalSSDCount:     ptr.To[int32](2),
+						},
+					}),
+				}
+
+				wd, err := NewWorkerDelegate(c, scheme, chartApplier, "", w, cluster, config.Worker{}, nil)
 				Expect(err).NotTo(HaveOccurred())
-				Expect(result).NotTo(BeNil())
+				expectedUserDataSecretRefRead()
+				result, err := wd.GenerateMachineDeployments(ctx)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(result[0].ClassName).NotTo(HaveSuffix(workerPoolHash1))
 
-				Expect(result[0].ClusterAutoscalerAnnotations).NotTo(BeNil())
-				Expect(result[1].ClusterAutoscalerAnnotations).NotTo(BeNil())
-				Expect(result[2].ClusterAutoscalerAnnotations).To(BeNil())
-				Expect(result[3].ClusterAutoscalerAnnotations).To(BeNil())
+				workerDelegate := wd.(*WorkerDelegate)
+				for _, mClz := range workerDelegate.GetMachineClasses() {
+					className := mClz["name"].(string)
+					if !strings.Contains(className, namePool1) {
+						continue
+					}
+					disks := mClz["disks"].([]map[string]interface{})
+					// boot disk, SCRATCH data volume and two local SSDs
+					Expect(disks).To(HaveLen(4))
+					for _, disk := range disks[2:] {
+						Expect(disk).To(Equal(map[string]interface{}{
+							"autoDelete": true,
+							"boot":       false,
+							"sizeGb":     375,
+							"type":       VolumeTypeScratch,
+							"interface":  "NVME",
+							"labels":     disks[0]["labels"],
+						}))
+					}
+				}
+			})
 
-				Expect(result[0].ClusterAutoscalerAnnotations[extensionsv1alpha1.MaxNodeProvisionTimeAnnotation]).To(Equal("1m0s"))
-				Expect(result[0].ClusterAutoscalerAnnotations[extensionsv1alpha1.ScaleDownGpuUtilizationThresholdAnnotation]).To(Equal("0.4"))
-				Expect(result[0].ClusterAutoscalerAnnotations[extensionsv1alpha1.ScaleDownUnneededTimeAnnotation]).To(Equal("2m0s"))
-				Expect(result[0].ClusterAutoscalerAnnotations[extensionsv1alpha1.ScaleDownUnreadyTimeAnnotation]).To(Equal("3m0s"))
-				Expect(result[0].ClusterAutoscalerAnnotations[extensionsv1alpha1.ScaleDownUtilizationThresholdAnnotation]).To(Equal("0.5"))
+			It("should use the custom machine type and derive the node capacity from its shape", func() {
+				w.Spec.Pools[0].ProviderConfig = &runtime.RawExtension{
+					Raw: encode(&api.WorkerConfig{
+						CustomMachine: &api.CustomMachine{
+							VCPUs:     6,
+							MemoryMiB: 12288,
+							Family:    "n2",
+						},
+					}),
+				}
 
-				Expect(result[1].ClusterAutoscalerAnnotations[extensionsv1alpha1.MaxNodeProvisionTimeAnnotation]).To(Equal("1m0s"))
-				Expect(result[1].ClusterAutoscalerAnnotations[extensionsv1alpha1.ScaleDownGpuUtilizationThresholdAnnotation]).To(Equal("0.4"))
-				Expect(result[1].ClusterAutoscalerAnnotations[extensionsv1alpha1.ScaleDownUnneededTimeAnnotation]).To(Equal("2m0s"))
-				Expect(result[1].ClusterAutoscalerAnnotations[extensionsv1alpha1.ScaleDownUnreadyTimeAnnotation]).To(Equal("3m0s"))
-				Expect(result[1].ClusterAutoscalerAnnotations[extensionsv1alpha1.ScaleDownUtilizationThresholdAnnotation]).To(Equal("0.5"))
+				expectedCapacity := w.Spec.Pools[0].NodeTemplate.Capacity.DeepCopy()
+				expectedCapacity[corev1.ResourceCPU] = resource.MustParse("6")
+				expectedCapacity[corev1.ResourceMemory] = resource.MustParse("12Gi")
+
+				wd, err := NewWorkerDelegate(c, scheme, chartApplier, "", w, cluster, config.Worker{}, nil)
+				Expect(err).NotTo(HaveOccurred())
+				expectedUserDataSecretRefRead()
+				result, err := wd.GenerateMachineDeployments(ctx)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(result[0].ClassName).NotTo(HaveSuffix(workerPoolHash1))
+
+				workerDelegate := wd.(*WorkerDelegate)
+				for _, mClz := range workerDelegate.GetMachineClasses() {
+					className := mClz["name"].(string)
+					if !strings.Contains(className, namePool1) {
+						Expect(mClz["machineType"]).To(Equal(machineType))
+						continue
+					}
+					Expect(mClz["machineType"]).To(Equal("n2-custom-6-12288"))
+					nt := mClz["nodeTemplate"].(machinev1alpha1.NodeTemplate)
+					Expect(nt.InstanceType).To(Equal("n2-custom-6-12288"))
+					Expect(nt.Capacity.Cpu().Equal(expectedCapacity[corev1.ResourceCPU])).To(BeTrue())
+					Expect(nt.Capacity.Memory().Equal(expectedCapacity[corev1.ResourceMemory])).To(BeTrue())
+				}
 			})
-		})
-	})
 
-	Describe("sanitize gcp label/value ", func() {
-		It("gcp label must start with lowercase character", func() {
-			Expect(SanitizeGcpLabel("////Abcd-efg")).To(Equal("abcd-efg"))
-			Expect(SanitizeGcpLabel("1Abcd-efg")).To(Equal("abcd-efg"))
-		})
-		It("gcp label value can  start with '-' ", func() {
-			Expect(SanitizeGcpLabelValue("////Abcd-efg")).To(Equal("____abcd-efg"))
-			Expect(SanitizeGcpLabelValue("1Abcd-efg")).To(Equal("1abcd-efg"))
-		})
-		It("label can be at most 63 characters long", func() {
-			Expect(SanitizeGcpLabel("abcdefghijklmnopqrstuvwxyzabcdefghijklmnopqrstuvwxyz0123456789abcd")).To(Equal("abcdefghijklmnopqrstuvwxyzabcdefghijklmnopqrstuvwxyz0123456789a"))
-		})
-	})
+			It("should set the resource manager tags on the machine classes", func() {
+				resourceManagerTags := map[string]string{
+					"tagKeys/123": "tagValues/456",
+					"tagKeys/789": "tagValues/012",
+				}
+				w.Spec.Pools[0].ProviderConfig = &runtime.RawExtension{
+					Raw: encode(&api.WorkerConfig{
+						ResourceManagerTags: resourceManagerTags,
+					}),
+				}
+
+				wd, err := NewWorkerDelegate(c, scheme, chartApplier, "", w, cluster, config.Worker{}, nil)
+				Expect(err).NotTo(HaveOccurred())
+				expectedUserDataSecretRefRead()
+				result, err := wd.GenerateMachineDeployments(ctx)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(result[0].ClassName).NotTo(HaveSuffix(workerPoolHash1))
+
+				workerDelegate := wd.(*WorkerDelegate)
+				for _, mClz := range workerDelegate.GetMachineClasses() {
+					className := mClz["name"].(string)
+					if strings.Contains(className, namePool1) {
+						Expect(mClz["resourceManagerTags"]).To(Equal(resourceManagerTags))
+					} else {
+						Expect(mClz).NotTo(HaveKey("resourceManagerTags"))
+					}
+				}
+			})
+
+			It("should configure the GPU driver version and sharing on the machine classes and nodes", func() {
+				w.Spec.Pools[0].ProviderConfig = &runtime.RawExtension{
+					Raw: encode(&api.WorkerConfig{
+						GPU: &api.GPU{
+							AcceleratorType: "nvidia-l4",
+							Count:           1,
+							DriverVersion:   "latest",
+							GpuSharingConfig: &api.GpuSharing{
+								Strategy:               api.GpuSharingStrategyMPS,
+								MaxSharedClientsPerGpu: 4,
+							},
+						},
+					}),
+				}
+
+				wd, err := NewWorkerDelegate(c, scheme, chartApplier, "", w, cluster, config.Worker{}, nil)
+				Expect(err).NotTo(HaveOccurred())
+				expectedUserDataSecretRefRead()
+				result, err := wd.GenerateMachineDeployments(ctx)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(result[0].ClassName).NotTo(HaveSuffix(workerPoolHash1))
+
+				for _, deployment := range result {
+					if strings.Contains(deployment.Name, namePool1) {
+						Expect(deployment.Labels).To(And(
+							HaveKeyWithValue("cloud.google.com/gke-gpu-driver-version", "latest"),
+							HaveKeyWithValue("cloud.google.com/gke-gpu-sharing-strategy", "mps"),
+							HaveKeyWithValue("cloud.google.com/gke-max-shared-clients-per-gpu", "4"),
+						))
+					} else {
+						Expect(deployment.Labels).NotTo(HaveKey("cloud.google.com/gke-gpu-sharing-strategy"))
+					}
+				}
+
+				workerDelegate := wd.(*WorkerDelegate)
+				for _, mClz := range workerDelegate.GetMachineClasses() {
+					className := mClz["name"].(string)
+					if !strings.Contains(className, namePool1) {
+						continue
+					}
+					Expect(mClz["gpu"]).To(Equal(map[string]interface{}{
+						"acceleratorType": "nvidia-l4",
+						"count":           int32(1),
+						"driverVersion":   "latest",
+						"gpuSharingConfig": map[string]interface{}{
+							"strategy":               "mps",
+							"maxSharedClientsPerGpu": int32(4),
+						},
+					}))
+				}
+			})
+
+			It("should merge the instance metadata into the metadata of the machine classes", func() {
+				w.Spec.Pools[0].ProviderConfig = &runtime.RawExtension{
+					Raw: encode(&api.WorkerConfig{
+						InstanceMetadata: map[string]string{
+							"serial-port-enable": "TRUE",
+							"enable-oslogin":     "FALSE",
+						},
+					}),
+				}
+
+				wd, err := NewWorkerDelegate(c, scheme, chartApplier, "", w, cluster, config.Worker{}, nil)
+				Expect(err).NotTo(HaveOccurred())
+				expectedUserDataSecretRefRead()
+				result, err := wd.GenerateMachineDeployments(ctx)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(result[0].ClassName).NotTo(HaveSuffix(workerPoolHash1))
+
+				workerDelegate := wd.(*WorkerDelegate)
+				for _, mClz := range workerDelegate.GetMachineClasses() {
+					className := mClz["name"].(string)
+					if strings.Contains(className, namePool1) {
+						Expect(mClz["metadata"]).To(Equal([]map[string]string{
+							{"key": "block-project-ssh-keys", "value": "TRUE"},
+							{"key": "enable-oslogin", "value": "FALSE"},
+							{"key": "serial-port-enable", "value": "TRUE"},
+						}))
+					} else {
+						Expect(mClz["metadata"]).To(Equal([]map[string]string{
+							{"key": "block-project-ssh-keys", "value": "TRUE"},
+						}))
+					}
+				}
+			})
+
+			It("should configure regional persistent disks", func() {
+				w.Spec.Pools[0].ProviderConfig = &runtime.RawExtension{
+					Raw: encode(&api.WorkerConfig{
+						Volume: &api.Volume{
+							Regional: &api.RegionalDisk{ReplicaZones: []string{zone1, zone2}},
+						},
+					}),
+				}
+
+				wd, err := NewWorkerDelegate(c, scheme, chartApplier, "", w, cluster, config.Worker{}, nil)
+				Expect(err).NotTo(HaveOccurred())
+				expectedUserDataSecretRefRead()
+				_, err = wd.GenerateMachineDeployments(ctx)
+				Expect(err).NotTo(HaveOccurred())
+
+				workerDelegate := wd.(*WorkerDelegate)
+				for _, mClz := range workerDelegate.GetMachineClasses() {
+					disks := mClz["disks"].([]map[string]interface{})
+					if !strings.Contains(mClz["name"].(string), namePool1) {
+						Expect(disks[0]).NotTo(HaveKey("replicaZones"))
+						continue
+					}
+					Expect(disks[0]).To(HaveKeyWithValue("region", region))
+					Expect(disks[0]).To(HaveKeyWithValue("replicaZones", []string{zone1, zone2}))
+					Expect(disks[1]).NotTo(HaveKey("replicaZones"))
+				}
+			})
+
+			It("should configure the disk encryption of the boot and data disks", func() {
+				kmsKeyName := "projects/project/locations/" + region + "/keyRings/ring/cryptoKeys/key"
+				w.Spec.Pools[0].NodeAgentSecretName = ptr.To("node-agent")
+				w.Spec.Pools[0].ProviderConfig = &runtime.RawExtension{
+					Raw: encode(&api.WorkerConfig{
+						Volume: &api.Volume{
+							Encryption: &api.DiskEncryption{
+								KmsKeyName:           ptr.To(kmsKeyName),
+								KmsKeyServiceAccount: ptr.To("kms@project.iam.gserviceaccount.com"),
+							},
+						},
+					}),
+				}
+
+				wd, err := NewWorkerDelegate(c, scheme, chartApplier, "", w, cluster, config.Worker{}, nil)
+				Expect(err).NotTo(HaveOccurred())
+				expectedUserDataSecretRefRead()
+				result, err := wd.GenerateMachineDeployments(ctx)
+				Expect(err).NotTo(HaveOccurred())
+
+				workerDelegate := wd.(*WorkerDelegate)
+				for _, mClz := range workerDelegate.GetMachineClasses() {
+					disks := mClz["disks"].([]map[string]interface{})
+					if !strings.Contains(mClz["name"].(string), namePool1) {
+						Expect(disks[0]).NotTo(HaveKey("encryption"))
+						continue
+					}
+					for _, disk := range disks {
+						Expect(disk).To(HaveKeyWithValue("encryption", map[string]interface{}{
+							"kmsKeyName":           kmsKeyName,
+							"kmsKeyServiceAccount": "kms@project.iam.gserviceaccount.com",
+						}))
+					}
+				}
+
+				w.Spec.Pools[0].ProviderConfig = &runtime.RawExtension{
+					Raw: encode(&api.WorkerConfig{
+						Volume: &api.Volume{
+							Encryption: &api.DiskEncryption{KmsKeyName: ptr.To(kmsKeyName + "-rotated")},
+						},
+					}),
+				}
+				wd, err = NewWorkerDelegate(c, scheme, chartApplier, "", w, cluster, config.Worker{}, nil)
+				Expect(err).NotTo(HaveOccurred())
+				expectedUserDataSecretRefRead()
+				changed, err := wd.GenerateMachineDeployments(ctx)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(changed[0].ClassName).NotTo(Equal(result[0].ClassName))
+			})
+
+			It("should encrypt data disks with the configured key and roll the nodes on a key change", func() {
+				kmsKeyName := "projects/project/locations/" + region + "/keyRings/ring/cryptoKeys/key"
+				w.Spec.Pools[0].DataVolumes = []extensionsv1alpha1.DataVolume{
+					{Name: "data", Type: ptr.To("pd-balanced"), Size: "100Gi"},
+				}
+				workerConfig := &api.WorkerConfig{
+					Volume: &api.Volume{
+						Encryption: &api.DiskEncryption{
+							KmsKeyName:           ptr.To(kmsKeyName),
+							KmsKeyServiceAccount: ptr.To("kms@project.iam.gserviceaccount.com"),
+						},
+					},
+				}
+				w.Spec.Pools[0].ProviderConfig = &runtime.RawExtension{Raw: encode(workerConfig)}
+
+				wd, err := NewWorkerDelegate(c, scheme, chartApplier, "", w, cluster, config.Worker{}, nil)
+				Expect(err).NotTo(HaveOccurred())
+				expectedUserDataSecretRefRead()
+				result, err := wd.GenerateMachineDeployments(ctx)
+				Expect(err).NotTo(HaveOccurred())
+
+				workerDelegate := wd.(*WorkerDelegate)
+				for _, mClz := range workerDelegate.GetMachineClasses() {
+					if !strings.Contains(mClz["name"].(string), namePool1) {
+						continue
+					}
+					disks := mClz["disks"].([]map[string]interface{})
+					Expect(disks).To(HaveLen(2))
+					Expect(disks[1]).To(HaveKeyWithValue("type", "pd-balanced"))
+					Expect(disks[1]).To(HaveKeyWithValue("encryption", map[string]interface{}{
+						"kmsKeyName":           kmsKeyName,
+						"kmsKeyServiceAccount": "kms@project.iam.gserviceaccount.com",
+					}))
+				}
+
+				workerConfig.Volume.Encryption.KmsKeyServiceAccount = ptr.To("other-kms@project.iam.gserviceaccount.com")
+				w.Spec.Pools[0].ProviderConfig = &runtime.RawExtension{Raw: encode(workerConfig)}
+				wd, err = NewWorkerDelegate(c, scheme, chartApplier, "", w, cluster, config.Worker{}, nil)
+				Expect(err).NotTo(HaveOccurred())
+				expectedUserDataSecretRefRead()
+				changed, err := wd.GenerateMachineDeployments(ctx)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(changed[0].ClassName).NotTo(Equal(result[0].ClassName))
+			})
+
+			It("should configure the provisioned performance of hyperdisk root volumes", func() {
+				w.Spec.Pools[0].Volume = &extensionsv1alpha1.Volume{Type: ptr.To("hyperdisk-balanced"), Size: fmt.Sprintf("%dGi", volumeSize)}
+				w.Spec.Pools[0].NodeAgentSecretName = ptr.To("node-agent")
+				w.Spec.Pools[0].ProviderConfig = &runtime.RawExtension{
+					Raw: encode(&api.WorkerConfig{
+						Volume: &api.Volume{
+							ProvisionedIops:       ptr.To[int64](5000),
+							ProvisionedThroughput: ptr.To[int64](300),
+						},
+					}),
+				}
+
+				wd, err := NewWorkerDelegate(c, scheme, chartApplier, "", w, cluster, config.Worker{}, nil)
+				Expect(err).NotTo(HaveOccurred())
+				expectedUserDataSecretRefRead()
+				result, err := wd.GenerateMachineDeployments(ctx)
+				Expect(err).NotTo(HaveOccurred())
+
+				workerDelegate := wd.(*WorkerDelegate)
+				for _, mClz := range workerDelegate.GetMachineClasses() {
+					disks := mClz["disks"].([]map[string]interface{})
+					if !strings.Contains(mClz["name"].(string), namePool1) {
+						Expect(disks[0]).NotTo(HaveKey("provisionedIops"))
+						continue
+					}
+					Expect(disks[0]).To(HaveKeyWithValue("provisionedIops", int64(5000)))
+					Expect(disks[0]).To(HaveKeyWithValue("provisionedThroughput", int64(300)))
+					Expect(disks[1]).NotTo(HaveKey("provisionedIops"))
+					Expect(disks[1]).NotTo(HaveKey("provisionedThroughput"))
+				}
+
+				w.Spec.Pools[0].ProviderConfig = &runtime.RawExtension{
+					Raw: encode(&api.WorkerConfig{
+						Volume: &api.Volume{
+							ProvisionedIops:       ptr.To[int64](6000),
+							ProvisionedThroughput: ptr.To[int64](300),
+						},
+					}),
+				}
+				wd, err = NewWorkerDelegate(c, scheme, chartApplier, "", w, cluster, config.Worker{}, nil)
+				Expect(err).NotTo(HaveOccurred())
+				expectedUserDataSecretRefRead()
+				changed, err := wd.GenerateMachineDeployments(ctx)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(changed[0].ClassName).NotTo(Equal(result[0].ClassName))
+			})
+
+			It("should fail if a zone of the pool is not a replica zone of its regional disks", func() {
+				w.Spec.Pools[0].ProviderConfig = &runtime.RawExtension{
+					Raw: encode(&api.WorkerConfig{
+						Volume: &api.Volume{
+							Regional: &api.RegionalDisk{ReplicaZones: []string{zone1, region + "c"}},
+						},
+					}),
+				}
+
+				wd, err := NewWorkerDelegate(c, scheme, chartApplier, "", w, cluster, config.Worker{}, nil)
+				Expect(err).NotTo(HaveOccurred())
+				expectedUserDataSecretRefRead()
+				result, err := wd.GenerateMachineDeployments(ctx)
+				Expect(err).To(MatchError(ContainSubstring(fmt.Sprintf("zone %q of worker pool %q is not a replica zone", zone2, namePool1))))
+				Expect(result).To(BeNil())
+			})
+
+			It("should derive the settings of accelerator-optimized machine types", func() {
+				w.Spec.Pools[0].MachineType = "a2-highgpu-1g"
+				w.Spec.Pools[0].NodeTemplate = nil
+
+				wd, err := NewWorkerDelegate(c, scheme, chartApplier, "", w, cluster, config.Worker{}, nil)
+				Expect(err).NotTo(HaveOccurred())
+				expectedUserDataSecretRefRead()
+				_, err = wd.GenerateMachineDeployments(ctx)
+				Expect(err).NotTo(HaveOccurred())
+
+				workerDelegate := wd.(*WorkerDelegate)
+				for _, mClz := range workerDelegate.GetMachineClasses() {
+					if !strings.Contains(mClz["name"].(string), namePool1) {
+						Expect(mClz).NotTo(HaveKey("confidentialInstanceConfig"))
+						continue
+					}
+					Expect(mClz["scheduling"]).To(Equal(map[string]interface{}{"automaticRestart": true, "onHostMaintenance": "TERMINATE", "preemptible": false}))
+					Expect(mClz["confidentialInstanceConfig"]).To(Equal(map[string]interface{}{"enableConfidentialCompute": false}))
+					Expect(mClz["disks"].([]map[string]interface{})[0]["guestOsFeatures"]).To(Equal([]map[string]interface{}{{"type": "GVNIC"}}))
+				}
+			})
+
+			It("should prefer explicit settings for accelerator-optimized machine types", func() {
+				w.Spec.Pools[0].MachineType = "g2-standard-4"
+				w.Spec.Pools[0].ProviderConfig = &runtime.RawExtension{
+					Raw: encode(&api.WorkerConfig{
+						GuestOSFeatures: []string{"GVNIC", "UEFI_COMPATIBLE"},
+					}),
+				}
+
+				wd, err := NewWorkerDelegate(c, scheme, chartApplier, "", w, cluster, config.Worker{}, nil)
+				Expect(err).NotTo(HaveOccurred())
+				expectedUserDataSecretRefRead()
+				_, err = wd.GenerateMachineDeployments(ctx)
+				Expect(err).NotTo(HaveOccurred())
+
+				workerDelegate := wd.(*WorkerDelegate)
+				for _, mClz := range workerDelegate.GetMachineClasses() {
+					if strings.Contains(mClz["name"].(string), namePool1) {
+						Expect(mClz["disks"].([]map[string]interface{})[0]["guestOsFeatures"]).To(Equal([]map[string]interface{}{{"type": "GVNIC"}, {"type": "UEFI_COMPATIBLE"}}))
+					}
+				}
+			})
+
+			DescribeTable("should set the block-project-ssh-keys metadata of the machine classes",
+				func(blockProjectSSHKeys bool, expectedValue string) {
+					w.Spec.Pools[0].ProviderConfig = &runtime.RawExtension{
+						Raw: encode(&api.WorkerConfig{
+							BlockProjectSSHKeys: ptr.To(blockProjectSSHKeys),
+						}),
+					}
+
+					wd, err := NewWorkerDelegate(c, scheme, chartApplier, "", w, cluster, config.Worker{AllowProjectSSHKeys: true}, nil)
+					Expect(err).NotTo(HaveOccurred())
+					expectedUserDataSecretRefRead()
+					_, err = wd.GenerateMachineDeployments(ctx)
+					Expect(err).NotTo(HaveOccurred())
+
+					workerDelegate := wd.(*WorkerDelegate)
+					for _, mClz := range workerDelegate.GetMachineClasses() {
+						if strings.Contains(mClz["name"].(string), namePool1) {
+							Expect(mClz["metadata"]).To(Equal([]map[string]string{
+								{"key": "block-project-ssh-keys", "value": expectedValue},
+							}))
+						}
+					}
+				},
+				Entry("blocked", true, "TRUE"),
+				Entry("allowed", false, "FALSE"),
+			)
+
+			It("should fail if project-wide SSH keys are allowed without permission of the controller configuration", func() {
+				w.Spec.Pools[0].ProviderConfig = &runtime.RawExtension{
+					Raw: encode(&api.WorkerConfig{
+						BlockProjectSSHKeys: ptr.To(false),
+					}),
+				}
+
+				wd, err := NewWorkerDelegate(c, scheme, chartApplier, "", w, cluster, config.Worker{}, nil)
+				Expect(err).NotTo(HaveOccurred())
+				expectedUserDataSecretRefRead()
+				result, err := wd.GenerateMachineDeployments(ctx)
+				Expect(err).To(MatchError(ContainSubstring("must not allow project-wide SSH keys")))
+				Expect(result).To(BeNil())
+			})
+
+			It("should configure the DNS search domains via the startup script of the machine classes", func() {
+				w.Spec.Pools[0].ProviderConfig = &runtime.RawExtension{
+					Raw: encode(&api.WorkerConfig{
+						DNSSearchDomains: []string{"corp.example.com", "example.com"},
+					}),
+				}
+
+				wd, err := NewWorkerDelegate(c, scheme, chartApplier, "", w, cluster, config.Worker{}, nil)
+				Expect(err).NotTo(HaveOccurred())
+				expectedUserDataSecretRefRead()
+				result, err := wd.GenerateMachineDeployments(ctx)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(result[0].ClassName).NotTo(HaveSuffix(workerPoolHash1))
+
+				workerDelegate := wd.(*WorkerDelegate)
+				for _, mClz := range workerDelegate.GetMachineClasses() {
+					className := mClz["name"].(string)
+					metadata := mClz["metadata"].([]map[string]string)
+					if !strings.Contains(className, namePool1) {
+						Expect(metadata).To(HaveLen(1))
+						continue
+					}
+					Expect(metadata).To(HaveLen(2))
+					Expect(metadata[1]["key"]).To(Equal("startup-script"))
+					Expect(metadata[1]["value"]).To(ContainSubstring("/etc/systemd/resolved.conf.d/99-gardener-search-domains.conf"))
+					Expect(metadata[1]["value"]).To(ContainSubstring("\nDomains=corp.example.com example.com\n"))
+				}
+			})
+
+			It("should enable the deletion protection of the VMs", func() {
+				w.Spec.Pools[0].ProviderConfig = &runtime.RawExtension{
+					Raw: encode(&api.WorkerConfig{
+						DeletionProtection: ptr.To(true),
+					}),
+				}
+
+				wd, err := NewWorkerDelegate(c, scheme, chartApplier, "", w, cluster, config.Worker{}, nil)
+				Expect(err).NotTo(HaveOccurred())
+				expectedUserDataSecretRefRead()
+				_, err = wd.GenerateMachineDeployments(ctx)
+				Expect(err).NotTo(HaveOccurred())
+
+				workerDelegate := wd.(*WorkerDelegate)
+				for _, mClz := range workerDelegate.GetMachineClasses() {
+					Expect(mClz["deletionProtection"]).To(Equal(strings.Contains(mClz["name"].(string), namePool1)))
+				}
+			})
+
+			It("should disable IP forwarding of the VMs", func() {
+				w.Spec.Pools[0].ProviderConfig = &runtime.RawExtension{
+					Raw: encode(&api.WorkerConfig{
+						CanIPForward: ptr.To(false),
+					}),
+				}
+
+				wd, err := NewWorkerDelegate(c, scheme, chartApplier, "", w, cluster, config.Worker{}, nil)
+				Expect(err).NotTo(HaveOccurred())
+				expectedUserDataSecretRefRead()
+				result, err := wd.GenerateMachineDeployments(ctx)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(result[0].ClassName).NotTo(HaveSuffix(workerPoolHash1))
+
+				workerDelegate := wd.(*WorkerDelegate)
+				for _, mClz := range workerDelegate.GetMachineClasses() {
+					Expect(mClz["canIpForward"]).To(Equal(!strings.Contains(mClz["name"].(string), namePool1)))
+				}
+			})
+
+			It("should merge the network tags into the tags of the machine classes", func() {
+				w.Spec.Pools[0].ProviderConfig = &runtime.RawExtension{
+					Raw: encode(&api.WorkerConfig{
+						NetworkTags: []string{"allow-monitoring", "kubernetes-io-role-node"},
+					}),
+				}
+
+				wd, err := NewWorkerDelegate(c, scheme, chartApplier, "", w, cluster, config.Worker{}, nil)
+				Expect(err).NotTo(HaveOccurred())
+				expectedUserDataSecretRefRead()
+				result, err := wd.GenerateMachineDeployments(ctx)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(result[0].ClassName).NotTo(HaveSuffix(workerPoolHash1))
+
+				workerDelegate := wd.(*WorkerDelegate)
+				for _, mClz := range workerDelegate.GetMachineClasses() {
+					gardenerTags := []string{namespace, "kubernetes-io-cluster-" + namespace, "kubernetes-io-role-node"}
+					if !strings.Contains(mClz["name"].(string), namePool1) {
+						Expect(mClz["tags"]).To(Equal(gardenerTags))
+						continue
+					}
+					Expect(mClz["tags"]).To(Equal(append(gardenerTags, "allow-monitoring")))
+				}
+			})
+
+			It("should prefer the configured onHostMaintenance policy if live migration is supported", func() {
+				w.Spec.Pools[1].ProviderConfig = &runtime.RawExtension{
+					Raw: encode(&api.WorkerConfig{
+						OnHostMaintenance: api.OnHostMaintenanceTerminate,
+					}),
+				}
+
+				wd, err := NewWorkerDelegate(c, scheme, chartApplier, "", w, cluster, config.Worker{}, nil)
+				Expect(err).NotTo(HaveOccurred())
+				expectedUserDataSecretRefRead()
+				result, err := wd.GenerateMachineDeployments(ctx)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(result[2].ClassName).NotTo(HaveSuffix(workerPoolHash2))
+
+				workerDelegate := wd.(*WorkerDelegate)
+				for _, mClz := range workerDelegate.GetMachineClasses() {
+					Expect(mClz["scheduling"]).To(Equal(map[string]interface{}{"automaticRestart": true, "onHostMaintenance": "TERMINATE", "preemptible": false}))
+				}
+			})
+
+			DescribeTable("should configure the automatic restart of the VMs",
+				func(automaticRestart *bool, expectedAutomaticRestart bool, hashChanged bool) {
+					// the provider config is only excluded from the hash of pools with a node agent secret
+					w.Spec.Pools[1].NodeAgentSecretName = ptr.To("node-agent")
+
+					generateMachineDeployments := func() (worker.MachineDeployments, *WorkerDelegate) {
+						wd, err := NewWorkerDelegate(c, scheme, chartApplier, "", w, cluster, config.Worker{}, nil)
+						Expect(err).NotTo(HaveOccurred())
+						expectedUserDataSecretRefRead()
+						result, err := wd.GenerateMachineDeployments(ctx)
+						Expect(err).NotTo(HaveOccurred())
+						return result, wd.(*WorkerDelegate)
+					}
+
+					defaultResult, _ := generateMachineDeployments()
+
+					w.Spec.Pools[1].ProviderConfig = &runtime.RawExtension{
+						Raw: encode(&api.WorkerConfig{
+							Volume: &api.Volume{
+								LocalSSDInterface: &localVolumeInterface,
+							},
+							ServiceAccount: &api.ServiceAccount{
+								Email:  "foo",
+								Scopes: []string{"https://www.googleapis.com/auth/devstorage.read_only"},
+							},
+							MinCpuPlatform:   &minCpuPlatform,
+							AutomaticRestart: automaticRestart,
+						}),
+					}
+
+					result, workerDelegate := generateMachineDeployments()
+					if hashChanged {
+						Expect(result[2].ClassName).NotTo(Equal(defaultResult[2].ClassName))
+					} else {
+						Expect(result[2].ClassName).To(Equal(defaultResult[2].ClassName))
+					}
+
+					for _, mClz := range workerDelegate.GetMachineClasses() {
+						if strings.Contains(mClz["name"].(string), namePool2) {
+							Expect(mClz["scheduling"]).To(HaveKeyWithValue("automaticRestart", expectedAutomaticRestart))
+						}
+					}
+				},
+				Entry("enabled", ptr.To(true), true, false),
+				Entry("disabled", ptr.To(false), false, true),
+			)
+
+			It("should normalize the service account scopes and keep the pool hash stable", func() {
+				w.Spec.Pools[1].NodeAgentSecretName = ptr.To("node-agent")
+
+				generateMachineDeployments := func(scopes ...string) (worker.MachineDeployments, *WorkerDelegate) {
+					w.Spec.Pools[1].ProviderConfig = &runtime.RawExtension{
+						Raw: encode(&api.WorkerConfig{
+							ServiceAccount: &api.ServiceAccount{
+								Email:  "foo",
+								Scopes: scopes,
+							},
+						}),
+					}
+
+					wd, err := NewWorkerDelegate(c, scheme, chartApplier, "", w, cluster, config.Worker{}, nil)
+					Expect(err).NotTo(HaveOccurred())
+					expectedUserDataSecretRefRead()
+					result, err := wd.GenerateMachineDeployments(ctx)
+					Expect(err).NotTo(HaveOccurred())
+					return result, wd.(*WorkerDelegate)
+				}
+
+				expectedResult, _ := generateMachineDeployments("https://www.googleapis.com/auth/compute", "https://www.googleapis.com/auth/devstorage.read_only")
+				result, workerDelegate := generateMachineDeployments("storage-ro", "compute", "https://www.googleapis.com/auth/compute")
+				Expect(result[2].ClassName).To(Equal(expectedResult[2].ClassName))
+
+				for _, mClz := range workerDelegate.GetMachineClasses() {
+					if strings.Contains(mClz["name"].(string), namePool2) {
+						Expect(mClz["serviceAccounts"]).To(Equal([]map[string]interface{}{{
+							"email":  "foo",
+							"scopes": []string{"https://www.googleapis.com/auth/compute", "https://www.googleapis.com/auth/devstorage.read_only"},
+						}}))
+					}
+				}
+			})
+
+			It("should add the instance labels and render the description template", func() {
+				w.Spec.Pools[1].NodeAgentSecretName = ptr.To("node-agent")
+
+				wd, err := NewWorkerDelegate(c, scheme, chartApplier, "", w, cluster, config.Worker{}, nil)
+				Expect(err).NotTo(HaveOccurred())
+				expectedUserDataSecretRefRead()
+				defaultResult, err := wd.GenerateMachineDeployments(ctx)
+				Expect(err).NotTo(HaveOccurred())
+
+				w.Spec.Pools[1].ProviderConfig = &runtime.RawExtension{
+					Raw: encode(&api.WorkerConfig{
+						ServiceAccount: &api.ServiceAccount{
+							Email:  "foo",
+							Scopes: []string{"https://www.googleapis.com/auth/devstorage.read_only"},
+						},
+						InstanceLabels: map[string]string{
+							"Cost-Center": "Team A",
+							"name":        "foo",
+							"component":   "foo",
+						},
+						DescriptionTemplate: "{{ .Pool }} of {{ .Shoot }} in {{ .Zone }}",
+					}),
+				}
+
+				wd, err = NewWorkerDelegate(c, scheme, chartApplier, "", w, cluster, config.Worker{}, nil)
+				Expect(err).NotTo(HaveOccurred())
+				expectedUserDataSecretRefRead()
+				result, err := wd.GenerateMachineDeployments(ctx)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(result[2].ClassName).NotTo(Equal(defaultResult[2].ClassName))
+
+				workerDelegate := wd.(*WorkerDelegate)
+				for _, mClz := range workerDelegate.GetMachineClasses() {
+					if !strings.Contains(mClz["name"].(string), namePool2) {
+						Expect(mClz["labels"]).NotTo(HaveKey("cost-center"))
+						continue
+					}
+					Expect(mClz["labels"]).To(Equal(map[string]interface{}{
+						"name":             name,
+						"k8s-cluster-name": namespace,
+						"component":        "tidb",
+						"cost-center":      "team_a",
+					}))
+					Expect(mClz["description"]).To(Equal(fmt.Sprintf("%s of %s in %s", namePool2, name, mClz["zone"])))
+				}
+			})
+
+			Context("subnet", func() {
+				BeforeEach(func() {
+					w.Spec.InfrastructureProviderStatus = &runtime.RawExtension{
+						Raw: encode(&api.InfrastructureStatus{
+							ServiceAccountEmail: serviceAccountEmail,
+							Networks: api.NetworkStatus{
+								Subnets: []api.Subnet{
+									{
+										Name:    subnetName,
+										Purpose: api.PurposeNodes,
+									},
+									{
+										Name:    "subnet-nodes-2",
+										Purpose: api.PurposeNodes,
+									},
+								},
+							},
+						}),
+					}
+				})
+
+				It("should attach the VMs to the configured subnet", func() {
+					w.Spec.Pools[1].ProviderConfig = &runtime.RawExtension{
+						Raw: encode(&api.WorkerConfig{
+							SubnetName: "subnet-nodes-2",
+						}),
+					}
+
+					wd, err := NewWorkerDelegate(c, scheme, chartApplier, "", w, cluster, config.Worker{}, nil)
+					Expect(err).NotTo(HaveOccurred())
+					expectedUserDataSecretRefRead()
+					_, err = wd.GenerateMachineDeployments(ctx)
+					Expect(err).NotTo(HaveOccurred())
+
+					workerDelegate := wd.(*WorkerDelegate)
+					for _, mClz := range workerDelegate.GetMachineClasses() {
+						expectedSubnet := subnetName
+						if strings.Contains(mClz["name"].(string), namePool2) {
+							expectedSubnet = "subnet-nodes-2"
+						}
+						Expect(mClz["networkInterfaces"]).To(ConsistOf(HaveKeyWithValue("subnetwork", expectedSubnet)))
+					}
+				})
+
+				It("should fail if the configured subnet is not part of the infrastructure status", func() {
+					w.Spec.Pools[1].ProviderConfig = &runtime.RawExtension{
+						Raw: encode(&api.WorkerConfig{
+							SubnetName: "subnet-unknown",
+						}),
+					}
+
+					wd, err := NewWorkerDelegate(c, scheme, chartApplier, "", w, cluster, config.Worker{}, nil)
+					Expect(err).NotTo(HaveOccurred())
+					expectedUserDataSecretRefRead()
+					_, err = wd.GenerateMachineDeployments(ctx)
+					Expect(err).To(MatchError(ContainSubstring(`cannot find subnet with name "subnet-unknown"`)))
+				})
+			})
+
+			Context("external IP", func() {
+				BeforeEach(func() {
+					w.Spec.Pools[1].ProviderConfig = &runtime.RawExtension{
+						Raw: encode(&api.WorkerConfig{
+							EnableExternalIP: ptr.To(true),
+						}),
+					}
+				})
+
+				It("should assign external IPs to the VMs of the pool", func() {
+					wd, err := NewWorkerDelegate(c, scheme, chartApplier, "", w, cluster, config.Worker{}, nil)
+					Expect(err).NotTo(HaveOccurred())
+					expectedUserDataSecretRefRead()
+					_, err = wd.GenerateMachineDeployments(ctx)
+					Expect(err).NotTo(HaveOccurred())
+
+					workerDelegate := wd.(*WorkerDelegate)
+					for _, mClz := range workerDelegate.GetMachineClasses() {
+						disableExternalIP := !strings.Contains(mClz["name"].(string), namePool2)
+						Expect(mClz["networkInterfaces"]).To(ConsistOf(HaveKeyWithValue("disableExternalIP", disableExternalIP)))
+					}
+				})
+
+				It("should fail if the Cloud NAT uses user-provided IPs", func() {
+					w.Spec.InfrastructureProviderStatus = &runtime.RawExtension{
+						Raw: encode(&api.InfrastructureStatus{
+							ServiceAccountEmail: serviceAccountEmail,
+							Networks: api.NetworkStatus{
+								Subnets: []api.Subnet{
+									{
+										Name:    subnetName,
+										Purpose: api.PurposeNodes,
+									},
+								},
+								NatIPs: []api.NatIP{{IP: "1.2.3.4"}},
+							},
+						}),
+					}
+
+					wd, err := NewWorkerDelegate(c, scheme, chartApplier, "", w, cluster, config.Worker{}, nil)
+					Expect(err).NotTo(HaveOccurred())
+					expectedUserDataSecretRefRead()
+					_, err = wd.GenerateMachineDeployments(ctx)
+					Expect(err).To(MatchError(ContainSubstring("must not enable external IPs")))
+				})
+			})
+
+			It("should terminate VMs with GPUs on host maintenance even without a node template", func() {
+				w.Spec.Pools[0].NodeTemplate = nil
+				w.Spec.Pools[0].ProviderConfig = &runtime.RawExtension{
+					Raw: encode(&api.WorkerConfig{
+						GPU: &api.GPU{
+							AcceleratorType: acceleratorTypeName,
+							Count:           acceleratorCount,
+						},
+						OnHostMaintenance: api.OnHostMaintenanceMigrate,
+					}),
+				}
+
+				wd, err := NewWorkerDelegate(c, scheme, chartApplier, "", w, cluster, config.Worker{}, nil)
+				Expect(err).NotTo(HaveOccurred())
+				expectedUserDataSecretRefRead()
+				_, err = wd.GenerateMachineDeployments(ctx)
+				Expect(err).NotTo(HaveOccurred())
+
+				workerDelegate := wd.(*WorkerDelegate)
+				for _, mClz := range workerDelegate.GetMachineClasses() {
+					if strings.Contains(mClz["name"].(string), namePool1) {
+						Expect(mClz["scheduling"]).To(Equal(map[string]interface{}{"automaticRestart": true, "onHostMaintenance": "TERMINATE", "preemptible": false}))
+					}
+				}
+			})
+
+			It("should attach the infrastructure service account with the configured default scopes", func() {
+				w.Spec.Pools[0].NodeAgentSecretName = ptr.To("node-agent")
+
+				wd, err := NewWorkerDelegate(c, scheme, chartApplier, "", w, cluster, config.Worker{}, nil)
+				Expect(err).NotTo(HaveOccurred())
+				expectedUserDataSecretRefRead()
+				defaultResult, err := wd.GenerateMachineDeployments(ctx)
+				Expect(err).NotTo(HaveOccurred())
+
+				wd, err = NewWorkerDelegate(c, scheme, chartApplier, "", w, cluster, config.Worker{DefaultServiceAccountScopes: []string{}}, nil)
+				Expect(err).NotTo(HaveOccurred())
+				expectedUserDataSecretRefRead()
+				result, err := wd.GenerateMachineDeployments(ctx)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(result[0].ClassName).NotTo(Equal(defaultResult[0].ClassName))
+
+				workerDelegate := wd.(*WorkerDelegate)
+				for _, mClz := range workerDelegate.GetMachineClasses() {
+					if strings.Contains(mClz["name"].(string), namePool1) {
+						Expect(mClz["serviceAccounts"]).To(Equal([]map[string]interface{}{
+							{"email": serviceAccountEmail, "scopes": []string{}},
+						}))
+					}
+				}
+			})
+
+			It("should use the resolved images of image families and record them in the worker status", func() {
+				imageFamily := "projects/image-project/global/images/family/my-os"
+				resolvedImage := "projects/image-project/global/images/my-os-v20261001"
+
+				clusterWithImageFamilies := *cluster
+				clusterWithImageFamilies.CloudProfile = cluster.CloudProfile.DeepCopy()
+				clusterWithImageFamilies.CloudProfile.Spec.ProviderConfig = &runtime.RawExtension{
+					Raw: encode(&apiv1alpha1.CloudProfileConfig{
+						TypeMeta: metav1.TypeMeta{
+							APIVersion: apiv1alpha1.SchemeGroupVersion.String(),
+							Kind:       "CloudProfileConfig",
+						},
+						MachineImages: []apiv1alpha1.MachineImages{
+							{
+								Name: machineImageName,
+								Versions: []apiv1alpha1.MachineImageVersion{
+									{Version: machineImageVersion, Image: imageFamily, Architecture: ptr.To(archAMD)},
+									{Version: machineImageVersion, Image: imageFamily, Architecture: ptr.To(archARM)},
+								},
+							},
+						},
+					}),
+				}
+
+				wd, err := NewWorkerDelegate(c, scheme, chartApplier, "", w, &clusterWithImageFamilies, config.Worker{}, nil)
+				Expect(err).NotTo(HaveOccurred())
+				gcpClientFactory := mockgcpclient.NewMockFactory(ctrl)
+				computeClient := mockgcpclient.NewMockComputeClient(ctrl)
+				wd.(*WorkerDelegate).SetGCPClientFactory(gcpClientFactory)
+
+				// the image family is resolved only once per reconciliation
+				gcpClientFactory.EXPECT().Compute(ctx, c, w.Spec.SecretRef).Return(computeClient, nil)
+				computeClient.EXPECT().ResolveLatestImage(ctx, "image-project", "my-os").Return(&compute.Image{Name: "my-os-v20261001"}, nil)
+
+				expectedUserDataSecretRefRead()
+				_, err = wd.GenerateMachineDeployments(ctx)
+				Expect(err).NotTo(HaveOccurred())
+
+				for _, mClz := range wd.(*WorkerDelegate).GetMachineClasses() {
+					Expect(mClz["disks"].([]map[string]interface{})[0]["image"]).To(Equal(resolvedImage))
+				}
+
+				workerWithExpectedImages := w.DeepCopy()
+				workerWithExpectedImages.Status.ProviderStatus = &runtime.RawExtension{
+					Object: &apiv1alpha1.WorkerStatus{
+						TypeMeta: metav1.TypeMeta{
+							APIVersion: apiv1alpha1.SchemeGroupVersion.String(),
+							Kind:       "WorkerStatus",
+						},
+						MachineImages: []apiv1alpha1.MachineImage{
+							{Name: machineImageName, Version: machineImageVersion, Image: resolvedImage, Architecture: ptr.To(archAMD)},
+							{Name: machineImageName, Version: machineImageVersion, Image: resolvedImage, Architecture: ptr.To(archARM)},
+						},
+					},
+				}
+				c.EXPECT().Status().Return(statusWriter)
+				statusWriter.EXPECT().Patch(ctx, workerWithExpectedImages, gomock.Any()).Return(nil)
+				Expect(wd.UpdateMachineImagesStatus(ctx)).To(Succeed())
+			})
+
+			It("should use the fully qualified images of image projects and record them in the worker status", func() {
+				qualifiedImage := "projects/image-project/global/images/my-os-v20261001"
+
+				clusterWithImageProject := *cluster
+				clusterWithImageProject.CloudProfile = cluster.CloudProfile.DeepCopy()
+				clusterWithImageProject.CloudProfile.Spec.ProviderConfig = &runtime.RawExtension{
+					Raw: encode(&apiv1alpha1.CloudProfileConfig{
+						TypeMeta: metav1.TypeMeta{
+							APIVersion: apiv1alpha1.SchemeGroupVersion.String(),
+							Kind:       "CloudProfileConfig",
+						},
+						MachineImages: []apiv1alpha1.MachineImages{
+							{
+								Name: machineImageName,
+								Versions: []apiv1alpha1.MachineImageVersion{
+									{Version: machineImageVersion, Image: "my-os-v20261001", ImageProjectID: ptr.To("image-project"), Architecture: ptr.To(archAMD)},
+									{Version: machineImageVersion, Image: qualifiedImage, Architecture: ptr.To(archARM)},
+								},
+							},
+						},
+					}),
+				}
+
+				wd, err := NewWorkerDelegate(c, scheme, chartApplier, "", w, &clusterWithImageProject, config.Worker{}, nil)
+				Expect(err).NotTo(HaveOccurred())
+				expectedUserDataSecretRefRead()
+				_, err = wd.GenerateMachineDeployments(ctx)
+				Expect(err).NotTo(HaveOccurred())
+
+				for _, mClz := range wd.(*WorkerDelegate).GetMachineClasses() {
+					Expect(mClz["disks"].([]map[string]interface{})[0]["image"]).To(Equal(qualifiedImage))
+				}
+
+				workerWithExpectedImages := w.DeepCopy()
+				workerWithExpectedImages.Status.ProviderStatus = &runtime.RawExtension{
+					Object: &apiv1alpha1.WorkerStatus{
+						TypeMeta: metav1.TypeMeta{
+							APIVersion: apiv1alpha1.SchemeGroupVersion.String(),
+							Kind:       "WorkerStatus",
+						},
+						MachineImages: []apiv1alpha1.MachineImage{
+							{Name: machineImageName, Version: machineImageVersion, Image: qualifiedImage, Architecture: ptr.To(archAMD)},
+							{Name: machineImageName, Version: machineImageVersion, Image: qualifiedImage, Architecture: ptr.To(archARM)},
+						},
+					},
+				}
+				c.EXPECT().Status().Return(statusWriter)
+				statusWriter.EXPECT().Patch(ctx, workerWithExpectedImages, gomock.Any()).Return(nil)
+				Expect(wd.UpdateMachineImagesStatus(ctx)).To(Succeed())
+			})
+
+			It("should set expected cluster-autoscaler annotations on the machine deployment", func() {
+				w.Spec.Pools[0].ClusterAutoscaler = &extensionsv1alpha1.ClusterAutoscalerOptions{
+					MaxNodeProvisionTime:             ptr.To(metav1.Duration{Duration: time.Minute}),
+					ScaleDownGpuUtilizationThreshold: ptr.To("0.4"),
+					ScaleDownUnneededTime:            ptr.To(metav1.Duration{Duration: 2 * time.Minute}),
+					ScaleDownUnreadyTime:             ptr.To(metav1.Duration{Duration: 3 * time.Minute}),
+					ScaleDownUtilizationThreshold:    ptr.To("0.5"),
+				}
+				w.Spec.Pools[1].ClusterAutoscaler = nil
+				workerDelegate, _ = NewWorkerDelegate(c, scheme, chartApplier, "", w, cluster, config.Worker{}, nil)
+
+				expectedUserDataSecretRefRead()
+
+				result, err := workerDelegate.GenerateMachineDeployments(ctx)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(result).NotTo(BeNil())
+
+				Expect(result[0].ClusterAutoscalerAnnotations).NotTo(BeNil())
+				Expect(result[1].ClusterAutoscalerAnnotations).NotTo(BeNil())
+				Expect(result[2].ClusterAutoscalerAnnotations).To(BeNil())
+				Expect(result[3].ClusterAutoscalerAnnotations).To(BeNil())
+
+				Expect(result[0].ClusterAutoscalerAnnotations[extensionsv1alpha1.MaxNodeProvisionTimeAnnotation]).To(Equal("1m0s"))
+				Expect(result[0].ClusterAutoscalerAnnotations[extensionsv1alpha1.ScaleDownGpuUtilizationThresholdAnnotation]).To(Equal("0.4"))
+				Expect(result[0].ClusterAutoscalerAnnotations[extensionsv1alpha1.ScaleDownUnneededTimeAnnotation]).To(Equal("2m0s"))
+				Expect(result[0].ClusterAutoscalerAnnotations[extensionsv1alpha1.ScaleDownUnreadyTimeAnnotation]).To(Equal("3m0s"))
+				Expect(result[0].ClusterAutoscalerAnnotations[extensionsv1alpha1.ScaleDownUtilizationThresholdAnnotation]).To(Equal("0.5"))
+
+				Expect(result[1].ClusterAutoscalerAnnotations[extensionsv1alpha1.MaxNodeProvisionTimeAnnotation]).To(Equal("1m0s"))
+				Expect(result[1].ClusterAutoscalerAnnotations[extensionsv1alpha1.ScaleDownGpuUtilizationThresholdAnnotation]).To(Equal("0.4"))
+				Expect(result[1].ClusterAutoscalerAnnotations[extensionsv1alpha1.ScaleDownUnneededTimeAnnotation]).To(Equal("2m0s"))
+				Expect(result[1].ClusterAutoscalerAnnotations[extensionsv1alpha1.ScaleDownUnreadyTimeAnnotation]).To(Equal("3m0s"))
+				Expect(result[1].ClusterAutoscalerAnnotations[extensionsv1alpha1.ScaleDownUtilizationThresholdAnnotation]).To(Equal("0.5"))
+			})
+		})
+
+		Describe("#PreDeleteHook", func() {
+			var (
+				namespace        = "shoot--foobar--gcp"
+				w                *extensionsv1alpha1.Worker
+				gcpClientFactory *mockgcpclient.MockFactory
+				computeClient    *mockgcpclient.MockComputeClient
+			)
+
+			BeforeEach(func() {
+				w = &extensionsv1alpha1.Worker{
+					ObjectMeta: metav1.ObjectMeta{Namespace: namespace},
+					Spec: extensionsv1alpha1.WorkerSpec{
+						SecretRef: corev1.SecretReference{Namespace: namespace, Name: "cloudprovider"},
+						Pools: []extensionsv1alpha1.WorkerPool{
+							{Name: "pool1", Zones: []string{"zone1", "zone2"}},
+							{Name: "pool2", Zones: []string{"zone2"}},
+						},
+					},
+				}
+
+				workerDelegate, _ = NewWorkerDelegate(c, scheme, nil, "", w, nil, config.Worker{}, nil)
+				gcpClientFactory = mockgcpclient.NewMockFactory(ctrl)
+				computeClient = mockgcpclient.NewMockComputeClient(ctrl)
+				workerDelegate.(*WorkerDelegate).SetGCPClientFactory(gcpClientFactory)
+			})
+
+			It("should lift the deletion protection of the VMs of the shoot", func() {
+				instances := map[string][]*compute.Instance{
+					"zone1": {
+						{Name: "vm1", Tags: &compute.Tags{Items: []string{namespace, "kubernetes-io-role-node"}}},
+						{Name: "vm2", Tags: &compute.Tags{Items: []string{"shoot--foobar--other"}}},
+					},
+					"zone2": {
+						{Name: "vm3", Tags: &compute.Tags{Items: []string{namespace}}},
+						{Name: "vm4"},
+					},
+				}
+
+				gcpClientFactory.EXPECT().Compute(ctx, c, w.Spec.SecretRef).Return(computeClient, nil)
+				for _, zone := range []string{"zone1", "zone2"} {
+					computeClient.EXPECT().ListInstances(ctx, zone, gomock.Any()).DoAndReturn(func(_ context.Context, zone string, opts gcpclient.InstanceListOpts) ([]*compute.Instance, error) {
+						Expect(opts.Filter).To(Equal("deletionProtection = true"))
+						var res []*compute.Instance
+						for _, instance := range instances[zone] {
+							if opts.ClientFilter(instance) {
+								res = append(res, instance)
+							}
+						}
+						return res, nil
+					})
+				}
+				computeClient.EXPECT().SetInstanceDeletionProtection(ctx, "zone1", "vm1", false)
+				computeClient.EXPECT().SetInstanceDeletionProtection(ctx, "zone2", "vm3", false)
+
+				Expect(workerDelegate.PreDeleteHook(ctx)).To(Succeed())
+			})
+
+			It("should do nothing if the worker has no pools", func() {
+				w.Spec.Pools = nil
+
+				Expect(workerDelegate.PreDeleteHook(ctx)).To(Succeed())
+			})
+		})
+	})
+
+	DescribeTable("#InitializeCapacity",
+		func(overrides corev1.ResourceList, gpuCount, localSSDCount int32, expected corev1.ResourceList) {
+			capacity := corev1.ResourceList{
+				corev1.ResourceCPU:              resource.MustParse("8"),
+				corev1.ResourceEphemeralStorage: resource.MustParse("50Gi"),
+			}
+
+			result := InitializeCapacity(capacity, overrides, gpuCount, localSSDCount)
+			Expect(result).To(HaveLen(len(expected)))
+			for name, quantity := range expected {
+				actual, ok := result[name]
+				Expect(ok).To(BeTrue(), "missing quantity of %s", name)
+				Expect(actual.Cmp(quantity)).To(BeZero(), "unexpected quantity of %s: %s", name, actual.String())
+			}
+			Expect(capacity.StorageEphemeral().String()).To(Equal("50Gi"))
+		},
+		Entry("without GPUs and local SSDs", nil, int32(0), int32(0), corev1.ResourceList{
+			corev1.ResourceCPU:              resource.MustParse("8"),
+			corev1.ResourceEphemeralStorage: resource.MustParse("50Gi"),
+		}),
+		Entry("with GPUs", nil, int32(2), int32(0), corev1.ResourceList{
+			corev1.ResourceCPU:              resource.MustParse("8"),
+			corev1.ResourceEphemeralStorage: resource.MustParse("50Gi"),
+			ResourceGPU:                     resource.MustParse("2"),
+		}),
+		Entry("with local SSDs", nil, int32(0), int32(2), corev1.ResourceList{
+			corev1.ResourceCPU:              resource.MustParse("8"),
+			corev1.ResourceEphemeralStorage: resource.MustParse("800Gi"),
+		}),
+		Entry("with an ephemeral storage override", corev1.ResourceList{corev1.ResourceEphemeralStorage: resource.MustParse("100Gi")}, int32(1), int32(2), corev1.ResourceList{
+			corev1.ResourceCPU:              resource.MustParse("8"),
+			corev1.ResourceEphemeralStorage: resource.MustParse("100Gi"),
+			ResourceGPU:                     resource.MustParse("1"),
+		}),
+	)
 })
 
 func encode(obj runtime.Object) []byte {
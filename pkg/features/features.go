@@ -13,6 +13,10 @@ const (
 	// DisableGardenerServiceAccountCreation controls whether the gcp provider will create a default service account for VMs managed by MCM.
 	// beta: v1.29.0
 	DisableGardenerServiceAccountCreation featuregate.Feature = "DisableGardenerServiceAccountCreation"
+	// PruneOrphanedFirewallRules controls whether the infrastructure controller deletes the firewall rules of the shoot
+	// network which follow the naming scheme of Gardener but are not desired anymore, e.g. rules with legacy names.
+	// alpha: v1.43.0
+	PruneOrphanedFirewallRules featuregate.Feature = "PruneOrphanedFirewallRules"
 )
 
 // ExtensionFeatureGate is the feature gate for the extension controllers.
@@ -22,5 +26,6 @@ var ExtensionFeatureGate = featuregate.NewFeatureGate()
 func RegisterExtensionFeatureGate() {
 	runtime.Must(ExtensionFeatureGate.Add(map[featuregate.Feature]featuregate.FeatureSpec{
 		DisableGardenerServiceAccountCreation: {Default: true, PreRelease: featuregate.Beta},
+		PruneOrphanedFirewallRules:            {Default: false, PreRelease: featuregate.Alpha},
 	}))
 }
@@ -9,8 +9,8 @@ import (
 	"fmt"
 	"net/http"
 	"strings"
+	"time"
 
-	"golang.org/x/oauth2"
 	"golang.org/x/oauth2/google"
 	"google.golang.org/api/compute/v1"
 	"google.golang.org/api/googleapi"
@@ -25,6 +25,12 @@ type ComputeClient interface {
 	GetExternalAddresses(ctx context.Context, region string) (map[string][]string, error)
 	// GetAddress returns a Address.
 	GetAddress(ctx context.Context, region, name string) (*compute.Address, error)
+	// GetGlobalAddress returns the global Address with the given name or nil if it does not exist.
+	GetGlobalAddress(ctx context.Context, name string) (*compute.Address, error)
+	// InsertGlobalAddress creates a global Address with the given specification.
+	InsertGlobalAddress(ctx context.Context, address *compute.Address) (*compute.Address, error)
+	// DeleteGlobalAddress deletes the global Address with the given name. Return no error if the address is not found.
+	DeleteGlobalAddress(ctx context.Context, name string) error
 
 	// GetInstance returns the Instance specified by zone and name.
 	GetInstance(ctx context.Context, zone, instanceName string) (*compute.Instance, error)
@@ -32,6 +38,10 @@ type ComputeClient interface {
 	InsertInstance(ctx context.Context, zone string, instance *compute.Instance) (*compute.Instance, error)
 	// DeleteInstance deletes the Instance. Returns no error if the Instance is not found.
 	DeleteInstance(ctx context.Context, zone, instanceName string) error
+	// ListInstances lists all Instances of the zone.
+	ListInstances(ctx context.Context, zone string, opts InstanceListOpts) ([]*compute.Instance, error)
+	// SetInstanceDeletionProtection enables or disables the deletion protection of the Instance.
+	SetInstanceDeletionProtection(ctx context.Context, zone, instanceName string, deletionProtection bool) error
 
 	// GetDisk returns the Disk specified by zone and name.
 	GetDisk(ctx context.Context, zone, instanceName string) (*compute.Disk, error)
@@ -59,6 +69,8 @@ type ComputeClient interface {
 	DeleteSubnet(ctx context.Context, region, id string) error
 	// ExpandSubnet expands the subnet to the target CIDR.
 	ExpandSubnet(ctx context.Context, region, id, cidr string) (*compute.Subnetwork, error)
+	// ListSubnets lists all Subnetworks of the region.
+	ListSubnets(ctx context.Context, region string, opts SubnetListOpts) ([]*compute.Subnetwork, error)
 
 	// InsertRouter creates a router with the given specification.
 	InsertRouter(ctx context.Context, region string, router *compute.Router) (*compute.Router, error)
@@ -68,6 +80,8 @@ type ComputeClient interface {
 	PatchRouter(ctx context.Context, region, id string, router *compute.Router) (*compute.Router, error)
 	// DeleteRouter deletes the router specified by id.
 	DeleteRouter(ctx context.Context, region, id string) error
+	// ListRouters lists all routers of the region.
+	ListRouters(ctx context.Context, region string, opts RouterListOpts) ([]*compute.Router, error)
 	// ListRoutes lists all routes.
 	ListRoutes(ctx context.Context, opts RouteListOpts) ([]*compute.Route, error)
 	// DeleteRoute deletes the specified route.
@@ -86,14 +100,56 @@ type ComputeClient interface {
 
 	// ListImages lists all Images with specified name.
 	ListImages(ctx context.Context, imageName, orderBy, fields string) (*compute.ImageList, error)
+	// ResolveLatestImage returns the newest image of the image family in the given project which is neither deprecated
+	// nor obsolete.
+	ResolveLatestImage(ctx context.Context, project, family string) (*compute.Image, error)
 
 	// GetRegion returns the Region specified.
 	GetRegion(ctx context.Context, region string) (*compute.Region, error)
+	// ListMachineTypes lists all MachineTypes offered in the zone.
+	ListMachineTypes(ctx context.Context, zone string) ([]*compute.MachineType, error)
+	// ListAcceleratorTypes lists all AcceleratorTypes offered in the zone.
+	ListAcceleratorTypes(ctx context.Context, zone string) ([]*compute.AcceleratorType, error)
+	// ListDiskTypes lists all DiskTypes offered in the zone.
+	ListDiskTypes(ctx context.Context, zone string) ([]*compute.DiskType, error)
+}
+
+// ComputeOptions configures the retries, the rate limiting and the operation waits of the compute clients.
+type ComputeOptions struct {
+	// Retry configures the retries of calls failing with transient errors.
+	Retry RetryOptions
+	// RateLimit configures the pacing of the calls.
+	RateLimit RateLimitOptions
+	// OperationWait configures how long and how often operations are polled.
+	OperationWait OperationWaitOptions
+}
+
+// DefaultComputeOptions returns the ComputeOptions which are used if nothing else is configured.
+func DefaultComputeOptions() ComputeOptions {
+	return ComputeOptions{
+		Retry: RetryOptions{
+			MaxAttempts:     5,
+			InitialInterval: time.Second,
+			MaxInterval:     30 * time.Second,
+		},
+		RateLimit: RateLimitOptions{
+			QPS:   10,
+			Burst: 30,
+		},
+		OperationWait: OperationWaitOptions{
+			InitialInterval: time.Second,
+			MaxInterval:     pollInterval,
+			Timeout:         15 * time.Minute,
+		},
+	}
 }
 
 type computeClient struct {
-	service   *compute.Service
-	projectID string
+	service       *compute.Service
+	projectID     string
+	retry         RetryOptions
+	limiter       *rateLimiter
+	operationWait OperationWaitOptions
 }
 
 // NewComputeClient returns a client for Compute API. The client follows the following conventions:
@@ -101,26 +157,43 @@ type computeClient struct {
 // the completion of the respective operations before returning.
 // Delete operations will ignore errors when the respective resource can not be found, meaning that the Delete operations will never return HTTP 404 errors.
 // Update operations will ignore errors when the update operation is a no-op, meaning that Update operations will ignore HTTP 304 errors.
-func NewComputeClient(ctx context.Context, serviceAccount *gcp.ServiceAccount) (ComputeClient, error) {
+// Mutating and list calls are paced by the rate limiter of the given options. The compute clients of a Factory share
+// one rate limiter, whereas a client returned by this function uses its own.
+// Mutating calls and the polling of their operations are retried with the retry options if they fail with transient
+// errors, see IsTransientError.
+// Operations are waited for with the operation wait options.
+// The public endpoint of the Compute API is used if the given endpoint is empty.
+func NewComputeClient(ctx context.Context, serviceAccount *gcp.ServiceAccount, endpoint string, opts ComputeOptions) (ComputeClient, error) {
+	return newComputeClient(ctx, serviceAccount, endpoint, opts, newRateLimiter(opts.RateLimit))
+}
+
+func newComputeClient(ctx context.Context, serviceAccount *gcp.ServiceAccount, endpoint string, opts ComputeOptions, limiter *rateLimiter) (ComputeClient, error) {
 	jwt, err := google.JWTConfigFromJSON(serviceAccount.Raw, compute.ComputeScope)
 	if err != nil {
 		return nil, err
 	}
 
-	httpClient := oauth2.NewClient(ctx, jwt.TokenSource(ctx))
-	service, err := compute.NewService(ctx, option.WithHTTPClient(httpClient))
+	httpClient := newInstrumentedHTTPClient(ctx, apiCompute, jwt.TokenSource(ctx))
+	service, err := compute.NewService(ctx, withEndpoint(endpoint, option.WithHTTPClient(httpClient))...)
 	if err != nil {
 		return nil, err
 	}
 
 	return &computeClient{
-		service:   service,
-		projectID: serviceAccount.ProjectID,
+		service:       service,
+		projectID:     serviceAccount.ProjectID,
+		retry:         opts.Retry,
+		limiter:       limiter,
+		operationWait: opts.OperationWait,
 	}, nil
 }
 
 // GetExternalAddresses returns a list of all external IP addresses mapped to the names of their users.
 func (c *computeClient) GetExternalAddresses(ctx context.Context, region string) (map[string][]string, error) {
+	if err := c.limiter.wait(ctx, c.projectID, region); err != nil {
+		return nil, err
+	}
+
 	addresses := make(map[string][]string)
 	if err := c.service.Addresses.List(c.projectID, region).Pages(ctx, func(resp *compute.AddressList) error {
 		for _, address := range resp.Items {
@@ -149,7 +222,7 @@ func (c *computeClient) GetInstance(ctx context.Context, zone, instanceName stri
 
 // InsertInstance creates a new Instance with the given specification.
 func (c *computeClient) InsertInstance(ctx context.Context, zone string, instance *compute.Instance) (*compute.Instance, error) {
-	op, err := c.service.Instances.Insert(c.projectID, zone, instance).Context(ctx).Do()
+	op, err := c.doOperation(ctx, zone, c.service.Instances.Insert(c.projectID, zone, instance).Context(ctx).Do)
 	if err != nil {
 		return nil, err
 	}
@@ -162,7 +235,7 @@ func (c *computeClient) InsertInstance(ctx context.Context, zone string, instanc
 
 // DeleteInstance deletes the Instance. Returns no error if the Instance is not found.
 func (c *computeClient) DeleteInstance(ctx context.Context, zone, instanceName string) error {
-	op, err := c.service.Instances.Delete(c.projectID, zone, instanceName).Context(ctx).Do()
+	op, err := c.doOperation(ctx, zone, c.service.Instances.Delete(c.projectID, zone, instanceName).Context(ctx).Do)
 	if IgnoreNotFoundError(err) != nil {
 		return err
 	}
@@ -172,6 +245,53 @@ func (c *computeClient) DeleteInstance(ctx context.Context, zone, instanceName s
 	return c.wait(ctx, op)
 }
 
+// InstanceListOpts are options for the ListInstances function.
+type InstanceListOpts struct {
+	// Filter is server side filtering applied by the GCP API.
+	Filter string
+	// ClientFilter is client-side filtering applied after the list call.
+	ClientFilter func(i *compute.Instance) bool
+}
+
+// ListInstances lists all Instances of the zone.
+func (c *computeClient) ListInstances(ctx context.Context, zone string, opts InstanceListOpts) ([]*compute.Instance, error) {
+	var res []*compute.Instance
+
+	if err := c.limiter.wait(ctx, c.projectID, zone); err != nil {
+		return nil, err
+	}
+
+	call := c.service.Instances.List(c.projectID, zone).Context(ctx)
+	if len(opts.Filter) > 0 {
+		call = call.Filter(opts.Filter)
+	}
+	if err := call.Pages(ctx, func(list *compute.InstanceList) error {
+		for _, item := range list.Items {
+			if item == nil {
+				continue
+			}
+			if opts.ClientFilter != nil && !opts.ClientFilter(item) {
+				continue
+			}
+			res = append(res, item)
+		}
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+// SetInstanceDeletionProtection enables or disables the deletion protection of the Instance.
+func (c *computeClient) SetInstanceDeletionProtection(ctx context.Context, zone, instanceName string, deletionProtection bool) error {
+	op, err := c.doOperation(ctx, zone, c.service.Instances.SetDeletionProtection(c.projectID, zone, instanceName).DeletionProtection(deletionProtection).Context(ctx).Do)
+	if err != nil {
+		return err
+	}
+	return c.wait(ctx, op)
+}
+
 // GetDisk returns the Disk specified by zone and name.
 func (c *computeClient) GetDisk(ctx context.Context, zone, diskName string) (*compute.Disk, error) {
 	return c.service.Disks.Get(c.projectID, zone, diskName).Context(ctx).Do()
@@ -179,7 +299,7 @@ func (c *computeClient) GetDisk(ctx context.Context, zone, diskName string) (*co
 
 // InsertDisk creates a new Disk with the given specification.
 func (c *computeClient) InsertDisk(ctx context.Context, zone string, disk *compute.Disk) (*compute.Disk, error) {
-	op, err := c.service.Disks.Insert(c.projectID, zone, disk).Context(ctx).Do()
+	op, err := c.doOperation(ctx, zone, c.service.Disks.Insert(c.projectID, zone, disk).Context(ctx).Do)
 	if err != nil {
 		return nil, err
 	}
@@ -192,7 +312,7 @@ func (c *computeClient) InsertDisk(ctx context.Context, zone string, disk *compu
 
 // DeleteDisk deletes the Disk. Returns no error if the Disk is not found.
 func (c *computeClient) DeleteDisk(ctx context.Context, zone, diskName string) error {
-	op, err := c.service.Disks.Delete(c.projectID, zone, diskName).Context(ctx).Do()
+	op, err := c.doOperation(ctx, zone, c.service.Disks.Delete(c.projectID, zone, diskName).Context(ctx).Do)
 	if IgnoreNotFoundError(err) != nil {
 		return err
 	}
@@ -204,7 +324,7 @@ func (c *computeClient) DeleteDisk(ctx context.Context, zone, diskName string) e
 
 // InsertNetwork creates a Network with the given specification.
 func (c *computeClient) InsertNetwork(ctx context.Context, n *compute.Network) (*compute.Network, error) {
-	op, err := c.service.Networks.Insert(c.projectID, n).Context(ctx).Do()
+	op, err := c.doOperation(ctx, globalLocation, c.service.Networks.Insert(c.projectID, n).Context(ctx).Do)
 	if err != nil {
 		return nil, err
 	}
@@ -226,7 +346,7 @@ func (c *computeClient) GetNetwork(ctx context.Context, id string) (*compute.Net
 
 // DeleteNetwork deletes the Network. Return no error if the network is not found
 func (c *computeClient) DeleteNetwork(ctx context.Context, id string) error {
-	op, err := c.service.Networks.Delete(c.projectID, id).Context(ctx).Do()
+	op, err := c.doOperation(ctx, globalLocation, c.service.Networks.Delete(c.projectID, id).Context(ctx).Do)
 	if IgnoreNotFoundError(err) != nil {
 		return err
 	}
@@ -238,7 +358,7 @@ func (c *computeClient) DeleteNetwork(ctx context.Context, id string) error {
 
 // PatchNetwork patches the network identified by id with the given specification.
 func (c *computeClient) PatchNetwork(ctx context.Context, id string, n *compute.Network) (*compute.Network, error) {
-	op, err := c.service.Networks.Patch(c.projectID, id, n).Context(ctx).Do()
+	op, err := c.doOperation(ctx, globalLocation, c.service.Networks.Patch(c.projectID, id, n).Context(ctx).Do)
 	if IsErrorCode(err, http.StatusNotModified) {
 		return n, nil
 	} else if err != nil {
@@ -255,7 +375,7 @@ func (c *computeClient) PatchNetwork(ctx context.Context, id string, n *compute.
 
 // InsertSubnet creates a Subnetwork with the given specification.
 func (c *computeClient) InsertSubnet(ctx context.Context, region string, subnet *compute.Subnetwork) (*compute.Subnetwork, error) {
-	op, err := c.service.Subnetworks.Insert(c.projectID, region, subnet).Context(ctx).Do()
+	op, err := c.doOperation(ctx, region, c.service.Subnetworks.Insert(c.projectID, region, subnet).Context(ctx).Do)
 	if err != nil {
 		return nil, err
 	}
@@ -278,7 +398,7 @@ func (c *computeClient) GetSubnet(ctx context.Context, region, id string) (*comp
 
 // PatchSubnet updates the Subnetwork specified by id with the given specification.
 func (c *computeClient) PatchSubnet(ctx context.Context, region, id string, subnet *compute.Subnetwork) (*compute.Subnetwork, error) {
-	op, err := c.service.Subnetworks.Patch(c.projectID, region, id, subnet).Context(ctx).Do()
+	op, err := c.doOperation(ctx, region, c.service.Subnetworks.Patch(c.projectID, region, id, subnet).Context(ctx).Do)
 	if IgnoreErrorCodes(err, http.StatusNotModified) != nil {
 		return nil, err
 	}
@@ -295,7 +415,7 @@ func (c *computeClient) PatchSubnet(ctx context.Context, region, id string, subn
 
 // DeleteSubnet deletes the Subnetwork specified by id.
 func (c *computeClient) DeleteSubnet(ctx context.Context, region, id string) error {
-	op, err := c.service.Subnetworks.Delete(c.projectID, region, id).Context(ctx).Do()
+	op, err := c.doOperation(ctx, region, c.service.Subnetworks.Delete(c.projectID, region, id).Context(ctx).Do)
 	if IgnoreNotFoundError(err) != nil {
 		return err
 	}
@@ -305,11 +425,49 @@ func (c *computeClient) DeleteSubnet(ctx context.Context, region, id string) err
 	return c.wait(ctx, op)
 }
 
+// SubnetListOpts are options for the ListSubnets function.
+type SubnetListOpts struct {
+	// Filter is server side filtering applied by the GCP API.
+	Filter string
+	// ClientFilter is client-side filtering applied after the list call.
+	ClientFilter func(s *compute.Subnetwork) bool
+}
+
+// ListSubnets lists all Subnetworks of the region.
+func (c *computeClient) ListSubnets(ctx context.Context, region string, opts SubnetListOpts) ([]*compute.Subnetwork, error) {
+	if err := c.limiter.wait(ctx, c.projectID, region); err != nil {
+		return nil, err
+	}
+
+	var res []*compute.Subnetwork
+
+	call := c.service.Subnetworks.List(c.projectID, region).Context(ctx)
+	if len(opts.Filter) > 0 {
+		call = call.Filter(opts.Filter)
+	}
+	if err := call.Pages(ctx, func(list *compute.SubnetworkList) error {
+		for _, item := range list.Items {
+			if item == nil {
+				continue
+			}
+			if opts.ClientFilter != nil && !opts.ClientFilter(item) {
+				continue
+			}
+			res = append(res, item)
+		}
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
 // ExpandSubnet expands the subnet to the target CIDR.
 func (c *computeClient) ExpandSubnet(ctx context.Context, region, id, cidr string) (*compute.Subnetwork, error) {
-	op, err := c.service.Subnetworks.ExpandIpCidrRange(c.projectID, region, id, &compute.SubnetworksExpandIpCidrRangeRequest{
+	op, err := c.doOperation(ctx, region, c.service.Subnetworks.ExpandIpCidrRange(c.projectID, region, id, &compute.SubnetworksExpandIpCidrRangeRequest{
 		IpCidrRange: cidr,
-	}).Context(ctx).Do()
+	}).Context(ctx).Do)
 	if err != nil {
 		return nil, err
 	}
@@ -324,7 +482,7 @@ func (c *computeClient) ExpandSubnet(ctx context.Context, region, id, cidr strin
 
 // InsertRouter creates a router with the given specification.
 func (c *computeClient) InsertRouter(ctx context.Context, region string, router *compute.Router) (*compute.Router, error) {
-	op, err := c.service.Routers.Insert(c.projectID, region, router).Context(ctx).Do()
+	op, err := c.doOperation(ctx, region, c.service.Routers.Insert(c.projectID, region, router).Context(ctx).Do)
 	if err != nil {
 		return nil, err
 	}
@@ -347,7 +505,7 @@ func (c *computeClient) GetRouter(ctx context.Context, region, id string) (*comp
 
 // PatchRouter updates the Router specified by id with the given specification.
 func (c *computeClient) PatchRouter(ctx context.Context, region, id string, router *compute.Router) (*compute.Router, error) {
-	op, err := c.service.Routers.Patch(c.projectID, region, id, router).Context(ctx).Do()
+	op, err := c.doOperation(ctx, region, c.service.Routers.Patch(c.projectID, region, id, router).Context(ctx).Do)
 	if IgnoreErrorCodes(err, http.StatusNotModified) != nil {
 		return nil, err
 	}
@@ -364,7 +522,7 @@ func (c *computeClient) PatchRouter(ctx context.Context, region, id string, rout
 
 // DeleteRouter deletes the router specified by id.
 func (c *computeClient) DeleteRouter(ctx context.Context, region, id string) error {
-	op, err := c.service.Routers.Delete(c.projectID, region, id).Context(ctx).Do()
+	op, err := c.doOperation(ctx, region, c.service.Routers.Delete(c.projectID, region, id).Context(ctx).Do)
 	if IgnoreNotFoundError(err) != nil {
 		return err
 	}
@@ -374,6 +532,44 @@ func (c *computeClient) DeleteRouter(ctx context.Context, region, id string) err
 	return c.wait(ctx, op)
 }
 
+// RouterListOpts are options for the ListRouters function.
+type RouterListOpts struct {
+	// Filter is server side filtering applied by the GCP API.
+	Filter string
+	// ClientFilter is client-side filtering applied after the list call.
+	ClientFilter func(r *compute.Router) bool
+}
+
+// ListRouters lists all routers of the region.
+func (c *computeClient) ListRouters(ctx context.Context, region string, opts RouterListOpts) ([]*compute.Router, error) {
+	var res []*compute.Router
+
+	if err := c.limiter.wait(ctx, c.projectID, region); err != nil {
+		return nil, err
+	}
+
+	call := c.service.Routers.List(c.projectID, region).Context(ctx)
+	if len(opts.Filter) > 0 {
+		call = call.Filter(opts.Filter)
+	}
+	if err := call.Pages(ctx, func(list *compute.RouterList) error {
+		for _, item := range list.Items {
+			if item == nil {
+				continue
+			}
+			if opts.ClientFilter != nil && !opts.ClientFilter(item) {
+				continue
+			}
+			res = append(res, item)
+		}
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
 // RouteListOpts are options for the ListRoutes function.
 type RouteListOpts struct {
 	// Filter is server side filtering applied by the GCP API.
@@ -386,6 +582,10 @@ type RouteListOpts struct {
 func (c *computeClient) ListRoutes(ctx context.Context, opts RouteListOpts) ([]*compute.Route, error) {
 	var res []*compute.Route
 
+	if err := c.limiter.wait(ctx, c.projectID, globalLocation); err != nil {
+		return nil, err
+	}
+
 	rtCall := c.service.Routes.List(c.projectID).Context(ctx)
 	if len(opts.Filter) > 0 {
 		rtCall = rtCall.Filter(opts.Filter)
@@ -419,9 +619,43 @@ func (c *computeClient) GetAddress(ctx context.Context, region, name string) (*c
 	return a, nil
 }
 
+// GetGlobalAddress returns the global Address with the given name or nil if it does not exist.
+func (c *computeClient) GetGlobalAddress(ctx context.Context, name string) (*compute.Address, error) {
+	a, err := c.service.GlobalAddresses.Get(c.projectID, name).Context(ctx).Do()
+	if err != nil {
+		return nil, IgnoreNotFoundError(err)
+	}
+	return a, nil
+}
+
+// InsertGlobalAddress creates a global Address with the given specification.
+func (c *computeClient) InsertGlobalAddress(ctx context.Context, address *compute.Address) (*compute.Address, error) {
+	op, err := c.doOperation(ctx, globalLocation, c.service.GlobalAddresses.Insert(c.projectID, address).Context(ctx).Do)
+	if err != nil {
+		return nil, err
+	}
+	err = c.wait(ctx, op)
+	if err != nil {
+		return nil, err
+	}
+	return c.GetGlobalAddress(ctx, address.Name)
+}
+
+// DeleteGlobalAddress deletes the global Address with the given name. Return no error if the address is not found.
+func (c *computeClient) DeleteGlobalAddress(ctx context.Context, name string) error {
+	op, err := c.doOperation(ctx, globalLocation, c.service.GlobalAddresses.Delete(c.projectID, name).Context(ctx).Do)
+	if IgnoreNotFoundError(err) != nil {
+		return err
+	}
+	if IsNotFoundError(err) {
+		return nil
+	}
+	return c.wait(ctx, op)
+}
+
 // InsertFirewallRule creates a firewall rule with the given specification.
 func (c *computeClient) InsertFirewallRule(ctx context.Context, firewall *compute.Firewall) (*compute.Firewall, error) {
-	op, err := c.service.Firewalls.Insert(c.projectID, firewall).Context(ctx).Do()
+	op, err := c.doOperation(ctx, globalLocation, c.service.Firewalls.Insert(c.projectID, firewall).Context(ctx).Do)
 	if err != nil {
 		return nil, err
 	}
@@ -444,7 +678,7 @@ func (c *computeClient) GetFirewallRule(ctx context.Context, firewall string) (*
 
 // DeleteFirewallRule deletes  the firewall rule specified by id.
 func (c *computeClient) DeleteFirewallRule(ctx context.Context, firewall string) error {
-	op, err := c.service.Firewalls.Delete(c.projectID, firewall).Context(ctx).Do()
+	op, err := c.doOperation(ctx, globalLocation, c.service.Firewalls.Delete(c.projectID, firewall).Context(ctx).Do)
 	if IgnoreNotFoundError(err) != nil {
 		return err
 	}
@@ -456,7 +690,7 @@ func (c *computeClient) DeleteFirewallRule(ctx context.Context, firewall string)
 
 // PatchFirewallRule updates the firewall rule specified by id with the given specification.
 func (c *computeClient) PatchFirewallRule(ctx context.Context, name string, rule *compute.Firewall) (*compute.Firewall, error) {
-	op, err := c.service.Firewalls.Patch(c.projectID, name, rule).Context(ctx).Do()
+	op, err := c.doOperation(ctx, globalLocation, c.service.Firewalls.Patch(c.projectID, name, rule).Context(ctx).Do)
 	switch {
 	case IsErrorCode(err, http.StatusNotModified):
 		return rule, nil
@@ -484,6 +718,10 @@ type FirewallListOpts struct {
 func (c *computeClient) ListFirewallRules(ctx context.Context, opts FirewallListOpts) ([]*compute.Firewall, error) {
 	var res []*compute.Firewall
 
+	if err := c.limiter.wait(ctx, c.projectID, globalLocation); err != nil {
+		return nil, err
+	}
+
 	fwCall := c.service.Firewalls.List(c.projectID).Context(ctx)
 	if len(opts.Filter) > 0 {
 		fwCall = fwCall.Filter(opts.Filter)
@@ -512,12 +750,9 @@ func (c *computeClient) ListFirewallRules(ctx context.Context, opts FirewallList
 
 // DeleteRoute deletes the specified route.
 func (c *computeClient) DeleteRoute(ctx context.Context, name string) error {
-	op, err := c.service.Routes.Delete(c.projectID, name).Context(ctx).Do()
-	if IgnoreNotFoundError(err) != nil {
-		return err
-	}
+	op, err := c.doOperation(ctx, globalLocation, c.service.Routes.Delete(c.projectID, name).Context(ctx).Do)
 	if err != nil {
-		return err
+		return IgnoreNotFoundError(err)
 	}
 
 	return c.wait(ctx, op)
@@ -525,6 +760,10 @@ func (c *computeClient) DeleteRoute(ctx context.Context, name string) error {
 
 // ListImages lists all Images with specified name.
 func (c *computeClient) ListImages(ctx context.Context, imageName, orderBy, fields string) (*compute.ImageList, error) {
+	if err := c.limiter.wait(ctx, c.projectID, globalLocation); err != nil {
+		return nil, err
+	}
+
 	imageList, err := c.service.Images.List(imageName).OrderBy(orderBy).Fields(googleapi.Field(fields)).Context(ctx).Do()
 	if err != nil {
 		return nil, err
@@ -537,7 +776,102 @@ func (c *computeClient) ListImages(ctx context.Context, imageName, orderBy, fiel
 	return imageList, nil
 }
 
+// ResolveLatestImage returns the newest image of the image family in the given project which is neither deprecated
+// nor obsolete.
+func (c *computeClient) ResolveLatestImage(ctx context.Context, project, family string) (*compute.Image, error) {
+	if err := c.limiter.wait(ctx, c.projectID, globalLocation); err != nil {
+		return nil, err
+	}
+
+	var (
+		latest        *compute.Image
+		latestCreated time.Time
+	)
+	if err := c.service.Images.List(project).Filter(fmt.Sprintf("family = %q", family)).Context(ctx).Pages(ctx, func(list *compute.ImageList) error {
+		for _, image := range list.Items {
+			if image == nil || image.Family != family || !isImageUsable(image) {
+				continue
+			}
+			created, err := time.Parse(time.RFC3339, image.CreationTimestamp)
+			if err != nil {
+				return fmt.Errorf("could not parse creation timestamp of image %q: %w", image.Name, err)
+			}
+			if latest == nil || created.After(latestCreated) {
+				latest, latestCreated = image, created
+			}
+		}
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+
+	if latest == nil {
+		return nil, fmt.Errorf("no usable image of family %q found in project %q", family, project)
+	}
+	return latest, nil
+}
+
+// isImageUsable returns false if the image is deprecated, obsolete or deleted.
+func isImageUsable(image *compute.Image) bool {
+	if image.Deprecated == nil {
+		return true
+	}
+	switch image.Deprecated.State {
+	case "DEPRECATED", "OBSOLETE", "DELETED":
+		return false
+	}
+	return true
+}
+
 // GetRegion returns the Region specified.
 func (c *computeClient) GetRegion(ctx context.Context, region string) (*compute.Region, error) {
 	return c.service.Regions.Get(c.projectID, region).Context(ctx).Do()
 }
+
+// ListMachineTypes lists all MachineTypes offered in the zone.
+func (c *computeClient) ListMachineTypes(ctx context.Context, zone string) ([]*compute.MachineType, error) {
+	if err := c.limiter.wait(ctx, c.projectID, zone); err != nil {
+		return nil, err
+	}
+
+	var machineTypes []*compute.MachineType
+	if err := c.service.MachineTypes.List(c.projectID, zone).Pages(ctx, func(list *compute.MachineTypeList) error {
+		machineTypes = append(machineTypes, list.Items...)
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+	return machineTypes, nil
+}
+
+// ListAcceleratorTypes lists all AcceleratorTypes offered in the zone.
+func (c *computeClient) ListAcceleratorTypes(ctx context.Context, zone string) ([]*compute.AcceleratorType, error) {
+	if err := c.limiter.wait(ctx, c.projectID, zone); err != nil {
+		return nil, err
+	}
+
+	var acceleratorTypes []*compute.AcceleratorType
+	if err := c.service.AcceleratorTypes.List(c.projectID, zone).Pages(ctx, func(list *compute.AcceleratorTypeList) error {
+		acceleratorTypes = append(acceleratorTypes, list.Items...)
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+	return acceleratorTypes, nil
+}
+
+// ListDiskTypes lists all DiskTypes offered in the zone.
+func (c *computeClient) ListDiskTypes(ctx context.Context, zone string) ([]*compute.DiskType, error) {
+	if err := c.limiter.wait(ctx, c.projectID, zone); err != nil {
+		return nil, err
+	}
+
+	var diskTypes []*compute.DiskType
+	if err := c.service.DiskTypes.List(c.projectID, zone).Pages(ctx, func(list *compute.DiskTypeList) error {
+		diskTypes = append(diskTypes, list.Items...)
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+	return diskTypes, nil
+}
@@ -0,0 +1,129 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package client
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"google.golang.org/api/compute/v1"
+	"google.golang.org/api/option"
+)
+
+// pagedTransport responds to list requests with the page of the requested page token.
+type pagedTransport struct {
+	pages    map[string]string
+	requests []*http.Request
+}
+
+func (t *pagedTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	t.requests = append(t.requests, req)
+	return &http.Response{
+		StatusCode: http.StatusOK,
+		Header:     http.Header{"Content-Type": []string{"application/json"}},
+		Body:       io.NopCloser(strings.NewReader(t.pages[req.URL.Query().Get("pageToken")])),
+		Request:    req,
+	}, nil
+}
+
+var _ = Describe("ComputeClient", func() {
+	var (
+		ctx       = context.Background()
+		transport *pagedTransport
+		client    *computeClient
+	)
+
+	BeforeEach(func() {
+		transport = &pagedTransport{}
+		service, err := compute.NewService(ctx, option.WithHTTPClient(&http.Client{Transport: transport}), option.WithEndpoint("https://compute.example.com/compute/v1/"))
+		Expect(err).NotTo(HaveOccurred())
+		client = &computeClient{service: service, projectID: "project"}
+	})
+
+	Describe("#ResolveLatestImage", func() {
+		It("should return the newest usable image of all pages", func() {
+			transport.pages = map[string]string{
+				"": `{"items":[
+					{"name":"image-1","family":"gardenlinux","creationTimestamp":"2024-01-01T00:00:00.000-07:00"},
+					{"name":"image-4","family":"gardenlinux","creationTimestamp":"2024-04-01T00:00:00.000-07:00","deprecated":{"state":"DEPRECATED"}}
+				],"nextPageToken":"page2"}`,
+				"page2": `{"items":[
+					{"name":"image-3","family":"gardenlinux","creationTimestamp":"2024-03-01T00:00:00.000-07:00"},
+					{"name":"image-2","family":"gardenlinux","creationTimestamp":"2024-02-01T00:00:00.000-07:00","deprecated":{"state":"ACTIVE"}}
+				],"nextPageToken":"page3"}`,
+				"page3": `{"items":[
+					{"name":"image-5","family":"gardenlinux","creationTimestamp":"2024-05-01T00:00:00.000-07:00","deprecated":{"state":"OBSOLETE"}},
+					{"name":"image-6","family":"gardenlinux","creationTimestamp":"2024-06-01T00:00:00.000-07:00","deprecated":{"state":"DELETED"}}
+				]}`,
+			}
+
+			image, err := client.ResolveLatestImage(ctx, "images", "gardenlinux")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(image.Name).To(Equal("image-3"))
+			Expect(transport.requests).To(HaveLen(3))
+			Expect(transport.requests[0].URL.Path).To(Equal("/compute/v1/projects/images/global/images"))
+			Expect(transport.requests[0].URL.Query().Get("filter")).To(Equal(`family = "gardenlinux"`))
+		})
+
+		It("should fail if all images of the family are deprecated", func() {
+			transport.pages = map[string]string{
+				"": `{"items":[{"name":"image-1","family":"gardenlinux","creationTimestamp":"2024-01-01T00:00:00.000-07:00","deprecated":{"state":"DEPRECATED"}}]}`,
+			}
+
+			_, err := client.ResolveLatestImage(ctx, "images", "gardenlinux")
+			Expect(err).To(MatchError(`no usable image of family "gardenlinux" found in project "images"`))
+		})
+
+		It("should fail if the family has no images", func() {
+			transport.pages = map[string]string{"": `{}`}
+
+			_, err := client.ResolveLatestImage(ctx, "images", "gardenlinux")
+			Expect(err).To(HaveOccurred())
+		})
+	})
+
+	Describe("#wait", func() {
+		var runningTransport *fakeTransport
+
+		BeforeEach(func() {
+			runningTransport = &fakeTransport{body: `{"name":"op","status":"RUNNING"}`}
+			service, err := compute.NewService(ctx, option.WithHTTPClient(&http.Client{Transport: runningTransport}), option.WithEndpoint("https://compute.example.com/compute/v1/"))
+			Expect(err).NotTo(HaveOccurred())
+			client = &computeClient{
+				service:   service,
+				projectID: "project",
+				operationWait: OperationWaitOptions{
+					InitialInterval: time.Millisecond,
+					MaxInterval:     5 * time.Millisecond,
+					Timeout:         50 * time.Millisecond,
+				},
+			}
+		})
+
+		It("should fail if the operation does not complete within the timeout", func() {
+			start := time.Now()
+			err := client.DeleteRoute(ctx, "route")
+			Expect(err).To(MatchError(`operation "op" did not complete within 50ms`))
+			Expect(time.Since(start)).To(BeNumerically("<", time.Second))
+			Expect(len(runningTransport.requests)).To(BeNumerically(">", 2))
+		})
+
+		It("should return the error of the context if it is cancelled before the timeout", func() {
+			client.operationWait.Timeout = time.Hour
+
+			timeoutCtx, cancel := context.WithTimeout(ctx, 20*time.Millisecond)
+			defer cancel()
+
+			err := client.DeleteRoute(timeoutCtx, "route")
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).NotTo(ContainSubstring("did not complete within"))
+		})
+	})
+})
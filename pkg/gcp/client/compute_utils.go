@@ -3,10 +3,12 @@ package client
 import (
 	"context"
 	"fmt"
+	"math"
 	"strings"
 	"time"
 
 	"google.golang.org/api/compute/v1"
+	"google.golang.org/api/googleapi"
 	"k8s.io/apimachinery/pkg/util/wait"
 )
 
@@ -14,9 +16,53 @@ const (
 	pollInterval = 10 * time.Second
 )
 
+// OperationWaitOptions configures how the compute clients wait for the completion of operations.
+type OperationWaitOptions struct {
+	// InitialInterval is the time waited before the operation is polled again for the first time. It is doubled after
+	// every poll.
+	InitialInterval time.Duration
+	// MaxInterval is the maximum time waited between two polls.
+	MaxInterval time.Duration
+	// Timeout is the maximum time waited for the completion of an operation. The time is only bounded by the context
+	// if it is not positive.
+	Timeout time.Duration
+}
+
 // Wait waits for async operations to complete.
 func (c *computeClient) wait(ctx context.Context, op *compute.Operation) error {
-	return wait.PollUntilContextCancel(ctx, pollInterval, true, c.waitOperation(op))
+	opts := c.operationWait
+	waitCtx := ctx
+	if opts.Timeout > 0 {
+		var cancel context.CancelFunc
+		waitCtx, cancel = context.WithTimeout(ctx, opts.Timeout)
+		defer cancel()
+	}
+
+	delay := wait.Backoff{
+		Duration: opts.InitialInterval,
+		Factor:   2,
+		Steps:    math.MaxInt32,
+		Cap:      opts.MaxInterval,
+	}.DelayFunc()
+	if err := delay.Until(waitCtx, true, false, c.waitOperation(op)); err != nil {
+		if ctx.Err() == nil && waitCtx.Err() != nil {
+			return fmt.Errorf("operation %q did not complete within %s", op.Name, opts.Timeout)
+		}
+		return err
+	}
+	return nil
+}
+
+// doOperation performs the given call of a mutating operation in the given location and retries it if it fails with a
+// transient error. Every attempt is paced by the rate limiter of the client.
+// Retried Insert calls may fail with an HTTP 409 error if the failed attempt was processed by GCP nevertheless.
+func (c *computeClient) doOperation(ctx context.Context, location string, do func(...googleapi.CallOption) (*compute.Operation, error)) (*compute.Operation, error) {
+	return retryOnTransientError(ctx, c.retry, func() (*compute.Operation, error) {
+		if err := c.limiter.wait(ctx, c.projectID, location); err != nil {
+			return nil, err
+		}
+		return do()
+	})
 }
 
 func (c *computeClient) QueryOperation(op *compute.Operation) (*compute.Operation, error) {
@@ -31,8 +77,10 @@ func (c *computeClient) QueryOperation(op *compute.Operation) (*compute.Operatio
 }
 
 func (c *computeClient) waitOperation(op *compute.Operation) func(context.Context) (bool, error) {
-	return func(_ context.Context) (bool, error) {
-		result, err := c.QueryOperation(op)
+	return func(ctx context.Context) (bool, error) {
+		result, err := retryOnTransientError(ctx, c.retry, func() (*compute.Operation, error) {
+			return c.QueryOperation(op)
+		})
 		if err != nil {
 			return false, fmt.Errorf("failed to query operation [Name=%s]: %s", op.Name, err)
 		}
@@ -6,10 +6,11 @@ package client
 
 import (
 	"context"
+	"path"
 	"reflect"
+	"slices"
 	"strings"
 
-	"golang.org/x/oauth2"
 	"golang.org/x/oauth2/google"
 	googledns "google.golang.org/api/dns/v1"
 	"google.golang.org/api/option"
@@ -19,24 +20,36 @@ import (
 
 // DNSClient is an interface which must be implemented by GCP DNS clients.
 type DNSClient interface {
-	GetManagedZones(ctx context.Context) (map[string]string, error)
+	GetManagedZones(ctx context.Context, filter ManagedZoneFilter) (map[string]string, error)
 	CreateOrUpdateRecordSet(ctx context.Context, managedZone, name, recordType string, rrdatas []string, ttl int64) error
+	CreateOrUpdateRoutingPolicyRecordSet(ctx context.Context, managedZone, name, recordType string, routingPolicy *googledns.RRSetRoutingPolicy, ttl int64) error
 	DeleteRecordSet(ctx context.Context, managedZone, name, recordType string) error
 }
 
+// ManagedZoneFilter restricts the managed zones returned by GetManagedZones.
+type ManagedZoneFilter struct {
+	// Visibility is the visibility of the managed zones, i.e. `public` or `private`. If empty, managed zones of any
+	// visibility are returned.
+	Visibility string
+	// Network is the name of the VPC network private managed zones must be bound to. If empty, private managed zones
+	// are returned regardless of their networks.
+	Network string
+}
+
 type dnsClient struct {
 	service   *googledns.Service
 	projectID string
 }
 
-// NewDNSClient returns a client for GCP's CloudDNS service.
-func NewDNSClient(ctx context.Context, serviceAccount *gcp.ServiceAccount) (DNSClient, error) {
+// NewDNSClient returns a client for GCP's CloudDNS service. The public endpoint of the service is used if the given
+// endpoint is empty.
+func NewDNSClient(ctx context.Context, serviceAccount *gcp.ServiceAccount, endpoint string) (DNSClient, error) {
 	credentials, err := google.CredentialsFromJSON(ctx, serviceAccount.Raw, googledns.NdevClouddnsReadwriteScope)
 	if err != nil {
 		return nil, err
 	}
-	client := oauth2.NewClient(ctx, credentials.TokenSource)
-	service, err := googledns.NewService(ctx, option.WithHTTPClient(client))
+	client := newInstrumentedHTTPClient(ctx, apiDNS, credentials.TokenSource)
+	service, err := googledns.NewService(ctx, withEndpoint(endpoint, option.WithHTTPClient(client))...)
 	if err != nil {
 		return nil, err
 	}
@@ -47,12 +60,15 @@ func NewDNSClient(ctx context.Context, serviceAccount *gcp.ServiceAccount) (DNSC
 	}, nil
 }
 
-// GetManagedZones returns a map of all managed zone DNS names mapped to their IDs, composed of the project ID and
-// their user assigned resource names.
-func (s *dnsClient) GetManagedZones(ctx context.Context) (map[string]string, error) {
+// GetManagedZones returns a map of all managed zone DNS names matching the given filter mapped to their IDs, composed
+// of the project ID and their user assigned resource names.
+func (s *dnsClient) GetManagedZones(ctx context.Context, filter ManagedZoneFilter) (map[string]string, error) {
 	zones := make(map[string]string)
 	f := func(resp *googledns.ManagedZonesListResponse) error {
 		for _, zone := range resp.ManagedZones {
+			if !filter.matches(zone) {
+				continue
+			}
 			zones[normalizeZoneName(zone.DnsName)] = s.zoneID(zone.Name)
 		}
 		return nil
@@ -76,7 +92,7 @@ func (s *dnsClient) CreateOrUpdateRecordSet(ctx context.Context, managedZone, na
 	rrdatas = formatRrdatas(recordType, rrdatas)
 	change := &googledns.Change{}
 	if rrs != nil {
-		if reflect.DeepEqual(rrs.Rrdatas, rrdatas) && rrs.Ttl == ttl {
+		if rrs.RoutingPolicy == nil && reflect.DeepEqual(rrs.Rrdatas, rrdatas) && rrs.Ttl == ttl {
 			return nil
 		}
 		change.Deletions = append(change.Deletions, rrs)
@@ -86,7 +102,30 @@ func (s *dnsClient) CreateOrUpdateRecordSet(ctx context.Context, managedZone, na
 	return err
 }
 
-// DeleteRecordSet deletes the resource recordset with the given name and record type
+// CreateOrUpdateRoutingPolicyRecordSet creates or updates the resource recordset with the given name, record type,
+// routing policy, and ttl in the managed zone with the given name or ID. An existing resource recordset with static
+// rrdatas is replaced.
+func (s *dnsClient) CreateOrUpdateRoutingPolicyRecordSet(ctx context.Context, managedZone, name, recordType string, routingPolicy *googledns.RRSetRoutingPolicy, ttl int64) error {
+	project, managedZone := s.projectAndManagedZone(managedZone)
+	name = ensureTrailingDot(name)
+	rrs, err := s.getResourceRecordSet(ctx, project, managedZone, name, recordType)
+	if err != nil {
+		return err
+	}
+	routingPolicy = normalizeRoutingPolicy(recordType, routingPolicy)
+	change := &googledns.Change{}
+	if rrs != nil {
+		if len(rrs.Rrdatas) == 0 && reflect.DeepEqual(normalizeRoutingPolicy(recordType, rrs.RoutingPolicy), routingPolicy) && rrs.Ttl == ttl {
+			return nil
+		}
+		change.Deletions = append(change.Deletions, rrs)
+	}
+	change.Additions = append(change.Additions, &googledns.ResourceRecordSet{Name: name, Type: recordType, RoutingPolicy: routingPolicy, Ttl: ttl})
+	_, err = s.service.Changes.Create(project, managedZone, change).Context(ctx).Do()
+	return err
+}
+
+// DeleteRecordSet deletes the resource recordset with the given name and record type, including its routing policy,
 // in the managed zone with the given name or ID.
 func (s *dnsClient) DeleteRecordSet(ctx context.Context, managedZone, name, recordType string) error {
 	project, managedZone := s.projectAndManagedZone(managedZone)
@@ -116,6 +155,29 @@ func (s *dnsClient) getResourceRecordSet(ctx context.Context, project, managedZo
 	return nil, nil
 }
 
+func (f ManagedZoneFilter) matches(zone *googledns.ManagedZone) bool {
+	visibility := zone.Visibility
+	if visibility == "" {
+		visibility = "public"
+	}
+	if f.Visibility != "" && visibility != f.Visibility {
+		return false
+	}
+	if f.Network == "" || visibility != "private" {
+		return true
+	}
+	if zone.PrivateVisibilityConfig == nil {
+		return false
+	}
+	for _, network := range zone.PrivateVisibilityConfig.Networks {
+		// The network URL has the form `https://www.googleapis.com/compute/v1/projects/<project>/global/networks/<name>`.
+		if path.Base(network.NetworkUrl) == f.Network {
+			return true
+		}
+	}
+	return false
+}
+
 func (s *dnsClient) zoneID(managedZone string) string {
 	return s.projectID + "/" + managedZone
 }
@@ -138,6 +200,38 @@ func normalizeZoneName(zoneName string) string {
 	return zoneName
 }
 
+// normalizeRoutingPolicy returns a copy of the given routing policy which only contains the fields managed by the
+// extension, so that routing policies returned by the API can be compared with desired ones.
+func normalizeRoutingPolicy(recordType string, routingPolicy *googledns.RRSetRoutingPolicy) *googledns.RRSetRoutingPolicy {
+	if routingPolicy == nil {
+		return nil
+	}
+	normalized := &googledns.RRSetRoutingPolicy{}
+	if routingPolicy.Wrr != nil {
+		normalized.Wrr = &googledns.RRSetRoutingPolicyWrrPolicy{}
+		for _, item := range routingPolicy.Wrr.Items {
+			normalized.Wrr.Items = append(normalized.Wrr.Items, &googledns.RRSetRoutingPolicyWrrPolicyWrrPolicyItem{
+				Weight:  item.Weight,
+				Rrdatas: formatRrdatas(recordType, item.Rrdatas),
+			})
+		}
+	}
+	if routingPolicy.Geo != nil {
+		normalized.Geo = &googledns.RRSetRoutingPolicyGeoPolicy{EnableFencing: routingPolicy.Geo.EnableFencing}
+		for _, item := range routingPolicy.Geo.Items {
+			normalized.Geo.Items = append(normalized.Geo.Items, &googledns.RRSetRoutingPolicyGeoPolicyGeoPolicyItem{
+				Location: item.Location,
+				Rrdatas:  formatRrdatas(recordType, item.Rrdatas),
+			})
+		}
+		// The order of the items is irrelevant for geo routing policies.
+		slices.SortFunc(normalized.Geo.Items, func(a, b *googledns.RRSetRoutingPolicyGeoPolicyGeoPolicyItem) int {
+			return strings.Compare(a.Location, b.Location)
+		})
+	}
+	return normalized
+}
+
 func formatRrdatas(recordType string, values []string) []string {
 	rrdatas := make([]string, len(values))
 	for i, value := range values {
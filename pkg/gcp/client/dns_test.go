@@ -0,0 +1,118 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package client
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	googledns "google.golang.org/api/dns/v1"
+)
+
+var _ = Describe("DNS", func() {
+	Describe("#normalizeRoutingPolicy", func() {
+		It("should only keep the managed fields", func() {
+			routingPolicy := &googledns.RRSetRoutingPolicy{
+				Kind: "dns#rRSetRoutingPolicy",
+				Wrr: &googledns.RRSetRoutingPolicyWrrPolicy{
+					Kind: "dns#rRSetRoutingPolicyWrrPolicy",
+					Items: []*googledns.RRSetRoutingPolicyWrrPolicyWrrPolicyItem{
+						{Kind: "dns#rRSetRoutingPolicyWrrPolicyWrrPolicyItem", Weight: 90, Rrdatas: []string{"foo.example.com."}},
+						{Kind: "dns#rRSetRoutingPolicyWrrPolicyWrrPolicyItem", Weight: 10, Rrdatas: []string{"bar.example.com."}},
+					},
+				},
+			}
+
+			Expect(normalizeRoutingPolicy("CNAME", routingPolicy)).To(Equal(&googledns.RRSetRoutingPolicy{
+				Wrr: &googledns.RRSetRoutingPolicyWrrPolicy{
+					Items: []*googledns.RRSetRoutingPolicyWrrPolicyWrrPolicyItem{
+						{Weight: 90, Rrdatas: []string{"foo.example.com."}},
+						{Weight: 10, Rrdatas: []string{"bar.example.com."}},
+					},
+				},
+			}))
+		})
+
+		It("should ignore the order of the items of geo routing policies", func() {
+			routingPolicy := &googledns.RRSetRoutingPolicy{
+				Kind: "dns#rRSetRoutingPolicy",
+				Geo: &googledns.RRSetRoutingPolicyGeoPolicy{
+					Kind: "dns#rRSetRoutingPolicyGeoPolicy",
+					Items: []*googledns.RRSetRoutingPolicyGeoPolicyGeoPolicyItem{
+						{Kind: "dns#rRSetRoutingPolicyGeoPolicyGeoPolicyItem", Location: "us-east1", Rrdatas: []string{"5.6.7.8"}},
+						{Kind: "dns#rRSetRoutingPolicyGeoPolicyGeoPolicyItem", Location: "europe-west1", Rrdatas: []string{"1.2.3.4"}},
+					},
+				},
+			}
+
+			Expect(normalizeRoutingPolicy("A", routingPolicy)).To(Equal(&googledns.RRSetRoutingPolicy{
+				Geo: &googledns.RRSetRoutingPolicyGeoPolicy{
+					Items: []*googledns.RRSetRoutingPolicyGeoPolicyGeoPolicyItem{
+						{Location: "europe-west1", Rrdatas: []string{"1.2.3.4"}},
+						{Location: "us-east1", Rrdatas: []string{"5.6.7.8"}},
+					},
+				},
+			}))
+		})
+
+		It("should format the rrdatas of CNAME records", func() {
+			routingPolicy := &googledns.RRSetRoutingPolicy{
+				Wrr: &googledns.RRSetRoutingPolicyWrrPolicy{
+					Items: []*googledns.RRSetRoutingPolicyWrrPolicyWrrPolicyItem{
+						{Weight: 1, Rrdatas: []string{"foo.example.com"}},
+					},
+				},
+			}
+
+			Expect(normalizeRoutingPolicy("CNAME", routingPolicy).Wrr.Items[0].Rrdatas).To(Equal([]string{"foo.example.com."}))
+		})
+
+		It("should return nil for records without routing policy", func() {
+			Expect(normalizeRoutingPolicy("A", nil)).To(BeNil())
+		})
+	})
+
+	Describe("ManagedZoneFilter", func() {
+		var (
+			publicZone = &googledns.ManagedZone{
+				Name:       "public",
+				DnsName:    "example.com.",
+				Visibility: "public",
+			}
+			privateZone = &googledns.ManagedZone{
+				Name:       "private",
+				DnsName:    "example.com.",
+				Visibility: "private",
+				PrivateVisibilityConfig: &googledns.ManagedZonePrivateVisibilityConfig{
+					Networks: []*googledns.ManagedZonePrivateVisibilityConfigNetwork{
+						{NetworkUrl: "https://www.googleapis.com/compute/v1/projects/project/global/networks/other-vpc"},
+						{NetworkUrl: "https://www.googleapis.com/compute/v1/projects/project/global/networks/shoot-vpc"},
+					},
+				},
+			}
+		)
+
+		It("should match zones of any visibility if no filter is set", func() {
+			filter := ManagedZoneFilter{}
+			Expect(filter.matches(publicZone)).To(BeTrue())
+			Expect(filter.matches(privateZone)).To(BeTrue())
+		})
+
+		It("should only match public zones", func() {
+			filter := ManagedZoneFilter{Visibility: "public", Network: "shoot-vpc"}
+			Expect(filter.matches(publicZone)).To(BeTrue())
+			Expect(filter.matches(&googledns.ManagedZone{Name: "legacy", DnsName: "example.com."})).To(BeTrue())
+			Expect(filter.matches(privateZone)).To(BeFalse())
+		})
+
+		It("should only match private zones bound to the network", func() {
+			filter := ManagedZoneFilter{Visibility: "private", Network: "shoot-vpc"}
+			Expect(filter.matches(publicZone)).To(BeFalse())
+			Expect(filter.matches(privateZone)).To(BeTrue())
+
+			filter.Network = "foo-vpc"
+			Expect(filter.matches(privateZone)).To(BeFalse())
+		})
+	})
+})
@@ -0,0 +1,36 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package client
+
+import (
+	"google.golang.org/api/option"
+)
+
+// Endpoints contains the endpoints of the GCP APIs used by the clients, e.g. restricted or private endpoints. The
+// public endpoint of an API is used if its endpoint is empty.
+type Endpoints struct {
+	// Compute is the endpoint of the Compute Engine API.
+	Compute string
+	// IAM is the endpoint of the IAM API.
+	IAM string
+	// DNS is the endpoint of the Cloud DNS API.
+	DNS string
+	// Storage is the endpoint of the Cloud Storage JSON API.
+	Storage string
+	// NetworkConnectivity is the endpoint of the Network Connectivity API.
+	NetworkConnectivity string
+	// ResourceManager is the endpoint of the Cloud Resource Manager API.
+	ResourceManager string
+	// ServiceNetworking is the endpoint of the Service Networking API.
+	ServiceNetworking string
+}
+
+// withEndpoint appends the option to use the given endpoint to the given client options if the endpoint is not empty.
+func withEndpoint(endpoint string, opts ...option.ClientOption) []option.ClientOption {
+	if endpoint == "" {
+		return opts
+	}
+	return append(opts, option.WithEndpoint(endpoint))
+}
@@ -0,0 +1,116 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package client
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/json"
+	"encoding/pem"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/gardener/gardener-extension-provider-gcp/pkg/gcp"
+)
+
+var _ = Describe("Endpoints", func() {
+	var (
+		ctx                = context.TODO()
+		server             *httptest.Server
+		lock               sync.Mutex
+		requestedPaths     []string
+		serviceAccountJSON []byte
+		serviceAccount     *gcp.ServiceAccount
+	)
+
+	BeforeEach(func() {
+		requestedPaths = nil
+		server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			lock.Lock()
+			requestedPaths = append(requestedPaths, r.URL.Path)
+			lock.Unlock()
+
+			w.Header().Set("Content-Type", "application/json")
+			if r.URL.Path == "/token" {
+				_, _ = w.Write([]byte(`{"access_token":"token","token_type":"Bearer","expires_in":3600}`))
+				return
+			}
+			_, _ = w.Write([]byte(`{"items":[{"name":"pd-standard"}]}`))
+		}))
+		DeferCleanup(server.Close)
+
+		privateKey, err := rsa.GenerateKey(rand.Reader, 2048)
+		Expect(err).NotTo(HaveOccurred())
+		serviceAccountJSON, err = json.Marshal(map[string]string{
+			"type":         gcp.ServiceAccountCredentialType,
+			"project_id":   "project",
+			"client_email": "sa@project.iam.gserviceaccount.com",
+			"private_key":  string(pem.EncodeToMemory(&pem.Block{Type: "RSA PRIVATE KEY", Bytes: x509.MarshalPKCS1PrivateKey(privateKey)})),
+			"token_uri":    server.URL + "/token",
+		})
+		Expect(err).NotTo(HaveOccurred())
+		serviceAccount, err = gcp.GetServiceAccountFromJSON(serviceAccountJSON)
+		Expect(err).NotTo(HaveOccurred())
+	})
+
+	It("should send the requests of the compute client to the configured endpoint", func() {
+		computeClient, err := NewComputeClient(ctx, serviceAccount, server.URL+"/compute/v1/", DefaultComputeOptions())
+		Expect(err).NotTo(HaveOccurred())
+
+		diskTypes, err := computeClient.ListDiskTypes(ctx, "zone1")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(diskTypes).To(HaveLen(1))
+		Expect(requestedPaths).To(ConsistOf("/token", "/compute/v1/projects/project/zones/zone1/diskTypes"))
+	})
+
+	It("should use the endpoints of the factory", func() {
+		secret := &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "cloudprovider"},
+			Data:       map[string][]byte{gcp.ServiceAccountJSONField: serviceAccountJSON},
+		}
+		c := fakeclient.NewClientBuilder().WithObjects(secret).Build()
+
+		computeClient, err := New(Options{Endpoints: Endpoints{Compute: server.URL + "/private/compute/v1/"}, Compute: DefaultComputeOptions()}).Compute(ctx, c, corev1.SecretReference{Namespace: "default", Name: "cloudprovider"})
+		Expect(err).NotTo(HaveOccurred())
+
+		_, err = computeClient.ListDiskTypes(ctx, "zone1")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(requestedPaths).To(ContainElement("/private/compute/v1/projects/project/zones/zone1/diskTypes"))
+	})
+
+	It("should configure the compute clients of the factory with its options and share the rate limiter", func() {
+		secret := &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "cloudprovider"},
+			Data:       map[string][]byte{gcp.ServiceAccountJSONField: serviceAccountJSON},
+		}
+		c := fakeclient.NewClientBuilder().WithObjects(secret).Build()
+		opts := ComputeOptions{
+			Retry:         RetryOptions{MaxAttempts: 2, InitialInterval: time.Millisecond, MaxInterval: time.Second},
+			RateLimit:     RateLimitOptions{QPS: 1, Burst: 2},
+			OperationWait: OperationWaitOptions{InitialInterval: time.Millisecond, MaxInterval: time.Second, Timeout: time.Minute},
+		}
+		factory := New(Options{Compute: opts})
+
+		client1, err := factory.Compute(ctx, c, corev1.SecretReference{Namespace: "default", Name: "cloudprovider"})
+		Expect(err).NotTo(HaveOccurred())
+		client2, err := factory.Compute(ctx, c, corev1.SecretReference{Namespace: "default", Name: "cloudprovider"})
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(client1.(*computeClient).retry).To(Equal(opts.Retry))
+		Expect(client1.(*computeClient).operationWait).To(Equal(opts.OperationWait))
+		Expect(client1.(*computeClient).limiter.opts).To(Equal(opts.RateLimit))
+		Expect(client1.(*computeClient).limiter).To(BeIdenticalTo(client2.(*computeClient).limiter))
+	})
+})
@@ -27,13 +27,40 @@ type Factory interface {
 	Compute(context.Context, client.Client, corev1.SecretReference) (ComputeClient, error)
 	// IAM returns a GCP compute client.
 	IAM(context.Context, client.Client, corev1.SecretReference) (IAMClient, error)
+	// NetworkConnectivity returns a GCP Network Connectivity Center client.
+	NetworkConnectivity(context.Context, client.Client, corev1.SecretReference) (NetworkConnectivityClient, error)
+	// ResourceManager returns a GCP Cloud Resource Manager client.
+	ResourceManager(context.Context, client.Client, corev1.SecretReference) (ResourceManagerClient, error)
+	// ServiceNetworking returns a GCP Service Networking client.
+	ServiceNetworking(context.Context, client.Client, corev1.SecretReference) (ServiceNetworkingClient, error)
 }
 
-type factory struct{}
+// Options configures the clients produced by a Factory.
+type Options struct {
+	// Endpoints are the endpoints of the GCP APIs.
+	Endpoints Endpoints
+	// Compute configures the compute clients.
+	Compute ComputeOptions
+}
+
+// DefaultOptions returns the Options which are used if nothing else is configured, i.e. the public endpoints of the
+// GCP APIs and the DefaultComputeOptions.
+func DefaultOptions() Options {
+	return Options{Compute: DefaultComputeOptions()}
+}
 
-// New returns a new instance of Factory.
-func New() Factory {
-	return &factory{}
+type factory struct {
+	opts               Options
+	computeRateLimiter *rateLimiter
+}
+
+// New returns a new instance of Factory whose clients are configured with the given options. All compute clients of
+// the factory share one rate limiter.
+func New(opts Options) Factory {
+	return &factory{
+		opts:               opts,
+		computeRateLimiter: newRateLimiter(opts.Compute.RateLimit),
+	}
 }
 
 // DNS returns a GCP cloud DNS service client.
@@ -42,7 +69,7 @@ func (f factory) DNS(ctx context.Context, c client.Client, sr corev1.SecretRefer
 	if err != nil {
 		return nil, err
 	}
-	return NewDNSClient(ctx, serviceAccount)
+	return NewDNSClient(ctx, serviceAccount, f.opts.Endpoints.DNS)
 }
 
 // Storage reads the secret from the passed reference and returns a GCP (blob) storage client.
@@ -51,7 +78,7 @@ func (f factory) Storage(ctx context.Context, c client.Client, sr corev1.SecretR
 	if err != nil {
 		return nil, err
 	}
-	return NewStorageClient(ctx, serviceAccount)
+	return NewStorageClient(ctx, serviceAccount, f.opts.Endpoints.Storage)
 }
 
 // Compute reads the secret from the passed reference and returns a GCP compute client.
@@ -60,7 +87,7 @@ func (f factory) Compute(ctx context.Context, c client.Client, sr corev1.SecretR
 	if err != nil {
 		return nil, err
 	}
-	return NewComputeClient(ctx, serviceAccount)
+	return newComputeClient(ctx, serviceAccount, f.opts.Endpoints.Compute, f.opts.Compute, f.computeRateLimiter)
 }
 
 // IAM reads the secret from the passed reference and returns a GCP compute client.
@@ -69,5 +96,32 @@ func (f factory) IAM(ctx context.Context, c client.Client, sr corev1.SecretRefer
 	if err != nil {
 		return nil, err
 	}
-	return NewIAMClient(ctx, serviceAccount)
+	return NewIAMClient(ctx, serviceAccount, f.opts.Endpoints.IAM)
+}
+
+// NetworkConnectivity reads the secret from the passed reference and returns a GCP Network Connectivity Center client.
+func (f factory) NetworkConnectivity(ctx context.Context, c client.Client, sr corev1.SecretReference) (NetworkConnectivityClient, error) {
+	serviceAccount, err := gcp.GetServiceAccountFromSecretReference(ctx, c, sr)
+	if err != nil {
+		return nil, err
+	}
+	return NewNetworkConnectivityClient(ctx, serviceAccount, f.opts.Endpoints.NetworkConnectivity)
+}
+
+// ResourceManager reads the secret from the passed reference and returns a GCP Cloud Resource Manager client.
+func (f factory) ResourceManager(ctx context.Context, c client.Client, sr corev1.SecretReference) (ResourceManagerClient, error) {
+	serviceAccount, err := gcp.GetServiceAccountFromSecretReference(ctx, c, sr)
+	if err != nil {
+		return nil, err
+	}
+	return NewResourceManagerClient(ctx, serviceAccount, f.opts.Endpoints.ResourceManager)
+}
+
+// ServiceNetworking reads the secret from the passed reference and returns a GCP Service Networking client.
+func (f factory) ServiceNetworking(ctx context.Context, c client.Client, sr corev1.SecretReference) (ServiceNetworkingClient, error) {
+	serviceAccount, err := gcp.GetServiceAccountFromSecretReference(ctx, c, sr)
+	if err != nil {
+		return nil, err
+	}
+	return NewServiceNetworkingClient(ctx, serviceAccount, f.opts.Endpoints.ServiceNetworking)
 }
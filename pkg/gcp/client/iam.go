@@ -8,17 +8,26 @@ import (
 	"context"
 	"fmt"
 	"regexp"
+	"time"
 
 	"golang.org/x/oauth2/google"
 	"google.golang.org/api/iam/v1"
 	"google.golang.org/api/option"
+	"k8s.io/apimachinery/pkg/util/cache"
 
 	"github.com/gardener/gardener-extension-provider-gcp/pkg/gcp"
 )
 
+// serviceAccountCacheTTL is the duration for which the results of service account lookups are cached.
+const serviceAccountCacheTTL = 5 * time.Minute
+
 var (
 	serviceAccountIDRegex           = regexp.MustCompile(`^projects/.*/serviceAccounts/.*@.*\.iam\.gserviceaccount\.com$`)
 	_                     IAMClient = &iamClient{}
+
+	// sharedServiceAccountCache caches the results of the service account lookups of all IAM clients, including
+	// service accounts which were not found, to avoid running into the quotas of the IAM API.
+	sharedServiceAccountCache = cache.NewExpiring()
 )
 
 // IAMClient is the client interface for the IAM API.
@@ -31,16 +40,21 @@ type IAMClient interface {
 type iamClient struct {
 	service   *iam.Service
 	projectID string
+	// cache caches the service accounts by their IDs. Lookups are not cached if it is nil.
+	cache *cache.Expiring
 }
 
-// NewIAMClient returns a new IAM client.
-func NewIAMClient(ctx context.Context, serviceAccount *gcp.ServiceAccount) (IAMClient, error) {
+// NewIAMClient returns a new IAM client. The results of GetServiceAccount are cached for a few minutes across all IAM
+// clients, the cache entry of a service account is invalidated when it is created or deleted by an IAM client.
+// The public endpoint of the IAM API is used if the given endpoint is empty.
+func NewIAMClient(ctx context.Context, serviceAccount *gcp.ServiceAccount, endpoint string) (IAMClient, error) {
 	credentials, err := google.CredentialsFromJSON(ctx, serviceAccount.Raw, iam.CloudPlatformScope)
 	if err != nil {
 		return nil, err
 	}
 
-	service, err := iam.NewService(ctx, option.WithCredentials(credentials))
+	httpClient := newInstrumentedHTTPClient(ctx, apiIAM, credentials.TokenSource)
+	service, err := iam.NewService(ctx, withEndpoint(endpoint, option.WithHTTPClient(httpClient))...)
 	if err != nil {
 		return nil, err
 	}
@@ -48,6 +62,7 @@ func NewIAMClient(ctx context.Context, serviceAccount *gcp.ServiceAccount) (IAMC
 	return &iamClient{
 		service:   service,
 		projectID: credentials.ProjectID,
+		cache:     sharedServiceAccountCache,
 	}, nil
 }
 
@@ -57,19 +72,37 @@ func (i *iamClient) GetServiceAccount(ctx context.Context, name string) (*iam.Se
 		accountID = i.serviceAccountID(name)
 	}
 
+	if i.cache != nil {
+		if sa, ok := i.cache.Get(accountID); ok {
+			return sa.(*iam.ServiceAccount), nil
+		}
+	}
+
 	sa, err := i.service.Projects.ServiceAccounts.Get(accountID).Context(ctx).Do()
-	if err != nil {
-		return nil, IgnoreNotFoundError(err)
+	if IgnoreNotFoundError(err) != nil {
+		return nil, err
+	}
+
+	if i.cache != nil {
+		i.cache.Set(accountID, sa, serviceAccountCacheTTL)
 	}
 	return sa, nil
 }
 
+// invalidate removes the service account with the given ID from the cache.
+func (i *iamClient) invalidate(accountID string) {
+	if i.cache != nil {
+		i.cache.Delete(accountID)
+	}
+}
+
 func (i *iamClient) serviceAccountID(baseName string) string {
 	return fmt.Sprintf("projects/%s/serviceAccounts/%s@%s.iam.gserviceaccount.com", i.projectID, baseName, i.projectID)
 }
 
 func (i *iamClient) CreateServiceAccount(ctx context.Context, accountId string) (*iam.ServiceAccount, error) {
 	name := "projects/" + i.projectID
+	defer i.invalidate(i.serviceAccountID(accountId))
 	return i.service.Projects.ServiceAccounts.Create(name, &iam.CreateServiceAccountRequest{
 		AccountId: accountId,
 		ServiceAccount: &iam.ServiceAccount{
@@ -84,6 +117,7 @@ func (i *iamClient) DeleteServiceAccount(ctx context.Context, name string) error
 		accountID = i.serviceAccountID(name)
 	}
 
+	defer i.invalidate(accountID)
 	_, err := i.service.Projects.ServiceAccounts.Delete(accountID).Context(ctx).Do()
 	return IgnoreNotFoundError(err)
 }
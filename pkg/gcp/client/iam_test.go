@@ -0,0 +1,92 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package client
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"google.golang.org/api/iam/v1"
+	"google.golang.org/api/option"
+	"k8s.io/apimachinery/pkg/util/cache"
+	testclock "k8s.io/utils/clock/testing"
+)
+
+var _ = Describe("IAMClient", func() {
+	const accountID = "projects/project/serviceAccounts/shoot@project.iam.gserviceaccount.com"
+
+	var (
+		ctx       = context.Background()
+		clock     *testclock.FakeClock
+		transport *fakeTransport
+		client    *iamClient
+	)
+
+	BeforeEach(func() {
+		clock = testclock.NewFakeClock(time.Now())
+		transport = &fakeTransport{body: `{"name":"` + accountID + `","email":"shoot@project.iam.gserviceaccount.com"}`}
+
+		service, err := iam.NewService(ctx, option.WithHTTPClient(&http.Client{Transport: transport}), option.WithEndpoint("https://iam.example.com/"))
+		Expect(err).NotTo(HaveOccurred())
+		client = &iamClient{service: service, projectID: "project", cache: cache.NewExpiringWithClock(clock)}
+	})
+
+	Describe("#GetServiceAccount", func() {
+		It("should not look up the service account again within the TTL", func() {
+			sa, err := client.GetServiceAccount(ctx, "shoot")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(sa.Email).To(Equal("shoot@project.iam.gserviceaccount.com"))
+
+			sa, err = client.GetServiceAccount(ctx, accountID)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(sa.Email).To(Equal("shoot@project.iam.gserviceaccount.com"))
+			Expect(transport.requests).To(HaveLen(1))
+
+			clock.Step(serviceAccountCacheTTL + time.Second)
+			_, err = client.GetServiceAccount(ctx, "shoot")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(transport.requests).To(HaveLen(2))
+		})
+
+		It("should cache service accounts which were not found", func() {
+			transport.statusCodes = []int{http.StatusNotFound}
+
+			Expect(client.GetServiceAccount(ctx, "shoot")).To(BeNil())
+			Expect(client.GetServiceAccount(ctx, "shoot")).To(BeNil())
+			Expect(transport.requests).To(HaveLen(1))
+		})
+
+		It("should not cache errors", func() {
+			transport.statusCodes = []int{http.StatusForbidden}
+
+			_, err := client.GetServiceAccount(ctx, "shoot")
+			Expect(IsErrorCode(err, http.StatusForbidden)).To(BeTrue())
+			Expect(client.GetServiceAccount(ctx, "shoot")).NotTo(BeNil())
+			Expect(transport.requests).To(HaveLen(2))
+		})
+
+		It("should invalidate the cache when the service account is created", func() {
+			transport.statusCodes = []int{http.StatusNotFound}
+
+			Expect(client.GetServiceAccount(ctx, "shoot")).To(BeNil())
+			_, err := client.CreateServiceAccount(ctx, "shoot")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(client.GetServiceAccount(ctx, "shoot")).NotTo(BeNil())
+			Expect(transport.requests).To(HaveLen(3))
+		})
+
+		It("should invalidate the cache when the service account is deleted", func() {
+			Expect(client.GetServiceAccount(ctx, "shoot")).NotTo(BeNil())
+			Expect(client.DeleteServiceAccount(ctx, "shoot")).To(Succeed())
+
+			transport.statusCodes = []int{http.StatusNotFound}
+			Expect(client.GetServiceAccount(ctx, "shoot")).To(BeNil())
+			Expect(transport.requests).To(HaveLen(3))
+		})
+	})
+})
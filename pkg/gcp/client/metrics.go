@@ -0,0 +1,86 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package client
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"golang.org/x/oauth2"
+	"sigs.k8s.io/controller-runtime/pkg/metrics"
+)
+
+const (
+	metricsNamespace = "gardener_extension_provider_gcp"
+	metricsSubsystem = "api"
+
+	apiCompute             = "compute"
+	apiIAM                 = "iam"
+	apiDNS                 = "dns"
+	apiStorage             = "storage"
+	apiNetworkConnectivity = "networkconnectivity"
+	apiResourceManager     = "cloudresourcemanager"
+	apiServiceNetworking   = "servicenetworking"
+)
+
+// The metrics are labeled by the GCP API, the HTTP method and the HTTP status code of the requests. Resource names,
+// projects or zones are deliberately not used as labels to keep the cardinality bounded.
+var (
+	apiRequestsTotal = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: metricsNamespace,
+			Subsystem: metricsSubsystem,
+			Name:      "requests_total",
+			Help:      "Number of requests sent to the GCP APIs. The code label is 'error' if no response was received.",
+		},
+		[]string{"api", "method", "code"},
+	)
+	apiRequestDuration = prometheus.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Namespace: metricsNamespace,
+			Subsystem: metricsSubsystem,
+			Name:      "request_duration_seconds",
+			Help:      "Latency of the requests sent to the GCP APIs in seconds.",
+			Buckets:   []float64{0.05, 0.1, 0.25, 0.5, 1, 2.5, 5, 10, 30},
+		},
+		[]string{"api", "method", "code"},
+	)
+)
+
+func init() {
+	metrics.Registry.MustRegister(apiRequestsTotal, apiRequestDuration)
+}
+
+// instrumentedTransport records the number and latency of the requests sent to the given GCP API.
+type instrumentedTransport struct {
+	api  string
+	base http.RoundTripper
+}
+
+// RoundTrip implements http.RoundTripper.
+func (t *instrumentedTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	start := time.Now()
+	resp, err := t.base.RoundTrip(req)
+
+	code := "error"
+	if err == nil {
+		code = strconv.Itoa(resp.StatusCode)
+	}
+	apiRequestsTotal.WithLabelValues(t.api, req.Method, code).Inc()
+	apiRequestDuration.WithLabelValues(t.api, req.Method, code).Observe(time.Since(start).Seconds())
+
+	return resp, err
+}
+
+// newInstrumentedHTTPClient returns an HTTP client which authenticates its requests to the given GCP API with tokens of
+// the given token source and records metrics about them.
+func newInstrumentedHTTPClient(ctx context.Context, api string, tokenSource oauth2.TokenSource) *http.Client {
+	client := oauth2.NewClient(ctx, tokenSource)
+	client.Transport = &instrumentedTransport{api: api, base: client.Transport}
+	return client
+}
@@ -0,0 +1,64 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package client
+
+import (
+	"context"
+	"errors"
+	"net/http"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"google.golang.org/api/compute/v1"
+	"google.golang.org/api/option"
+)
+
+type failingTransport struct{}
+
+func (failingTransport) RoundTrip(*http.Request) (*http.Response, error) {
+	return nil, errors.New("connection refused")
+}
+
+var _ = Describe("Metrics", func() {
+	var (
+		ctx       = context.Background()
+		transport *fakeTransport
+		client    *computeClient
+	)
+
+	BeforeEach(func() {
+		apiRequestsTotal.Reset()
+		apiRequestDuration.Reset()
+
+		transport = &fakeTransport{body: `{"name":"op","status":"DONE"}`}
+		service, err := compute.NewService(ctx,
+			option.WithHTTPClient(&http.Client{Transport: &instrumentedTransport{api: apiCompute, base: transport}}),
+			option.WithEndpoint("https://compute.example.com/compute/v1/"),
+		)
+		Expect(err).NotTo(HaveOccurred())
+		client = &computeClient{service: service, projectID: "project", retry: RetryOptions{MaxAttempts: 1}}
+	})
+
+	It("should count the requests by API, method and status code", func() {
+		transport.statusCodes = []int{http.StatusOK, http.StatusOK, http.StatusNotFound}
+
+		Expect(client.DeleteRoute(ctx, "route")).To(Succeed())
+		Expect(client.GetFirewallRule(ctx, "rule")).To(BeNil())
+
+		Expect(testutil.ToFloat64(apiRequestsTotal.WithLabelValues("compute", http.MethodDelete, "200"))).To(Equal(1.0))
+		Expect(testutil.ToFloat64(apiRequestsTotal.WithLabelValues("compute", http.MethodGet, "200"))).To(Equal(1.0))
+		Expect(testutil.ToFloat64(apiRequestsTotal.WithLabelValues("compute", http.MethodGet, "404"))).To(Equal(1.0))
+		Expect(testutil.CollectAndCount(apiRequestDuration)).To(Equal(3))
+	})
+
+	It("should count requests without response as errors", func() {
+		httpClient := &http.Client{Transport: &instrumentedTransport{api: apiDNS, base: failingTransport{}}}
+
+		_, err := httpClient.Get("https://dns.example.com/dns/v1/projects/project/managedZones")
+		Expect(err).To(HaveOccurred())
+		Expect(testutil.ToFloat64(apiRequestsTotal.WithLabelValues("dns", http.MethodGet, "error"))).To(Equal(1.0))
+	})
+})
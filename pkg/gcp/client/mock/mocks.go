@@ -1,9 +1,9 @@
 // Code generated by MockGen. DO NOT EDIT.
-// Source: github.com/gardener/gardener-extension-provider-gcp/pkg/gcp/client (interfaces: Factory,DNSClient,ComputeClient,StorageClient)
+// Source: github.com/gardener/gardener-extension-provider-gcp/pkg/gcp/client (interfaces: Factory,DNSClient,ComputeClient,StorageClient,NetworkConnectivityClient,IAMClient,ResourceManagerClient,ServiceNetworkingClient)
 //
 // Generated by this command:
 //
-//	mockgen -package client -destination=mocks.go github.com/gardener/gardener-extension-provider-gcp/pkg/gcp/client Factory,DNSClient,ComputeClient,StorageClient
+//	mockgen -package client -destination=mocks.go github.com/gardener/gardener-extension-provider-gcp/pkg/gcp/client Factory,DNSClient,ComputeClient,StorageClient,NetworkConnectivityClient,IAMClient,ResourceManagerClient,ServiceNetworkingClient
 //
 
 // Package client is a generated GoMock package.
@@ -16,7 +16,12 @@ import (
 	storage "cloud.google.com/go/storage"
 	client "github.com/gardener/gardener-extension-provider-gcp/pkg/gcp/client"
 	gomock "go.uber.org/mock/gomock"
+	cloudresourcemanager "google.golang.org/api/cloudresourcemanager/v1"
 	compute "google.golang.org/api/compute/v1"
+	dns "google.golang.org/api/dns/v1"
+	iam "google.golang.org/api/iam/v1"
+	networkconnectivity "google.golang.org/api/networkconnectivity/v1"
+	servicenetworking "google.golang.org/api/servicenetworking/v1"
 	v1 "k8s.io/api/core/v1"
 	client0 "sigs.k8s.io/controller-runtime/pkg/client"
 )
@@ -90,6 +95,51 @@ func (mr *MockFactoryMockRecorder) IAM(arg0, arg1, arg2 any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IAM", reflect.TypeOf((*MockFactory)(nil).IAM), arg0, arg1, arg2)
 }
 
+// NetworkConnectivity mocks base method.
+func (m *MockFactory) NetworkConnectivity(arg0 context.Context, arg1 client0.Client, arg2 v1.SecretReference) (client.NetworkConnectivityClient, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NetworkConnectivity", arg0, arg1, arg2)
+	ret0, _ := ret[0].(client.NetworkConnectivityClient)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// NetworkConnectivity indicates an expected call of NetworkConnectivity.
+func (mr *MockFactoryMockRecorder) NetworkConnectivity(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NetworkConnectivity", reflect.TypeOf((*MockFactory)(nil).NetworkConnectivity), arg0, arg1, arg2)
+}
+
+// ResourceManager mocks base method.
+func (m *MockFactory) ResourceManager(arg0 context.Context, arg1 client0.Client, arg2 v1.SecretReference) (client.ResourceManagerClient, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ResourceManager", arg0, arg1, arg2)
+	ret0, _ := ret[0].(client.ResourceManagerClient)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ResourceManager indicates an expected call of ResourceManager.
+func (mr *MockFactoryMockRecorder) ResourceManager(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResourceManager", reflect.TypeOf((*MockFactory)(nil).ResourceManager), arg0, arg1, arg2)
+}
+
+// ServiceNetworking mocks base method.
+func (m *MockFactory) ServiceNetworking(arg0 context.Context, arg1 client0.Client, arg2 v1.SecretReference) (client.ServiceNetworkingClient, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ServiceNetworking", arg0, arg1, arg2)
+	ret0, _ := ret[0].(client.ServiceNetworkingClient)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ServiceNetworking indicates an expected call of ServiceNetworking.
+func (mr *MockFactoryMockRecorder) ServiceNetworking(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ServiceNetworking", reflect.TypeOf((*MockFactory)(nil).ServiceNetworking), arg0, arg1, arg2)
+}
+
 // Storage mocks base method.
 func (m *MockFactory) Storage(arg0 context.Context, arg1 client0.Client, arg2 v1.SecretReference) (client.StorageClient, error) {
 	m.ctrl.T.Helper()
@@ -143,6 +193,20 @@ func (mr *MockDNSClientMockRecorder) CreateOrUpdateRecordSet(ctx, managedZone, n
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateOrUpdateRecordSet", reflect.TypeOf((*MockDNSClient)(nil).CreateOrUpdateRecordSet), ctx, managedZone, name, recordType, rrdatas, ttl)
 }
 
+// CreateOrUpdateRoutingPolicyRecordSet mocks base method.
+func (m *MockDNSClient) CreateOrUpdateRoutingPolicyRecordSet(ctx context.Context, managedZone, name, recordType string, routingPolicy *dns.RRSetRoutingPolicy, ttl int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateOrUpdateRoutingPolicyRecordSet", ctx, managedZone, name, recordType, routingPolicy, ttl)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateOrUpdateRoutingPolicyRecordSet indicates an expected call of CreateOrUpdateRoutingPolicyRecordSet.
+func (mr *MockDNSClientMockRecorder) CreateOrUpdateRoutingPolicyRecordSet(ctx, managedZone, name, recordType, routingPolicy, ttl any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateOrUpdateRoutingPolicyRecordSet", reflect.TypeOf((*MockDNSClient)(nil).CreateOrUpdateRoutingPolicyRecordSet), ctx, managedZone, name, recordType, routingPolicy, ttl)
+}
+
 // DeleteRecordSet mocks base method.
 func (m *MockDNSClient) DeleteRecordSet(ctx context.Context, managedZone, name, recordType string) error {
 	m.ctrl.T.Helper()
@@ -158,18 +222,18 @@ func (mr *MockDNSClientMockRecorder) DeleteRecordSet(ctx, managedZone, name, rec
 }
 
 // GetManagedZones mocks base method.
-func (m *MockDNSClient) GetManagedZones(ctx context.Context) (map[string]string, error) {
+func (m *MockDNSClient) GetManagedZones(ctx context.Context, filter client.ManagedZoneFilter) (map[string]string, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetManagedZones", ctx)
+	ret := m.ctrl.Call(m, "GetManagedZones", ctx, filter)
 	ret0, _ := ret[0].(map[string]string)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetManagedZones indicates an expected call of GetManagedZones.
-func (mr *MockDNSClientMockRecorder) GetManagedZones(ctx any) *gomock.Call {
+func (mr *MockDNSClientMockRecorder) GetManagedZones(ctx, filter any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetManagedZones", reflect.TypeOf((*MockDNSClient)(nil).GetManagedZones), ctx)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetManagedZones", reflect.TypeOf((*MockDNSClient)(nil).GetManagedZones), ctx, filter)
 }
 
 // MockComputeClient is a mock of ComputeClient interface.
@@ -224,6 +288,20 @@ func (mr *MockComputeClientMockRecorder) DeleteFirewallRule(ctx, firewall any) *
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteFirewallRule", reflect.TypeOf((*MockComputeClient)(nil).DeleteFirewallRule), ctx, firewall)
 }
 
+// DeleteGlobalAddress mocks base method.
+func (m *MockComputeClient) DeleteGlobalAddress(ctx context.Context, name string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteGlobalAddress", ctx, name)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteGlobalAddress indicates an expected call of DeleteGlobalAddress.
+func (mr *MockComputeClientMockRecorder) DeleteGlobalAddress(ctx, name any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteGlobalAddress", reflect.TypeOf((*MockComputeClient)(nil).DeleteGlobalAddress), ctx, name)
+}
+
 // DeleteInstance mocks base method.
 func (m *MockComputeClient) DeleteInstance(ctx context.Context, zone, instanceName string) error {
 	m.ctrl.T.Helper()
@@ -369,6 +447,21 @@ func (mr *MockComputeClientMockRecorder) GetFirewallRule(ctx, firewall any) *gom
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetFirewallRule", reflect.TypeOf((*MockComputeClient)(nil).GetFirewallRule), ctx, firewall)
 }
 
+// GetGlobalAddress mocks base method.
+func (m *MockComputeClient) GetGlobalAddress(ctx context.Context, name string) (*compute.Address, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetGlobalAddress", ctx, name)
+	ret0, _ := ret[0].(*compute.Address)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetGlobalAddress indicates an expected call of GetGlobalAddress.
+func (mr *MockComputeClientMockRecorder) GetGlobalAddress(ctx, name any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetGlobalAddress", reflect.TypeOf((*MockComputeClient)(nil).GetGlobalAddress), ctx, name)
+}
+
 // GetInstance mocks base method.
 func (m *MockComputeClient) GetInstance(ctx context.Context, zone, instanceName string) (*compute.Instance, error) {
 	m.ctrl.T.Helper()
@@ -474,6 +567,21 @@ func (mr *MockComputeClientMockRecorder) InsertFirewallRule(ctx, firewall any) *
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InsertFirewallRule", reflect.TypeOf((*MockComputeClient)(nil).InsertFirewallRule), ctx, firewall)
 }
 
+// InsertGlobalAddress mocks base method.
+func (m *MockComputeClient) InsertGlobalAddress(ctx context.Context, address *compute.Address) (*compute.Address, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "InsertGlobalAddress", ctx, address)
+	ret0, _ := ret[0].(*compute.Address)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// InsertGlobalAddress indicates an expected call of InsertGlobalAddress.
+func (mr *MockComputeClientMockRecorder) InsertGlobalAddress(ctx, address any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InsertGlobalAddress", reflect.TypeOf((*MockComputeClient)(nil).InsertGlobalAddress), ctx, address)
+}
+
 // InsertInstance mocks base method.
 func (m *MockComputeClient) InsertInstance(ctx context.Context, zone string, instance *compute.Instance) (*compute.Instance, error) {
 	m.ctrl.T.Helper()
@@ -534,6 +642,36 @@ func (mr *MockComputeClientMockRecorder) InsertSubnet(ctx, region, subnet any) *
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InsertSubnet", reflect.TypeOf((*MockComputeClient)(nil).InsertSubnet), ctx, region, subnet)
 }
 
+// ListAcceleratorTypes mocks base method.
+func (m *MockComputeClient) ListAcceleratorTypes(ctx context.Context, zone string) ([]*compute.AcceleratorType, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListAcceleratorTypes", ctx, zone)
+	ret0, _ := ret[0].([]*compute.AcceleratorType)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListAcceleratorTypes indicates an expected call of ListAcceleratorTypes.
+func (mr *MockComputeClientMockRecorder) ListAcceleratorTypes(ctx, zone any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAcceleratorTypes", reflect.TypeOf((*MockComputeClient)(nil).ListAcceleratorTypes), ctx, zone)
+}
+
+// ListDiskTypes mocks base method.
+func (m *MockComputeClient) ListDiskTypes(ctx context.Context, zone string) ([]*compute.DiskType, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListDiskTypes", ctx, zone)
+	ret0, _ := ret[0].([]*compute.DiskType)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListDiskTypes indicates an expected call of ListDiskTypes.
+func (mr *MockComputeClientMockRecorder) ListDiskTypes(ctx, zone any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListDiskTypes", reflect.TypeOf((*MockComputeClient)(nil).ListDiskTypes), ctx, zone)
+}
+
 // ListFirewallRules mocks base method.
 func (m *MockComputeClient) ListFirewallRules(ctx context.Context, opts client.FirewallListOpts) ([]*compute.Firewall, error) {
 	m.ctrl.T.Helper()
@@ -564,6 +702,51 @@ func (mr *MockComputeClientMockRecorder) ListImages(ctx, imageName, orderBy, fie
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListImages", reflect.TypeOf((*MockComputeClient)(nil).ListImages), ctx, imageName, orderBy, fields)
 }
 
+// ListInstances mocks base method.
+func (m *MockComputeClient) ListInstances(ctx context.Context, zone string, opts client.InstanceListOpts) ([]*compute.Instance, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListInstances", ctx, zone, opts)
+	ret0, _ := ret[0].([]*compute.Instance)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListInstances indicates an expected call of ListInstances.
+func (mr *MockComputeClientMockRecorder) ListInstances(ctx, zone, opts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListInstances", reflect.TypeOf((*MockComputeClient)(nil).ListInstances), ctx, zone, opts)
+}
+
+// ListMachineTypes mocks base method.
+func (m *MockComputeClient) ListMachineTypes(ctx context.Context, zone string) ([]*compute.MachineType, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListMachineTypes", ctx, zone)
+	ret0, _ := ret[0].([]*compute.MachineType)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListMachineTypes indicates an expected call of ListMachineTypes.
+func (mr *MockComputeClientMockRecorder) ListMachineTypes(ctx, zone any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListMachineTypes", reflect.TypeOf((*MockComputeClient)(nil).ListMachineTypes), ctx, zone)
+}
+
+// ListRouters mocks base method.
+func (m *MockComputeClient) ListRouters(ctx context.Context, region string, opts client.RouterListOpts) ([]*compute.Router, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListRouters", ctx, region, opts)
+	ret0, _ := ret[0].([]*compute.Router)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListRouters indicates an expected call of ListRouters.
+func (mr *MockComputeClientMockRecorder) ListRouters(ctx, region, opts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListRouters", reflect.TypeOf((*MockComputeClient)(nil).ListRouters), ctx, region, opts)
+}
+
 // ListRoutes mocks base method.
 func (m *MockComputeClient) ListRoutes(ctx context.Context, opts client.RouteListOpts) ([]*compute.Route, error) {
 	m.ctrl.T.Helper()
@@ -579,6 +762,21 @@ func (mr *MockComputeClientMockRecorder) ListRoutes(ctx, opts any) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListRoutes", reflect.TypeOf((*MockComputeClient)(nil).ListRoutes), ctx, opts)
 }
 
+// ListSubnets mocks base method.
+func (m *MockComputeClient) ListSubnets(ctx context.Context, region string, opts client.SubnetListOpts) ([]*compute.Subnetwork, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListSubnets", ctx, region, opts)
+	ret0, _ := ret[0].([]*compute.Subnetwork)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListSubnets indicates an expected call of ListSubnets.
+func (mr *MockComputeClientMockRecorder) ListSubnets(ctx, region, opts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListSubnets", reflect.TypeOf((*MockComputeClient)(nil).ListSubnets), ctx, region, opts)
+}
+
 // PatchFirewallRule mocks base method.
 func (m *MockComputeClient) PatchFirewallRule(ctx context.Context, name string, firewall *compute.Firewall) (*compute.Firewall, error) {
 	m.ctrl.T.Helper()
@@ -639,6 +837,35 @@ func (mr *MockComputeClientMockRecorder) PatchSubnet(ctx, region, id, subnet any
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PatchSubnet", reflect.TypeOf((*MockComputeClient)(nil).PatchSubnet), ctx, region, id, subnet)
 }
 
+// ResolveLatestImage mocks base method.
+func (m *MockComputeClient) ResolveLatestImage(ctx context.Context, project, family string) (*compute.Image, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ResolveLatestImage", ctx, project, family)
+	ret0, _ := ret[0].(*compute.Image)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ResolveLatestImage indicates an expected call of ResolveLatestImage.
+func (mr *MockComputeClientMockRecorder) ResolveLatestImage(ctx, project, family any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResolveLatestImage", reflect.TypeOf((*MockComputeClient)(nil).ResolveLatestImage), ctx, project, family)
+}
+
+// SetInstanceDeletionProtection mocks base method.
+func (m *MockComputeClient) SetInstanceDeletionProtection(ctx context.Context, zone, instanceName string, deletionProtection bool) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetInstanceDeletionProtection", ctx, zone, instanceName, deletionProtection)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetInstanceDeletionProtection indicates an expected call of SetInstanceDeletionProtection.
+func (mr *MockComputeClientMockRecorder) SetInstanceDeletionProtection(ctx, zone, instanceName, deletionProtection any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetInstanceDeletionProtection", reflect.TypeOf((*MockComputeClient)(nil).SetInstanceDeletionProtection), ctx, zone, instanceName, deletionProtection)
+}
+
 // MockStorageClient is a mock of StorageClient interface.
 type MockStorageClient struct {
 	ctrl     *gomock.Controller
@@ -748,3 +975,289 @@ func (mr *MockStorageClientMockRecorder) UpdateBucket(ctx, bucketName, bucketAtt
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateBucket", reflect.TypeOf((*MockStorageClient)(nil).UpdateBucket), ctx, bucketName, bucketAttrsToUpdate)
 }
+
+// MockNetworkConnectivityClient is a mock of NetworkConnectivityClient interface.
+type MockNetworkConnectivityClient struct {
+	ctrl     *gomock.Controller
+	recorder *MockNetworkConnectivityClientMockRecorder
+	isgomock struct{}
+}
+
+// MockNetworkConnectivityClientMockRecorder is the mock recorder for MockNetworkConnectivityClient.
+type MockNetworkConnectivityClientMockRecorder struct {
+	mock *MockNetworkConnectivityClient
+}
+
+// NewMockNetworkConnectivityClient creates a new mock instance.
+func NewMockNetworkConnectivityClient(ctrl *gomock.Controller) *MockNetworkConnectivityClient {
+	mock := &MockNetworkConnectivityClient{ctrl: ctrl}
+	mock.recorder = &MockNetworkConnectivityClientMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockNetworkConnectivityClient) EXPECT() *MockNetworkConnectivityClientMockRecorder {
+	return m.recorder
+}
+
+// CreateSpoke mocks base method.
+func (m *MockNetworkConnectivityClient) CreateSpoke(ctx context.Context, id string, spoke *networkconnectivity.Spoke) (*networkconnectivity.Spoke, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateSpoke", ctx, id, spoke)
+	ret0, _ := ret[0].(*networkconnectivity.Spoke)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateSpoke indicates an expected call of CreateSpoke.
+func (mr *MockNetworkConnectivityClientMockRecorder) CreateSpoke(ctx, id, spoke any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateSpoke", reflect.TypeOf((*MockNetworkConnectivityClient)(nil).CreateSpoke), ctx, id, spoke)
+}
+
+// DeleteSpoke mocks base method.
+func (m *MockNetworkConnectivityClient) DeleteSpoke(ctx context.Context, id string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteSpoke", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteSpoke indicates an expected call of DeleteSpoke.
+func (mr *MockNetworkConnectivityClientMockRecorder) DeleteSpoke(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteSpoke", reflect.TypeOf((*MockNetworkConnectivityClient)(nil).DeleteSpoke), ctx, id)
+}
+
+// GetSpoke mocks base method.
+func (m *MockNetworkConnectivityClient) GetSpoke(ctx context.Context, id string) (*networkconnectivity.Spoke, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSpoke", ctx, id)
+	ret0, _ := ret[0].(*networkconnectivity.Spoke)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSpoke indicates an expected call of GetSpoke.
+func (mr *MockNetworkConnectivityClientMockRecorder) GetSpoke(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSpoke", reflect.TypeOf((*MockNetworkConnectivityClient)(nil).GetSpoke), ctx, id)
+}
+
+// MockIAMClient is a mock of IAMClient interface.
+type MockIAMClient struct {
+	ctrl     *gomock.Controller
+	recorder *MockIAMClientMockRecorder
+	isgomock struct{}
+}
+
+// MockIAMClientMockRecorder is the mock recorder for MockIAMClient.
+type MockIAMClientMockRecorder struct {
+	mock *MockIAMClient
+}
+
+// NewMockIAMClient creates a new mock instance.
+func NewMockIAMClient(ctrl *gomock.Controller) *MockIAMClient {
+	mock := &MockIAMClient{ctrl: ctrl}
+	mock.recorder = &MockIAMClientMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockIAMClient) EXPECT() *MockIAMClientMockRecorder {
+	return m.recorder
+}
+
+// CreateServiceAccount mocks base method.
+func (m *MockIAMClient) CreateServiceAccount(ctx context.Context, accountID string) (*iam.ServiceAccount, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateServiceAccount", ctx, accountID)
+	ret0, _ := ret[0].(*iam.ServiceAccount)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateServiceAccount indicates an expected call of CreateServiceAccount.
+func (mr *MockIAMClientMockRecorder) CreateServiceAccount(ctx, accountID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateServiceAccount", reflect.TypeOf((*MockIAMClient)(nil).CreateServiceAccount), ctx, accountID)
+}
+
+// DeleteServiceAccount mocks base method.
+func (m *MockIAMClient) DeleteServiceAccount(arg0 context.Context, arg1 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteServiceAccount", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteServiceAccount indicates an expected call of DeleteServiceAccount.
+func (mr *MockIAMClientMockRecorder) DeleteServiceAccount(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteServiceAccount", reflect.TypeOf((*MockIAMClient)(nil).DeleteServiceAccount), arg0, arg1)
+}
+
+// GetServiceAccount mocks base method.
+func (m *MockIAMClient) GetServiceAccount(ctx context.Context, name string) (*iam.ServiceAccount, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetServiceAccount", ctx, name)
+	ret0, _ := ret[0].(*iam.ServiceAccount)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetServiceAccount indicates an expected call of GetServiceAccount.
+func (mr *MockIAMClientMockRecorder) GetServiceAccount(ctx, name any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetServiceAccount", reflect.TypeOf((*MockIAMClient)(nil).GetServiceAccount), ctx, name)
+}
+
+// MockResourceManagerClient is a mock of ResourceManagerClient interface.
+type MockResourceManagerClient struct {
+	ctrl     *gomock.Controller
+	recorder *MockResourceManagerClientMockRecorder
+	isgomock struct{}
+}
+
+// MockResourceManagerClientMockRecorder is the mock recorder for MockResourceManagerClient.
+type MockResourceManagerClientMockRecorder struct {
+	mock *MockResourceManagerClient
+}
+
+// NewMockResourceManagerClient creates a new mock instance.
+func NewMockResourceManagerClient(ctrl *gomock.Controller) *MockResourceManagerClient {
+	mock := &MockResourceManagerClient{ctrl: ctrl}
+	mock.recorder = &MockResourceManagerClientMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockResourceManagerClient) EXPECT() *MockResourceManagerClientMockRecorder {
+	return m.recorder
+}
+
+// GetProjectIAMPolicy mocks base method.
+func (m *MockResourceManagerClient) GetProjectIAMPolicy(ctx context.Context) (*cloudresourcemanager.Policy, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetProjectIAMPolicy", ctx)
+	ret0, _ := ret[0].(*cloudresourcemanager.Policy)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetProjectIAMPolicy indicates an expected call of GetProjectIAMPolicy.
+func (mr *MockResourceManagerClientMockRecorder) GetProjectIAMPolicy(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetProjectIAMPolicy", reflect.TypeOf((*MockResourceManagerClient)(nil).GetProjectIAMPolicy), ctx)
+}
+
+// GetProjectNumber mocks base method.
+func (m *MockResourceManagerClient) GetProjectNumber(ctx context.Context) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetProjectNumber", ctx)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetProjectNumber indicates an expected call of GetProjectNumber.
+func (mr *MockResourceManagerClientMockRecorder) GetProjectNumber(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetProjectNumber", reflect.TypeOf((*MockResourceManagerClient)(nil).GetProjectNumber), ctx)
+}
+
+// SetProjectIAMPolicy mocks base method.
+func (m *MockResourceManagerClient) SetProjectIAMPolicy(ctx context.Context, policy *cloudresourcemanager.Policy) (*cloudresourcemanager.Policy, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetProjectIAMPolicy", ctx, policy)
+	ret0, _ := ret[0].(*cloudresourcemanager.Policy)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SetProjectIAMPolicy indicates an expected call of SetProjectIAMPolicy.
+func (mr *MockResourceManagerClientMockRecorder) SetProjectIAMPolicy(ctx, policy any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetProjectIAMPolicy", reflect.TypeOf((*MockResourceManagerClient)(nil).SetProjectIAMPolicy), ctx, policy)
+}
+
+// MockServiceNetworkingClient is a mock of ServiceNetworkingClient interface.
+type MockServiceNetworkingClient struct {
+	ctrl     *gomock.Controller
+	recorder *MockServiceNetworkingClientMockRecorder
+	isgomock struct{}
+}
+
+// MockServiceNetworkingClientMockRecorder is the mock recorder for MockServiceNetworkingClient.
+type MockServiceNetworkingClientMockRecorder struct {
+	mock *MockServiceNetworkingClient
+}
+
+// NewMockServiceNetworkingClient creates a new mock instance.
+func NewMockServiceNetworkingClient(ctrl *gomock.Controller) *MockServiceNetworkingClient {
+	mock := &MockServiceNetworkingClient{ctrl: ctrl}
+	mock.recorder = &MockServiceNetworkingClientMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockServiceNetworkingClient) EXPECT() *MockServiceNetworkingClientMockRecorder {
+	return m.recorder
+}
+
+// CreateConnection mocks base method.
+func (m *MockServiceNetworkingClient) CreateConnection(ctx context.Context, network string, ranges []string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateConnection", ctx, network, ranges)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateConnection indicates an expected call of CreateConnection.
+func (mr *MockServiceNetworkingClientMockRecorder) CreateConnection(ctx, network, ranges any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateConnection", reflect.TypeOf((*MockServiceNetworkingClient)(nil).CreateConnection), ctx, network, ranges)
+}
+
+// DeleteConnection mocks base method.
+func (m *MockServiceNetworkingClient) DeleteConnection(ctx context.Context, network string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteConnection", ctx, network)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteConnection indicates an expected call of DeleteConnection.
+func (mr *MockServiceNetworkingClientMockRecorder) DeleteConnection(ctx, network any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteConnection", reflect.TypeOf((*MockServiceNetworkingClient)(nil).DeleteConnection), ctx, network)
+}
+
+// GetConnection mocks base method.
+func (m *MockServiceNetworkingClient) GetConnection(ctx context.Context, network string) (*servicenetworking.Connection, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetConnection", ctx, network)
+	ret0, _ := ret[0].(*servicenetworking.Connection)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetConnection indicates an expected call of GetConnection.
+func (mr *MockServiceNetworkingClientMockRecorder) GetConnection(ctx, network any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetConnection", reflect.TypeOf((*MockServiceNetworkingClient)(nil).GetConnection), ctx, network)
+}
+
+// UpdateConnection mocks base method.
+func (m *MockServiceNetworkingClient) UpdateConnection(ctx context.Context, network string, ranges []string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateConnection", ctx, network, ranges)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateConnection indicates an expected call of UpdateConnection.
+func (mr *MockServiceNetworkingClientMockRecorder) UpdateConnection(ctx, network, ranges any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateConnection", reflect.TypeOf((*MockServiceNetworkingClient)(nil).UpdateConnection), ctx, network, ranges)
+}
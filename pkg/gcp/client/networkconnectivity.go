@@ -0,0 +1,107 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package client
+
+import (
+	"context"
+	"fmt"
+
+	"golang.org/x/oauth2/google"
+	"google.golang.org/api/networkconnectivity/v1"
+	"google.golang.org/api/option"
+	"k8s.io/apimachinery/pkg/util/wait"
+
+	"github.com/gardener/gardener-extension-provider-gcp/pkg/gcp"
+)
+
+var _ NetworkConnectivityClient = &networkConnectivityClient{}
+
+// NetworkConnectivityClient is the client interface for the Network Connectivity Center API.
+type NetworkConnectivityClient interface {
+	// GetSpoke returns the spoke with the given ID or nil if it does not exist.
+	GetSpoke(ctx context.Context, id string) (*networkconnectivity.Spoke, error)
+	// CreateSpoke creates the given spoke with the given ID.
+	CreateSpoke(ctx context.Context, id string, spoke *networkconnectivity.Spoke) (*networkconnectivity.Spoke, error)
+	// DeleteSpoke deletes the spoke with the given ID.
+	DeleteSpoke(ctx context.Context, id string) error
+}
+
+type networkConnectivityClient struct {
+	service   *networkconnectivity.Service
+	projectID string
+}
+
+// NewNetworkConnectivityClient returns a client for the Network Connectivity Center API. Like the compute client, all
+// operations wait for the completion of the respective long-running operations and deletions ignore NotFound errors.
+// Spokes are always managed in the global location of the project of the service account. The public endpoint of the API
+// is used if the given endpoint is empty.
+func NewNetworkConnectivityClient(ctx context.Context, serviceAccount *gcp.ServiceAccount, endpoint string) (NetworkConnectivityClient, error) {
+	jwt, err := google.JWTConfigFromJSON(serviceAccount.Raw, networkconnectivity.CloudPlatformScope)
+	if err != nil {
+		return nil, err
+	}
+
+	httpClient := newInstrumentedHTTPClient(ctx, apiNetworkConnectivity, jwt.TokenSource(ctx))
+	service, err := networkconnectivity.NewService(ctx, withEndpoint(endpoint, option.WithHTTPClient(httpClient))...)
+	if err != nil {
+		return nil, err
+	}
+
+	return &networkConnectivityClient{
+		service:   service,
+		projectID: serviceAccount.ProjectID,
+	}, nil
+}
+
+func (n *networkConnectivityClient) GetSpoke(ctx context.Context, id string) (*networkconnectivity.Spoke, error) {
+	spoke, err := n.service.Projects.Locations.Spokes.Get(n.spokeName(id)).Context(ctx).Do()
+	if err != nil {
+		return nil, IgnoreNotFoundError(err)
+	}
+	return spoke, nil
+}
+
+func (n *networkConnectivityClient) CreateSpoke(ctx context.Context, id string, spoke *networkconnectivity.Spoke) (*networkconnectivity.Spoke, error) {
+	op, err := n.service.Projects.Locations.Spokes.Create(n.location(), spoke).SpokeId(id).Context(ctx).Do()
+	if err != nil {
+		return nil, err
+	}
+	if err := n.wait(ctx, op); err != nil {
+		return nil, err
+	}
+	return n.GetSpoke(ctx, id)
+}
+
+func (n *networkConnectivityClient) DeleteSpoke(ctx context.Context, id string) error {
+	op, err := n.service.Projects.Locations.Spokes.Delete(n.spokeName(id)).Context(ctx).Do()
+	if err != nil {
+		return IgnoreNotFoundError(err)
+	}
+	return n.wait(ctx, op)
+}
+
+func (n *networkConnectivityClient) location() string {
+	return fmt.Sprintf("projects/%s/locations/global", n.projectID)
+}
+
+func (n *networkConnectivityClient) spokeName(id string) string {
+	return fmt.Sprintf("%s/spokes/%s", n.location(), id)
+}
+
+func (n *networkConnectivityClient) wait(ctx context.Context, op *networkconnectivity.GoogleLongrunningOperation) error {
+	return wait.PollUntilContextCancel(ctx, pollInterval, true, func(ctx context.Context) (bool, error) {
+		result, err := n.service.Projects.Locations.Operations.Get(op.Name).Context(ctx).Do()
+		if err != nil {
+			return false, fmt.Errorf("failed to query operation [Name=%s]: %s", op.Name, err)
+		}
+		if !result.Done {
+			return false, nil
+		}
+		if result.Error != nil {
+			return false, fmt.Errorf("operation %q failed with error: %s", op.Name, result.Error.Message)
+		}
+		return true, nil
+	})
+}
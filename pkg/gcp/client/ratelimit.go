@@ -0,0 +1,69 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package client
+
+import (
+	"context"
+	"strings"
+	"sync"
+
+	"golang.org/x/time/rate"
+)
+
+// globalLocation is the location of the calls to global resources, e.g. networks and firewall rules.
+const globalLocation = "global"
+
+// RateLimitOptions configures the token buckets which pace the calls of the compute clients. There is one bucket per
+// project and region which is shared by all compute clients of a Factory.
+type RateLimitOptions struct {
+	// QPS is the number of calls per second the bucket is refilled with. Calls are not paced if it is not positive.
+	QPS float64
+	// Burst is the size of the bucket, i.e. the number of calls which may be made at once.
+	Burst int
+}
+
+// rateLimiter paces calls per project and region with token buckets. A nil rateLimiter does not pace calls.
+type rateLimiter struct {
+	lock     sync.Mutex
+	opts     RateLimitOptions
+	limiters map[string]*rate.Limiter
+}
+
+func newRateLimiter(opts RateLimitOptions) *rateLimiter {
+	return &rateLimiter{
+		opts:     opts,
+		limiters: make(map[string]*rate.Limiter),
+	}
+}
+
+// wait blocks until a call to the given location, i.e. a zone, region or globalLocation, of the given project is
+// allowed or the context is done.
+func (r *rateLimiter) wait(ctx context.Context, projectID, location string) error {
+	if r == nil || r.opts.QPS <= 0 {
+		return nil
+	}
+	return r.limiter(projectID + "/" + regionOf(location)).Wait(ctx)
+}
+
+func (r *rateLimiter) limiter(key string) *rate.Limiter {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	limiter, ok := r.limiters[key]
+	if !ok {
+		limiter = rate.NewLimiter(rate.Limit(r.opts.QPS), max(r.opts.Burst, 1))
+		r.limiters[key] = limiter
+	}
+	return limiter
+}
+
+// regionOf returns the region of the given location, i.e. the region itself or the region of a zone like
+// `europe-west1-b`.
+func regionOf(location string) string {
+	if strings.Count(location, "-") < 2 {
+		return location
+	}
+	return location[:strings.LastIndex(location, "-")]
+}
@@ -0,0 +1,92 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package client
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"google.golang.org/api/compute/v1"
+	"google.golang.org/api/option"
+)
+
+var _ = Describe("RateLimiter", func() {
+	var ctx = context.Background()
+
+	It("should pace the calls", func() {
+		limiter := newRateLimiter(RateLimitOptions{QPS: 20, Burst: 1})
+
+		start := time.Now()
+		for range 3 {
+			Expect(limiter.wait(ctx, "project", "europe-west1")).To(Succeed())
+		}
+		Expect(time.Since(start)).To(BeNumerically(">=", 90*time.Millisecond))
+	})
+
+	It("should share the bucket between the zones of a region", func() {
+		limiter := newRateLimiter(RateLimitOptions{QPS: 0.001, Burst: 1})
+		Expect(limiter.wait(ctx, "project", "europe-west1-b")).To(Succeed())
+
+		timeoutCtx, cancel := context.WithTimeout(ctx, 10*time.Millisecond)
+		defer cancel()
+		Expect(limiter.wait(timeoutCtx, "project", "europe-west1-c")).NotTo(Succeed())
+	})
+
+	It("should use separate buckets for other projects and regions", func() {
+		limiter := newRateLimiter(RateLimitOptions{QPS: 0.001, Burst: 1})
+
+		Expect(limiter.wait(ctx, "project", "europe-west1")).To(Succeed())
+		Expect(limiter.wait(ctx, "project", "europe-west3")).To(Succeed())
+		Expect(limiter.wait(ctx, "project", globalLocation)).To(Succeed())
+		Expect(limiter.wait(ctx, "other-project", "europe-west1")).To(Succeed())
+	})
+
+	It("should not pace the calls if the QPS is not positive", func() {
+		limiter := newRateLimiter(RateLimitOptions{QPS: 0, Burst: 1})
+		for range 100 {
+			Expect(limiter.wait(ctx, "project", "europe-west1")).To(Succeed())
+		}
+	})
+
+	It("should not pace the calls of a client without rate limiter", func() {
+		var limiter *rateLimiter
+		Expect(limiter.wait(ctx, "project", "europe-west1")).To(Succeed())
+	})
+
+	It("should honor the cancellation of the context", func() {
+		transport := &fakeTransport{body: `{"name":"op","status":"DONE"}`}
+		service, err := compute.NewService(ctx, option.WithHTTPClient(&http.Client{Transport: transport}), option.WithEndpoint("https://compute.example.com/compute/v1/"))
+		Expect(err).NotTo(HaveOccurred())
+		client := &computeClient{
+			service:   service,
+			projectID: "project",
+			retry:     RetryOptions{MaxAttempts: 1},
+			limiter:   newRateLimiter(RateLimitOptions{QPS: 0.001, Burst: 1}),
+		}
+		Expect(client.DeleteRoute(ctx, "route")).To(Succeed())
+
+		cancelCtx, cancel := context.WithCancel(ctx)
+		cancel()
+		Expect(client.DeleteRoute(cancelCtx, "other-route")).NotTo(Succeed())
+		_, err = client.ListFirewallRules(cancelCtx, FirewallListOpts{})
+		Expect(err).To(HaveOccurred())
+		Expect(transport.requests).To(Equal([]string{
+			"DELETE /compute/v1/projects/project/global/routes/route",
+			"GET /compute/v1/projects/project/global/operations/op",
+		}))
+	})
+
+	DescribeTable("#regionOf",
+		func(location, region string) {
+			Expect(regionOf(location)).To(Equal(region))
+		},
+		Entry("zone", "europe-west1-b", "europe-west1"),
+		Entry("region", "europe-west1", "europe-west1"),
+		Entry("global", "global", "global"),
+	)
+})
@@ -0,0 +1,78 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package client
+
+import (
+	"context"
+
+	"golang.org/x/oauth2/google"
+	"google.golang.org/api/cloudresourcemanager/v1"
+	"google.golang.org/api/option"
+
+	"github.com/gardener/gardener-extension-provider-gcp/pkg/gcp"
+)
+
+// iamPolicyVersion is the version of the IAM policies which are read and written. Version 3 is required to preserve
+// conditional role bindings.
+const iamPolicyVersion = 3
+
+var _ ResourceManagerClient = &resourceManagerClient{}
+
+// ResourceManagerClient is the client interface for the Cloud Resource Manager API.
+type ResourceManagerClient interface {
+	// GetProjectIAMPolicy returns the IAM policy of the project of the service account.
+	GetProjectIAMPolicy(ctx context.Context) (*cloudresourcemanager.Policy, error)
+	// SetProjectIAMPolicy replaces the IAM policy of the project of the service account. The update is rejected if
+	// the etag of the given policy does not match the etag of the current policy.
+	SetProjectIAMPolicy(ctx context.Context, policy *cloudresourcemanager.Policy) (*cloudresourcemanager.Policy, error)
+	// GetProjectNumber returns the number of the project of the service account.
+	GetProjectNumber(ctx context.Context) (int64, error)
+}
+
+type resourceManagerClient struct {
+	service   *cloudresourcemanager.Service
+	projectID string
+}
+
+// NewResourceManagerClient returns a client for the Cloud Resource Manager API. The public endpoint of the API is used
+// if the given endpoint is empty.
+func NewResourceManagerClient(ctx context.Context, serviceAccount *gcp.ServiceAccount, endpoint string) (ResourceManagerClient, error) {
+	credentials, err := google.CredentialsFromJSON(ctx, serviceAccount.Raw, cloudresourcemanager.CloudPlatformScope)
+	if err != nil {
+		return nil, err
+	}
+
+	httpClient := newInstrumentedHTTPClient(ctx, apiResourceManager, credentials.TokenSource)
+	service, err := cloudresourcemanager.NewService(ctx, withEndpoint(endpoint, option.WithHTTPClient(httpClient))...)
+	if err != nil {
+		return nil, err
+	}
+
+	return &resourceManagerClient{
+		service:   service,
+		projectID: serviceAccount.ProjectID,
+	}, nil
+}
+
+func (r *resourceManagerClient) GetProjectIAMPolicy(ctx context.Context) (*cloudresourcemanager.Policy, error) {
+	return r.service.Projects.GetIamPolicy(r.projectID, &cloudresourcemanager.GetIamPolicyRequest{
+		Options: &cloudresourcemanager.GetPolicyOptions{RequestedPolicyVersion: iamPolicyVersion},
+	}).Context(ctx).Do()
+}
+
+func (r *resourceManagerClient) SetProjectIAMPolicy(ctx context.Context, policy *cloudresourcemanager.Policy) (*cloudresourcemanager.Policy, error) {
+	policy.Version = iamPolicyVersion
+	return r.service.Projects.SetIamPolicy(r.projectID, &cloudresourcemanager.SetIamPolicyRequest{
+		Policy: policy,
+	}).Context(ctx).Do()
+}
+
+func (r *resourceManagerClient) GetProjectNumber(ctx context.Context) (int64, error) {
+	project, err := r.service.Projects.Get(r.projectID).Context(ctx).Do()
+	if err != nil {
+		return 0, err
+	}
+	return project.ProjectNumber, nil
+}
@@ -0,0 +1,70 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package client
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"k8s.io/apimachinery/pkg/util/wait"
+)
+
+// RetryOptions configures how often and how long calls to the GCP APIs are retried if they fail with transient errors.
+type RetryOptions struct {
+	// MaxAttempts is the maximum number of attempts of a call, including the first one. Calls are not retried if it is
+	// less than two.
+	MaxAttempts int
+	// InitialInterval is the time waited before the first retry. It is doubled for every further retry.
+	InitialInterval time.Duration
+	// MaxInterval is the maximum time waited before a retry.
+	MaxInterval time.Duration
+}
+
+// transientErrorCodes are the HTTP status codes of GCP API errors which are likely to disappear when the call is
+// retried, i.e. rate limits and temporary server-side failures.
+var transientErrorCodes = []int{
+	http.StatusTooManyRequests,
+	http.StatusInternalServerError,
+	http.StatusBadGateway,
+	http.StatusServiceUnavailable,
+}
+
+// IsTransientError returns true if the given error is a GCP API error which is worth retrying.
+func IsTransientError(err error) bool {
+	return IsErrorCode(err, transientErrorCodes...)
+}
+
+// retryOnTransientError calls fn until it succeeds, fails with an error which is not transient or the attempts of the
+// given options are exhausted. The backoff between the attempts grows exponentially. No further attempt is made if
+// the context is cancelled or its deadline would be exceeded before the next attempt.
+func retryOnTransientError[T any](ctx context.Context, opts RetryOptions, fn func() (T, error)) (T, error) {
+	backoff := wait.Backoff{
+		Duration: opts.InitialInterval,
+		Factor:   2,
+		Steps:    opts.MaxAttempts,
+		Cap:      opts.MaxInterval,
+	}
+
+	for attempt := 1; ; attempt++ {
+		result, err := fn()
+		if err == nil || !IsTransientError(err) || attempt >= opts.MaxAttempts {
+			return result, err
+		}
+
+		delay := backoff.Step()
+		if deadline, ok := ctx.Deadline(); ok && time.Now().Add(delay).After(deadline) {
+			return result, err
+		}
+
+		timer := time.NewTimer(delay)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return result, err
+		case <-timer.C:
+		}
+	}
+}
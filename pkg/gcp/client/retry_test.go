@@ -0,0 +1,139 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package client
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"google.golang.org/api/compute/v1"
+	"google.golang.org/api/option"
+)
+
+// fakeTransport responds to every request with the next of its status codes. Once the status codes are used up, it
+// responds with HTTP 200 and the given body.
+type fakeTransport struct {
+	lock        sync.Mutex
+	statusCodes []int
+	body        string
+	requests    []string
+}
+
+func (t *fakeTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+
+	t.requests = append(t.requests, req.Method+" "+req.URL.Path)
+	statusCode, body := http.StatusOK, t.body
+	if len(t.statusCodes) > 0 {
+		statusCode = t.statusCodes[0]
+		t.statusCodes = t.statusCodes[1:]
+	}
+	if statusCode != http.StatusOK {
+		body = `{"error":{"code":` + strconv.Itoa(statusCode) + `,"message":"` + http.StatusText(statusCode) + `"}}`
+	}
+
+	return &http.Response{
+		StatusCode: statusCode,
+		Header:     http.Header{"Content-Type": []string{"application/json"}},
+		Body:       io.NopCloser(strings.NewReader(body)),
+		Request:    req,
+	}, nil
+}
+
+var _ = Describe("Retry", func() {
+	var (
+		ctx       context.Context
+		transport *fakeTransport
+		client    *computeClient
+	)
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		transport = &fakeTransport{body: `{"name":"op","status":"DONE"}`}
+
+		service, err := compute.NewService(ctx, option.WithHTTPClient(&http.Client{Transport: transport}), option.WithEndpoint("https://compute.example.com/compute/v1/"))
+		Expect(err).NotTo(HaveOccurred())
+		client = &computeClient{
+			service:   service,
+			projectID: "project",
+			retry: RetryOptions{
+				MaxAttempts:     3,
+				InitialInterval: time.Millisecond,
+				MaxInterval:     time.Millisecond,
+			},
+		}
+	})
+
+	DescribeTable("transient errors",
+		func(statusCode int) {
+			transport.statusCodes = []int{statusCode, statusCode}
+
+			Expect(client.DeleteRoute(ctx, "route")).To(Succeed())
+			Expect(transport.requests).To(Equal([]string{
+				"DELETE /compute/v1/projects/project/global/routes/route",
+				"DELETE /compute/v1/projects/project/global/routes/route",
+				"DELETE /compute/v1/projects/project/global/routes/route",
+				"GET /compute/v1/projects/project/global/operations/op",
+			}))
+		},
+		Entry("too many requests", http.StatusTooManyRequests),
+		Entry("internal server error", http.StatusInternalServerError),
+		Entry("bad gateway", http.StatusBadGateway),
+		Entry("service unavailable", http.StatusServiceUnavailable),
+	)
+
+	It("should retry the polling of operations", func() {
+		transport.statusCodes = []int{http.StatusOK, http.StatusServiceUnavailable}
+
+		Expect(client.DeleteRoute(ctx, "route")).To(Succeed())
+		Expect(transport.requests).To(Equal([]string{
+			"DELETE /compute/v1/projects/project/global/routes/route",
+			"GET /compute/v1/projects/project/global/operations/op",
+			"GET /compute/v1/projects/project/global/operations/op",
+		}))
+	})
+
+	It("should give up after the maximum number of attempts", func() {
+		transport.statusCodes = []int{http.StatusServiceUnavailable, http.StatusServiceUnavailable, http.StatusServiceUnavailable}
+
+		err := client.DeleteRoute(ctx, "route")
+		Expect(IsErrorCode(err, http.StatusServiceUnavailable)).To(BeTrue())
+		Expect(transport.requests).To(HaveLen(3))
+	})
+
+	DescribeTable("non-transient errors",
+		func(statusCode int) {
+			transport.statusCodes = []int{statusCode}
+
+			_, err := client.InsertFirewallRule(ctx, &compute.Firewall{Name: "rule"})
+			Expect(IsErrorCode(err, statusCode)).To(BeTrue())
+			Expect(transport.requests).To(HaveLen(1))
+		},
+		Entry("forbidden", http.StatusForbidden),
+		Entry("not found", http.StatusNotFound),
+		Entry("conflict", http.StatusConflict),
+	)
+
+	It("should not retry if the deadline of the context would be exceeded", func() {
+		client.retry.InitialInterval = time.Hour
+		client.retry.MaxInterval = time.Hour
+		transport.statusCodes = []int{http.StatusServiceUnavailable}
+
+		ctx, cancel := context.WithTimeout(ctx, time.Minute)
+		defer cancel()
+
+		err := client.DeleteRoute(ctx, "route")
+		Expect(IsErrorCode(err, http.StatusServiceUnavailable)).To(BeTrue())
+		Expect(transport.requests).To(HaveLen(1))
+	})
+})
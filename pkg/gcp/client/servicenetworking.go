@@ -0,0 +1,129 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package client
+
+import (
+	"context"
+	"fmt"
+
+	"golang.org/x/oauth2/google"
+	"google.golang.org/api/option"
+	"google.golang.org/api/servicenetworking/v1"
+	"k8s.io/apimachinery/pkg/util/wait"
+
+	"github.com/gardener/gardener-extension-provider-gcp/pkg/gcp"
+)
+
+const (
+	// privateServiceAccessService is the service which manages the peerings of Private Service Access.
+	privateServiceAccessService = "services/servicenetworking.googleapis.com"
+	// privateServiceAccessConnection is the name of the connection of Private Service Access. There is only one
+	// connection per VPC network.
+	privateServiceAccessConnection = privateServiceAccessService + "/connections/servicenetworking-googleapis-com"
+)
+
+var _ ServiceNetworkingClient = &serviceNetworkingClient{}
+
+// ServiceNetworkingClient is the client interface for the Service Networking API. The connections are identified by
+// the network of the service consumer in the form `projects/<project-number>/global/networks/<network>`.
+type ServiceNetworkingClient interface {
+	// GetConnection returns the Private Service Access connection of the given network or nil if it does not exist.
+	GetConnection(ctx context.Context, network string) (*servicenetworking.Connection, error)
+	// CreateConnection creates the Private Service Access connection of the given network with the given allocated
+	// ranges.
+	CreateConnection(ctx context.Context, network string, ranges []string) error
+	// UpdateConnection replaces the allocated ranges of the Private Service Access connection of the given network.
+	UpdateConnection(ctx context.Context, network string, ranges []string) error
+	// DeleteConnection deletes the Private Service Access connection of the given network.
+	DeleteConnection(ctx context.Context, network string) error
+}
+
+type serviceNetworkingClient struct {
+	service *servicenetworking.APIService
+}
+
+// NewServiceNetworkingClient returns a client for the Service Networking API. Like the compute client, all operations
+// wait for the completion of the respective long-running operations and deletions ignore NotFound errors. The public
+// endpoint of the API is used if the given endpoint is empty.
+func NewServiceNetworkingClient(ctx context.Context, serviceAccount *gcp.ServiceAccount, endpoint string) (ServiceNetworkingClient, error) {
+	jwt, err := google.JWTConfigFromJSON(serviceAccount.Raw, servicenetworking.CloudPlatformScope)
+	if err != nil {
+		return nil, err
+	}
+
+	httpClient := newInstrumentedHTTPClient(ctx, apiServiceNetworking, jwt.TokenSource(ctx))
+	service, err := servicenetworking.NewService(ctx, withEndpoint(endpoint, option.WithHTTPClient(httpClient))...)
+	if err != nil {
+		return nil, err
+	}
+
+	return &serviceNetworkingClient{
+		service: service,
+	}, nil
+}
+
+func (s *serviceNetworkingClient) GetConnection(ctx context.Context, network string) (*servicenetworking.Connection, error) {
+	resp, err := s.service.Services.Connections.List(privateServiceAccessService).Network(network).Context(ctx).Do()
+	if err != nil {
+		return nil, IgnoreNotFoundError(err)
+	}
+	if len(resp.Connections) == 0 {
+		return nil, nil
+	}
+	return resp.Connections[0], nil
+}
+
+func (s *serviceNetworkingClient) CreateConnection(ctx context.Context, network string, ranges []string) error {
+	op, err := s.service.Services.Connections.Create(privateServiceAccessService, &servicenetworking.Connection{
+		Network:               network,
+		ReservedPeeringRanges: ranges,
+	}).Context(ctx).Do()
+	if err != nil {
+		return err
+	}
+	return s.wait(ctx, op)
+}
+
+func (s *serviceNetworkingClient) UpdateConnection(ctx context.Context, network string, ranges []string) error {
+	// force is required to remove allocated ranges, the update is still rejected if a removed range is in use.
+	op, err := s.service.Services.Connections.Patch(privateServiceAccessConnection, &servicenetworking.Connection{
+		Network:               network,
+		ReservedPeeringRanges: ranges,
+	}).Force(true).Context(ctx).Do()
+	if err != nil {
+		return err
+	}
+	return s.wait(ctx, op)
+}
+
+func (s *serviceNetworkingClient) DeleteConnection(ctx context.Context, network string) error {
+	op, err := s.service.Services.Connections.DeleteConnection(privateServiceAccessConnection, &servicenetworking.DeleteConnectionRequest{
+		ConsumerNetwork: network,
+	}).Context(ctx).Do()
+	if err != nil {
+		return IgnoreNotFoundError(err)
+	}
+	return s.wait(ctx, op)
+}
+
+func (s *serviceNetworkingClient) wait(ctx context.Context, op *servicenetworking.Operation) error {
+	return wait.PollUntilContextCancel(ctx, pollInterval, true, func(ctx context.Context) (bool, error) {
+		result := op
+		if !op.Done {
+			var err error
+			result, err = s.service.Operations.Get(op.Name).Context(ctx).Do()
+			if err != nil {
+				return false, fmt.Errorf("failed to query operation [Name=%s]: %s", op.Name, err)
+			}
+		}
+		if !result.Done {
+			return false, nil
+		}
+		if result.Error != nil {
+			return false, fmt.Errorf("operation %q failed with error: %s", op.Name, result.Error.Message)
+		}
+		return true, nil
+	})
+}
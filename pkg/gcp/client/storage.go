@@ -9,6 +9,7 @@ import (
 	"fmt"
 
 	"cloud.google.com/go/storage"
+	"golang.org/x/oauth2/google"
 	"google.golang.org/api/iterator"
 	"google.golang.org/api/option"
 	corev1 "k8s.io/api/core/v1"
@@ -37,9 +38,16 @@ type storageClient struct {
 	serviceAccount *gcp.ServiceAccount
 }
 
-// NewStorageClient creates a new storage client from the given  serviceAccount.
-func NewStorageClient(ctx context.Context, serviceAccount *gcp.ServiceAccount) (StorageClient, error) {
-	client, err := storage.NewClient(ctx, option.WithCredentialsJSON(serviceAccount.Raw), option.WithScopes(storage.ScopeFullControl))
+// NewStorageClient creates a new storage client from the given  serviceAccount. The public endpoint of the Cloud Storage
+// JSON API is used if the given endpoint is empty.
+func NewStorageClient(ctx context.Context, serviceAccount *gcp.ServiceAccount, endpoint string) (StorageClient, error) {
+	credentials, err := google.CredentialsFromJSON(ctx, serviceAccount.Raw, storage.ScopeFullControl)
+	if err != nil {
+		return nil, err
+	}
+
+	httpClient := newInstrumentedHTTPClient(ctx, apiStorage, credentials.TokenSource)
+	client, err := storage.NewClient(ctx, withEndpoint(endpoint, option.WithHTTPClient(httpClient))...)
 	if err != nil {
 		return nil, err
 	}
@@ -49,14 +57,15 @@ func NewStorageClient(ctx context.Context, serviceAccount *gcp.ServiceAccount) (
 	}, nil
 }
 
-// NewStorageClientFromSecretRef creates a new storage client from the given <secretRef>.
+// NewStorageClientFromSecretRef creates a new storage client from the given <secretRef>. It uses the public endpoint of
+// the Cloud Storage JSON API.
 func NewStorageClientFromSecretRef(ctx context.Context, c client.Client, secretRef corev1.SecretReference) (StorageClient, error) {
 	serviceAccount, err := gcp.GetServiceAccountFromSecretReference(ctx, c, secretRef)
 	if err != nil {
 		return nil, err
 	}
 
-	return NewStorageClient(ctx, serviceAccount)
+	return NewStorageClient(ctx, serviceAccount, "")
 }
 
 // Attrs retrieves the attributes of the specified bucket.
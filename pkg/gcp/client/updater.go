@@ -158,6 +158,9 @@ func (u *updater) NAT(ctx context.Context, region string, router compute.Router,
 	if !desired.EnableDynamicPortAllocation {
 		desired.ForceSendFields = append(desired.ForceSendFields, "EnableDynamicPortAllocation")
 	}
+	if !desired.EnableEndpointIndependentMapping {
+		desired.ForceSendFields = append(desired.ForceSendFields, "EnableEndpointIndependentMapping")
+	}
 	if desired.MinPortsPerVm == 0 {
 		desired.ForceSendFields = append(desired.ForceSendFields, "MinPortsPerVM")
 	}
@@ -182,6 +185,9 @@ func (u *updater) NAT(ctx context.Context, region string, router compute.Router,
 	if len(desired.NatIps) == 0 {
 		desired.NullFields = append(desired.NullFields, "NatIps")
 	}
+	if len(desired.DrainNatIps) == 0 {
+		desired.NullFields = append(desired.NullFields, "DrainNatIps")
+	}
 
 	index := slices.IndexFunc(router.Nats, func(nat *compute.RouterNat) bool {
 		return nat.Name == desired.Name
@@ -0,0 +1,41 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package gcp
+
+import (
+	"regexp"
+	"strings"
+)
+
+const maxGcpLabelCharactersSize = 63
+
+var labelRegex = regexp.MustCompile(`[^a-z0-9_-]`)
+
+// SanitizeGcpLabel will sanitize the label base on the gcp label Restrictions
+func SanitizeGcpLabel(label string) string {
+	return sanitizeGcpLabelOrValue(label, true)
+}
+
+// SanitizeGcpLabelValue will sanitize the value base on the gcp label Restrictions
+func SanitizeGcpLabelValue(value string) string {
+	return sanitizeGcpLabelOrValue(value, false)
+}
+
+// sanitizeGcpLabelOrValue will sanitize the label/value base on the gcp label Restrictions
+func sanitizeGcpLabelOrValue(label string, startWithCharacter bool) string {
+	v := labelRegex.ReplaceAllString(strings.ToLower(label), "_")
+	if startWithCharacter {
+		v = strings.TrimLeftFunc(v, func(r rune) bool {
+			if ('0' <= r && r <= '9') || r == '_' {
+				return true
+			}
+			return false
+		})
+	}
+	if len(v) > maxGcpLabelCharactersSize {
+		return v[0:maxGcpLabelCharactersSize]
+	}
+	return v
+}
@@ -0,0 +1,26 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package gcp
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Labels", func() {
+	Describe("sanitize gcp label/value ", func() {
+		It("gcp label must start with lowercase character", func() {
+			Expect(SanitizeGcpLabel("////Abcd-efg")).To(Equal("abcd-efg"))
+			Expect(SanitizeGcpLabel("1Abcd-efg")).To(Equal("abcd-efg"))
+		})
+		It("gcp label value can  start with '-' ", func() {
+			Expect(SanitizeGcpLabelValue("////Abcd-efg")).To(Equal("____abcd-efg"))
+			Expect(SanitizeGcpLabelValue("1Abcd-efg")).To(Equal("1abcd-efg"))
+		})
+		It("label can be at most 63 characters long", func() {
+			Expect(SanitizeGcpLabel("abcdefghijklmnopqrstuvwxyzabcdefghijklmnopqrstuvwxyz0123456789abcd")).To(Equal("abcdefghijklmnopqrstuvwxyzabcdefghijklmnopqrstuvwxyz0123456789a"))
+		})
+	})
+})
@@ -51,6 +51,8 @@ const (
 	CSINodeName = "csi-driver-node"
 	// CSIDriverName is a constant for the name of the csi-driver component.
 	CSIDriverName = "csi-driver"
+	// CSIStorageProvisioner is a constant for the name of the CSIDriver and the provisioner of the storage classes.
+	CSIStorageProvisioner = "pd.csi.storage.gke.io"
 	// CSIProvisionerName is a constant for the name of the csi-provisioner component.
 	CSIProvisionerName = "csi-provisioner"
 	// CSIAttacherName is a constant for the name of the csi-attacher component.
@@ -77,8 +79,21 @@ const (
 	SeedAnnotationKeyUseFlow = AnnotationKeyUseFlow
 	// SeedAnnotationUseFlowValueNew is the value to restrict flow reconciliation to new shoot clusters
 	SeedAnnotationUseFlowValueNew = "new"
-	// AnnotationEnableVolumeAttributesClass is the annotation to use on shoots to enable VolumeAttributesClasses
+	// AnnotationKeyMigrateVerify enables the verified migration of an infrastructure from Terraform to flow if its value
+	// is `true`. The live GCP resources are then diffed against the Terraform state and the equivalent flow state, and
+	// the migration is refused as long as discrepancies are found.
+	AnnotationKeyMigrateVerify = "gcp.provider.extensions.gardener.cloud/migrate-verify"
+	// AnnotationEnableVolumeAttributesClass is the annotation to use on shoots to enable VolumeAttributesClasses. It is
+	// only a fallback if the `storage.enableVolumeAttributesClass` field of the ControlPlaneConfig is not set.
+	// TODO: Remove this annotation in the next release.
 	AnnotationEnableVolumeAttributesClass = "gcp.provider.extensions.gardener.cloud/enable-volume-attributes-class"
+
+	// BucketLabelKeyManagedBy is the label key of GCS buckets which marks them as managed by Gardener.
+	BucketLabelKeyManagedBy = "managed-by"
+	// BucketLabelValueManagedBy is the value of the BucketLabelKeyManagedBy label.
+	BucketLabelValueManagedBy = "gardener"
+	// BucketLabelKeyBackupBucket is the label key of GCS buckets containing the name of the BackupBucket resource.
+	BucketLabelKeyBackupBucket = "gardener-backup-bucket"
 )
 
 var (
@@ -10,8 +10,11 @@ import (
 
 	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
 	"google.golang.org/api/compute/v1"
+	"k8s.io/utils/ptr"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
+	"github.com/gardener/gardener-extension-provider-gcp/pkg/apis/config"
+	api "github.com/gardener/gardener-extension-provider-gcp/pkg/apis/gcp"
 	"github.com/gardener/gardener-extension-provider-gcp/pkg/gcp"
 	gcpclient "github.com/gardener/gardener-extension-provider-gcp/pkg/gcp/client"
 )
@@ -121,3 +124,32 @@ func CleanupKubernetesRoutes(ctx context.Context, client gcpclient.ComputeClient
 func GetServiceAccountFromInfrastructure(ctx context.Context, c client.Client, config *extensionsv1alpha1.Infrastructure) (*gcp.ServiceAccount, error) {
 	return gcp.GetServiceAccountFromSecretReference(ctx, c, config.Spec.SecretRef)
 }
+
+// CloudNATWithDefaults returns the CloudNAT configuration of a shoot with the controller-wide defaults applied to the
+// fields which are not configured by the shoot. The dynamic port allocation cannot be distinguished from an unset
+// value, hence its default only applies if the shoot does not configure a CloudNAT at all. Defaults which would
+// conflict with the configuration of the shoot are ignored, e.g. a minimum number of ports which exceeds the maximum.
+func CloudNATWithDefaults(cloudNAT *api.CloudNAT, defaults *config.CloudNAT) *api.CloudNAT {
+	if defaults == nil {
+		return cloudNAT
+	}
+
+	out := &api.CloudNAT{}
+	if cloudNAT != nil {
+		out = cloudNAT.DeepCopy()
+	} else if defaults.EnableDynamicPortAllocation != nil {
+		out.EnableDynamicPortAllocation = *defaults.EnableDynamicPortAllocation
+	}
+
+	if out.MaxPortsPerVM == nil && out.EnableDynamicPortAllocation && defaults.MaxPortsPerVM != nil &&
+		(out.MinPortsPerVM == nil || *out.MinPortsPerVM <= *defaults.MaxPortsPerVM) {
+		out.MaxPortsPerVM = ptr.To(*defaults.MaxPortsPerVM)
+	}
+
+	if out.MinPortsPerVM == nil && defaults.MinPortsPerVM != nil &&
+		(out.MaxPortsPerVM == nil || *defaults.MinPortsPerVM <= *out.MaxPortsPerVM) {
+		out.MinPortsPerVM = ptr.To(*defaults.MinPortsPerVM)
+	}
+
+	return out
+}
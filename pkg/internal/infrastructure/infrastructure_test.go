@@ -12,7 +12,10 @@ import (
 	. "github.com/onsi/gomega"
 	"go.uber.org/mock/gomock"
 	"google.golang.org/api/compute/v1"
+	"k8s.io/utils/ptr"
 
+	"github.com/gardener/gardener-extension-provider-gcp/pkg/apis/config"
+	api "github.com/gardener/gardener-extension-provider-gcp/pkg/apis/gcp"
 	gcpclient "github.com/gardener/gardener-extension-provider-gcp/pkg/gcp/client"
 	mockgcpclient "github.com/gardener/gardener-extension-provider-gcp/pkg/gcp/client/mock"
 )
@@ -155,4 +158,40 @@ var _ = Describe("Infrastructure", func() {
 			Expect(DeleteRoutes(ctx, client, routes)).To(Succeed())
 		})
 	})
+
+	DescribeTable("#CloudNATWithDefaults",
+		func(cloudNAT *api.CloudNAT, defaults *config.CloudNAT, expected *api.CloudNAT) {
+			Expect(CloudNATWithDefaults(cloudNAT, defaults)).To(Equal(expected))
+		},
+		Entry("without defaults",
+			&api.CloudNAT{MinPortsPerVM: ptr.To[int32](128)},
+			nil,
+			&api.CloudNAT{MinPortsPerVM: ptr.To[int32](128)},
+		),
+		Entry("without CloudNAT of the shoot",
+			nil,
+			&config.CloudNAT{MinPortsPerVM: ptr.To[int32](1024), MaxPortsPerVM: ptr.To[int32](4096), EnableDynamicPortAllocation: ptr.To(true)},
+			&api.CloudNAT{MinPortsPerVM: ptr.To[int32](1024), MaxPortsPerVM: ptr.To[int32](4096), EnableDynamicPortAllocation: true},
+		),
+		Entry("with the maximum default and disabled dynamic port allocation",
+			nil,
+			&config.CloudNAT{MinPortsPerVM: ptr.To[int32](1024), MaxPortsPerVM: ptr.To[int32](4096)},
+			&api.CloudNAT{MinPortsPerVM: ptr.To[int32](1024)},
+		),
+		Entry("with fields configured by the shoot",
+			&api.CloudNAT{MinPortsPerVM: ptr.To[int32](256), MaxPortsPerVM: ptr.To[int32](512), EnableDynamicPortAllocation: true},
+			&config.CloudNAT{MinPortsPerVM: ptr.To[int32](1024), MaxPortsPerVM: ptr.To[int32](4096), EnableDynamicPortAllocation: ptr.To(false)},
+			&api.CloudNAT{MinPortsPerVM: ptr.To[int32](256), MaxPortsPerVM: ptr.To[int32](512), EnableDynamicPortAllocation: true},
+		),
+		Entry("with the dynamic port allocation of the shoot",
+			&api.CloudNAT{MinPortsPerVM: ptr.To[int32](256)},
+			&config.CloudNAT{MaxPortsPerVM: ptr.To[int32](4096), EnableDynamicPortAllocation: ptr.To(true)},
+			&api.CloudNAT{MinPortsPerVM: ptr.To[int32](256)},
+		),
+		Entry("with defaults conflicting with the shoot",
+			&api.CloudNAT{MaxPortsPerVM: ptr.To[int32](512), EnableDynamicPortAllocation: true},
+			&config.CloudNAT{MinPortsPerVM: ptr.To[int32](1024)},
+			&api.CloudNAT{MaxPortsPerVM: ptr.To[int32](512), EnableDynamicPortAllocation: true},
+		),
+	)
 })
@@ -188,7 +188,7 @@ resource "google_compute_firewall" "rule-allow-health-checks" {
 
   allow {
     protocol = "tcp"
-    ports    = ["30000-32767"]
+    ports    = ["30000-32767"{{ range .networks.healthCheckPorts }}, "{{ . }}"{{ end }}]
   }
 
   allow {
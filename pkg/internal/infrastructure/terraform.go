@@ -193,6 +193,10 @@ func ComputeTerraformerTemplateValues(
 		values["networks"].(map[string]interface{})["flowLogs"] = fl
 	}
 
+	if config.Networks.HealthChecks != nil && len(config.Networks.HealthChecks.AdditionalPorts) > 0 {
+		values["networks"].(map[string]interface{})["healthCheckPorts"] = config.Networks.HealthChecks.AdditionalPorts
+	}
+
 	return values, nil
 }
 
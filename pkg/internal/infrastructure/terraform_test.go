@@ -426,6 +426,16 @@ var _ = Describe("Terraform", func() {
 			}))
 		})
 
+		It("should correctly compute the terraformer chart values with additional health check ports", func() {
+			config.Networks.HealthChecks = &api.HealthChecks{
+				AdditionalPorts: []string{"8080", "9000-9100"},
+			}
+
+			values, err := ComputeTerraformerTemplateValues(infra, serviceAccount, config, &podCIDR, false)
+			Expect(err).To(BeNil())
+			Expect(values["networks"]).To(HaveKeyWithValue("healthCheckPorts", []string{"8080", "9000-9100"}))
+		})
+
 		It("should correctly compute the terraformer chart values with vpc creation", func() {
 			config.Networks.VPC = nil
 			values, err := ComputeTerraformerTemplateValues(infra, serviceAccount, config, &podCIDR, true)
@@ -7,6 +7,7 @@ package controlplane
 import (
 	"bytes"
 	"context"
+	"fmt"
 	"regexp"
 	"strconv"
 
@@ -16,28 +17,33 @@ import (
 	gcontext "github.com/gardener/gardener/extensions/pkg/webhook/context"
 	"github.com/gardener/gardener/extensions/pkg/webhook/controlplane/genericmutator"
 	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
 	"github.com/gardener/gardener/pkg/component/nodemanagement/machinecontrollermanager"
 	versionutils "github.com/gardener/gardener/pkg/utils/version"
 	"github.com/go-logr/logr"
 	appsv1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/util/sets"
 	vpaautoscalingv1 "k8s.io/autoscaler/vertical-pod-autoscaler/pkg/apis/autoscaling.k8s.io/v1"
 	kubeletconfigv1beta1 "k8s.io/kubelet/config/v1beta1"
 	"k8s.io/utils/ptr"
 
 	"github.com/gardener/gardener-extension-provider-gcp/imagevector"
+	"github.com/gardener/gardener-extension-provider-gcp/pkg/apis/config"
 	"github.com/gardener/gardener-extension-provider-gcp/pkg/gcp"
 )
 
 // NewEnsurer creates a new controlplane ensurer.
-func NewEnsurer(logger logr.Logger) genericmutator.Ensurer {
+func NewEnsurer(node *config.Node, logger logr.Logger) genericmutator.Ensurer {
 	return &ensurer{
+		node:   node,
 		logger: logger.WithName("gcp-controlplane-ensurer"),
 	}
 }
 
 type ensurer struct {
 	genericmutator.NoopEnsurer
+	node   *config.Node
 	logger logr.Logger
 }
 
@@ -375,16 +381,76 @@ func (e *ensurer) EnsureKubernetesGeneralConfiguration(_ context.Context, _ gcon
 	if regexFindProperty.MatchString(*newConf) {
 		res := regexFindProperty.ReplaceAll([]byte(*newConf), []byte("net.ipv4.ip_forward = 1"))
 		*newConf = string(res)
-		return nil
+	} else {
+		// If the property do not exist, append it in the end of the string
+		buf := bytes.Buffer{}
+		buf.WriteString(*newConf)
+		buf.WriteString("\n")
+		buf.WriteString("# GCE specific settings\n")
+		buf.WriteString("net.ipv4.ip_forward = 1")
+		*newConf = buf.String()
 	}
 
-	// If the property do not exist, append it in the end of the string
-	buf := bytes.Buffer{}
-	buf.WriteString(*newConf)
-	buf.WriteString("\n")
-	buf.WriteString("# GCE specific settings\n")
-	buf.WriteString("net.ipv4.ip_forward = 1")
+	e.ensureAdditionalSysctls(newConf)
+	return nil
+}
 
-	*newConf = buf.String()
+// instanceConfigsPath is the path of the configuration file of the Google guest agent.
+const instanceConfigsPath = "/etc/default/instance_configs.cfg"
+
+// EnsureAdditionalFiles ensures that the configuration of the Google guest agent is written to the nodes.
+func (e *ensurer) EnsureAdditionalFiles(_ context.Context, _ gcontext.GardenContext, new, _ *[]extensionsv1alpha1.File) error {
+	*new = extensionswebhook.EnsureFileWithPath(*new, extensionsv1alpha1.File{
+		Path:        instanceConfigsPath,
+		Permissions: ptr.To[uint32](0644),
+		Content: extensionsv1alpha1.FileContent{
+			Inline: &extensionsv1alpha1.FileContentInline{
+				Data: e.instanceConfigs(),
+			},
+		},
+	})
 	return nil
 }
+
+// instanceConfigs renders the configuration of the Google guest agent. The alias IP ranges are not added as local
+// routes by default, the other settings are only rendered if they are configured.
+func (e *ensurer) instanceConfigs() string {
+	var guestAgent config.GuestAgent
+	if e.node != nil && e.node.GuestAgent != nil {
+		guestAgent = *e.node.GuestAgent
+	}
+
+	buf := bytes.Buffer{}
+	buf.WriteString("[IpForwarding]\n")
+	buf.WriteString(fmt.Sprintf("ip_aliases = %t\n", ptr.Deref(guestAgent.IPAliases, false)))
+
+	if guestAgent.IPForwarding != nil || guestAgent.SetupNetwork != nil {
+		buf.WriteString("\n[NetworkInterfaces]\n")
+		if guestAgent.IPForwarding != nil {
+			buf.WriteString(fmt.Sprintf("ip_forwarding = %t\n", *guestAgent.IPForwarding))
+		}
+		if guestAgent.SetupNetwork != nil {
+			buf.WriteString(fmt.Sprintf("setup = %t\n", *guestAgent.SetupNetwork))
+		}
+	}
+
+	return buf.String()
+}
+
+// ensureAdditionalSysctls sets the additional sysctls of the controller configuration. Existing entries of the same
+// sysctls are replaced, so that the configuration stays the same if it is ensured again.
+func (e *ensurer) ensureAdditionalSysctls(conf *string) {
+	if e.node == nil {
+		return
+	}
+
+	for _, key := range sets.List(sets.KeySet(e.node.Sysctls)) {
+		property := fmt.Sprintf("%s = %s", key, e.node.Sysctls[key])
+		regex := regexp.MustCompile(`(?m)^[[:space:]]*` + regexp.QuoteMeta(key) + `[[:space:]]*=.*$`)
+		if regex.MatchString(*conf) {
+			*conf = regex.ReplaceAllLiteralString(*conf, property)
+		} else {
+			*conf += "\n" + property
+		}
+	}
+}
@@ -17,6 +17,7 @@ import (
 	"github.com/gardener/gardener/extensions/pkg/webhook/controlplane/test"
 	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
 	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
 	"github.com/gardener/gardener/pkg/component/nodemanagement/machinecontrollermanager"
 	imagevectorutils "github.com/gardener/gardener/pkg/utils/imagevector"
 	testutils "github.com/gardener/gardener/pkg/utils/test"
@@ -30,6 +31,8 @@ import (
 	vpaautoscalingv1 "k8s.io/autoscaler/vertical-pod-autoscaler/pkg/apis/autoscaling.k8s.io/v1"
 	kubeletconfigv1beta1 "k8s.io/kubelet/config/v1beta1"
 	"k8s.io/utils/ptr"
+
+	"github.com/gardener/gardener-extension-provider-gcp/pkg/apis/config"
 )
 
 const namespace = "test"
@@ -121,7 +124,7 @@ var _ = Describe("Ensurer", func() {
 				},
 			}
 
-			ensurer = NewEnsurer(logger)
+			ensurer = NewEnsurer(&config.Node{}, logger)
 		})
 
 		It("should add missing elements to kube-apiserver deployment (k8s < 1.27)", func() {
@@ -211,7 +214,7 @@ var _ = Describe("Ensurer", func() {
 				},
 			}
 
-			ensurer = NewEnsurer(logger)
+			ensurer = NewEnsurer(&config.Node{}, logger)
 		})
 
 		It("should add missing elements to kube-controller-manager deployment (k8s < 1.27)", func() {
@@ -306,7 +309,7 @@ var _ = Describe("Ensurer", func() {
 				},
 			}
 
-			ensurer = NewEnsurer(logger)
+			ensurer = NewEnsurer(&config.Node{}, logger)
 		})
 
 		It("should add missing elements to kube-scheduler deployment (k8s < 1.27)", func() {
@@ -360,7 +363,7 @@ var _ = Describe("Ensurer", func() {
 				},
 			}
 
-			ensurer = NewEnsurer(logger)
+			ensurer = NewEnsurer(&config.Node{}, logger)
 		})
 
 		It("should add missing elements to cluster-autoscaler deployment (k8s < 1.17)", func() {
@@ -400,7 +403,7 @@ var _ = Describe("Ensurer", func() {
 		)
 
 		BeforeEach(func() {
-			ensurer = NewEnsurer(logger)
+			ensurer = NewEnsurer(&config.Node{}, logger)
 			oldUnitOptions = []*unit.UnitOption{
 				{
 					Section: "Service",
@@ -441,7 +444,7 @@ var _ = Describe("Ensurer", func() {
 		)
 
 		BeforeEach(func() {
-			ensurer = NewEnsurer(logger)
+			ensurer = NewEnsurer(&config.Node{}, logger)
 			oldKubeletConfig = &kubeletconfigv1beta1.KubeletConfiguration{
 				FeatureGates: map[string]bool{
 					"Foo": true,
@@ -480,7 +483,7 @@ var _ = Describe("Ensurer", func() {
 		var ensurer genericmutator.Ensurer
 
 		BeforeEach(func() {
-			ensurer = NewEnsurer(logger)
+			ensurer = NewEnsurer(&config.Node{}, logger)
 		})
 
 		It("should modify existing elements of kubernetes general configuration", func() {
@@ -516,6 +519,89 @@ var _ = Describe("Ensurer", func() {
 			Expect(err).To(Not(HaveOccurred()))
 			Expect(*data).To(Equal(result))
 		})
+
+		It("should merge the additional sysctls into the kubernetes general configuration", func() {
+			ensurer = NewEnsurer(&config.Node{Sysctls: map[string]string{
+				"net.ipv4.tcp_keepalive_time": "600",
+				"net.core.wmem_max":           "33554432",
+			}}, logger)
+
+			var (
+				data = ptr.To("# Default Socket Send Buffer\n" +
+					"net.core.wmem_max = 16777216")
+				result = "# Default Socket Send Buffer\n" +
+					"net.core.wmem_max = 33554432\n" +
+					"# GCE specific settings\n" +
+					"net.ipv4.ip_forward = 1\n" +
+					"net.ipv4.tcp_keepalive_time = 600"
+			)
+
+			Expect(ensurer.EnsureKubernetesGeneralConfiguration(ctx, dummyContext, data, nil)).To(Succeed())
+			Expect(*data).To(Equal(result))
+
+			// ensuring the configuration again must not change it
+			Expect(ensurer.EnsureKubernetesGeneralConfiguration(ctx, dummyContext, data, nil)).To(Succeed())
+			Expect(*data).To(Equal(result))
+		})
+	})
+
+	Describe("#EnsureAdditionalFiles", func() {
+		var existingFile = extensionsv1alpha1.File{Path: "/etc/foo"}
+
+		instanceConfigs := func(files []extensionsv1alpha1.File) string {
+			for _, file := range files {
+				if file.Path == "/etc/default/instance_configs.cfg" {
+					Expect(file.Permissions).To(Equal(ptr.To[uint32](0644)))
+					return file.Content.Inline.Data
+				}
+			}
+			Fail("instance_configs.cfg not found")
+			return ""
+		}
+
+		It("should disable the IP aliases of the guest agent by default", func() {
+			files := []extensionsv1alpha1.File{existingFile}
+
+			Expect(NewEnsurer(&config.Node{}, logger).EnsureAdditionalFiles(ctx, dummyContext, &files, nil)).To(Succeed())
+			Expect(files).To(HaveLen(2))
+			Expect(files[0]).To(Equal(existingFile))
+			Expect(instanceConfigs(files)).To(Equal("[IpForwarding]\n" +
+				"ip_aliases = false\n"))
+		})
+
+		It("should render the configured network settings of the guest agent", func() {
+			files := []extensionsv1alpha1.File{existingFile}
+			ensurer := NewEnsurer(&config.Node{GuestAgent: &config.GuestAgent{
+				IPAliases:    ptr.To(true),
+				IPForwarding: ptr.To(true),
+				SetupNetwork: ptr.To(false),
+			}}, logger)
+
+			Expect(ensurer.EnsureAdditionalFiles(ctx, dummyContext, &files, nil)).To(Succeed())
+			// ensuring the files again must not add the file twice
+			Expect(ensurer.EnsureAdditionalFiles(ctx, dummyContext, &files, nil)).To(Succeed())
+			Expect(files).To(HaveLen(2))
+			Expect(instanceConfigs(files)).To(Equal("[IpForwarding]\n" +
+				"ip_aliases = true\n" +
+				"\n" +
+				"[NetworkInterfaces]\n" +
+				"ip_forwarding = true\n" +
+				"setup = false\n"))
+		})
+
+		It("should only render the configured settings of the network interfaces", func() {
+			files := []extensionsv1alpha1.File{}
+			ensurer := NewEnsurer(&config.Node{GuestAgent: &config.GuestAgent{
+				IPForwarding: ptr.To(false),
+			}}, logger)
+
+			Expect(ensurer.EnsureAdditionalFiles(ctx, dummyContext, &files, nil)).To(Succeed())
+			Expect(instanceConfigs(files)).To(Equal("[IpForwarding]\n" +
+				"ip_aliases = false\n" +
+				"\n" +
+				"[NetworkInterfaces]\n" +
+				"ip_forwarding = false\n"))
+		})
 	})
 
 	Describe("#EnsureMachineControllerManagerDeployment", func() {
@@ -526,7 +612,7 @@ var _ = Describe("Ensurer", func() {
 
 		BeforeEach(func() {
 			deployment = &appsv1.Deployment{ObjectMeta: metav1.ObjectMeta{Namespace: "foo"}}
-			ensurer = NewEnsurer(logger)
+			ensurer = NewEnsurer(&config.Node{}, logger)
 			DeferCleanup(testutils.WithVar(&ImageVector, imagevectorutils.ImageVector{{
 				Name:       "machine-controller-manager-provider-gcp",
 				Repository: ptr.To("foo"),
@@ -549,7 +635,7 @@ var _ = Describe("Ensurer", func() {
 		)
 
 		BeforeEach(func() {
-			ensurer = NewEnsurer(logger)
+			ensurer = NewEnsurer(&config.Node{}, logger)
 			vpa = &vpaautoscalingv1.VerticalPodAutoscaler{}
 		})
 
@@ -8,9 +8,12 @@ import (
 	extensionswebhook "github.com/gardener/gardener/extensions/pkg/webhook"
 	"github.com/gardener/gardener/extensions/pkg/webhook/shoot"
 	corev1 "k8s.io/api/core/v1"
+	storagev1 "k8s.io/api/storage/v1"
 	"k8s.io/utils/ptr"
 	"sigs.k8s.io/controller-runtime/pkg/log"
 	"sigs.k8s.io/controller-runtime/pkg/manager"
+
+	"github.com/gardener/gardener-extension-provider-gcp/pkg/apis/config"
 )
 
 var (
@@ -19,18 +22,26 @@ var (
 )
 
 // AddOptions are options to apply when adding the GCP shoot webhook to the manager.
-type AddOptions struct{}
+type AddOptions struct {
+	// StorageClasses is the configuration of the mutation of the storage classes in the shoot clusters.
+	StorageClasses config.StorageClasses
+}
 
 var logger = log.Log.WithName("gcp-shoot-webhook")
 
 // AddToManagerWithOptions creates a webhook with the given options and adds it to the manager.
-func AddToManagerWithOptions(mgr manager.Manager, _ AddOptions) (*extensionswebhook.Webhook, error) {
+func AddToManagerWithOptions(mgr manager.Manager, opts AddOptions) (*extensionswebhook.Webhook, error) {
 	logger.Info("Adding webhook to manager")
+	types := []extensionswebhook.Type{
+		{Obj: &corev1.Node{}, Subresource: ptr.To("status")},
+	}
+	if ptr.Deref(opts.StorageClasses.WaitForFirstConsumer, false) {
+		types = append(types, extensionswebhook.Type{Obj: &storagev1.StorageClass{}})
+	}
+
 	return shoot.New(mgr, shoot.Args{
-		Types: []extensionswebhook.Type{
-			{Obj: &corev1.Node{}, Subresource: ptr.To("status")},
-		},
-		Mutator: NewMutator(),
+		Types:   types,
+		Mutator: NewMutator(opts.StorageClasses),
 	})
 }
 
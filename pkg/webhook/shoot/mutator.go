@@ -11,19 +11,24 @@ import (
 	extensionswebhook "github.com/gardener/gardener/extensions/pkg/webhook"
 	"github.com/go-logr/logr"
 	corev1 "k8s.io/api/core/v1"
+	storagev1 "k8s.io/api/storage/v1"
 	"k8s.io/apimachinery/pkg/api/meta"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	"github.com/gardener/gardener-extension-provider-gcp/pkg/apis/config"
 )
 
 type mutator struct {
-	logger logr.Logger
+	logger         logr.Logger
+	storageClasses config.StorageClasses
 }
 
 // NewMutator creates a new Mutator that mutates resources in the shoot cluster.
-func NewMutator() extensionswebhook.Mutator {
+func NewMutator(storageClasses config.StorageClasses) extensionswebhook.Mutator {
 	return &mutator{
-		logger: log.Log.WithName("shoot-mutator"),
+		logger:         log.Log.WithName("shoot-mutator"),
+		storageClasses: storageClasses,
 	}
 }
 
@@ -46,6 +51,13 @@ func (m *mutator) Mutate(ctx context.Context, newObj, oldObj client.Object) erro
 				extensionswebhook.LogMutation(logger, x.Kind, x.Namespace, x.Name)
 			})
 		}
+	case *storagev1.StorageClass:
+		// the volume binding mode is immutable, hence only new storage classes are mutated.
+		if oldObj == nil {
+			return m.mutateStorageClassVolumeBindingMode(ctx, x, func() {
+				extensionswebhook.LogMutation(logger, x.Kind, x.Namespace, x.Name)
+			})
+		}
 	}
 	return nil
 }
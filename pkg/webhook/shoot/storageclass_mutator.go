@@ -0,0 +1,34 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package shoot
+
+import (
+	"context"
+
+	storagev1 "k8s.io/api/storage/v1"
+	"k8s.io/utils/ptr"
+
+	"github.com/gardener/gardener-extension-provider-gcp/pkg/gcp"
+)
+
+// mutateStorageClassVolumeBindingMode switches storage classes of the GCP PD CSI driver with the `Immediate` volume
+// binding mode to `WaitForFirstConsumer` if it is enabled. Otherwise, zonal disks are provisioned in an arbitrary zone
+// of the region and the pods using them may not be schedulable.
+func (m *mutator) mutateStorageClassVolumeBindingMode(
+	_ context.Context,
+	storageClass *storagev1.StorageClass,
+	logMutation func()) error {
+	if !ptr.Deref(m.storageClasses.WaitForFirstConsumer, false) || storageClass.Provisioner != gcp.CSIStorageProvisioner {
+		return nil
+	}
+	// the API server defaults an unset volume binding mode to `Immediate` before the webhook is called.
+	if storageClass.VolumeBindingMode != nil && *storageClass.VolumeBindingMode != storagev1.VolumeBindingImmediate {
+		return nil
+	}
+
+	logMutation()
+	storageClass.VolumeBindingMode = ptr.To(storagev1.VolumeBindingWaitForFirstConsumer)
+	return nil
+}
@@ -0,0 +1,64 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package shoot
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gstruct"
+	storagev1 "k8s.io/api/storage/v1"
+	"k8s.io/utils/ptr"
+
+	"github.com/gardener/gardener-extension-provider-gcp/pkg/apis/config"
+)
+
+var _ = Describe("StorageClass mutator", func() {
+	var (
+		ctx          = context.TODO()
+		m            *mutator
+		storageClass *storagev1.StorageClass
+	)
+
+	BeforeEach(func() {
+		m = &mutator{storageClasses: config.StorageClasses{WaitForFirstConsumer: ptr.To(true)}}
+		storageClass = &storagev1.StorageClass{
+			Provisioner:       "pd.csi.storage.gke.io",
+			VolumeBindingMode: ptr.To(storagev1.VolumeBindingImmediate),
+		}
+	})
+
+	It("should switch new storage classes to WaitForFirstConsumer", func() {
+		Expect(m.Mutate(ctx, storageClass, nil)).To(Succeed())
+		Expect(storageClass.VolumeBindingMode).To(PointTo(Equal(storagev1.VolumeBindingWaitForFirstConsumer)))
+	})
+
+	It("should be idempotent", func() {
+		Expect(m.Mutate(ctx, storageClass, nil)).To(Succeed())
+		expected := storageClass.DeepCopy()
+		Expect(m.Mutate(ctx, storageClass, nil)).To(Succeed())
+		Expect(storageClass).To(Equal(expected))
+	})
+
+	It("should not mutate existing storage classes", func() {
+		Expect(m.Mutate(ctx, storageClass, storageClass.DeepCopy())).To(Succeed())
+		Expect(storageClass.VolumeBindingMode).To(PointTo(Equal(storagev1.VolumeBindingImmediate)))
+	})
+
+	It("should not mutate storage classes of other provisioners", func() {
+		storageClass.Provisioner = "kubernetes.io/no-provisioner"
+
+		Expect(m.Mutate(ctx, storageClass, nil)).To(Succeed())
+		Expect(storageClass.VolumeBindingMode).To(PointTo(Equal(storagev1.VolumeBindingImmediate)))
+	})
+
+	It("should not mutate storage classes if it is disabled", func() {
+		m.storageClasses.WaitForFirstConsumer = nil
+
+		Expect(m.Mutate(ctx, storageClass, nil)).To(Succeed())
+		Expect(storageClass.VolumeBindingMode).To(PointTo(Equal(storagev1.VolumeBindingImmediate)))
+	})
+})
@@ -43,21 +43,35 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/manager"
 	"sigs.k8s.io/controller-runtime/pkg/metrics/server"
 
+	"github.com/gardener/gardener-extension-provider-gcp/pkg/apis/config"
 	api "github.com/gardener/gardener-extension-provider-gcp/pkg/apis/gcp"
 	gcpinstall "github.com/gardener/gardener-extension-provider-gcp/pkg/apis/gcp/install"
 	gcpv1alpha1 "github.com/gardener/gardener-extension-provider-gcp/pkg/apis/gcp/v1alpha1"
 	bastionctrl "github.com/gardener/gardener-extension-provider-gcp/pkg/controller/bastion"
 	"github.com/gardener/gardener-extension-provider-gcp/pkg/gcp"
+	gcpclient "github.com/gardener/gardener-extension-provider-gcp/pkg/gcp/client"
 )
 
 const (
 	imageName         = "gardenLinux"
 	workersSubnetCIDR = "10.250.0.0/16"
-	userDataConst     = "#!/bin/bash -eu"
+	// managementSubnetCIDR is the range of the subnet in which the bastion is placed instead of the nodes subnet.
+	managementSubnetCIDR = "10.251.0.0/24"
+	userDataConst        = "#!/bin/bash -eu"
 )
 
 var (
 	myPublicIP = ""
+	// myPublicIPv6 is the /64 network of the public IPv6 address of the test runner, it is empty if the test runner has
+	// no IPv6 connectivity.
+	myPublicIPv6 = ""
+
+	// bastionConfig is the configuration of the bastion controller, it overrides the shape of the boot disk and places
+	// the bastion in the management subnet.
+	bastionConfig = config.Bastion{
+		DiskSizeGB: ptr.To[int64](20),
+		DiskType:   ptr.To("pd-balanced"),
+	}
 
 	serviceAccount = flag.String("service-account", "", "Service account containing credentials for the GCP API")
 	region         = flag.String("region", "", "GCP region")
@@ -90,10 +104,11 @@ var (
 	options   *bastionctrl.Options
 	bastion   *extensionsv1alpha1.Bastion
 
-	name       string
-	vNetName   string
-	routerName string
-	subnetName string
+	name                 string
+	vNetName             string
+	routerName           string
+	subnetName           string
+	managementSubnetName string
 )
 
 var _ = BeforeSuite(func() {
@@ -125,9 +140,15 @@ var _ = BeforeSuite(func() {
 	vNetName = name
 	routerName = vNetName + "-cloud-router"
 	subnetName = vNetName + "-nodes"
+	managementSubnetName = vNetName + "-management"
+	bastionConfig.Subnet = ptr.To(managementSubnetName)
 
-	myPublicIP, err = getMyPublicIPWithMask()
+	myPublicIP, err = getMyPublicIPWithMask("https://api.ipify.org")
 	Expect(err).ToNot(HaveOccurred())
+	if myPublicIPv6, err = getMyPublicIPWithMask("https://api6.ipify.org"); err != nil {
+		log.Info("Skipping IPv6 connectivity checks, no public IPv6 address found", "error", err.Error())
+		myPublicIPv6 = ""
+	}
 
 	By("starting test environment")
 	testEnv = &envtest.Environment{
@@ -156,7 +177,10 @@ var _ = BeforeSuite(func() {
 	Expect(extensionsv1alpha1.AddToScheme(mgr.GetScheme())).To(Succeed())
 	Expect(gcpinstall.AddToScheme(mgr.GetScheme())).To(Succeed())
 
-	Expect(bastionctrl.AddToManager(ctx, mgr)).To(Succeed())
+	bastionAddOptions := bastionctrl.DefaultAddOptions
+	bastionAddOptions.Bastion = bastionConfig
+	bastionAddOptions.GCPClientFactory = gcpclient.New(gcpclient.DefaultOptions())
+	Expect(bastionctrl.AddToManagerWithOptions(mgr, bastionAddOptions)).To(Succeed())
 
 	var mgrContext context.Context
 	mgrContext, mgrCancel = context.WithCancel(ctx)
@@ -186,7 +210,7 @@ var _ = BeforeSuite(func() {
 
 	extensionscluster, controllercluster = createClusters(name)
 	worker = createWorker(name, vNetName, subnetName)
-	bastion, options = createBastion(controllercluster, name, project, vNetName, subnetName)
+	bastion, options = createBastion(controllercluster, name, project, vNetName, managementSubnetName)
 
 	secret = &corev1.Secret{
 		ObjectMeta: metav1.ObjectMeta{
@@ -202,10 +226,10 @@ var _ = BeforeSuite(func() {
 var _ = Describe("Bastion tests", func() {
 	It("should successfully create and delete", func() {
 		By("setup Infrastructure")
-		err := prepareNewNetwork(ctx, log, project, computeService, vNetName, routerName, subnetName)
+		err := prepareNewNetwork(ctx, log, project, computeService, vNetName, routerName, subnetName, managementSubnetName)
 		Expect(err).NotTo(HaveOccurred())
 		framework.AddCleanupAction(func() {
-			err = teardownNetwork(ctx, log, project, computeService, vNetName, routerName, subnetName)
+			err = teardownNetwork(ctx, log, project, computeService, vNetName, routerName, subnetName, managementSubnetName)
 			Expect(err).NotTo(HaveOccurred())
 		})
 
@@ -242,6 +266,7 @@ var _ = Describe("Bastion tests", func() {
 		time.Sleep(60 * time.Second)
 		verifyPort22IsOpen(ctx, c, bastion)
 		verifyPort42IsClosed(ctx, c, bastion)
+		verifyPort22IsOpenViaIPv6(ctx, project, computeService, options)
 
 		By("verify cloud resources")
 		verifyCreation(ctx, project, computeService, options)
@@ -260,6 +285,23 @@ func verifyPort22IsOpen(ctx context.Context, c client.Client, bastion *extension
 	Expect(conn).NotTo(BeNil())
 }
 
+func verifyPort22IsOpenViaIPv6(ctx context.Context, project string, computeService *compute.Service, options *bastionctrl.Options) {
+	if myPublicIPv6 == "" {
+		return
+	}
+
+	By("check connection to port 22 via IPv6 open should not error")
+
+	instance, err := computeService.Instances.Get(project, options.Zone, options.BastionInstanceName).Context(ctx).Do()
+	Expect(err).NotTo(HaveOccurred())
+	Expect(instance.NetworkInterfaces[0].Ipv6AccessConfigs).NotTo(BeEmpty())
+
+	address := net.JoinHostPort(instance.NetworkInterfaces[0].Ipv6AccessConfigs[0].ExternalIpv6, "22")
+	conn, err := net.DialTimeout("tcp6", address, 60*time.Second)
+	Expect(err).ShouldNot(HaveOccurred())
+	Expect(conn).NotTo(BeNil())
+}
+
 func verifyPort42IsClosed(ctx context.Context, c client.Client, bastion *extensionsv1alpha1.Bastion) {
 	By("check connection to port 42 which should fail")
 
@@ -273,14 +315,14 @@ func verifyPort42IsClosed(ctx context.Context, c client.Client, bastion *extensi
 	Expect(conn).To(BeNil())
 }
 
-func prepareNewNetwork(ctx context.Context, log logr.Logger, project string, computeService *compute.Service, networkName string, routerName string, subnetName string) error {
+func prepareNewNetwork(ctx context.Context, log logr.Logger, project string, computeService *compute.Service, networkName string, routerName string, subnetName string, managementSubnetName string) error {
 	network := &compute.Network{
 		Name:                  networkName,
 		AutoCreateSubnetworks: false,
 		RoutingConfig: &compute.NetworkRoutingConfig{
 			RoutingMode: "REGIONAL",
 		},
-		Subnetworks:     []string{subnetName},
+		Subnetworks:     []string{subnetName, managementSubnetName},
 		ForceSendFields: []string{"AutoCreateSubnetworks"},
 	}
 	networkOp, err := computeService.Networks.Insert(project, network).Context(ctx).Do()
@@ -310,6 +352,25 @@ func prepareNewNetwork(ctx context.Context, log logr.Logger, project string, com
 		return err
 	}
 
+	managementSubnet := &compute.Subnetwork{
+		Name:        managementSubnetName,
+		Region:      *region,
+		Network:     fmt.Sprintf("projects/%s/global/networks/%s", project, networkName),
+		IpCidrRange: managementSubnetCIDR,
+		// the management subnet is dual-stack, so that IPv6 clients can connect to the bastion
+		StackType:      "IPV4_IPV6",
+		Ipv6AccessType: "EXTERNAL",
+	}
+
+	managementSubnetOp, err := computeService.Subnetworks.Insert(project, *region, managementSubnet).Context(ctx).Do()
+	if err != nil {
+		return err
+	}
+	log.Info("Waiting until management subnet is created...", "subnet ", managementSubnetName)
+	if err := waitForOperation(ctx, project, computeService, managementSubnetOp); err != nil {
+		return err
+	}
+
 	router := &compute.Router{
 		Name:    routerName,
 		Network: networkOp.TargetLink,
@@ -328,7 +389,7 @@ func prepareNewNetwork(ctx context.Context, log logr.Logger, project string, com
 	return nil
 }
 
-func teardownNetwork(ctx context.Context, log logr.Logger, project string, computeService *compute.Service, networkName string, routerName string, subnetName string) error {
+func teardownNetwork(ctx context.Context, log logr.Logger, project string, computeService *compute.Service, networkName string, routerName string, subnetName string, managementSubnetName string) error {
 
 	routerOp, err := computeService.Routers.Delete(project, *region, routerName).Context(ctx).Do()
 	if err != nil {
@@ -350,6 +411,16 @@ func teardownNetwork(ctx context.Context, log logr.Logger, project string, compu
 		return err
 	}
 
+	managementSubnetOp, err := computeService.Subnetworks.Delete(project, *region, managementSubnetName).Context(ctx).Do()
+	if err != nil {
+		return err
+	}
+
+	log.Info("Waiting until management subnet is deleted...", "subnet ", managementSubnetName)
+	if err := waitForOperation(ctx, project, computeService, managementSubnetOp); err != nil {
+		return err
+	}
+
 	networkOp, err := computeService.Networks.Delete(project, networkName).Context(ctx).Do()
 	if err != nil {
 		return err
@@ -365,8 +436,11 @@ func teardownNetwork(ctx context.Context, log logr.Logger, project string, compu
 	return nil
 }
 
+// operationPollBackoff polls operations frequently at first and backs off for slow operations.
+var operationPollBackoff = wait.Backoff{Duration: time.Second, Factor: 2, Steps: 4, Cap: 10 * time.Second}
+
 func waitForOperation(ctx context.Context, project string, computeService *compute.Service, op *compute.Operation) error {
-	return wait.PollUntilContextCancel(ctx, 5*time.Second, false, func(_ context.Context) (bool, error) {
+	return operationPollBackoff.DelayFunc().Until(ctx, false, false, func(_ context.Context) (bool, error) {
 		var (
 			currentOp *compute.Operation
 			err       error
@@ -410,7 +484,13 @@ func createBastion(cluster *controller.Cluster, name, project, vNet, subnet stri
 		},
 	}
 
-	options, err := bastionctrl.DetermineOptions(bastion, cluster, project, vNet, subnet)
+	if myPublicIPv6 != "" {
+		bastion.Spec.Ingress = append(bastion.Spec.Ingress, extensionsv1alpha1.BastionIngressPolicy{
+			IPBlock: networkingv1.IPBlock{CIDR: myPublicIPv6},
+		})
+	}
+
+	options, err := bastionctrl.DetermineOptions(bastion, cluster, project, vNet, subnet, bastionConfig)
 	Expect(err).NotTo(HaveOccurred())
 
 	return bastion, options
@@ -609,6 +689,14 @@ func verifyCreation(ctx context.Context, project string, computeService *compute
 	Expect(firewall.Allowed[0].Ports[0]).To(Equal("22"))
 	Expect(firewall.SourceRanges[0]).To(Equal(myPublicIP))
 
+	if myPublicIPv6 != "" {
+		By("checking Firewall-allow-ssh-ipv6 rule SSHPortOpen,Public IPv6 Source Ranges")
+		firewall, err = computeService.Firewalls.Get(project, bastionctrl.FirewallIngressAllowSSHIPv6ResourceName(options.BastionInstanceName)).Context(ctx).Do()
+		Expect(err).NotTo(HaveOccurred())
+		Expect(firewall.Allowed[0].Ports[0]).To(Equal("22"))
+		Expect(firewall.SourceRanges).To(ConsistOf(myPublicIPv6))
+	}
+
 	By("checking Firewall-deny-all rule")
 	firewall, err = computeService.Firewalls.Get(project, bastionctrl.FirewallEgressDenyAllResourceName(options.BastionInstanceName)).Context(ctx).Do()
 	Expect(ignoreNotFoundError(err)).NotTo(HaveOccurred())
@@ -625,6 +713,11 @@ func verifyCreation(ctx context.Context, project string, computeService *compute
 	createdInstance, err := computeService.Instances.Get(project, options.Zone, options.BastionInstanceName).Context(ctx).Do()
 	Expect(err).NotTo(HaveOccurred())
 	Expect(createdInstance.Name).To(Equal(options.BastionInstanceName))
+	Expect(createdInstance.MachineType).To(HaveSuffix("/machineTypes/" + options.MachineName))
+
+	By("checking bastion is placed in the management subnet")
+	Expect(createdInstance.NetworkInterfaces[0].Subnetwork).To(HaveSuffix("/subnetworks/" + managementSubnetName))
+	Expect(options.WorkersCIDR).To(Equal(workersSubnetCIDR))
 
 	By("checking bastion ingress IPs exist")
 	// bastion ingress IPs exist
@@ -639,6 +732,8 @@ func verifyCreation(ctx context.Context, project string, computeService *compute
 	createdDisk, err := computeService.Disks.Get(project, options.Zone, bastionctrl.DiskResourceName(options.BastionInstanceName)).Context(ctx).Do()
 	Expect(ignoreNotFoundError(err)).NotTo(HaveOccurred())
 	Expect(createdDisk.Name).To(Equal(bastionctrl.DiskResourceName(options.BastionInstanceName)))
+	Expect(createdDisk.SizeGb).To(Equal(*bastionConfig.DiskSizeGB))
+	Expect(createdDisk.Type).To(HaveSuffix("/diskTypes/" + *bastionConfig.DiskType))
 
 	By("checking userData matches the constant")
 	// userdata ssh-public-key validation
@@ -649,6 +744,7 @@ func verifyDeletion(ctx context.Context, project string, computeService *compute
 	// bastion firewalls should be gone
 	// Check Firewall for Ingress / Egress
 	checkFirewallDoesNotExist(ctx, project, computeService, bastionctrl.FirewallIngressAllowSSHResourceName(options.BastionInstanceName))
+	checkFirewallDoesNotExist(ctx, project, computeService, bastionctrl.FirewallIngressAllowSSHIPv6ResourceName(options.BastionInstanceName))
 	checkFirewallDoesNotExist(ctx, project, computeService, bastionctrl.FirewallEgressAllowOnlyResourceName(options.BastionInstanceName))
 	checkFirewallDoesNotExist(ctx, project, computeService, bastionctrl.FirewallEgressDenyAllResourceName(options.BastionInstanceName))
 
@@ -698,8 +794,8 @@ func teardownShootEnvironment(ctx context.Context, c client.Client, namespace *c
 	Expect(client.IgnoreNotFound(c.Delete(ctx, namespace))).To(Succeed())
 }
 
-func getMyPublicIPWithMask() (string, error) {
-	resp, err := http.Get("https://api.ipify.org")
+func getMyPublicIPWithMask(url string) (string, error) {
+	resp, err := http.Get(url)
 
 	if err != nil {
 		return "", err
@@ -719,10 +815,13 @@ func getMyPublicIPWithMask() (string, error) {
 
 	ip := net.ParseIP(string(body))
 	var mask net.IPMask
+	if ip == nil {
+		return "", fmt.Errorf("not a valid IP address: %q", string(body))
+	}
 	if ip.To4() != nil {
 		mask = net.CIDRMask(24, 32) // use a /24 net for IPv4
 	} else {
-		return "", fmt.Errorf("not valid IPv4 address")
+		mask = net.CIDRMask(64, 128) // use a /64 net for IPv6, i.e. the network of the client
 	}
 
 	cidr := net.IPNet{
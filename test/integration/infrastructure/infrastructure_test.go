@@ -12,6 +12,7 @@ import (
 	"fmt"
 	"net/http"
 	"path/filepath"
+	"strconv"
 	"strings"
 	"time"
 
@@ -59,9 +60,11 @@ import (
 )
 
 const (
-	workersSubnetCIDR  = "10.250.0.0/19"
-	internalSubnetCIDR = "10.250.112.0/22"
-	podCIDR            = "100.96.0.0/11"
+	workersSubnetCIDR   = "10.250.0.0/19"
+	internalSubnetCIDR  = "10.250.112.0/22"
+	proxyOnlySubnetCIDR = "10.251.0.0/23"
+	psaRangeAddress     = "10.252.0.0"
+	podCIDR             = "100.96.0.0/11"
 
 	reconcilerUseTF     string = "tf"
 	reconcilerMigrateTF string = "migrate"
@@ -168,6 +171,7 @@ var _ = BeforeSuite(func() {
 		Controller: controller.Options{
 			MaxConcurrentReconciles: 5,
 		},
+		GCPClientFactory: gcpclient.New(gcpclient.DefaultOptions()),
 	})).To(Succeed())
 
 	var mgrContext context.Context
@@ -213,6 +217,132 @@ var _ = Describe("Infrastructure tests", func() {
 		})
 	})
 
+	Context("with infrastructure that extends the health check firewall", func() {
+		AfterEach(func() {
+			framework.RunCleanupActions()
+		})
+
+		It("should successfully create and delete", func() {
+			providerConfig := newProviderConfig(nil, nil)
+			providerConfig.Networks.HealthChecks = &gcpv1alpha1.HealthChecks{
+				AdditionalPorts: []string{"8080", "9000-9100"},
+			}
+
+			namespace, err := generateNamespaceName()
+			Expect(err).NotTo(HaveOccurred())
+
+			err = runTest(ctx, c, namespace, providerConfig, project, computeService, iamService)
+			Expect(err).NotTo(HaveOccurred())
+		})
+	})
+
+	Context("with infrastructure that disables the cloud nat", func() {
+		AfterEach(func() {
+			framework.RunCleanupActions()
+		})
+
+		It("should successfully create and delete", func() {
+			if *reconciler != reconcilerUseFlow {
+				Skip("disabling the CloudNAT is only supported by the flow reconciler")
+			}
+
+			providerConfig := newProviderConfig(nil, nil)
+			providerConfig.Networks.DisableCloudNAT = ptr.To(true)
+
+			namespace, err := generateNamespaceName()
+			Expect(err).NotTo(HaveOccurred())
+
+			err = runTest(ctx, c, namespace, providerConfig, project, computeService, iamService)
+			Expect(err).NotTo(HaveOccurred())
+		})
+	})
+
+	Context("with infrastructure that creates a proxy-only subnet", func() {
+		AfterEach(func() {
+			framework.RunCleanupActions()
+		})
+
+		It("should successfully create and delete", func() {
+			if *reconciler != reconcilerUseFlow {
+				Skip("proxy-only subnets are only supported by the flow reconciler")
+			}
+
+			providerConfig := newProviderConfig(nil, nil)
+			providerConfig.Networks.ProxyOnly = ptr.To(proxyOnlySubnetCIDR)
+
+			namespace, err := generateNamespaceName()
+			Expect(err).NotTo(HaveOccurred())
+
+			err = runTest(ctx, c, namespace, providerConfig, project, computeService, iamService)
+			Expect(err).NotTo(HaveOccurred())
+		})
+	})
+
+	Context("with infrastructure that configures private service access", func() {
+		AfterEach(func() {
+			framework.RunCleanupActions()
+		})
+
+		It("should successfully create and delete", func() {
+			if *reconciler != reconcilerUseFlow {
+				Skip("private service access is only supported by the flow reconciler")
+			}
+
+			namespace, err := generateNamespaceName()
+			Expect(err).NotTo(HaveOccurred())
+
+			providerConfig := newProviderConfig(nil, nil)
+			providerConfig.Networks.PrivateServiceAccess = &gcpv1alpha1.PSAConfig{
+				Name:         namespace + "-psa",
+				PrefixLength: 20,
+				Address:      ptr.To(psaRangeAddress),
+			}
+
+			err = runTest(ctx, c, namespace, providerConfig, project, computeService, iamService)
+			Expect(err).NotTo(HaveOccurred())
+		})
+	})
+
+	Context("with infrastructure that enables endpoint independent mapping", func() {
+		AfterEach(func() {
+			framework.RunCleanupActions()
+		})
+
+		It("should successfully create and delete", func() {
+			providerConfig := newProviderConfig(nil, &gcpv1alpha1.CloudNAT{
+				EndpointIndependentMapping: &gcpv1alpha1.EndpointIndependentMapping{Enabled: true},
+			})
+
+			namespace, err := generateNamespaceName()
+			Expect(err).NotTo(HaveOccurred())
+
+			err = runTest(ctx, c, namespace, providerConfig, project, computeService, iamService)
+			Expect(err).NotTo(HaveOccurred())
+		})
+	})
+
+	Context("with infrastructure that configures the cloud nat timeouts", func() {
+		AfterEach(func() {
+			framework.RunCleanupActions()
+		})
+
+		It("should successfully create and delete", func() {
+			providerConfig := newProviderConfig(nil, &gcpv1alpha1.CloudNAT{
+				IcmpIdleTimeoutSec:           ptr.To[int32](60),
+				TcpEstablishedIdleTimeoutSec: ptr.To[int32](3600),
+				TcpTimeWaitTimeoutSec:        ptr.To[int32](60),
+				TcpTransitoryIdleTimeoutSec:  ptr.To[int32](60),
+				UdpIdleTimeoutSec:            ptr.To[int32](60),
+			})
+
+			namespace, err := generateNamespaceName()
+			Expect(err).NotTo(HaveOccurred())
+
+			err = runTest(ctx, c, namespace, providerConfig, project, computeService, iamService)
+			Expect(err).NotTo(HaveOccurred())
+		})
+	})
+
 	Context("with infrastructure that uses existing vpc, cloud router and cloud nat", func() {
 		AfterEach(func() {
 			framework.RunCleanupActions()
@@ -700,8 +830,11 @@ func teardownIPAddresses(ctx context.Context, logger logr.Logger, project string
 	return nil
 }
 
+// operationPollBackoff polls operations frequently at first and backs off for slow operations.
+var operationPollBackoff = wait.Backoff{Duration: time.Second, Factor: 2, Steps: 4, Cap: 10 * time.Second}
+
 func waitForOperation(ctx context.Context, project string, computeService *computev1.Service, op *computev1.Operation) error {
-	return wait.PollUntilContextCancel(ctx, 5*time.Second, false, func(_ context.Context) (bool, error) {
+	return operationPollBackoff.DelayFunc().Until(ctx, false, false, func(_ context.Context) (bool, error) {
 		var (
 			currentOp *computev1.Operation
 			err       error
@@ -747,7 +880,18 @@ func verifyCreation(
 	network, err := computeService.Networks.Get(project, infra.Namespace).Do()
 	Expect(err).NotTo(HaveOccurred())
 	Expect(network.AutoCreateSubnetworks).To(BeFalse())
-	Expect(network.Subnetworks).To(HaveLen(2))
+	expectedSubnets := 2
+	if providerConfig.Networks.ProxyOnly != nil {
+		expectedSubnets++
+	}
+	Expect(network.Subnetworks).To(HaveLen(expectedSubnets))
+
+	if infra.Annotations[gcp.AnnotationKeyUseFlow] == "true" {
+		status := &gcpv1alpha1.InfrastructureStatus{}
+		Expect(json.Unmarshal(infra.Status.ProviderStatus.Raw, status)).To(Succeed())
+		Expect(status.Networks.VPC.SelfLink).To(Equal(network.SelfLink))
+		Expect(status.Networks.VPC.ID).To(Equal(strconv.FormatUint(network.Id, 10)))
+	}
 
 	// subnets
 
@@ -765,53 +909,96 @@ func verifyCreation(
 	Expect(subnetInternal.Network).To(Equal(network.SelfLink))
 	Expect(subnetInternal.IpCidrRange).To(Equal(internalSubnetCIDR))
 
+	if providerConfig.Networks.ProxyOnly != nil {
+		subnetProxyOnly, err := computeService.Subnetworks.Get(project, *region, infra.Namespace+"-proxy-only").Context(ctx).Do()
+		Expect(err).NotTo(HaveOccurred())
+		Expect(subnetProxyOnly.Network).To(Equal(network.SelfLink))
+		Expect(subnetProxyOnly.IpCidrRange).To(Equal(*providerConfig.Networks.ProxyOnly))
+		Expect(subnetProxyOnly.Purpose).To(Equal("REGIONAL_MANAGED_PROXY"))
+		Expect(subnetProxyOnly.Role).To(Equal("ACTIVE"))
+
+		status := &gcpv1alpha1.InfrastructureStatus{}
+		Expect(json.Unmarshal(infra.Status.ProviderStatus.Raw, status)).To(Succeed())
+		Expect(status.Networks.Subnets).To(ContainElement(gcpv1alpha1.Subnet{
+			Name:    subnetProxyOnly.Name,
+			Purpose: gcpv1alpha1.PurposeProxyOnly,
+		}))
+	}
+
+	// private service access
+
+	if psa := providerConfig.Networks.PrivateServiceAccess; psa != nil {
+		psaRange, err := computeService.GlobalAddresses.Get(project, psa.Name).Context(ctx).Do()
+		Expect(err).NotTo(HaveOccurred())
+		Expect(psaRange.Network).To(Equal(network.SelfLink))
+		Expect(psaRange.Purpose).To(Equal("VPC_PEERING"))
+		Expect(psaRange.AddressType).To(Equal("INTERNAL"))
+		Expect(psaRange.Address).To(Equal(*psa.Address))
+		Expect(psaRange.PrefixLength).To(Equal(int64(psa.PrefixLength)))
+		Expect(network.Peerings).To(ContainElement(HaveField("Name", "servicenetworking-googleapis-com")))
+	}
+
 	// router
 
 	router, err := computeService.Routers.Get(project, *region, infra.Namespace+"-cloud-router").Context(ctx).Do()
 	Expect(err).NotTo(HaveOccurred())
 	Expect(router.Network).To(Equal(network.SelfLink))
-	Expect(router.Nats).To(HaveLen(1))
-
-	routerNAT := router.Nats[0]
-	Expect(routerNAT.Name).To(Equal(infra.Namespace + "-cloud-nat"))
-	Expect(routerNAT.SourceSubnetworkIpRangesToNat).To(Equal("LIST_OF_SUBNETWORKS"))
-	Expect(routerNAT.LogConfig.Enable).To(BeTrue())
-	Expect(routerNAT.LogConfig.Filter).To(Equal("ERRORS_ONLY"))
-	Expect(routerNAT.Subnetworks).To(HaveLen(1))
-	Expect(routerNAT.Subnetworks[0].Name).To(Equal(subnetNodes.SelfLink))
-	Expect(routerNAT.Subnetworks[0].SourceIpRangesToNat).To(Equal([]string{"ALL_IP_RANGES"}))
-
-	if cn := providerConfig.Networks.CloudNAT; cn != nil {
-		Expect(routerNAT.EnableDynamicPortAllocation).To(Equal(cn.EnableDynamicPortAllocation))
-		if cn.MinPortsPerVM != nil {
-			Expect(routerNAT.MinPortsPerVm).To(Equal(int64(*cn.MinPortsPerVM)))
-		} else {
-			Expect(routerNAT.MinPortsPerVm).To(Equal(int64(2048)))
-		}
-		if cn.MaxPortsPerVM != nil {
-			Expect(routerNAT.MaxPortsPerVm).To(Equal(int64(*cn.MaxPortsPerVM)))
+	if ptr.Deref(providerConfig.Networks.DisableCloudNAT, false) {
+		Expect(router.Nats).To(BeEmpty())
+	} else {
+		Expect(router.Nats).To(HaveLen(1))
+
+		routerNAT := router.Nats[0]
+		Expect(routerNAT.Name).To(Equal(infra.Namespace + "-cloud-nat"))
+		Expect(routerNAT.SourceSubnetworkIpRangesToNat).To(Equal("LIST_OF_SUBNETWORKS"))
+		Expect(routerNAT.LogConfig.Enable).To(BeTrue())
+		Expect(routerNAT.LogConfig.Filter).To(Equal("ERRORS_ONLY"))
+		Expect(routerNAT.Subnetworks).To(HaveLen(1))
+		Expect(routerNAT.Subnetworks[0].Name).To(Equal(subnetNodes.SelfLink))
+		Expect(routerNAT.Subnetworks[0].SourceIpRangesToNat).To(Equal([]string{"ALL_IP_RANGES"}))
+
+		if cn := providerConfig.Networks.CloudNAT; cn != nil {
+			Expect(routerNAT.EnableDynamicPortAllocation).To(Equal(cn.EnableDynamicPortAllocation))
+			Expect(routerNAT.IcmpIdleTimeoutSec).To(Equal(int64(ptr.Deref(cn.IcmpIdleTimeoutSec, 30))))
+			Expect(routerNAT.TcpEstablishedIdleTimeoutSec).To(Equal(int64(ptr.Deref(cn.TcpEstablishedIdleTimeoutSec, 1200))))
+			Expect(routerNAT.TcpTimeWaitTimeoutSec).To(Equal(int64(ptr.Deref(cn.TcpTimeWaitTimeoutSec, 120))))
+			Expect(routerNAT.TcpTransitoryIdleTimeoutSec).To(Equal(int64(ptr.Deref(cn.TcpTransitoryIdleTimeoutSec, 30))))
+			Expect(routerNAT.UdpIdleTimeoutSec).To(Equal(int64(ptr.Deref(cn.UdpIdleTimeoutSec, 30))))
+			if cn.EndpointIndependentMapping != nil {
+				Expect(routerNAT.EnableEndpointIndependentMapping).To(Equal(cn.EndpointIndependentMapping.Enabled))
+			} else {
+				Expect(routerNAT.EnableEndpointIndependentMapping).To(BeFalse())
+			}
+			if cn.MinPortsPerVM != nil {
+				Expect(routerNAT.MinPortsPerVm).To(Equal(int64(*cn.MinPortsPerVM)))
+			} else {
+				Expect(routerNAT.MinPortsPerVm).To(Equal(int64(2048)))
+			}
+			if cn.MaxPortsPerVM != nil {
+				Expect(routerNAT.MaxPortsPerVm).To(Equal(int64(*cn.MaxPortsPerVM)))
+			}
 		}
-	}
 
-	if providerConfig.Networks.CloudNAT != nil && len(providerConfig.Networks.CloudNAT.NatIPNames) > 0 {
-		Expect(routerNAT.NatIpAllocateOption).To(Equal("MANUAL_ONLY"))
-		Expect(routerNAT.NatIps).To(HaveLen(len(providerConfig.Networks.CloudNAT.NatIPNames)))
+		if providerConfig.Networks.CloudNAT != nil && len(providerConfig.Networks.CloudNAT.NatIPNames) > 0 {
+			Expect(routerNAT.NatIpAllocateOption).To(Equal("MANUAL_ONLY"))
+			Expect(routerNAT.NatIps).To(HaveLen(len(providerConfig.Networks.CloudNAT.NatIPNames)))
 
-		// ip addresses
-		var ipAddresses = make(map[string]bool)
-		for _, natIPName := range providerConfig.Networks.CloudNAT.NatIPNames {
-			address, err := computeService.Addresses.Get(project, *region, natIPName.Name).Context(ctx).Do()
-			Expect(err).NotTo(HaveOccurred())
-			ipAddresses[address.SelfLink] = true
-			// egress cidr
-			ipCIDR := fmt.Sprintf("%s/32", address.Address)
-			Expect(infra.Status.EgressCIDRs).Should(ContainElement(ipCIDR))
-		}
-		for _, natIP := range routerNAT.NatIps {
-			Expect(ipAddresses).Should(HaveKey(natIP))
+			// ip addresses
+			var ipAddresses = make(map[string]bool)
+			for _, natIPName := range providerConfig.Networks.CloudNAT.NatIPNames {
+				address, err := computeService.Addresses.Get(project, *region, natIPName.Name).Context(ctx).Do()
+				Expect(err).NotTo(HaveOccurred())
+				ipAddresses[address.SelfLink] = true
+				// egress cidr
+				ipCIDR := fmt.Sprintf("%s/32", address.Address)
+				Expect(infra.Status.EgressCIDRs).Should(ContainElement(ipCIDR))
+			}
+			for _, natIP := range routerNAT.NatIps {
+				Expect(ipAddresses).Should(HaveKey(natIP))
+			}
+		} else {
+			Expect(routerNAT.NatIpAllocateOption).To(Equal("AUTO_ONLY"))
 		}
-	} else {
-		Expect(routerNAT.NatIpAllocateOption).To(Equal("AUTO_ONLY"))
 	}
 
 	// firewalls
@@ -820,8 +1007,11 @@ func verifyCreation(
 	Expect(err).NotTo(HaveOccurred())
 
 	Expect(allowInternalAccess.Network).To(Equal(network.SelfLink))
-	Expect(allowInternalAccess.SourceRanges).To(HaveLen(3))
-	Expect(allowInternalAccess.SourceRanges).To(ConsistOf(workersSubnetCIDR, internalSubnetCIDR, podCIDR))
+	sourceRanges := []string{workersSubnetCIDR, internalSubnetCIDR, podCIDR}
+	if providerConfig.Networks.ProxyOnly != nil {
+		sourceRanges = append(sourceRanges, *providerConfig.Networks.ProxyOnly)
+	}
+	Expect(allowInternalAccess.SourceRanges).To(ConsistOf(sourceRanges))
 	Expect(allowInternalAccess.Priority).To(Equal(int64(1000)))
 	Expect(allowInternalAccess.Allowed).To(ConsistOf([]*computev1.FirewallAllowed{
 		{
@@ -851,10 +1041,14 @@ func verifyCreation(
 		"209.85.152.0/22",
 		"130.211.0.0/22",
 	}))
+	healthCheckPorts := []string{"30000-32767"}
+	if providerConfig.Networks.HealthChecks != nil {
+		healthCheckPorts = append(healthCheckPorts, providerConfig.Networks.HealthChecks.AdditionalPorts...)
+	}
 	Expect(allowHealthChecks.Allowed).To(ConsistOf([]*computev1.FirewallAllowed{
 		{
 			IPProtocol: "tcp",
-			Ports:      []string{"30000-32767"},
+			Ports:      healthCheckPorts,
 		},
 		{
 			IPProtocol: "udp",
@@ -892,6 +1086,16 @@ func verifyDeletion(
 	_, err = computeService.Subnetworks.Get(project, *region, infra.Namespace+"-internal").Context(ctx).Do()
 	Expect(err).To(BeNotFoundError())
 
+	_, err = computeService.Subnetworks.Get(project, *region, infra.Namespace+"-proxy-only").Context(ctx).Do()
+	Expect(err).To(BeNotFoundError())
+
+	// private service access
+
+	if psa := providerConfig.Networks.PrivateServiceAccess; psa != nil {
+		_, err = computeService.GlobalAddresses.Get(project, psa.Name).Context(ctx).Do()
+		Expect(err).To(BeNotFoundError())
+	}
+
 	// router
 
 	if providerConfig.Networks.VPC == nil || providerConfig.Networks.VPC.CloudRouter == nil {